@@ -124,10 +124,7 @@ func ChangefeedOperationMiddleware(operation string) gin.HandlerFunc {
 			info.changefeed = c.Param(api.APIOpVarChangefeedID)
 		}
 
-		username, _, _ := c.Request.BasicAuth()
-		if username == "" {
-			username = "anonymous"
-		}
+		username := GetRequestUsername(c)
 
 		var operationErr error
 		if lastError != nil {
@@ -165,6 +162,16 @@ func ChangefeedOperationMiddleware(operation string) gin.HandlerFunc {
 	}
 }
 
+// GetRequestUsername returns the basic auth username of the request, or
+// "anonymous" if the request does not carry one.
+func GetRequestUsername(c *gin.Context) string {
+	username, _, _ := c.Request.BasicAuth()
+	if username == "" {
+		username = "anonymous"
+	}
+	return username
+}
+
 // SetChangefeedOperationTarget attaches the logical changefeed identity that the
 // current mutation request targets.
 func SetChangefeedOperationTarget(c *gin.Context, keyspace, changefeed string) {
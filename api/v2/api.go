@@ -21,12 +21,13 @@ import (
 
 // OpenAPIV2 provides CDC v2 APIs
 type OpenAPIV2 struct {
-	server server.Server
+	server     server.Server
+	verifyJobs *verifyJobs
 }
 
 // NewOpenAPIV2 creates a new OpenAPIV2.
 func NewOpenAPIV2(c server.Server) OpenAPIV2 {
-	return OpenAPIV2{c}
+	return OpenAPIV2{server: c, verifyJobs: newVerifyJobs()}
 }
 
 // RegisterOpenAPIV2Routes registers routes for OpenAPI
@@ -71,6 +72,18 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	changefeedGroup.DELETE("/:changefeed_id", coordinatorMiddleware, middleware.ChangefeedOperationMiddleware("delete"), keyspaceCheckerMiddleware, authenticateMiddleware, api.DeleteChangefeed)
 	changefeedGroup.GET("/:changefeed_id/status", coordinatorMiddleware, keyspaceCheckerMiddleware, authenticateMiddleware, api.status)
 	changefeedGroup.GET("/:changefeed_id/synced", coordinatorMiddleware, keyspaceCheckerMiddleware, authenticateMiddleware, api.synced)
+	changefeedGroup.GET("/:changefeed_id/history", coordinatorMiddleware, keyspaceCheckerMiddleware, authenticateMiddleware, api.GetChangefeedConfigHistory)
+	changefeedGroup.POST("/:changefeed_id/rollback", coordinatorMiddleware, middleware.ChangefeedOperationMiddleware("rollback"), keyspaceCheckerMiddleware, authenticateMiddleware, api.RollbackChangefeed)
+	changefeedGroup.POST("/:changefeed_id/filter_preview", coordinatorMiddleware, keyspaceCheckerMiddleware, authenticateMiddleware, api.PreviewChangefeedFilter)
+	changefeedGroup.POST("/:changefeed_id/verify", coordinatorMiddleware, keyspaceCheckerMiddleware, authenticateMiddleware, api.VerifyChangefeed)
+	changefeedGroup.GET("/:changefeed_id/verify", coordinatorMiddleware, keyspaceCheckerMiddleware, authenticateMiddleware, api.GetVerifyChangefeedJob)
+	changefeedGroup.DELETE("/:changefeed_id/verify", coordinatorMiddleware, keyspaceCheckerMiddleware, authenticateMiddleware, api.CancelVerifyChangefeedJob)
+	changefeedGroup.GET("/:changefeed_id/dead_letters", coordinatorMiddleware, keyspaceCheckerMiddleware, authenticateMiddleware, api.ListDeadLetters)
+	changefeedGroup.POST("/:changefeed_id/dead_letters/replay", coordinatorMiddleware, keyspaceCheckerMiddleware, authenticateMiddleware, api.ReplayDeadLetters)
+	// the ddl approval requests are forwarded to the node of the maintainer by the handlers
+	changefeedGroup.GET("/:changefeed_id/pending_ddls", keyspaceCheckerMiddleware, authenticateMiddleware, api.ListPendingDDLs)
+	changefeedGroup.POST("/:changefeed_id/ddl/:commit_ts/approve", keyspaceCheckerMiddleware, authenticateMiddleware, api.ApproveDDL)
+	changefeedGroup.POST("/:changefeed_id/ddl/:commit_ts/skip", keyspaceCheckerMiddleware, authenticateMiddleware, api.SkipDDL)
 
 	// internal APIs
 	changefeedGroup.POST("/:changefeed_id/move_table", keyspaceCheckerMiddleware, authenticateMiddleware, api.MoveTable)
@@ -80,6 +93,22 @@ func RegisterOpenAPIV2Routes(router *gin.Engine, api OpenAPIV2) {
 	changefeedGroup.GET("/:changefeed_id/get_dispatcher_count", keyspaceCheckerMiddleware, api.getDispatcherCount)
 	changefeedGroup.GET("/:changefeed_id/tables", keyspaceCheckerMiddleware, api.ListTables)
 
+	// changefeed template apis
+	templateGroup := v2.Group("/changefeed_templates")
+	templateGroup.Use(coordinatorMiddleware, authenticateMiddleware)
+	templateGroup.GET("", api.ListChangefeedTemplates)
+	templateGroup.GET("/:template_name", api.GetChangefeedTemplate)
+	templateGroup.POST("", api.CreateChangefeedTemplate)
+	templateGroup.PUT("/:template_name", api.UpdateChangefeedTemplate)
+	templateGroup.DELETE("/:template_name", api.DeleteChangefeedTemplate)
+
+	// bulk changefeed apis
+	bulkGroup := v2.Group("/changefeed_bulk")
+	bulkGroup.POST("/create", coordinatorMiddleware, middleware.ChangefeedOperationMiddleware("bulk_create"), keyspaceCheckerMiddleware, authenticateMiddleware, api.BulkCreateChangefeeds)
+	bulkGroup.POST("/update", coordinatorMiddleware, middleware.ChangefeedOperationMiddleware("bulk_update"), keyspaceCheckerMiddleware, authenticateMiddleware, api.BulkUpdateChangefeeds)
+	bulkGroup.POST("/pause", coordinatorMiddleware, middleware.ChangefeedOperationMiddleware("bulk_pause"), keyspaceCheckerMiddleware, authenticateMiddleware, api.BulkPauseChangefeeds)
+	bulkGroup.POST("/resume", coordinatorMiddleware, middleware.ChangefeedOperationMiddleware("bulk_resume"), keyspaceCheckerMiddleware, authenticateMiddleware, api.BulkResumeChangefeeds)
+
 	// capture apis
 	captureGroup := v2.Group("/captures")
 	captureGroup.Use(coordinatorMiddleware)
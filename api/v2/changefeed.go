@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/ticdc/downstreamadapter/sink/columnselector"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/eventrouter"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/helper"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/transformer"
 	"github.com/pingcap/ticdc/logservice/schemastore"
 	"github.com/pingcap/ticdc/pkg/api"
 	"github.com/pingcap/ticdc/pkg/check"
@@ -43,6 +44,7 @@ import (
 	"github.com/pingcap/ticdc/pkg/keyspace"
 	"github.com/pingcap/ticdc/pkg/node"
 	"github.com/pingcap/ticdc/pkg/routing"
+	"github.com/pingcap/ticdc/pkg/server"
 	"github.com/pingcap/ticdc/pkg/txnutil/gc"
 	"github.com/pingcap/ticdc/pkg/util"
 	"github.com/pingcap/ticdc/pkg/version"
@@ -138,26 +140,73 @@ func (h *OpenAPIV2) CreateChangefeed(c *gin.Context) {
 		return
 	}
 
-	_, status, err := co.GetChangefeed(ctx, common.NewChangeFeedDisplayName(cfg.ID, cfg.Keyspace))
-	if err != nil && errors.ErrChangeFeedNotExists.NotEqual(err) {
+	info, err := h.verifyCreateChangefeedConfig(ctx, co, cfg, changefeedID, keyspaceMeta)
+	middleware.SetChangefeedOperationDetails(c, fmt.Sprintf(
+		"start_ts=%d target_ts=%d sink_scheme=%s", cfg.StartTs, cfg.TargetTs, sinkSchemeForDetails(cfg.SinkURI)))
+	if err != nil {
 		_ = c.Error(err)
 		return
 	}
-	if status != nil {
-		err = errors.ErrChangeFeedAlreadyExists.GenWithStackByArgs(cfg.ID)
+
+	err = co.CreateChangefeed(ctx, info)
+	if err != nil {
+		h.undoEnsureCreateStartTsSafety(info.KeyspaceID, info.ChangefeedID, info.StartTs)
 		_ = c.Error(err)
 		return
 	}
 
+	log.Info("create changefeed successfully",
+		zap.String("changefeedID", info.ChangefeedID.Name()),
+		zap.String("state", string(info.State)),
+		zap.String("changefeedInfo", info.String()),
+	)
+
+	c.JSON(getStatus(c), CfInfoToAPIModel(
+		info,
+		&config.ChangeFeedStatus{
+			CheckpointTs: info.StartTs,
+		},
+		nil,
+	))
+}
+
+// sinkSchemeForDetails returns the scheme of the sink uri recorded in the
+// changefeed operation details, it never exposes the other parts of the uri.
+func sinkSchemeForDetails(sinkURI string) string {
+	sinkURIParsed, err := url.Parse(sinkURI)
+	if err != nil {
+		return ""
+	}
+	return sinkURIParsed.Scheme
+}
+
+// verifyCreateChangefeedConfig verifies the config of a new changefeed and
+// returns the changefeed info to be created, it's shared by the create and the
+// bulk create apis. The start ts of the changefeed is protected from gc when it
+// returns successfully, the caller must undo the protection by
+// undoEnsureCreateStartTsSafety if the changefeed is not created at last.
+func (h *OpenAPIV2) verifyCreateChangefeedConfig(
+	ctx context.Context,
+	co server.Coordinator,
+	cfg *ChangefeedConfig,
+	changefeedID common.ChangeFeedID,
+	keyspaceMeta *keyspacepb.KeyspaceMeta,
+) (*config.ChangeFeedInfo, error) {
+	_, status, err := co.GetChangefeed(ctx, changefeedID.DisplayName)
+	if err != nil && errors.ErrChangeFeedNotExists.NotEqual(err) {
+		return nil, err
+	}
+	if status != nil {
+		return nil, errors.ErrChangeFeedAlreadyExists.GenWithStackByArgs(changefeedID.Name())
+	}
+
 	if cfg.SinkURI == "" {
-		_ = c.Error(errors.ErrSinkURIInvalid.GenWithStackByArgs(
-			"sink_uri is empty, cannot create a changefeed without sink_uri"))
-		return
+		return nil, errors.ErrSinkURIInvalid.GenWithStackByArgs(
+			"sink_uri is empty, cannot create a changefeed without sink_uri")
 	}
 	sinkURIParsed, err := url.Parse(cfg.SinkURI)
 	if err != nil {
-		_ = c.Error(genSinkURIInvalidError(cfg.SinkURI, err))
-		return
+		return nil, genSinkURIInvalidError(cfg.SinkURI, err)
 	}
 
 	scheme := sinkURIParsed.Scheme
@@ -165,40 +214,43 @@ func (h *OpenAPIV2) CreateChangefeed(c *gin.Context) {
 	if config.IsMQScheme(scheme) {
 		topic, err = helper.GetTopic(sinkURIParsed)
 		if err != nil {
-			_ = c.Error(errors.WrapError(errors.ErrSinkURIInvalid, err, maskSinkURIForError(cfg.SinkURI)))
-			return
+			return nil, errors.WrapError(errors.ErrSinkURIInvalid, err, maskSinkURIForError(cfg.SinkURI))
 		}
 	}
 
 	ts, logical, err := h.server.GetPdClient().GetTS(ctx)
 	if err != nil {
-		_ = c.Error(errors.ErrPDEtcdAPIError.GenWithStackByArgs("fail to get ts from pd client"))
-		return
+		return nil, errors.ErrPDEtcdAPIError.GenWithStackByArgs("fail to get ts from pd client")
 	}
 	currentTSO := oracle.ComposeTS(ts, logical)
 	// verify start ts
 	if cfg.StartTs == 0 {
 		cfg.StartTs = currentTSO
 	} else if cfg.StartTs > currentTSO {
-		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack(
-			"invalid start-ts %v, larger than current tso %v", cfg.StartTs, currentTSO))
-		return
+		return nil, errors.ErrAPIInvalidParam.GenWithStack(
+			"invalid start-ts %v, larger than current tso %v", cfg.StartTs, currentTSO)
 	}
 
 	// verify target ts
 	if cfg.TargetTs > 0 && cfg.TargetTs <= cfg.StartTs {
-		_ = c.Error(errors.ErrTargetTsBeforeStartTs.GenWithStackByArgs(cfg.TargetTs, cfg.StartTs))
-		return
+		return nil, errors.ErrTargetTsBeforeStartTs.GenWithStackByArgs(cfg.TargetTs, cfg.StartTs)
+	}
+
+	// verify stop policy
+	var stopPolicy *config.StopPolicy
+	if cfg.StopPolicy != nil {
+		stopPolicy = cfg.StopPolicy.ToInternalStopPolicy()
+		if err = stopPolicy.Validate(cfg.StartTs); err != nil {
+			return nil, err
+		}
+		stopPolicy.Arm(time.Now())
 	}
-	middleware.SetChangefeedOperationDetails(c, fmt.Sprintf(
-		"start_ts=%d target_ts=%d sink_scheme=%s", cfg.StartTs, cfg.TargetTs, scheme))
 
 	// fill replicaConfig
 	replicaCfg := cfg.ReplicaConfig.ToInternalReplicaConfig()
 	err = replicaCfg.ValidateAndAdjust(sinkURIParsed)
 	if err != nil {
-		_ = c.Error(errors.WrapError(errors.ErrInvalidReplicaConfig, err))
-		return
+		return nil, errors.WrapError(errors.ErrInvalidReplicaConfig, err)
 	}
 
 	// do not shadow err after this point
@@ -210,8 +262,7 @@ func (h *OpenAPIV2) CreateChangefeed(c *gin.Context) {
 	}
 	err = check.ValidateActiveActiveTSOIndexes(ctx, pdClient, tmpInfo.ToChangefeedConfig())
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 
 	// Ensure the start ts is valid in the next 3600 seconds, aka 1 hour
@@ -219,46 +270,28 @@ func (h *OpenAPIV2) CreateChangefeed(c *gin.Context) {
 	createGcServiceID := h.server.GetEtcdClient().GetEnsureGCServiceID(gc.EnsureGCServiceCreating)
 	if err = gc.EnsureChangefeedStartTsSafety(
 		ctx,
-		h.server.GetPdClient(),
+		pdClient,
 		createGcServiceID,
 		keyspaceMeta.Id,
 		changefeedID,
 		ensureTTL, cfg.StartTs); err != nil {
 		if !errors.ErrStartTsBeforeGC.Equal(err) {
-			_ = c.Error(errors.ErrPDEtcdAPIError.Wrap(err))
-			return
+			return nil, errors.ErrPDEtcdAPIError.Wrap(err)
 		}
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 
 	defer func() {
-		if err == nil {
-			return
-		}
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		undoErr := gc.UndoEnsureChangefeedStartTsSafety(
-			ctx,
-			pdClient,
-			keyspaceMeta.Id,
-			createGcServiceID,
-			changefeedID,
-		)
-		if undoErr != nil {
-			log.Warn("undo ensure changefeed start ts safety failed when creating the changefeed",
-				zap.String("changefeedID", changefeedID.Name()), zap.Uint64("startTs", cfg.StartTs),
-				zap.String("gcServiceID", createGcServiceID),
-				zap.Error(undoErr))
+		if err != nil {
+			h.undoEnsureCreateStartTsSafety(keyspaceMeta.Id, changefeedID, cfg.StartTs)
 		}
-		cancel()
 	}()
 
 	var kvStorage kv.Storage
 	keyspaceManager := appcontext.GetService[keyspace.Manager](appcontext.KeyspaceManager)
-	kvStorage, err = keyspaceManager.GetStorage(ctx, keyspaceName)
+	kvStorage, err = keyspaceManager.GetStorage(ctx, changefeedID.Keyspace())
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 
 	schemaStore := appcontext.GetService[schemastore.SchemaStore](appcontext.SchemaStore)
@@ -271,8 +304,7 @@ func (h *OpenAPIV2) CreateChangefeed(c *gin.Context) {
 		ID:   keyspaceMeta.Id,
 		Name: keyspaceMeta.Name,
 	}); err != nil {
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 
 	var (
@@ -283,14 +315,12 @@ func (h *OpenAPIV2) CreateChangefeed(c *gin.Context) {
 	protocol, _ := config.ParseSinkProtocolFromString(util.GetOrZero(replicaCfg.Sink.Protocol))
 	ineligibleTables, eligibleTables, allTables, err = getVerifiedTables(ctx, changefeedID, replicaCfg, kvStorage, cfg.StartTs, scheme, topic, protocol)
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 	if !util.GetOrZero(replicaCfg.ForceReplicate) && !util.GetOrZero(cfg.ReplicaConfig.IgnoreIneligibleTable) {
 		if len(ineligibleTables) != 0 {
 			err = errors.ErrTableIneligible.GenWithStackByArgs(ineligibleTables)
-			_ = c.Error(err)
-			return
+			return nil, err
 		}
 	}
 
@@ -301,6 +331,7 @@ func (h *OpenAPIV2) CreateChangefeed(c *gin.Context) {
 		CreateTime:     time.Now(),
 		StartTs:        cfg.StartTs,
 		TargetTs:       cfg.TargetTs,
+		StopPolicy:     stopPolicy,
 		Config:         replicaCfg,
 		State:          config.StateNormal,
 		CreatorVersion: version.ReleaseVersion,
@@ -312,43 +343,49 @@ func (h *OpenAPIV2) CreateChangefeed(c *gin.Context) {
 	// Reject a changefeed if the downstream is the same TiDB logical cluster as the upstream.
 	isSame, err := check.IsSameUpstreamDownstream(ctx, pdClient, cfConfig)
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return nil, err
 	}
 	if isSame {
-		_ = c.Error(errors.ErrSameUpstreamDownstream.GenWithStack(
+		err = errors.ErrSameUpstreamDownstream.GenWithStack(
 			"TiCDC does not support creating a changefeed with the same TiDB cluster " +
-				"as both the source and the target for the changefeed."))
-		return
+				"as both the source and the target for the changefeed.")
+		return nil, err
 	}
 	err = sink.Verify(ctx, cfConfig, changefeedID)
 	if err != nil {
-		_ = c.Error(errors.WrapError(errors.ErrSinkURIInvalid, err, maskSinkURIForError(cfg.SinkURI)))
-		return
+		err = errors.WrapError(errors.ErrSinkURIInvalid, err, maskSinkURIForError(cfg.SinkURI))
+		return nil, err
 	}
 
-	err = co.CreateChangefeed(ctx, info)
-	if err != nil {
-		_ = c.Error(err)
-		return
-	}
-
-	log.Info("create changefeed successfully",
-		zap.String("changefeedID", info.ChangefeedID.Name()),
-		zap.String("state", string(info.State)),
-		zap.String("changefeedInfo", info.String()),
+	log.Info("verify changefeed config successfully",
+		zap.String("changefeedID", changefeedID.Name()),
+		zap.Uint64("startTs", cfg.StartTs),
 		zap.Int("eligibleTablesLength", len(eligibleTables)),
 		zap.Int("ineligibleTablesLength", len(ineligibleTables)),
 		zap.Int("allTablesLength", len(allTables)),
 	)
+	return info, nil
+}
 
-	c.JSON(getStatus(c), CfInfoToAPIModel(
-		info,
-		&config.ChangeFeedStatus{
-			CheckpointTs: info.StartTs,
-		},
-		nil,
-	))
+// undoEnsureCreateStartTsSafety removes the gc protection of the start ts of a
+// changefeed which is verified but not created.
+func (h *OpenAPIV2) undoEnsureCreateStartTsSafety(keyspaceID uint32, changefeedID common.ChangeFeedID, startTs uint64) {
+	createGcServiceID := h.server.GetEtcdClient().GetEnsureGCServiceID(gc.EnsureGCServiceCreating)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	undoErr := gc.UndoEnsureChangefeedStartTsSafety(
+		ctx,
+		h.server.GetPdClient(),
+		keyspaceID,
+		createGcServiceID,
+		changefeedID,
+	)
+	if undoErr != nil {
+		log.Warn("undo ensure changefeed start ts safety failed when creating the changefeed",
+			zap.String("changefeedID", changefeedID.Name()), zap.Uint64("startTs", startTs),
+			zap.String("gcServiceID", createGcServiceID),
+			zap.Error(undoErr))
+	}
 }
 
 // ListChangeFeeds lists all changefeeds in cdc cluster
@@ -597,6 +634,7 @@ func CfInfoToAPIModel(
 		CreateTime:     info.CreateTime,
 		StartTs:        info.StartTs,
 		TargetTs:       info.TargetTs,
+		StopPolicy:     ToAPIStopPolicy(info.StopPolicy),
 		AdminJobType:   info.AdminJobType,
 		Config:         ToAPIReplicaConfig(info.Config),
 		State:          info.State,
@@ -912,7 +950,7 @@ func (h *OpenAPIV2) ResumeChangefeed(c *gin.Context) {
 
 // UpdateChangefeed handles update changefeed request,
 // it returns the updated changefeedInfo
-// Can only update a changefeed's: TargetTs, SinkURI,
+// Can only update a changefeed's: TargetTs, StopPolicy, SinkURI,
 // ReplicaConfig, PDAddrs, CAPath, CertPath, KeyPath,
 // SyncPointEnabled, SyncPointInterval
 // UpdateChangefeed updates a changefeed
@@ -930,8 +968,6 @@ func (h *OpenAPIV2) ResumeChangefeed(c *gin.Context) {
 func (h *OpenAPIV2) UpdateChangefeed(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	keyspaceName := GetKeyspaceValueWithDefault(c)
-
 	keyspaceMeta := middleware.GetKeyspaceFromContext(c)
 
 	if keyspaceMeta.State != keyspacepb.KeyspaceState_ENABLED {
@@ -980,7 +1016,7 @@ func (h *OpenAPIV2) UpdateChangefeed(c *gin.Context) {
 		return
 	}
 
-	var configUpdated, sinkURIUpdated, targetTsUpdated bool
+	var configUpdated, sinkURIUpdated, targetTsUpdated, stopPolicyUpdated bool
 	if updateCfConfig.TargetTs != 0 {
 		if updateCfConfig.TargetTs <= oldCfInfo.StartTs {
 			_ = c.Error(errors.ErrChangefeedUpdateRefused.GenWithStack(
@@ -991,6 +1027,19 @@ func (h *OpenAPIV2) UpdateChangefeed(c *gin.Context) {
 		oldCfInfo.TargetTs = updateCfConfig.TargetTs
 		targetTsUpdated = true
 	}
+	if updateCfConfig.StopPolicy != nil {
+		stopPolicyUpdated = true
+		oldCfInfo.StopPolicy = nil
+		if !updateCfConfig.StopPolicy.IsEmpty() {
+			stopPolicy := updateCfConfig.StopPolicy.ToInternalStopPolicy()
+			if err = stopPolicy.Validate(oldCfInfo.StartTs); err != nil {
+				_ = c.Error(err)
+				return
+			}
+			stopPolicy.Arm(time.Now())
+			oldCfInfo.StopPolicy = stopPolicy
+		}
+	}
 	if updateCfConfig.ReplicaConfig != nil {
 		configUpdated = true
 		oldCfInfo.Config = updateCfConfig.ReplicaConfig.ToInternalReplicaConfig()
@@ -1004,25 +1053,51 @@ func (h *OpenAPIV2) UpdateChangefeed(c *gin.Context) {
 		return
 	}
 	middleware.SetChangefeedOperationDetails(c, fmt.Sprintf(
-		"target_ts_changed=%t sink_uri_changed=%t replica_config_changed=%t",
-		targetTsUpdated, sinkURIUpdated, configUpdated))
+		"target_ts_changed=%t sink_uri_changed=%t replica_config_changed=%t stop_policy_changed=%t",
+		targetTsUpdated, sinkURIUpdated, configUpdated, stopPolicyUpdated))
 
-	var (
-		ineligibleTables []common.TableName
-		eligibleTables   []common.TableName
-		allTables        []common.TableName
+	if err = verifyUpdateChangefeedConfig(ctx, h.server.GetPdClient(), oldCfInfo, status.CheckpointTs, configUpdated || sinkURIUpdated); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	revision := &config.ChangefeedConfigRevision{Author: middleware.GetRequestUsername(c)}
+	if err = co.UpdateChangefeed(ctx, oldCfInfo, revision); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	log.Info("Update changefeed successfully",
+		zap.String("id", oldCfInfo.ChangefeedID.Name()),
+		zap.String("state", string(oldCfInfo.State)),
+		zap.String("changefeedInfo", oldCfInfo.String()),
+		zap.Bool("configUpdated", configUpdated),
+		zap.Bool("sinkURIUpdated", sinkURIUpdated),
+		zap.Uint64("configRevision", revision.Revision),
 	)
-	if configUpdated || sinkURIUpdated {
+
+	c.JSON(getStatus(c), CfInfoToAPIModel(oldCfInfo, status, nil))
+}
+
+// verifyUpdateChangefeedConfig verifies the updated changefeed info, the tables
+// are verified only if the replica config or the sink uri is changed. It's shared
+// by the update and the bulk update apis.
+func verifyUpdateChangefeedConfig(
+	ctx context.Context,
+	pdClient pd.Client,
+	info *config.ChangeFeedInfo,
+	checkpointTs uint64,
+	verifyTables bool,
+) error {
+	if verifyTables {
 		// verify replicaConfig
-		sinkURIParsed, err := url.Parse(oldCfInfo.SinkURI)
+		sinkURIParsed, err := url.Parse(info.SinkURI)
 		if err != nil {
-			_ = c.Error(genSinkURIInvalidError(oldCfInfo.SinkURI, err))
-			return
+			return genSinkURIInvalidError(info.SinkURI, err)
 		}
-		err = oldCfInfo.Config.ValidateAndAdjust(sinkURIParsed)
+		err = info.Config.ValidateAndAdjust(sinkURIParsed)
 		if err != nil {
-			_ = c.Error(errors.WrapError(errors.ErrInvalidReplicaConfig, err))
-			return
+			return errors.WrapError(errors.ErrInvalidReplicaConfig, err)
 		}
 
 		scheme := sinkURIParsed.Scheme
@@ -1030,77 +1105,58 @@ func (h *OpenAPIV2) UpdateChangefeed(c *gin.Context) {
 		if config.IsMQScheme(scheme) {
 			topic, err = helper.GetTopic(sinkURIParsed)
 			if err != nil {
-				_ = c.Error(errors.WrapError(errors.ErrSinkURIInvalid, err, maskSinkURIForError(oldCfInfo.SinkURI)))
-				return
+				return errors.WrapError(errors.ErrSinkURIInvalid, err, maskSinkURIForError(info.SinkURI))
 			}
 		}
-		protocol, _ := config.ParseSinkProtocolFromString(util.GetOrZero(oldCfInfo.Config.Sink.Protocol))
+		protocol, _ := config.ParseSinkProtocolFromString(util.GetOrZero(info.Config.Sink.Protocol))
 
 		keyspaceManager := appcontext.GetService[keyspace.Manager](appcontext.KeyspaceManager)
 
-		kvStorage, err := keyspaceManager.GetStorage(ctx, keyspaceName)
+		kvStorage, err := keyspaceManager.GetStorage(ctx, info.ChangefeedID.Keyspace())
 		if err != nil {
-			_ = c.Error(err)
-			return
+			return err
 		}
 
 		// use checkpointTs get snapshot from kv storage
-		ineligibleTables, eligibleTables, allTables, err = getVerifiedTables(ctx, oldCfInfo.ChangefeedID, oldCfInfo.Config, kvStorage, status.CheckpointTs, scheme, topic, protocol)
+		ineligibleTables, eligibleTables, allTables, err := getVerifiedTables(ctx, info.ChangefeedID, info.Config, kvStorage, checkpointTs, scheme, topic, protocol)
 		if err != nil {
-			_ = c.Error(errors.ErrChangefeedUpdateRefused.GenWithStackByCause(err))
-			return
+			return errors.ErrChangefeedUpdateRefused.GenWithStackByCause(err)
 		}
-		if !util.GetOrZero(oldCfInfo.Config.ForceReplicate) && !util.GetOrZero(oldCfInfo.Config.IgnoreIneligibleTable) {
+		if !util.GetOrZero(info.Config.ForceReplicate) && !util.GetOrZero(info.Config.IgnoreIneligibleTable) {
 			if len(ineligibleTables) != 0 {
-				_ = c.Error(errors.ErrTableIneligible.GenWithStackByArgs(ineligibleTables))
-				return
+				return errors.ErrTableIneligible.GenWithStackByArgs(ineligibleTables)
 			}
 		}
+		log.Info("verify updated changefeed tables successfully",
+			zap.String("changefeedID", info.ChangefeedID.Name()),
+			zap.Int("eligibleTablesLength", len(eligibleTables)),
+			zap.Int("ineligibleTablesLength", len(ineligibleTables)),
+			zap.Int("allTables", len(allTables)),
+		)
 	}
 
 	// verify sink
 	// Reject a changefeed if the downstream is the same TiDB logical cluster as the upstream.
-	isSame, err := check.IsSameUpstreamDownstream(ctx, h.server.GetPdClient(), oldCfInfo.ToChangefeedConfig())
+	isSame, err := check.IsSameUpstreamDownstream(ctx, pdClient, info.ToChangefeedConfig())
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return err
 	}
 	if isSame {
-		_ = c.Error(errors.ErrSameUpstreamDownstream.GenWithStack(
+		return errors.ErrSameUpstreamDownstream.GenWithStack(
 			"TiCDC does not support updating a changefeed with the same TiDB cluster " +
-				"as both the source and the target for the changefeed."))
-		return
+				"as both the source and the target for the changefeed.")
 	}
 
-	err = check.ValidateActiveActiveTSOIndexes(ctx, h.server.GetPdClient(), oldCfInfo.ToChangefeedConfig())
+	err = check.ValidateActiveActiveTSOIndexes(ctx, pdClient, info.ToChangefeedConfig())
 	if err != nil {
-		_ = c.Error(err)
-		return
+		return err
 	}
 
-	err = sink.Verify(ctx, oldCfInfo.ToChangefeedConfig(), oldCfInfo.ChangefeedID)
+	err = sink.Verify(ctx, info.ToChangefeedConfig(), info.ChangefeedID)
 	if err != nil {
-		_ = c.Error(errors.WrapError(errors.ErrSinkURIInvalid, err, maskSinkURIForError(oldCfInfo.SinkURI)))
-		return
+		return errors.WrapError(errors.ErrSinkURIInvalid, err, maskSinkURIForError(info.SinkURI))
 	}
-
-	if err = co.UpdateChangefeed(ctx, oldCfInfo); err != nil {
-		_ = c.Error(err)
-		return
-	}
-
-	log.Info("Update changefeed successfully",
-		zap.String("id", oldCfInfo.ChangefeedID.Name()),
-		zap.String("state", string(oldCfInfo.State)),
-		zap.String("changefeedInfo", oldCfInfo.String()),
-		zap.Bool("configUpdated", configUpdated),
-		zap.Bool("sinkURIUpdated", sinkURIUpdated),
-		zap.Int("eligibleTablesLength", len(eligibleTables)),
-		zap.Int("ineligibleTablesLength", len(ineligibleTables)),
-		zap.Int("allTables", len(allTables)),
-	)
-
-	c.JSON(getStatus(c), CfInfoToAPIModel(oldCfInfo, status, nil))
+	return nil
 }
 
 func validateResumeChangefeedState(state config.FeedState) error {
@@ -1673,8 +1729,7 @@ func (h *OpenAPIV2) synced(c *gin.Context) {
 
 	// If physcialNow - lastSyncedTs > SyncedCheckInterval && physcialNow - CheckpointTs < CheckpointInterval
 	//         --> reach strict synced status
-	if (ts-oracle.ExtractPhysical(status.LastSyncedTs) > syncedCheckInterval*1000) &&
-		(ts-oracle.ExtractPhysical(status.CheckpointTs) < checkpointInterval*1000) {
+	if info.Config.SyncedStatus.IsSynced(ts, status.CheckpointTs, status.LastSyncedTs) {
 		c.JSON(http.StatusOK, SyncedStatus{
 			Synced:           true,
 			SinkCheckpointTs: api.JSONTime(oracle.GetTimeFromTS(status.CheckpointTs)),
@@ -1766,6 +1821,14 @@ func verifyTablesForSink(
 	protocol config.Protocol,
 	tableInfos []*common.TableInfo,
 ) error {
+	transformers, err := transformer.New(replicaConfig.Sink, "")
+	if err != nil {
+		return err
+	}
+	if err = transformers.VerifyTables(tableInfos); err != nil {
+		return err
+	}
+
 	if config.IsStorageScheme(scheme) {
 		selectors, err := columnselector.New(replicaConfig.Sink)
 		if err != nil {
@@ -1778,7 +1841,7 @@ func verifyTablesForSink(
 		return nil
 	}
 
-	isAvroLike := protocol == config.ProtocolAvro || protocol == config.ProtocolDebeziumAvro
+	isAvroLike := protocol.IsSchemaRegistryBased()
 	eventRouter, err := eventrouter.NewEventRouter(replicaConfig.Sink, topic, config.IsPulsarScheme(scheme), isAvroLike)
 	if err != nil {
 		return err
@@ -0,0 +1,458 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/kvproto/pkg/keyspacepb"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/api/middleware"
+	"github.com/pingcap/ticdc/pkg/check"
+	"github.com/pingcap/ticdc/pkg/common"
+	appcontext "github.com/pingcap/ticdc/pkg/common/context"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/config/kerneltype"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/keyspace"
+	"github.com/pingcap/ticdc/pkg/server"
+	"github.com/pingcap/ticdc/pkg/txnutil/gc"
+	"go.uber.org/zap"
+)
+
+// bulkChangefeedOp is the operation on a changefeed in a bulk request. All
+// operations are verified before any changefeed is changed, and the applied
+// operations are undone if a later one fails, so a bulk request is atomic.
+type bulkChangefeedOp struct {
+	id common.ChangeFeedID
+	// apply changes the changefeed, it's nil if the changefeed is already in the
+	// expected state.
+	apply func(ctx context.Context) error
+	// undo reverts the applied change, it's nil if the change is not revertible.
+	undo func(ctx context.Context) error
+	// release releases the resources held by the verification if the operation
+	// is not applied or is rolled back, such as the gc protection of the start ts.
+	release func()
+}
+
+// bulkPrepareFunc verifies an item of a bulk request and returns the operation on it.
+type bulkPrepareFunc func(
+	ctx context.Context,
+	id common.ChangeFeedID,
+	keyspaceMeta *keyspacepb.KeyspaceMeta,
+	item BulkChangefeedItem,
+) (*bulkChangefeedOp, error)
+
+// BulkCreateChangefeeds creates changefeeds from a template
+// @Summary Create changefeeds in bulk
+// @Description create changefeeds from a template atomically, either all changefeeds are created or none of them
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param bulk body BulkChangefeedConfig true "bulk changefeed config"
+// @Param keyspace query string false "default"
+// @Success 200 {object} BulkChangefeedResponse
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeed_bulk/create [post]
+func (h *OpenAPIV2) BulkCreateChangefeeds(c *gin.Context) {
+	h.handleBulkChangefeeds(c, true, func(co server.Coordinator, cfg *BulkChangefeedConfig, tpl *config.ChangefeedTemplate) bulkPrepareFunc {
+		return func(
+			ctx context.Context, id common.ChangeFeedID, keyspaceMeta *keyspacepb.KeyspaceMeta, item BulkChangefeedItem,
+		) (*bulkChangefeedOp, error) {
+			sinkURI, err := tpl.RenderSinkURI(id.Name(), id.Keyspace(), item.Variables)
+			if err != nil {
+				return nil, err
+			}
+			cfConfig := &ChangefeedConfig{
+				Keyspace:      id.Keyspace(),
+				ID:            id.Name(),
+				StartTs:       cfg.StartTs,
+				TargetTs:      cfg.TargetTs,
+				SinkURI:       sinkURI,
+				ReplicaConfig: ToAPIReplicaConfig(tpl.Config),
+			}
+			info, err := h.verifyCreateChangefeedConfig(ctx, co, cfConfig, id, keyspaceMeta)
+			if err != nil {
+				return nil, err
+			}
+			return &bulkChangefeedOp{
+				id: id,
+				apply: func(ctx context.Context) error {
+					return co.CreateChangefeed(ctx, info)
+				},
+				undo: func(ctx context.Context) error {
+					_, err := co.RemoveChangefeed(ctx, info.ChangefeedID)
+					return err
+				},
+				release: func() {
+					h.undoEnsureCreateStartTsSafety(info.KeyspaceID, info.ChangefeedID, info.StartTs)
+				},
+			}, nil
+		}
+	})
+}
+
+// BulkUpdateChangefeeds applies a template to existing changefeeds
+// @Summary Update changefeeds in bulk
+// @Description apply the sink uri and the replica config of a template to stopped or failed changefeeds atomically
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param bulk body BulkChangefeedConfig true "bulk changefeed config"
+// @Param keyspace query string false "default"
+// @Success 200 {object} BulkChangefeedResponse
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeed_bulk/update [post]
+func (h *OpenAPIV2) BulkUpdateChangefeeds(c *gin.Context) {
+	author := middleware.GetRequestUsername(c)
+	h.handleBulkChangefeeds(c, true, func(co server.Coordinator, cfg *BulkChangefeedConfig, tpl *config.ChangefeedTemplate) bulkPrepareFunc {
+		return func(
+			ctx context.Context, id common.ChangeFeedID, _ *keyspacepb.KeyspaceMeta, item BulkChangefeedItem,
+		) (*bulkChangefeedOp, error) {
+			if cfg.StartTs != 0 {
+				return nil, errors.ErrAPIInvalidParam.GenWithStack("start_ts can not be updated")
+			}
+			info, status, err := co.GetChangefeed(ctx, id.DisplayName)
+			if err != nil {
+				return nil, err
+			}
+			switch info.State {
+			case config.StateStopped, config.StateFailed:
+			default:
+				return nil, errors.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+					"can only update changefeed config when it is stopped or failed")
+			}
+			origin, err := info.Clone()
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			if cfg.TargetTs != 0 {
+				if cfg.TargetTs <= info.StartTs {
+					return nil, errors.ErrChangefeedUpdateRefused.GenWithStack(
+						"can not update target_ts:%d less than start_ts:%d", cfg.TargetTs, info.StartTs)
+				}
+				info.TargetTs = cfg.TargetTs
+			}
+			info.SinkURI, err = tpl.RenderSinkURI(id.Name(), id.Keyspace(), item.Variables)
+			if err != nil {
+				return nil, err
+			}
+			info.Config = tpl.Config.Clone()
+			if err = verifyUpdateChangefeedConfig(ctx, h.server.GetPdClient(), info, status.CheckpointTs, true); err != nil {
+				return nil, err
+			}
+			return &bulkChangefeedOp{
+				id: info.ChangefeedID,
+				apply: func(ctx context.Context) error {
+					return co.UpdateChangefeed(ctx, info, &config.ChangefeedConfigRevision{Author: author})
+				},
+				undo: func(ctx context.Context) error {
+					return co.UpdateChangefeed(ctx, origin, &config.ChangefeedConfigRevision{Author: author})
+				},
+			}, nil
+		}
+	})
+}
+
+// BulkPauseChangefeeds pauses changefeeds
+// @Summary Pause changefeeds in bulk
+// @Description pause changefeeds atomically, the changefeeds which are running are resumed if any of them fails to pause
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param bulk body BulkChangefeedConfig true "bulk changefeed config"
+// @Param keyspace query string false "default"
+// @Success 200 {object} BulkChangefeedResponse
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeed_bulk/pause [post]
+func (h *OpenAPIV2) BulkPauseChangefeeds(c *gin.Context) {
+	h.handleBulkChangefeeds(c, false, func(co server.Coordinator, _ *BulkChangefeedConfig, _ *config.ChangefeedTemplate) bulkPrepareFunc {
+		return func(
+			ctx context.Context, id common.ChangeFeedID, _ *keyspacepb.KeyspaceMeta, _ BulkChangefeedItem,
+		) (*bulkChangefeedOp, error) {
+			info, status, err := co.GetChangefeed(ctx, id.DisplayName)
+			if err != nil {
+				return nil, err
+			}
+			op := &bulkChangefeedOp{id: info.ChangefeedID}
+			if info.State == config.StateStopped {
+				return op, nil
+			}
+			op.apply = func(ctx context.Context) error {
+				return co.PauseChangefeed(ctx, info.ChangefeedID)
+			}
+			// The failed and finished changefeeds do not replicate data, so they are
+			// left stopped when the bulk operation is rolled back.
+			if !info.State.IsResumable() {
+				checkpointTs := status.CheckpointTs
+				op.undo = func(ctx context.Context) error {
+					return co.ResumeChangefeed(ctx, info.ChangefeedID, checkpointTs, false)
+				}
+			}
+			return op, nil
+		}
+	})
+}
+
+// BulkResumeChangefeeds resumes changefeeds
+// @Summary Resume changefeeds in bulk
+// @Description resume changefeeds from their checkpoints atomically, the resumed changefeeds are paused if any of them fails to resume
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param bulk body BulkChangefeedConfig true "bulk changefeed config"
+// @Param keyspace query string false "default"
+// @Success 200 {object} BulkChangefeedResponse
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeed_bulk/resume [post]
+func (h *OpenAPIV2) BulkResumeChangefeeds(c *gin.Context) {
+	h.handleBulkChangefeeds(c, false, func(co server.Coordinator, _ *BulkChangefeedConfig, _ *config.ChangefeedTemplate) bulkPrepareFunc {
+		return func(
+			ctx context.Context, id common.ChangeFeedID, keyspaceMeta *keyspacepb.KeyspaceMeta, _ BulkChangefeedItem,
+		) (*bulkChangefeedOp, error) {
+			cfInfo, status, err := co.GetChangefeed(ctx, id.DisplayName)
+			if err != nil {
+				return nil, err
+			}
+			// Resume validation must use persisted metadata, the same as the resume api.
+			cfInfo, err = co.GetPersistedChangefeedInfo(ctx, cfInfo.ChangefeedID)
+			if err != nil {
+				return nil, err
+			}
+			if err = validateResumeChangefeedState(cfInfo.State); err != nil {
+				return nil, err
+			}
+			pdClient := h.server.GetPdClient()
+			isSame, err := check.IsSameUpstreamDownstream(ctx, pdClient, cfInfo.ToChangefeedConfig())
+			if err != nil {
+				return nil, err
+			}
+			if isSame {
+				return nil, errors.ErrSameUpstreamDownstream.GenWithStack(
+					"TiCDC does not support resuming a changefeed with the same TiDB cluster " +
+						"as both the source and the target for the changefeed.")
+			}
+			if err = check.ValidateActiveActiveTSOIndexes(ctx, pdClient, cfInfo.ToChangefeedConfig()); err != nil {
+				return nil, err
+			}
+
+			checkpointTs := status.CheckpointTs
+			resumeGcServiceID := h.server.GetEtcdClient().GetEnsureGCServiceID(gc.EnsureGCServiceResuming)
+			if err = verifyResumeChangefeedConfig(
+				ctx, pdClient, resumeGcServiceID, keyspaceMeta.Id, cfInfo.ChangefeedID, checkpointTs,
+			); err != nil {
+				return nil, err
+			}
+			return &bulkChangefeedOp{
+				id: cfInfo.ChangefeedID,
+				apply: func(ctx context.Context) error {
+					return co.ResumeChangefeed(ctx, cfInfo.ChangefeedID, checkpointTs, false)
+				},
+				undo: func(ctx context.Context) error {
+					return co.PauseChangefeed(ctx, cfInfo.ChangefeedID)
+				},
+				release: func() {
+					ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+					defer cancel()
+					undoErr := gc.UndoEnsureChangefeedStartTsSafety(
+						ctx, pdClient, keyspaceMeta.Id, resumeGcServiceID, cfInfo.ChangefeedID)
+					if undoErr != nil {
+						log.Warn("undo ensure changefeed start ts safety failed when resuming the changefeed",
+							zap.String("changefeedID", cfInfo.ChangefeedID.Name()), zap.Uint64("startTs", checkpointTs),
+							zap.String("gcServiceID", resumeGcServiceID),
+							zap.Error(undoErr))
+					}
+				},
+			}, nil
+		}
+	})
+}
+
+// handleBulkChangefeeds verifies all items of a bulk request first, and applies
+// the operations one by one only if all of them are verified. If an operation
+// fails, the applied ones are undone in the reverse order.
+func (h *OpenAPIV2) handleBulkChangefeeds(
+	c *gin.Context,
+	needTemplate bool,
+	newPrepare func(co server.Coordinator, cfg *BulkChangefeedConfig, tpl *config.ChangefeedTemplate) bulkPrepareFunc,
+) {
+	ctx := c.Request.Context()
+	cfg := &BulkChangefeedConfig{}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(errors.WrapError(errors.ErrAPIInvalidParam, err))
+		return
+	}
+	if len(cfg.Items) == 0 {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack("no changefeed is specified"))
+		return
+	}
+	middleware.SetChangefeedOperationDetails(c, fmt.Sprintf(
+		"template=%s items=%d", cfg.TemplateName, len(cfg.Items)))
+
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ok, err := isInitialized(co)
+	if err != nil || !ok {
+		_ = c.Error(err)
+		return
+	}
+
+	var tpl *config.ChangefeedTemplate
+	if needTemplate {
+		if cfg.TemplateName == "" {
+			_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack("template_name is required"))
+			return
+		}
+		tpl, err = co.GetChangefeedTemplate(ctx, cfg.TemplateName)
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+	prepare := newPrepare(co, cfg, tpl)
+
+	results := make([]BulkChangefeedResult, len(cfg.Items))
+	ops := make([]*bulkChangefeedOp, len(cfg.Items))
+	seen := make(map[common.ChangeFeedDisplayName]struct{}, len(cfg.Items))
+	verified := true
+	for i, item := range cfg.Items {
+		results[i] = BulkChangefeedResult{ID: item.ID, Keyspace: item.Keyspace}
+		ops[i], err = h.prepareBulkItem(c, prepare, item, seen)
+		if ops[i] != nil {
+			results[i].Keyspace = ops[i].id.Keyspace()
+		}
+		if err != nil {
+			results[i].Error = err.Error()
+			verified = false
+		}
+	}
+	if !verified {
+		for i, op := range ops {
+			if op != nil && op.release != nil {
+				op.release()
+			}
+			if results[i].Error == "" {
+				results[i].Error = "not applied, because other changefeeds fail to verify"
+			}
+		}
+		c.JSON(getStatus(c), &BulkChangefeedResponse{Results: results})
+		return
+	}
+
+	for i, op := range ops {
+		if op.apply == nil {
+			continue
+		}
+		applyErr := op.apply(ctx)
+		if applyErr == nil {
+			continue
+		}
+		log.Warn("bulk changefeed operation failed, roll back the applied changefeeds",
+			zap.String("changefeedID", op.id.Name()), zap.String("keyspace", op.id.Keyspace()), zap.Error(applyErr))
+		results[i].Error = applyErr.Error()
+		for j := i + 1; j < len(ops); j++ {
+			if ops[j].release != nil {
+				ops[j].release()
+			}
+			results[j].Error = "not applied, because other changefeeds fail to apply"
+		}
+		if ops[i].release != nil {
+			ops[i].release()
+		}
+		for j := i - 1; j >= 0; j-- {
+			results[j].Error = "rolled back, because other changefeeds fail to apply"
+			if ops[j].apply != nil && ops[j].undo != nil {
+				if undoErr := ops[j].undo(ctx); undoErr != nil {
+					log.Warn("roll back the bulk changefeed operation failed",
+						zap.String("changefeedID", ops[j].id.Name()), zap.String("keyspace", ops[j].id.Keyspace()),
+						zap.Error(undoErr))
+					results[j].Error = "roll back failed: " + undoErr.Error()
+				}
+			}
+			if ops[j].release != nil {
+				ops[j].release()
+			}
+		}
+		c.JSON(getStatus(c), &BulkChangefeedResponse{Results: results})
+		return
+	}
+
+	for i := range results {
+		results[i].Success = true
+	}
+	log.Info("bulk changefeed operation successfully",
+		zap.String("template", cfg.TemplateName), zap.Int("items", len(cfg.Items)))
+	c.JSON(getStatus(c), &BulkChangefeedResponse{Succeeded: true, Results: results})
+}
+
+// prepareBulkItem resolves the changefeed id and the keyspace of an item, then verifies it.
+func (h *OpenAPIV2) prepareBulkItem(
+	c *gin.Context,
+	prepare bulkPrepareFunc,
+	item BulkChangefeedItem,
+	seen map[common.ChangeFeedDisplayName]struct{},
+) (*bulkChangefeedOp, error) {
+	keyspaceName := item.Keyspace
+	if keyspaceName == "" {
+		keyspaceName = GetKeyspaceValueWithDefault(c)
+	}
+	if err := common.ValidateChangefeedID(item.ID); err != nil {
+		return nil, errors.ErrAPIInvalidParam.GenWithStack("invalid changefeed_id: %s, %s", item.ID, err.Error())
+	}
+	if err := common.ValidateKeyspace(keyspaceName); err != nil {
+		return nil, errors.ErrAPIInvalidParam.GenWithStack("invalid keyspace: %s", keyspaceName)
+	}
+	displayName := common.NewChangeFeedDisplayName(item.ID, keyspaceName)
+	if _, ok := seen[displayName]; ok {
+		return nil, errors.ErrAPIInvalidParam.GenWithStack("duplicated changefeed %s in keyspace %s", item.ID, keyspaceName)
+	}
+	seen[displayName] = struct{}{}
+
+	keyspaceMeta, err := loadBulkItemKeyspace(c, keyspaceName)
+	if err != nil {
+		return nil, err
+	}
+	return prepare(c.Request.Context(), common.NewChangeFeedIDWithDisplayName(displayName), keyspaceMeta, item)
+}
+
+// loadBulkItemKeyspace returns the keyspace meta of an item, the items of a bulk
+// request may be in different keyspaces.
+func loadBulkItemKeyspace(c *gin.Context, keyspaceName string) (*keyspacepb.KeyspaceMeta, error) {
+	if kerneltype.IsClassic() {
+		if keyspaceName != common.DefaultKeyspaceName {
+			return nil, errors.ErrAPIInvalidParam.GenWithStack(
+				"keyspace %s is not supported in the classic mode", keyspaceName)
+		}
+		return middleware.GetKeyspaceFromContext(c), nil
+	}
+	keyspaceManager := appcontext.GetService[keyspace.Manager](appcontext.KeyspaceManager)
+	meta, err := keyspaceManager.LoadKeyspace(c.Request.Context(), keyspaceName)
+	if err != nil {
+		if errors.IsKeyspaceNotExistError(err) {
+			return nil, errors.ErrAPIInvalidParam.GenWithStack("keyspace %q does not exist", keyspaceName)
+		}
+		return nil, err
+	}
+	if meta.State != keyspacepb.KeyspaceState_ENABLED {
+		return nil, errors.ErrAPIInvalidParam.GenWithStack("keyspace %q is not enabled", keyspaceName)
+	}
+	return meta, nil
+}
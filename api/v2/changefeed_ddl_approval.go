@@ -0,0 +1,170 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/api/middleware"
+	"github.com/pingcap/ticdc/maintainer"
+	"github.com/pingcap/ticdc/pkg/api"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// getMaintainerForDDLApproval gets the maintainer of the changefeed, the
+// request is forwarded to the node of the maintainer if it's not this node.
+// On failure it writes the error to c and returns false, it also returns false
+// if the request is forwarded.
+func (h *OpenAPIV2) getMaintainerForDDLApproval(c *gin.Context) (common.ChangeFeedID, *maintainer.Maintainer, bool) {
+	changefeedDisplayName, ok := validateChangefeedIDParam(c)
+	if !ok {
+		return common.ChangeFeedID{}, nil, false
+	}
+	cfInfo, err := getChangeFeed(c.Request.Host, changefeedDisplayName.Keyspace, changefeedDisplayName.Name)
+	if err != nil {
+		_ = c.Error(err)
+		return common.ChangeFeedID{}, nil, false
+	}
+	if cfInfo.MaintainerAddr == "" {
+		_ = c.Error(errors.ErrMaintainerNotFounded)
+		return common.ChangeFeedID{}, nil, false
+	}
+	selfInfo, err := h.server.SelfInfo()
+	if err != nil {
+		_ = c.Error(err)
+		return common.ChangeFeedID{}, nil, false
+	}
+	if cfInfo.MaintainerAddr != selfInfo.AdvertiseAddr {
+		middleware.ForwardToServer(c, selfInfo.ID, cfInfo.MaintainerAddr)
+		c.Abort()
+		return common.ChangeFeedID{}, nil, false
+	}
+
+	changefeedID := common.ChangeFeedID{
+		Id:          cfInfo.GID,
+		DisplayName: common.NewChangeFeedDisplayName(cfInfo.ID, cfInfo.Keyspace),
+	}
+	m, ok := h.server.GetMaintainerManager().GetMaintainerForChangefeed(changefeedID)
+	if !ok {
+		_ = c.Error(errors.ErrMaintainerNotFounded)
+		return common.ChangeFeedID{}, nil, false
+	}
+	return changefeedID, m, true
+}
+
+func getDDLCommitTsParam(c *gin.Context) (uint64, bool) {
+	commitTsStr := c.Param(api.APIOpVarCommitTs)
+	commitTs, err := strconv.ParseUint(commitTsStr, 10, 64)
+	if err != nil {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack("invalid commit ts: %s", commitTsStr))
+		return 0, false
+	}
+	return commitTs, true
+}
+
+// ListPendingDDLs lists the pending ddls of a changefeed
+// @Summary List the pending ddls of a changefeed
+// @Description list the DDLs matched by a pause ddl policy, which block the
+// changefeed until they are approved or skipped, sorted by the commit ts.
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param keyspace query string false "default"
+// @Success 200 {array} PendingDDL
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/pending_ddls [get]
+func (h *OpenAPIV2) ListPendingDDLs(c *gin.Context) {
+	_, m, ok := h.getMaintainerForDDLApproval(c)
+	if !ok {
+		return
+	}
+	ddls := m.GetPendingDDLs()
+	resps := make([]PendingDDL, 0, len(ddls))
+	for _, ddl := range ddls {
+		resps = append(resps, PendingDDL{
+			CommitTs:   ddl.CommitTs,
+			Schema:     ddl.SchemaName,
+			Table:      ddl.TableName,
+			Query:      ddl.Query,
+			CreateTime: ddl.CreateTime,
+		})
+	}
+	c.JSON(getStatus(c), toListResponse(c, resps))
+}
+
+// ApproveDDL approves a pending ddl of a changefeed
+// @Summary Approve a pending ddl of a changefeed
+// @Description approve the DDL waiting for the approval, it's written to the downstream
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param commit_ts  path  int  true  "commit_ts"
+// @Param query query string false "the query of the ddl, required if several ddls are pending at the commit_ts"
+// @Param keyspace query string false "default"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/ddl/{commit_ts}/approve [post]
+func (h *OpenAPIV2) ApproveDDL(c *gin.Context) {
+	h.decideDDL(c, true)
+}
+
+// SkipDDL skips a pending ddl of a changefeed
+// @Summary Skip a pending ddl of a changefeed
+// @Description skip the DDL waiting for the approval, it's not written to the
+// downstream while the changefeed moves on.
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param commit_ts  path  int  true  "commit_ts"
+// @Param query query string false "the query of the ddl, required if several ddls are pending at the commit_ts"
+// @Param keyspace query string false "default"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/ddl/{commit_ts}/skip [post]
+func (h *OpenAPIV2) SkipDDL(c *gin.Context) {
+	h.decideDDL(c, false)
+}
+
+func (h *OpenAPIV2) decideDDL(c *gin.Context, approve bool) {
+	commitTs, ok := getDDLCommitTsParam(c)
+	if !ok {
+		return
+	}
+	changefeedID, m, ok := h.getMaintainerForDDLApproval(c)
+	if !ok {
+		return
+	}
+	query := c.Query(api.APIOpVarDDLQuery)
+	var err error
+	if approve {
+		err = m.ApproveDDL(commitTs, query)
+	} else {
+		err = m.SkipDDL(commitTs, query)
+	}
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("pending ddl is decided by the operator",
+		zap.String("keyspace", changefeedID.Keyspace()),
+		zap.String("changefeed", changefeedID.Name()),
+		zap.Uint64("commitTs", commitTs),
+		zap.String("query", query),
+		zap.Bool("approve", approve))
+	c.JSON(getStatus(c), &EmptyResponse{})
+}
@@ -0,0 +1,195 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/deadletter"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// defaultDeadLetterListLimit is the default number of the listed dead letter records.
+const defaultDeadLetterListLimit = 100
+
+// getChangefeedForDeadLetters gets the changefeed of the dead letter api and
+// opens its dead letter queue. On failure it writes the error to c and
+// returns false.
+func (h *OpenAPIV2) getChangefeedForDeadLetters(c *gin.Context) (*config.ChangeFeedInfo, *deadletter.Queue, bool) {
+	changefeedDisplayName, ok := validateChangefeedIDParam(c)
+	if !ok {
+		return nil, nil, false
+	}
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return nil, nil, false
+	}
+	ok, err = isInitialized(co)
+	if err != nil || !ok {
+		_ = c.Error(err)
+		return nil, nil, false
+	}
+	info, _, err := co.GetChangefeed(c, changefeedDisplayName)
+	if err != nil {
+		_ = c.Error(err)
+		return nil, nil, false
+	}
+	queue, err := deadletter.Open(c.Request.Context(), info.ChangefeedID, info.Config.Sink)
+	if err != nil {
+		_ = c.Error(err)
+		return nil, nil, false
+	}
+	if queue == nil {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack(
+			"changefeed %s has no dead letter queue", info.ChangefeedID.Name()))
+		return nil, nil, false
+	}
+	return info, queue, true
+}
+
+// ListDeadLetters lists the dead letter records of a changefeed
+// @Summary List the dead letter records of a changefeed
+// @Description list the events rejected by the downstream and written to the dead
+// letter queue of a changefeed, sorted by the commit ts. The kafka dead letter
+// queue can not be listed, consume the topic instead.
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param keyspace query string false "default"
+// @Param limit query int false "100"
+// @Success 200 {array} DeadLetterRecord
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/dead_letters [get]
+func (h *OpenAPIV2) ListDeadLetters(c *gin.Context) {
+	limit := defaultDeadLetterListLimit
+	if limitStr := c.Query("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack("invalid limit: %s", limitStr))
+			return
+		}
+	}
+	_, queue, ok := h.getChangefeedForDeadLetters(c)
+	if !ok {
+		return
+	}
+	defer queue.Close()
+
+	records, err := queue.List(c.Request.Context(), limit)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resps := make([]DeadLetterRecord, 0, len(records))
+	for _, record := range records {
+		resps = append(resps, toAPIDeadLetterRecord(record))
+	}
+	c.JSON(getStatus(c), toListResponse(c, resps))
+}
+
+// ReplayDeadLetters replays the dead letter records of a changefeed
+// @Summary Replay the dead letter records of a changefeed
+// @Description execute the statements of the dead letter records against the MySQL
+// compatible downstream of the changefeed, after the cause of the errors is fixed.
+// The replayed records are removed from the dead letter queue. The records are
+// replayed as they are, so a record may overwrite the rows changed after it.
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param keyspace query string false "default"
+// @Param replayConfig body ReplayDeadLettersConfig true "replay config"
+// @Success 200 {object} ReplayDeadLettersResult
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/dead_letters/replay [post]
+func (h *OpenAPIV2) ReplayDeadLetters(c *gin.Context) {
+	ctx := c.Request.Context()
+	cfg := &ReplayDeadLettersConfig{}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(errors.WrapError(errors.ErrAPIInvalidParam, err))
+		return
+	}
+	if len(cfg.IDs) == 0 {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack("ids is required"))
+		return
+	}
+	for _, id := range cfg.IDs {
+		if err := deadletter.ValidateRecordID(id); err != nil {
+			_ = c.Error(errors.WrapError(errors.ErrAPIInvalidParam, err))
+			return
+		}
+	}
+	info, queue, ok := h.getChangefeedForDeadLetters(c)
+	if !ok {
+		return
+	}
+	defer queue.Close()
+
+	records, err := queue.Get(ctx, cfg.IDs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	db, err := deadletter.OpenDownstream(info.ChangefeedID, info.ToChangefeedConfig())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	defer db.Close()
+
+	resp := &ReplayDeadLettersResult{Replayed: make([]string, 0, len(records))}
+	for _, record := range records {
+		if err = deadletter.Replay(ctx, db, record); err != nil {
+			resp.Failed = append(resp.Failed, DeadLetterReplayFail{ID: record.ID, Error: err.Error()})
+			continue
+		}
+		resp.Replayed = append(resp.Replayed, record.ID)
+	}
+	if err = queue.Remove(ctx, resp.Replayed); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("replay dead letter records finished",
+		zap.String("keyspace", info.ChangefeedID.Keyspace()),
+		zap.String("changefeed", info.ChangefeedID.Name()),
+		zap.Strings("replayed", resp.Replayed),
+		zap.Int("failed", len(resp.Failed)))
+	c.JSON(getStatus(c), resp)
+}
+
+func toAPIDeadLetterRecord(record *deadletter.Record) DeadLetterRecord {
+	resp := DeadLetterRecord{
+		ID:         record.ID,
+		EventType:  string(record.EventType),
+		Schema:     record.Schema,
+		Table:      record.Table,
+		StartTs:    record.StartTs,
+		CommitTs:   record.CommitTs,
+		PreRow:     record.PreRow,
+		Row:        record.Row,
+		Error:      record.Error,
+		Retries:    record.Retries,
+		CreateTime: record.CreateTime,
+	}
+	for _, stmt := range record.Statements {
+		resp.Statements = append(resp.Statements, stmt.Query)
+	}
+	return resp
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"net/url"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/api/middleware"
+	"github.com/pingcap/ticdc/logservice/schemastore"
+	"github.com/pingcap/ticdc/pkg/common"
+	appcontext "github.com/pingcap/ticdc/pkg/common/context"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+	"github.com/pingcap/ticdc/pkg/routing"
+	"github.com/pingcap/ticdc/pkg/util"
+	"go.uber.org/zap"
+)
+
+// PreviewChangefeedFilter previews the table set change of a changefeed
+// @Summary Preview the table set change of a changefeed
+// @Description compare the tables replicated by the current config and the proposed config
+// at the checkpoint ts of the changefeed, nothing is applied to the changefeed
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param keyspace query string false "default"
+// @Param changefeedConfig body ChangefeedConfig true "the proposed changefeed config"
+// @Success 200 {object} FilterPreview
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/filter_preview [post]
+func (h *OpenAPIV2) PreviewChangefeedFilter(c *gin.Context) {
+	changefeedDisplayName, ok := validateChangefeedIDParam(c)
+	if !ok {
+		return
+	}
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ok, err = isInitialized(co)
+	if err != nil || !ok {
+		_ = c.Error(err)
+		return
+	}
+	info, status, err := co.GetChangefeed(c, changefeedDisplayName)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	cfg := &ChangefeedConfig{}
+	if err = c.BindJSON(cfg); err != nil {
+		_ = c.Error(errors.WrapError(errors.ErrAPIInvalidParam, err))
+		return
+	}
+	if cfg.ReplicaConfig == nil {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack("replica_config is required"))
+		return
+	}
+	sinkURI := info.SinkURI
+	if cfg.SinkURI != "" {
+		sinkURI = cfg.SinkURI
+	}
+	sinkURIParsed, err := url.Parse(sinkURI)
+	if err != nil {
+		_ = c.Error(genSinkURIInvalidError(sinkURI, err))
+		return
+	}
+	newCfg := cfg.ReplicaConfig.ToInternalReplicaConfig()
+	if err = newCfg.ValidateAndAdjust(sinkURIParsed); err != nil {
+		_ = c.Error(errors.WrapError(errors.ErrInvalidReplicaConfig, err))
+		return
+	}
+
+	keyspaceMeta := middleware.GetKeyspaceFromContext(c)
+	schemaStore := appcontext.GetService[schemastore.SchemaStore](appcontext.SchemaStore)
+	preview, err := previewFilterChange(
+		schemaStore, common.KeyspaceMeta{ID: keyspaceMeta.Id, Name: keyspaceMeta.Name},
+		info.ChangefeedID, info.Config, newCfg, status.CheckpointTs)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("preview changefeed filter",
+		zap.String("keyspace", info.ChangefeedID.Keyspace()),
+		zap.String("changefeed", info.ChangefeedID.Name()),
+		zap.Uint64("checkpointTs", status.CheckpointTs),
+		zap.Int("addedTables", len(preview.AddedTables)),
+		zap.Int("removedTables", len(preview.RemovedTables)),
+		zap.Int("ineligibleTables", len(preview.IneligibleTables)),
+		zap.Int("routeChanges", len(preview.RouteChanges)))
+	c.JSON(getStatus(c), preview)
+}
+
+// previewFilterChange compares the tables replicated by the old config and the
+// new config at the given ts.
+func previewFilterChange(
+	schemaStore schemastore.SchemaStore,
+	keyspaceMeta common.KeyspaceMeta,
+	changefeedID common.ChangeFeedID,
+	oldCfg, newCfg *config.ReplicaConfig,
+	ts uint64,
+) (*FilterPreview, error) {
+	oldTables, _, err := getMatchedTables(schemaStore, keyspaceMeta, oldCfg, ts)
+	if err != nil {
+		return nil, err
+	}
+	newTables, ineligibleTables, err := getMatchedTables(schemaStore, keyspaceMeta, newCfg, ts)
+	if err != nil {
+		return nil, err
+	}
+	oldRouter, err := routing.NewRouter(changefeedID,
+		util.GetOrZero(oldCfg.Sink.CaseSensitive), oldCfg.Sink.DispatchRules)
+	if err != nil {
+		return nil, err
+	}
+	newRouter, err := routing.NewRouter(changefeedID,
+		util.GetOrZero(newCfg.Sink.CaseSensitive), newCfg.Sink.DispatchRules)
+	if err != nil {
+		return nil, err
+	}
+	preview := diffMatchedTables(oldTables, newTables, ineligibleTables, oldRouter, newRouter)
+	preview.CheckpointTs = ts
+	return preview, nil
+}
+
+// eligibilityCollector is a filter that accepts the ineligible tables and
+// records them, so the ineligible tables can be reported instead of being
+// dropped silently by the schema store.
+type eligibilityCollector struct {
+	filter.Filter
+	ineligible map[commonEvent.SchemaTableName]struct{}
+}
+
+func (c *eligibilityCollector) IsEligibleTable(tableInfo *common.TableInfo) bool {
+	if !c.Filter.IsEligibleTable(tableInfo) {
+		c.ineligible[commonEvent.SchemaTableName{
+			SchemaName: tableInfo.GetSchemaName(),
+			TableName:  tableInfo.GetTableName(),
+		}] = struct{}{}
+	}
+	return true
+}
+
+// getMatchedTables returns the eligible and the ineligible physical tables
+// matched by the filter of the replica config at the given ts.
+func getMatchedTables(
+	schemaStore schemastore.SchemaStore,
+	keyspaceMeta common.KeyspaceMeta,
+	cfg *config.ReplicaConfig, ts uint64,
+) ([]commonEvent.Table, []commonEvent.Table, error) {
+	f, err := filter.NewFilter(cfg.Filter, "",
+		util.GetOrZero(cfg.CaseSensitive), util.GetOrZero(cfg.ForceReplicate))
+	if err != nil {
+		return nil, nil, err
+	}
+	collector := &eligibilityCollector{
+		Filter:     f,
+		ineligible: make(map[commonEvent.SchemaTableName]struct{}),
+	}
+	tables, err := schemaStore.GetAllPhysicalTables(keyspaceMeta, ts, collector)
+	if err != nil {
+		return nil, nil, err
+	}
+	var eligible, ineligible []commonEvent.Table
+	for _, table := range tables {
+		if _, ok := collector.ineligible[*table.SchemaTableName]; ok {
+			ineligible = append(ineligible, table)
+		} else {
+			eligible = append(eligible, table)
+		}
+	}
+	return eligible, ineligible, nil
+}
+
+// diffMatchedTables compares the tables by name, the partitions of a table
+// are merged into one table.
+func diffMatchedTables(
+	oldTables, newTables, ineligibleTables []commonEvent.Table,
+	oldRouter, newRouter routing.Router,
+) *FilterPreview {
+	oldNames := toLogicalTableNames(oldTables)
+	newNames := toLogicalTableNames(newTables)
+
+	added := make(map[commonEvent.SchemaTableName]TableName)
+	removed := make(map[commonEvent.SchemaTableName]TableName)
+	preview := &FilterPreview{}
+	for name, table := range newNames {
+		if _, ok := oldNames[name]; !ok {
+			added[name] = table
+			continue
+		}
+		oldBinding := oldRouter.RouteTable(name.SchemaName, name.TableName)
+		newBinding := newRouter.RouteTable(name.SchemaName, name.TableName)
+		if !oldBinding.Target.Equal(newBinding.Target) {
+			preview.RouteChanges = append(preview.RouteChanges, TableRouteChange{
+				Schema:          name.SchemaName,
+				Table:           name.TableName,
+				OldTargetSchema: oldBinding.Target.Schema,
+				OldTargetTable:  oldBinding.Target.Table,
+				NewTargetSchema: newBinding.Target.Schema,
+				NewTargetTable:  newBinding.Target.Table,
+			})
+		}
+	}
+	for name, table := range oldNames {
+		if _, ok := newNames[name]; !ok {
+			removed[name] = table
+		}
+	}
+	sort.Slice(preview.RouteChanges, func(i, j int) bool {
+		a, b := preview.RouteChanges[i], preview.RouteChanges[j]
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		return a.Table < b.Table
+	})
+	preview.AddedTables = sortTableNames(added)
+	preview.RemovedTables = sortTableNames(removed)
+	preview.IneligibleTables = sortTableNames(toLogicalTableNames(ineligibleTables))
+	return preview
+}
+
+func toLogicalTableNames(tables []commonEvent.Table) map[commonEvent.SchemaTableName]TableName {
+	names := make(map[commonEvent.SchemaTableName]TableName, len(tables))
+	for _, table := range tables {
+		name := *table.SchemaTableName
+		if _, ok := names[name]; ok {
+			// a partitioned table has a physical table for each partition.
+			names[name] = TableName{Schema: name.SchemaName, Table: name.TableName, IsPartition: true}
+			continue
+		}
+		names[name] = TableName{Schema: name.SchemaName, Table: name.TableName, TableID: table.TableID}
+	}
+	return names
+}
+
+func sortTableNames(names map[commonEvent.SchemaTableName]TableName) []TableName {
+	if len(names) == 0 {
+		return nil
+	}
+	result := make([]TableName, 0, len(names))
+	for _, name := range names {
+		result = append(result, name)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Schema != result[j].Schema {
+			return result[i].Schema < result[j].Schema
+		}
+		return result[i].Table < result[j].Table
+	})
+	return result
+}
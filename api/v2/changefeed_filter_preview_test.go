@@ -0,0 +1,81 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/routing"
+	"github.com/stretchr/testify/require"
+)
+
+func newPreviewTable(schema, table string, tableID int64) commonEvent.Table {
+	return commonEvent.Table{
+		TableID: tableID,
+		SchemaTableName: &commonEvent.SchemaTableName{
+			SchemaName: schema,
+			TableName:  table,
+		},
+	}
+}
+
+func TestDiffMatchedTables(t *testing.T) {
+	t.Parallel()
+
+	changefeedID := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	oldRouter, err := routing.NewRouter(changefeedID, false, nil)
+	require.NoError(t, err)
+	newRouter, err := routing.NewRouter(changefeedID, false, []*config.DispatchRule{
+		{Matcher: []string{"test.routed"}, TargetSchema: "dst"},
+	})
+	require.NoError(t, err)
+
+	oldTables := []commonEvent.Table{
+		newPreviewTable("test", "kept", 1),
+		newPreviewTable("test", "routed", 2),
+		newPreviewTable("test", "removed", 3),
+	}
+	newTables := []commonEvent.Table{
+		newPreviewTable("test", "routed", 2),
+		newPreviewTable("test", "kept", 1),
+		// the partitions of a table are merged.
+		newPreviewTable("test", "partitioned", 11),
+		newPreviewTable("test", "partitioned", 12),
+		newPreviewTable("a", "added", 4),
+	}
+	ineligibleTables := []commonEvent.Table{newPreviewTable("test", "no_pk", 5)}
+
+	preview := diffMatchedTables(oldTables, newTables, ineligibleTables, oldRouter, newRouter)
+	require.Equal(t, []TableName{
+		{Schema: "a", Table: "added", TableID: 4},
+		{Schema: "test", Table: "partitioned", IsPartition: true},
+	}, preview.AddedTables)
+	require.Equal(t, []TableName{{Schema: "test", Table: "removed", TableID: 3}}, preview.RemovedTables)
+	require.Equal(t, []TableName{{Schema: "test", Table: "no_pk", TableID: 5}}, preview.IneligibleTables)
+	require.Equal(t, []TableRouteChange{{
+		Schema: "test", Table: "routed",
+		OldTargetSchema: "test", OldTargetTable: "routed",
+		NewTargetSchema: "dst", NewTargetTable: "routed",
+	}}, preview.RouteChanges)
+
+	// nothing is changed by the same config.
+	preview = diffMatchedTables(oldTables, oldTables, nil, oldRouter, oldRouter)
+	require.Empty(t, preview.AddedTables)
+	require.Empty(t, preview.RemovedTables)
+	require.Empty(t, preview.IneligibleTables)
+	require.Empty(t, preview.RouteChanges)
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/kvproto/pkg/keyspacepb"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/api/middleware"
+	"github.com/pingcap/ticdc/pkg/api"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// GetChangefeedConfigHistory lists the config revisions of a changefeed
+// @Summary List the config history of a changefeed
+// @Description list the config revisions of a changefeed, a revision is recorded every time the changefeed is updated
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param keyspace query string false "default"
+// @Success 200 {array} ChangefeedConfigRevision
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/history [get]
+func (h *OpenAPIV2) GetChangefeedConfigHistory(c *gin.Context) {
+	changefeedDisplayName, ok := validateChangefeedIDParam(c)
+	if !ok {
+		return
+	}
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	info, _, err := co.GetChangefeed(c, changefeedDisplayName)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	history, err := co.GetChangefeedConfigHistory(c.Request.Context(), info.ChangefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resps := make([]ChangefeedConfigRevision, 0, len(history))
+	for _, revision := range history {
+		resps = append(resps, *ToAPIChangefeedConfigRevision(revision))
+	}
+	c.JSON(getStatus(c), toListResponse(c, resps))
+}
+
+// RollbackChangefeed rolls back the config of a changefeed to a revision
+// @Summary Rollback the config of a changefeed
+// @Description restore the sink uri, the target ts and the replica config of a stopped or failed
+// changefeed to a revision, the rollback is recorded as a new revision
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param keyspace query string false "default"
+// @Param revision query int true "the revision to rollback to"
+// @Success 200 {object} ChangeFeedInfo
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/rollback [post]
+func (h *OpenAPIV2) RollbackChangefeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	keyspaceMeta := middleware.GetKeyspaceFromContext(c)
+	if keyspaceMeta.State != keyspacepb.KeyspaceState_ENABLED {
+		c.IndentedJSON(http.StatusBadRequest, errors.ErrAPIInvalidParam)
+		c.Abort()
+		return
+	}
+
+	changefeedDisplayName, ok := validateChangefeedIDParam(c)
+	if !ok {
+		return
+	}
+	middleware.SetChangefeedOperationTarget(c, changefeedDisplayName.Keyspace, changefeedDisplayName.Name)
+	target, err := strconv.ParseUint(c.Query(api.APIOpVarConfigRevision), 10, 64)
+	if err != nil || target == 0 {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack(
+			"invalid revision: %s", c.Query(api.APIOpVarConfigRevision)))
+		return
+	}
+	middleware.SetChangefeedOperationDetails(c, fmt.Sprintf("rollback_to=%d", target))
+
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ok, err = isInitialized(co)
+	if err != nil || !ok {
+		_ = c.Error(err)
+		return
+	}
+
+	info, status, err := co.GetChangefeed(c, changefeedDisplayName)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	switch info.State {
+	case config.StateStopped, config.StateFailed:
+	default:
+		_ = c.Error(errors.ErrChangefeedUpdateRefused.GenWithStackByArgs(
+			"can only rollback changefeed config when it is stopped or failed"))
+		return
+	}
+
+	history, err := co.GetChangefeedConfigHistory(ctx, info.ChangefeedID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	var revision *config.ChangefeedConfigRevision
+	for _, r := range history {
+		if r.Revision == target {
+			revision = r
+			break
+		}
+	}
+	if revision == nil {
+		_ = c.Error(errors.ErrChangefeedConfigRevisionNotExists.GenWithStackByArgs(
+			fmt.Sprintf("revision %d of changefeed %s", target, changefeedDisplayName.Name)))
+		return
+	}
+	revision.ApplyTo(info)
+	if info.TargetTs != 0 && info.TargetTs <= info.StartTs {
+		_ = c.Error(errors.ErrChangefeedUpdateRefused.GenWithStack(
+			"can not rollback to target_ts:%d less than start_ts:%d", info.TargetTs, info.StartTs))
+		return
+	}
+
+	// The revision may be recorded before the downstream or the upstream
+	// tables changed, so it's verified as a new config.
+	if err = verifyUpdateChangefeedConfig(ctx, h.server.GetPdClient(), info, status.CheckpointTs, true); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	newRevision := &config.ChangefeedConfigRevision{
+		Author:     middleware.GetRequestUsername(c),
+		RollbackTo: target,
+	}
+	if err = co.UpdateChangefeed(ctx, info, newRevision); err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	log.Info("Rollback changefeed config successfully",
+		zap.String("id", info.ChangefeedID.Name()),
+		zap.Uint64("rollbackTo", target),
+		zap.Uint64("configRevision", newRevision.Revision),
+		zap.String("changefeedInfo", info.String()),
+	)
+	c.JSON(getStatus(c), CfInfoToAPIModel(info, status, nil))
+}
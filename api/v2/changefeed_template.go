@@ -0,0 +1,217 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/api"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// validateTemplateNameParam extracts and validates the template name from the
+// URL path parameter. On failure it writes the error to c and returns false.
+func validateTemplateNameParam(c *gin.Context) (string, bool) {
+	name := c.Param(api.APIOpVarTemplateName)
+	if err := config.ValidateChangefeedTemplateName(name); err != nil {
+		_ = c.Error(errors.WrapError(errors.ErrAPIInvalidParam, err))
+		return "", false
+	}
+	return name, true
+}
+
+// ListChangefeedTemplates lists all changefeed templates
+// @Summary List changefeed templates
+// @Description list all changefeed templates in cdc cluster
+// @Tags changefeed,v2
+// @Produce json
+// @Success 200 {array} ChangefeedTemplate
+// @Failure 500 {object} common.HTTPError
+// @Router /api/v2/changefeed_templates [get]
+func (h *OpenAPIV2) ListChangefeedTemplates(c *gin.Context) {
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	templates, err := co.ListChangefeedTemplates(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	resps := make([]ChangefeedTemplate, 0, len(templates))
+	for _, tpl := range templates {
+		resps = append(resps, *ToAPIChangefeedTemplate(tpl))
+	}
+	c.JSON(getStatus(c), toListResponse(c, resps))
+}
+
+// GetChangefeedTemplate gets a changefeed template
+// @Summary Get changefeed template
+// @Description get the detail of a changefeed template
+// @Tags changefeed,v2
+// @Produce json
+// @Param template_name path string true "template_name"
+// @Success 200 {object} ChangefeedTemplate
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeed_templates/{template_name} [get]
+func (h *OpenAPIV2) GetChangefeedTemplate(c *gin.Context) {
+	name, ok := validateTemplateNameParam(c)
+	if !ok {
+		return
+	}
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	tpl, err := co.GetChangefeedTemplate(c.Request.Context(), name)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(getStatus(c), ToAPIChangefeedTemplate(tpl))
+}
+
+// CreateChangefeedTemplate creates a changefeed template
+// @Summary Create changefeed template
+// @Description create a changefeed template, the replica config is validated
+// when the template is applied, because the sink uri is a pattern
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param template body ChangefeedTemplate true "changefeed template"
+// @Success 200 {object} ChangefeedTemplate
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeed_templates [post]
+func (h *OpenAPIV2) CreateChangefeedTemplate(c *gin.Context) {
+	cfg := &ChangefeedTemplate{ReplicaConfig: GetDefaultReplicaConfig()}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(errors.WrapError(errors.ErrAPIInvalidParam, err))
+		return
+	}
+	tpl := cfg.ToInternalChangefeedTemplate()
+	if err := tpl.Validate(); err != nil {
+		_ = c.Error(errors.WrapError(errors.ErrAPIInvalidParam, err))
+		return
+	}
+	tpl.CreateTime = time.Now()
+	tpl.UpdateTime = tpl.CreateTime
+
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if err = co.CreateChangefeedTemplate(c.Request.Context(), tpl); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("create changefeed template successfully",
+		zap.String("template", tpl.Name), zap.Strings("variables", tpl.Variables()))
+	c.JSON(getStatus(c), ToAPIChangefeedTemplate(tpl))
+}
+
+// UpdateChangefeedTemplate updates a changefeed template, the changefeeds
+// created from the template are not changed until the template is applied
+// to them by the bulk update api.
+// @Summary Update changefeed template
+// @Description update the description, the sink uri pattern or the replica config of a changefeed template
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param template_name path string true "template_name"
+// @Param template body ChangefeedTemplate true "changefeed template"
+// @Success 200 {object} ChangefeedTemplate
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeed_templates/{template_name} [put]
+func (h *OpenAPIV2) UpdateChangefeedTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+	name, ok := validateTemplateNameParam(c)
+	if !ok {
+		return
+	}
+	cfg := &ChangefeedTemplate{}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(errors.WrapError(errors.ErrAPIInvalidParam, err))
+		return
+	}
+	if cfg.Name != "" && cfg.Name != name {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack("template name can not be updated"))
+		return
+	}
+
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	tpl, err := co.GetChangefeedTemplate(ctx, name)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if cfg.Description != "" {
+		tpl.Description = cfg.Description
+	}
+	if cfg.SinkURI != "" {
+		tpl.SinkURI = cfg.SinkURI
+	}
+	if cfg.ReplicaConfig != nil {
+		tpl.Config = cfg.ReplicaConfig.ToInternalReplicaConfig()
+	}
+	if err = tpl.Validate(); err != nil {
+		_ = c.Error(errors.WrapError(errors.ErrAPIInvalidParam, err))
+		return
+	}
+	tpl.UpdateTime = time.Now()
+	if err = co.UpdateChangefeedTemplate(ctx, tpl); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("update changefeed template successfully",
+		zap.String("template", tpl.Name), zap.Strings("variables", tpl.Variables()))
+	c.JSON(getStatus(c), ToAPIChangefeedTemplate(tpl))
+}
+
+// DeleteChangefeedTemplate deletes a changefeed template, the changefeeds
+// created from the template are not affected.
+// @Summary Remove changefeed template
+// @Description remove a changefeed template
+// @Tags changefeed,v2
+// @Produce json
+// @Param template_name path string true "template_name"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeed_templates/{template_name} [delete]
+func (h *OpenAPIV2) DeleteChangefeedTemplate(c *gin.Context) {
+	name, ok := validateTemplateNameParam(c)
+	if !ok {
+		return
+	}
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if err = co.RemoveChangefeedTemplate(c.Request.Context(), name); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	c.JSON(getStatus(c), &EmptyResponse{})
+}
@@ -165,13 +165,41 @@ func (c *resumeNormalCoordinator) ResumeChangefeed(ctx context.Context, id commo
 	return nil
 }
 
-func (c *resumeNormalCoordinator) UpdateChangefeed(ctx context.Context, change *config.ChangeFeedInfo) error {
+func (c *resumeNormalCoordinator) UpdateChangefeed(
+	ctx context.Context, change *config.ChangeFeedInfo, revision *config.ChangefeedConfigRevision,
+) error {
 	return nil
 }
 
+func (c *resumeNormalCoordinator) GetChangefeedConfigHistory(
+	ctx context.Context, id common.ChangeFeedID,
+) ([]*config.ChangefeedConfigRevision, error) {
+	return nil, nil
+}
+
 func (c *resumeNormalCoordinator) RequestResolvedTsFromLogCoordinator(ctx context.Context, changefeedDisplayName common.ChangeFeedDisplayName) {
 }
 
+func (c *resumeNormalCoordinator) ListChangefeedTemplates(ctx context.Context) ([]*config.ChangefeedTemplate, error) {
+	return nil, nil
+}
+
+func (c *resumeNormalCoordinator) GetChangefeedTemplate(ctx context.Context, name string) (*config.ChangefeedTemplate, error) {
+	return nil, nil
+}
+
+func (c *resumeNormalCoordinator) CreateChangefeedTemplate(ctx context.Context, template *config.ChangefeedTemplate) error {
+	return nil
+}
+
+func (c *resumeNormalCoordinator) UpdateChangefeedTemplate(ctx context.Context, template *config.ChangefeedTemplate) error {
+	return nil
+}
+
+func (c *resumeNormalCoordinator) RemoveChangefeedTemplate(ctx context.Context, name string) error {
+	return nil
+}
+
 func (c *resumeNormalCoordinator) DrainNode(ctx context.Context, target node.ID) (int, error) {
 	return 0, nil
 }
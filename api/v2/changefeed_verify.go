@@ -0,0 +1,313 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/verifier"
+	"go.uber.org/zap"
+)
+
+// verifyJobs are the background jobs verifying the changefeeds, only the last
+// job of a changefeed is kept. The jobs are kept in the memory of the
+// coordinator node, so they are lost if the coordinator is changed.
+type verifyJobs struct {
+	mu   sync.Mutex
+	jobs map[common.ChangeFeedDisplayName]*verifyJob
+}
+
+type verifyJob struct {
+	cancel context.CancelFunc
+	// status is protected by the mutex of verifyJobs.
+	status VerifyChangefeedJob
+}
+
+func newVerifyJobs() *verifyJobs {
+	return &verifyJobs{jobs: make(map[common.ChangeFeedDisplayName]*verifyJob)}
+}
+
+// start runs the verification of the changefeed in the background, it fails
+// if the last job of the changefeed is still running.
+func (j *verifyJobs) start(
+	id common.ChangeFeedDisplayName, verify func(ctx context.Context) (*verifier.Result, error),
+) (VerifyChangefeedJob, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if job, ok := j.jobs[id]; ok && job.status.State == VerifyJobStateRunning {
+		return VerifyChangefeedJob{}, errors.ErrAPIInvalidParam.GenWithStack(
+			"the verification of changefeed %s is running", id.Name)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &verifyJob{
+		cancel: cancel,
+		status: VerifyChangefeedJob{State: VerifyJobStateRunning, StartTime: time.Now()},
+	}
+	j.jobs[id] = job
+	go func() {
+		defer cancel()
+		result, err := verify(ctx)
+		j.finish(ctx, id, job, result, err)
+	}()
+	return job.status, nil
+}
+
+func (j *verifyJobs) finish(
+	ctx context.Context, id common.ChangeFeedDisplayName, job *verifyJob,
+	result *verifier.Result, err error,
+) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	finishTime := time.Now()
+	job.status.FinishTime = &finishTime
+	switch {
+	case err != nil && ctx.Err() != nil:
+		job.status.State = VerifyJobStateCancelled
+	case err != nil:
+		job.status.State = VerifyJobStateFailed
+		job.status.Error = err.Error()
+	default:
+		job.status.State = VerifyJobStateFinished
+		job.status.Result = toAPIVerifyChangefeedResult(result)
+	}
+	log.Info("verify changefeed finished",
+		zap.String("keyspace", id.Keyspace),
+		zap.String("changefeed", id.Name),
+		zap.String("state", job.status.State),
+		zap.Error(err))
+}
+
+// get returns the last verification job of the changefeed.
+func (j *verifyJobs) get(id common.ChangeFeedDisplayName) (VerifyChangefeedJob, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	job, ok := j.jobs[id]
+	if !ok {
+		return VerifyChangefeedJob{}, false
+	}
+	return job.status, true
+}
+
+// cancel cancels the running verification job of the changefeed.
+func (j *verifyJobs) cancel(id common.ChangeFeedDisplayName) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	job, ok := j.jobs[id]
+	if !ok || job.status.State != VerifyJobStateRunning {
+		return false
+	}
+	job.cancel()
+	return true
+}
+
+// checkVerifyURI checks the uri of the database used by a verification is
+// MySQL compatible.
+func checkVerifyURI(name, uri string) error {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return genSinkURIInvalidError(uri, err)
+	}
+	if !config.IsMySQLCompatibleScheme(strings.ToLower(parsed.Scheme)) {
+		return errors.ErrAPIInvalidParam.GenWithStack(
+			"%s scheme %s is not MySQL compatible", name, parsed.Scheme)
+	}
+	return nil
+}
+
+// VerifyChangefeed verifies the consistency of a changefeed
+// @Summary Verify the consistency of a changefeed
+// @Description start a background job comparing the upstream snapshot and the downstream
+// snapshot of a changefeed at a syncpoint, the job reports the mismatched tables and chunks.
+// The changefeed must have syncpoint enabled and replicate to a MySQL compatible downstream.
+// @Tags changefeed,v2
+// @Accept json
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param keyspace query string false "default"
+// @Param verifyConfig body VerifyChangefeedConfig true "verify config"
+// @Success 200 {object} VerifyChangefeedJob
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/verify [post]
+func (h *OpenAPIV2) VerifyChangefeed(c *gin.Context) {
+	changefeedDisplayName, ok := validateChangefeedIDParam(c)
+	if !ok {
+		return
+	}
+	cfg := &VerifyChangefeedConfig{}
+	if err := c.BindJSON(cfg); err != nil {
+		_ = c.Error(errors.WrapError(errors.ErrAPIInvalidParam, err))
+		return
+	}
+	if cfg.UpstreamURI == "" {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack("upstream_uri is required"))
+		return
+	}
+	if err := checkVerifyURI("upstream uri", cfg.UpstreamURI); err != nil {
+		_ = c.Error(err)
+		return
+	}
+	if cfg.CheckpointURI != "" {
+		if err := checkVerifyURI("checkpoint uri", cfg.CheckpointURI); err != nil {
+			_ = c.Error(err)
+			return
+		}
+	}
+	if cfg.ChunkSize < 0 || cfg.CheckThreadCount < 0 {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack(
+			"chunk_size and check_thread_count can not be negative"))
+		return
+	}
+
+	co, err := h.server.GetCoordinator()
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	ok, err = isInitialized(co)
+	if err != nil || !ok {
+		_ = c.Error(err)
+		return
+	}
+	info, _, err := co.GetChangefeed(c, changefeedDisplayName)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	sinkURI, err := url.Parse(info.SinkURI)
+	if err != nil {
+		_ = c.Error(genSinkURIInvalidError(info.SinkURI, err))
+		return
+	}
+	if !config.IsMySQLCompatibleScheme(strings.ToLower(sinkURI.Scheme)) {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack(
+			"changefeed %s does not replicate to a MySQL compatible downstream", info.ChangefeedID.Name()))
+		return
+	}
+
+	verifierConfig := &verifier.Config{
+		ChangefeedID:     info.ChangefeedID,
+		ClusterID:        config.GetGlobalServerConfig().ClusterID,
+		ChangefeedConfig: info.ToChangefeedConfig(),
+		UpstreamURI:      cfg.UpstreamURI,
+		SyncpointTs:      cfg.SyncpointTs,
+		Tables:           cfg.Tables,
+		ChunkSize:        cfg.ChunkSize,
+		CheckThreadCount: cfg.CheckThreadCount,
+		Resume:           cfg.Resume,
+		FixSQL:           cfg.FixSQL,
+		CheckpointURI:    cfg.CheckpointURI,
+	}
+	// the verification may take a long time, so it runs in the background
+	// instead of the request context, and its status is queried by the client.
+	job, err := h.verifyJobs.start(changefeedDisplayName, func(ctx context.Context) (*verifier.Result, error) {
+		return verifier.Verify(ctx, verifierConfig)
+	})
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+	log.Info("verify changefeed started",
+		zap.String("keyspace", info.ChangefeedID.Keyspace()),
+		zap.String("changefeed", info.ChangefeedID.Name()),
+		zap.Uint64("syncpointTs", cfg.SyncpointTs),
+		zap.Strings("tables", cfg.Tables),
+		zap.Bool("resume", cfg.Resume),
+		zap.Bool("checkpointInDownstream", cfg.CheckpointURI == ""))
+	c.JSON(getStatus(c), job)
+}
+
+// GetVerifyChangefeedJob gets the verification job of a changefeed
+// @Summary Get the verification job of a changefeed
+// @Description get the state and the result of the last verification job of a changefeed
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param keyspace query string false "default"
+// @Success 200 {object} VerifyChangefeedJob
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/verify [get]
+func (h *OpenAPIV2) GetVerifyChangefeedJob(c *gin.Context) {
+	changefeedDisplayName, ok := validateChangefeedIDParam(c)
+	if !ok {
+		return
+	}
+	job, ok := h.verifyJobs.get(changefeedDisplayName)
+	if !ok {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack(
+			"changefeed %s has no verification job", changefeedDisplayName.Name))
+		return
+	}
+	c.JSON(getStatus(c), job)
+}
+
+// CancelVerifyChangefeedJob cancels the verification job of a changefeed
+// @Summary Cancel the verification job of a changefeed
+// @Description cancel the running verification job of a changefeed
+// @Tags changefeed,v2
+// @Produce json
+// @Param changefeed_id  path  string  true  "changefeed_id"
+// @Param keyspace query string false "default"
+// @Success 200 {object} EmptyResponse
+// @Failure 500,400 {object} common.HTTPError
+// @Router /api/v2/changefeeds/{changefeed_id}/verify [delete]
+func (h *OpenAPIV2) CancelVerifyChangefeedJob(c *gin.Context) {
+	changefeedDisplayName, ok := validateChangefeedIDParam(c)
+	if !ok {
+		return
+	}
+	if !h.verifyJobs.cancel(changefeedDisplayName) {
+		_ = c.Error(errors.ErrAPIInvalidParam.GenWithStack(
+			"changefeed %s has no running verification job", changefeedDisplayName.Name))
+		return
+	}
+	c.JSON(getStatus(c), &EmptyResponse{})
+}
+
+func toAPIVerifyChangefeedResult(result *verifier.Result) *VerifyChangefeedResult {
+	resp := &VerifyChangefeedResult{
+		PrimaryTs:   result.PrimaryTs,
+		SecondaryTs: result.SecondaryTs,
+		Consistent:  result.Consistent,
+	}
+	for _, table := range result.Tables {
+		tableResp := VerifyTableResult{
+			Schema:       table.Schema,
+			Table:        table.Table,
+			TargetSchema: table.TargetSchema,
+			TargetTable:  table.TargetTable,
+			StructEqual:  table.StructEqual,
+			DataEqual:    table.DataEqual,
+			FixSQL:       table.FixSQL,
+			Error:        table.Error,
+		}
+		for _, chunk := range table.MismatchedChunks {
+			tableResp.MismatchedChunks = append(tableResp.MismatchedChunks, VerifyChunk{
+				ID:    chunk.ID,
+				Range: chunk.Range,
+				State: chunk.State,
+			})
+		}
+		resp.Tables = append(resp.Tables, tableResp)
+	}
+	return resp
+}
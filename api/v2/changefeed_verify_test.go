@@ -0,0 +1,79 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/verifier"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyJobs(t *testing.T) {
+	jobs := newVerifyJobs()
+	id := common.NewChangeFeedDisplayName("test", "default")
+	_, ok := jobs.get(id)
+	require.False(t, ok)
+
+	// the job runs in the background until it's done.
+	done := make(chan struct{})
+	job, err := jobs.start(id, func(ctx context.Context) (*verifier.Result, error) {
+		<-done
+		return &verifier.Result{
+			Syncpoint:  verifier.Syncpoint{PrimaryTs: 100, SecondaryTs: 105},
+			Consistent: true,
+		}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, VerifyJobStateRunning, job.State)
+	_, err = jobs.start(id, func(ctx context.Context) (*verifier.Result, error) {
+		return nil, nil
+	})
+	require.ErrorContains(t, err, "is running")
+	close(done)
+	require.Eventually(t, func() bool {
+		job, ok = jobs.get(id)
+		return ok && job.State == VerifyJobStateFinished
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NotNil(t, job.FinishTime)
+	require.Equal(t, &VerifyChangefeedResult{PrimaryTs: 100, SecondaryTs: 105, Consistent: true}, job.Result)
+	require.False(t, jobs.cancel(id))
+
+	// the failed job records the error.
+	_, err = jobs.start(id, func(ctx context.Context) (*verifier.Result, error) {
+		return nil, errors.New("syncpoint not found")
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		job, ok = jobs.get(id)
+		return ok && job.State == VerifyJobStateFailed
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, "syncpoint not found", job.Error)
+
+	// the running job is cancelled.
+	_, err = jobs.start(id, func(ctx context.Context) (*verifier.Result, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	require.NoError(t, err)
+	require.True(t, jobs.cancel(id))
+	require.Eventually(t, func() bool {
+		job, ok = jobs.get(id)
+		return ok && job.State == VerifyJobStateCancelled
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -75,6 +75,150 @@ type TableName struct {
 	IsPartition bool   `json:"is_partition"`
 }
 
+// FilterPreview is the difference of the replicated tables of a changefeed
+// between its current config and a proposed config, at the checkpoint ts.
+type FilterPreview struct {
+	CheckpointTs uint64 `json:"checkpoint_ts"`
+	// AddedTables are the tables replicated by the proposed config only.
+	AddedTables []TableName `json:"added_tables,omitempty"`
+	// RemovedTables are the tables replicated by the current config only.
+	RemovedTables []TableName `json:"removed_tables,omitempty"`
+	// IneligibleTables are the tables matched by the proposed config but
+	// can not be replicated.
+	IneligibleTables []TableName `json:"ineligible_tables,omitempty"`
+	// RouteChanges are the tables replicated by both configs whose route
+	// target is changed.
+	RouteChanges []TableRouteChange `json:"route_changes,omitempty"`
+}
+
+// TableRouteChange is the change of the route target of a table
+type TableRouteChange struct {
+	Schema          string `json:"database_name"`
+	Table           string `json:"table_name"`
+	OldTargetSchema string `json:"old_target_database_name"`
+	OldTargetTable  string `json:"old_target_table_name"`
+	NewTargetSchema string `json:"new_target_database_name"`
+	NewTargetTable  string `json:"new_target_table_name"`
+}
+
+// VerifyChangefeedConfig is the config to verify the consistency of a
+// changefeed between the upstream and the downstream at a syncpoint.
+type VerifyChangefeedConfig struct {
+	// UpstreamURI is a MySQL compatible uri of the upstream TiDB.
+	UpstreamURI string `json:"upstream_uri"`
+	// SyncpointTs is the primary ts of the syncpoint, 0 means the latest one.
+	SyncpointTs uint64 `json:"syncpoint_ts"`
+	// Tables are the table filter rules to limit the tables to verify.
+	Tables           []string `json:"tables,omitempty"`
+	ChunkSize        int      `json:"chunk_size"`
+	CheckThreadCount int      `json:"check_thread_count"`
+	// Resume continues the last verification at the same syncpoint.
+	Resume bool `json:"resume"`
+	// FixSQL generates the SQL to fix the downstream data.
+	FixSQL bool `json:"fix_sql"`
+	// CheckpointURI is a MySQL compatible uri of the database saving the
+	// checkpoint of the verification, the downstream is used by default.
+	CheckpointURI string `json:"checkpoint_uri,omitempty"`
+}
+
+const (
+	// VerifyJobStateRunning means the verification job is running.
+	VerifyJobStateRunning = "running"
+	// VerifyJobStateFinished means the verification job is finished, its
+	// result is set.
+	VerifyJobStateFinished = "finished"
+	// VerifyJobStateFailed means the verification job is failed, its error is set.
+	VerifyJobStateFailed = "failed"
+	// VerifyJobStateCancelled means the verification job is cancelled.
+	VerifyJobStateCancelled = "cancelled"
+)
+
+// VerifyChangefeedJob is the background job verifying the consistency of a
+// changefeed, only the last job of a changefeed is kept by the coordinator.
+type VerifyChangefeedJob struct {
+	State      string                  `json:"state"`
+	StartTime  time.Time               `json:"start_time"`
+	FinishTime *time.Time              `json:"finish_time,omitempty"`
+	Error      string                  `json:"error,omitempty"`
+	Result     *VerifyChangefeedResult `json:"result,omitempty"`
+}
+
+// VerifyChangefeedResult is the result of a changefeed verification.
+type VerifyChangefeedResult struct {
+	PrimaryTs   uint64              `json:"primary_ts"`
+	SecondaryTs uint64              `json:"secondary_ts"`
+	Consistent  bool                `json:"consistent"`
+	Tables      []VerifyTableResult `json:"tables,omitempty"`
+}
+
+// VerifyTableResult is the verification result of a table.
+type VerifyTableResult struct {
+	Schema           string        `json:"database_name"`
+	Table            string        `json:"table_name"`
+	TargetSchema     string        `json:"target_database_name"`
+	TargetTable      string        `json:"target_table_name"`
+	StructEqual      bool          `json:"struct_equal"`
+	DataEqual        bool          `json:"data_equal"`
+	MismatchedChunks []VerifyChunk `json:"mismatched_chunks,omitempty"`
+	FixSQL           []string      `json:"fix_sql,omitempty"`
+	Error            string        `json:"error,omitempty"`
+}
+
+// VerifyChunk is a chunk whose data is not equal or failed to be checked.
+type VerifyChunk struct {
+	ID    int    `json:"id"`
+	Range string `json:"range"`
+	State string `json:"state"`
+}
+
+// DeadLetterRecord is an event rejected by the downstream and written to
+// the dead letter queue of a changefeed.
+type DeadLetterRecord struct {
+	ID        string `json:"id"`
+	EventType string `json:"event_type"`
+	Schema    string `json:"database_name"`
+	Table     string `json:"table_name"`
+	StartTs   uint64 `json:"start_ts"`
+	CommitTs  uint64 `json:"commit_ts"`
+	// Statements are the statements replayed to the downstream.
+	Statements []string `json:"statements,omitempty"`
+	// PreRow and Row are the column values of a row rejected by the mq downstream.
+	PreRow     map[string]interface{} `json:"pre_row,omitempty"`
+	Row        map[string]interface{} `json:"row,omitempty"`
+	Error      string                 `json:"error"`
+	Retries    int                    `json:"retries"`
+	CreateTime time.Time              `json:"create_time"`
+}
+
+// ReplayDeadLettersConfig is the config to replay the records in the dead
+// letter queue of a changefeed.
+type ReplayDeadLettersConfig struct {
+	IDs []string `json:"ids"`
+}
+
+// ReplayDeadLettersResult is the result of replaying the dead letter records,
+// the replayed records are removed from the dead letter queue.
+type ReplayDeadLettersResult struct {
+	Replayed []string               `json:"replayed"`
+	Failed   []DeadLetterReplayFail `json:"failed,omitempty"`
+}
+
+// DeadLetterReplayFail is a dead letter record failed to be replayed.
+type DeadLetterReplayFail struct {
+	ID    string `json:"id"`
+	Error string `json:"error"`
+}
+
+// PendingDDL is a DDL matched by a pause ddl policy, which blocks the
+// changefeed until it's approved or skipped.
+type PendingDDL struct {
+	CommitTs   uint64    `json:"commit_ts"`
+	Schema     string    `json:"schema"`
+	Table      string    `json:"table,omitempty"`
+	Query      string    `json:"query"`
+	CreateTime time.Time `json:"create_time"`
+}
+
 // VerifyTableConfig use to verify tables.
 // Only use by Open API v2.
 type VerifyTableConfig struct {
@@ -119,6 +263,15 @@ type SyncedStatusConfig struct {
 	CheckpointInterval *int64 `json:"checkpoint_interval" toml:"checkpoint-interval"`
 }
 
+// ValidationConfig represents the periodic data validation config for a changefeed
+// This is a duplicate of config.ValidationConfig
+type ValidationConfig struct {
+	Enable           *bool         `json:"enable,omitempty" toml:"enable,omitempty"`
+	UpstreamURI      *string       `json:"upstream_uri,omitempty" toml:"upstream-uri,omitempty"`
+	SampleTableCount *int          `json:"sample_table_count,omitempty" toml:"sample-table-count,omitempty"`
+	Interval         *JSONDuration `json:"interval,omitempty" toml:"interval,omitempty"`
+}
+
 // MarshalJSON marshal changefeed common info to json
 // we need to set feed state to normal if it is uninitialized and pending to warning
 // to hide the detail of uninitialized and pending state from user
@@ -148,9 +301,188 @@ type ChangefeedConfig struct {
 	TargetTs      uint64         `json:"target_ts"`
 	SinkURI       string         `json:"sink_uri"`
 	ReplicaConfig *ReplicaConfig `json:"replica_config"`
+	// StopPolicy stops the changefeed automatically. When updating a changefeed,
+	// an empty stop policy removes the stop policy of the changefeed.
+	StopPolicy *StopPolicy `json:"stop_policy,omitempty"`
+	PDConfig
+}
+
+// StopPolicy describes when the coordinator stops a changefeed automatically,
+// the policy fires once any of the conditions is met.
+type StopPolicy struct {
+	TargetTs uint64     `json:"target_ts,omitempty" toml:"target-ts,omitempty"`
+	Deadline *time.Time `json:"deadline,omitempty" toml:"deadline,omitempty"`
+	// DailyTime is in the format of "HH:MM"
+	DailyTime  string `json:"daily_time,omitempty" toml:"daily-time,omitempty"`
+	TimeZone   string `json:"time_zone,omitempty" toml:"time-zone,omitempty"`
+	OnceSynced bool   `json:"once_synced,omitempty" toml:"once-synced,omitempty"`
+	// Action is one of "finish" and "pause", "finish" by default.
+	Action     string `json:"action,omitempty" toml:"action,omitempty"`
+	WebhookURL string `json:"webhook_url,omitempty" toml:"webhook-url,omitempty"`
+}
+
+// IsEmpty returns true if none of the conditions of the policy is set.
+func (p *StopPolicy) IsEmpty() bool {
+	return p.TargetTs == 0 && p.Deadline == nil && p.DailyTime == "" && !p.OnceSynced
+}
+
+// ToInternalStopPolicy converts the api stop policy to the internal one.
+func (p *StopPolicy) ToInternalStopPolicy() *config.StopPolicy {
+	return &config.StopPolicy{
+		TargetTs:   p.TargetTs,
+		Deadline:   p.Deadline,
+		DailyTime:  p.DailyTime,
+		TimeZone:   p.TimeZone,
+		OnceSynced: p.OnceSynced,
+		Action:     config.StopPolicyAction(p.Action),
+		WebhookURL: p.WebhookURL,
+	}
+}
+
+// ToAPIStopPolicy converts the internal stop policy to the api one.
+func ToAPIStopPolicy(p *config.StopPolicy) *StopPolicy {
+	if p == nil {
+		return nil
+	}
+	return &StopPolicy{
+		TargetTs:   p.TargetTs,
+		Deadline:   p.Deadline,
+		DailyTime:  p.DailyTime,
+		TimeZone:   p.TimeZone,
+		OnceSynced: p.OnceSynced,
+		Action:     string(p.Action),
+		WebhookURL: p.WebhookURL,
+	}
+}
+
+// ChangefeedTemplate is a stored replica config and sink uri pattern used by
+// the bulk changefeed apis
+type ChangefeedTemplate struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// SinkURI is the sink uri pattern, placeholders like {changefeed_id}, {keyspace}
+	// and the user defined {var} are replaced when the template is applied.
+	SinkURI       string         `json:"sink_uri"`
+	ReplicaConfig *ReplicaConfig `json:"replica_config"`
+	// Variables is the variables referenced by the sink uri pattern, it's read only.
+	Variables  []string  `json:"variables,omitempty"`
+	CreateTime time.Time `json:"create_time"`
+	UpdateTime time.Time `json:"update_time"`
+}
+
+// ToInternalChangefeedTemplate converts the api template to the internal one.
+func (t *ChangefeedTemplate) ToInternalChangefeedTemplate() *config.ChangefeedTemplate {
+	tpl := &config.ChangefeedTemplate{
+		Name:        t.Name,
+		Description: t.Description,
+		SinkURI:     t.SinkURI,
+	}
+	if t.ReplicaConfig != nil {
+		tpl.Config = t.ReplicaConfig.ToInternalReplicaConfig()
+	}
+	return tpl
+}
+
+// ToAPIChangefeedTemplate converts the internal template to the api one.
+func ToAPIChangefeedTemplate(t *config.ChangefeedTemplate) *ChangefeedTemplate {
+	tpl := &ChangefeedTemplate{
+		Name:        t.Name,
+		Description: t.Description,
+		SinkURI:     t.SinkURI,
+		Variables:   t.Variables(),
+		CreateTime:  t.CreateTime,
+		UpdateTime:  t.UpdateTime,
+	}
+	if t.Config != nil {
+		tpl.ReplicaConfig = ToAPIReplicaConfig(t.Config)
+	}
+	return tpl
+}
+
+// BulkChangefeedItem is a changefeed operated by the bulk changefeed apis
+type BulkChangefeedItem struct {
+	ID string `json:"changefeed_id"`
+	// Keyspace is the keyspace of the changefeed, the keyspace of the request is used if it's empty.
+	Keyspace string `json:"keyspace,omitempty"`
+	// Variables are used to render the sink uri pattern of the template.
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// BulkChangefeedConfig is used by the bulk changefeed apis. The template is
+// required by the bulk create and update apis, and is ignored by the bulk
+// pause and resume apis.
+type BulkChangefeedConfig struct {
+	TemplateName string               `json:"template_name"`
+	StartTs      uint64               `json:"start_ts"`
+	TargetTs     uint64               `json:"target_ts"`
+	Items        []BulkChangefeedItem `json:"items"`
 	PDConfig
 }
 
+// BulkChangefeedResult is the result of a changefeed in a bulk operation
+type BulkChangefeedResult struct {
+	ID       string `json:"changefeed_id"`
+	Keyspace string `json:"keyspace"`
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+}
+
+// BulkChangefeedResponse is the response of the bulk changefeed apis. The bulk
+// operation is atomic, either all changefeeds succeed, or none of them is changed.
+type BulkChangefeedResponse struct {
+	Succeeded bool                   `json:"succeeded"`
+	Results   []BulkChangefeedResult `json:"results"`
+}
+
+// ChangefeedConfigChange is a changed item between two config revisions
+type ChangefeedConfigChange struct {
+	Path string `json:"path"`
+	From any    `json:"from"`
+	To   any    `json:"to"`
+}
+
+// ChangefeedConfigRevision is a revision of the config of a changefeed
+type ChangefeedConfigRevision struct {
+	Revision uint64    `json:"revision"`
+	Author   string    `json:"author"`
+	Time     time.Time `json:"time"`
+	// RollbackTo is the revision restored by this revision, 0 means it's a normal update.
+	RollbackTo    uint64                   `json:"rollback_to,omitempty"`
+	SinkURI       string                   `json:"sink_uri"`
+	TargetTs      uint64                   `json:"target_ts"`
+	ReplicaConfig *ReplicaConfig           `json:"replica_config"`
+	Diff          []ChangefeedConfigChange `json:"diff,omitempty"`
+}
+
+// ToAPIChangefeedConfigRevision converts the internal config revision to the api one,
+// the sensitive data in the sink uri is masked.
+func ToAPIChangefeedConfigRevision(r *config.ChangefeedConfigRevision) *ChangefeedConfigRevision {
+	revision := &ChangefeedConfigRevision{
+		Revision:   r.Revision,
+		Author:     r.Author,
+		Time:       r.Time,
+		RollbackTo: r.RollbackTo,
+		SinkURI:    util.MaskSensitiveDataInURI(r.SinkURI),
+		TargetTs:   r.TargetTs,
+	}
+	if r.Config != nil {
+		revision.ReplicaConfig = ToAPIReplicaConfig(r.Config)
+	}
+	for _, change := range r.Diff {
+		if change.Path == "sink-uri" {
+			from, _ := change.From.(string)
+			to, _ := change.To.(string)
+			change.From, change.To = util.MaskSensitiveDataInURI(from), util.MaskSensitiveDataInURI(to)
+		}
+		revision.Diff = append(revision.Diff, ChangefeedConfigChange{
+			Path: change.Path,
+			From: change.From,
+			To:   change.To,
+		})
+	}
+	return revision
+}
+
 // ProcessorCommonInfo holds the common info of a processor
 type ProcessorCommonInfo struct {
 	Keyspace     string `json:"keyspace"`
@@ -243,6 +575,7 @@ type ReplicaConfig struct {
 	Integrity                    *IntegrityConfig           `json:"integrity,omitempty" toml:"integrity,omitempty"`
 	ChangefeedErrorStuckDuration *JSONDuration              `json:"changefeed_error_stuck_duration,omitempty" toml:"changefeed-error-stuck-duration,omitempty"`
 	SyncedStatus                 *SyncedStatusConfig        `json:"synced_status,omitempty" toml:"synced-status,omitempty"`
+	Validation                   *ValidationConfig          `json:"validation,omitempty" toml:"validation,omitempty"`
 
 	// Deprecated: we don't use this field since v8.0.0.
 	SQLMode *string `json:"sql_mode,omitempty" toml:"sql-mode,omitempty"`
@@ -304,10 +637,15 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 		for _, ef := range c.Filter.EventFilters {
 			efs = append(efs, ef.ToInternalEventFilterRule())
 		}
+		var dps []*config.DDLPolicyRule
+		for _, dp := range c.Filter.DDLPolicies {
+			dps = append(dps, dp.ToInternalDDLPolicyRule())
+		}
 		res.Filter = &config.FilterConfig{
 			Rules:            c.Filter.Rules,
 			IgnoreTxnStartTs: c.Filter.IgnoreTxnStartTs,
 			EventFilters:     efs,
+			DDLPolicies:      dps,
 		}
 	}
 	if c.Consistent != nil {
@@ -376,6 +714,34 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				Columns: selector.Columns,
 			})
 		}
+		var transforms []*config.TransformRule
+		for _, rule := range c.Sink.Transforms {
+			columns := make([]*config.ColumnTransform, 0, len(rule.Columns))
+			for _, column := range rule.Columns {
+				columns = append(columns, &config.ColumnTransform{
+					Column:     column.Column,
+					Type:       column.Type,
+					KeepPrefix: column.KeepPrefix,
+					KeepSuffix: column.KeepSuffix,
+					MaskChar:   column.MaskChar,
+					Length:     column.Length,
+					Value:      column.Value,
+					Expression: column.Expression,
+				})
+			}
+			transforms = append(transforms, &config.TransformRule{
+				Matcher: rule.Matcher,
+				Columns: columns,
+			})
+		}
+		var deadLetterQueue *config.DeadLetterQueueConfig
+		if c.Sink.DeadLetterQueue != nil {
+			deadLetterQueue = &config.DeadLetterQueueConfig{
+				URI:        c.Sink.DeadLetterQueue.URI,
+				Table:      c.Sink.DeadLetterQueue.Table,
+				MaxRetries: c.Sink.DeadLetterQueue.MaxRetries,
+			}
+		}
 		var csvConfig *config.CSVConfig
 		if c.Sink.CSVConfig != nil {
 			csvConfig = &config.CSVConfig{
@@ -517,6 +883,22 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				EnableBatchDML:               c.Sink.MySQLConfig.EnableBatchDML,
 				EnableMultiStatement:         c.Sink.MySQLConfig.EnableMultiStatement,
 				EnableCachePreparedStatement: c.Sink.MySQLConfig.EnableCachePreparedStatement,
+				SoftDeleteColumn:             c.Sink.MySQLConfig.SoftDeleteColumn,
+			}
+			for _, column := range c.Sink.MySQLConfig.ExtraColumns {
+				mysqlConfig.ExtraColumns = append(mysqlConfig.ExtraColumns, &config.ExtraColumn{
+					Name:  column.Name,
+					Type:  column.Type,
+					Value: column.Value,
+				})
+			}
+			for _, rule := range c.Sink.MySQLConfig.ConflictPolicies {
+				mysqlConfig.ConflictPolicies = append(mysqlConfig.ConflictPolicies, &config.ConflictPolicyRule{
+					Matcher:         rule.Matcher,
+					Policy:          rule.Policy,
+					TimestampColumn: rule.TimestampColumn,
+					DeadLetterTable: rule.DeadLetterTable,
+				})
 			}
 		}
 		var cloudStorageConfig *config.CloudStorageConfig
@@ -533,12 +915,14 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 				FlushConcurrency:     c.Sink.CloudStorageConfig.FlushConcurrency,
 				OutputRawChangeEvent: c.Sink.CloudStorageConfig.OutputRawChangeEvent,
 				UseTableIDAsPath:     c.Sink.CloudStorageConfig.UseTableIDAsPath,
+				TableFormat:          c.Sink.CloudStorageConfig.TableFormat,
 			}
 		}
 		var debeziumConfig *config.DebeziumConfig
 		if c.Sink.DebeziumConfig != nil {
 			debeziumConfig = &config.DebeziumConfig{
 				OutputOldValue: c.Sink.DebeziumConfig.OutputOldValue,
+				SnapshotMode:   c.Sink.DebeziumConfig.SnapshotMode,
 			}
 		}
 		var openProtocolConfig *config.OpenProtocolConfig
@@ -553,6 +937,8 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			Protocol:                         c.Sink.Protocol,
 			CSVConfig:                        csvConfig,
 			ColumnSelectors:                  columnSelectors,
+			Transforms:                       transforms,
+			DeadLetterQueue:                  deadLetterQueue,
 			SchemaRegistry:                   c.Sink.SchemaRegistry,
 			EncoderConcurrency:               c.Sink.EncoderConcurrency,
 			Terminator:                       c.Sink.Terminator,
@@ -673,6 +1059,24 @@ func (c *ReplicaConfig) toInternalReplicaConfigWithOriginConfig(
 			res.SyncedStatus.CheckpointInterval = c.SyncedStatus.CheckpointInterval
 		}
 	}
+	if c.Validation != nil {
+		if res.Validation == nil {
+			res.Validation = &config.ValidationConfig{}
+		}
+
+		if c.Validation.Enable != nil {
+			res.Validation.Enable = c.Validation.Enable
+		}
+		if c.Validation.UpstreamURI != nil {
+			res.Validation.UpstreamURI = c.Validation.UpstreamURI
+		}
+		if c.Validation.SampleTableCount != nil {
+			res.Validation.SampleTableCount = c.Validation.SampleTableCount
+		}
+		if c.Validation.Interval != nil {
+			res.Validation.Interval = &c.Validation.Interval.duration
+		}
+	}
 	return res
 }
 
@@ -714,10 +1118,16 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			efs = append(efs, ToAPIEventFilterRule(ef))
 		}
 
+		var dps []DDLPolicyRule
+		for _, dp := range cloned.Filter.DDLPolicies {
+			dps = append(dps, ToAPIDDLPolicyRule(dp))
+		}
+
 		res.Filter = &FilterConfig{
 			Rules:            cloned.Filter.Rules,
 			IgnoreTxnStartTs: cloned.Filter.IgnoreTxnStartTs,
 			EventFilters:     efs,
+			DDLPolicies:      dps,
 		}
 	}
 	if cloned.Sink != nil {
@@ -740,6 +1150,34 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				Columns: selector.Columns,
 			})
 		}
+		var transforms []*TransformRule
+		for _, rule := range cloned.Sink.Transforms {
+			columns := make([]*ColumnTransform, 0, len(rule.Columns))
+			for _, column := range rule.Columns {
+				columns = append(columns, &ColumnTransform{
+					Column:     column.Column,
+					Type:       column.Type,
+					KeepPrefix: column.KeepPrefix,
+					KeepSuffix: column.KeepSuffix,
+					MaskChar:   column.MaskChar,
+					Length:     column.Length,
+					Value:      column.Value,
+					Expression: column.Expression,
+				})
+			}
+			transforms = append(transforms, &TransformRule{
+				Matcher: rule.Matcher,
+				Columns: columns,
+			})
+		}
+		var deadLetterQueue *DeadLetterQueueConfig
+		if cloned.Sink.DeadLetterQueue != nil {
+			deadLetterQueue = &DeadLetterQueueConfig{
+				URI:        cloned.Sink.DeadLetterQueue.URI,
+				Table:      cloned.Sink.DeadLetterQueue.Table,
+				MaxRetries: cloned.Sink.DeadLetterQueue.MaxRetries,
+			}
+		}
 		var csvConfig *CSVConfig
 		if cloned.Sink.CSVConfig != nil {
 			csvConfig = &CSVConfig{
@@ -848,6 +1286,22 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				EnableBatchDML:               cloned.Sink.MySQLConfig.EnableBatchDML,
 				EnableMultiStatement:         cloned.Sink.MySQLConfig.EnableMultiStatement,
 				EnableCachePreparedStatement: cloned.Sink.MySQLConfig.EnableCachePreparedStatement,
+				SoftDeleteColumn:             cloned.Sink.MySQLConfig.SoftDeleteColumn,
+			}
+			for _, column := range cloned.Sink.MySQLConfig.ExtraColumns {
+				mysqlConfig.ExtraColumns = append(mysqlConfig.ExtraColumns, &ExtraColumn{
+					Name:  column.Name,
+					Type:  column.Type,
+					Value: column.Value,
+				})
+			}
+			for _, rule := range cloned.Sink.MySQLConfig.ConflictPolicies {
+				mysqlConfig.ConflictPolicies = append(mysqlConfig.ConflictPolicies, &ConflictPolicyRule{
+					Matcher:         rule.Matcher,
+					Policy:          rule.Policy,
+					TimestampColumn: rule.TimestampColumn,
+					DeadLetterTable: rule.DeadLetterTable,
+				})
 			}
 		}
 		var pulsarConfig *PulsarConfig
@@ -896,12 +1350,14 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 				FlushConcurrency:     cloned.Sink.CloudStorageConfig.FlushConcurrency,
 				OutputRawChangeEvent: cloned.Sink.CloudStorageConfig.OutputRawChangeEvent,
 				UseTableIDAsPath:     cloned.Sink.CloudStorageConfig.UseTableIDAsPath,
+				TableFormat:          cloned.Sink.CloudStorageConfig.TableFormat,
 			}
 		}
 		var debeziumConfig *DebeziumConfig
 		if cloned.Sink.Debezium != nil {
 			debeziumConfig = &DebeziumConfig{
 				OutputOldValue: cloned.Sink.Debezium.OutputOldValue,
+				SnapshotMode:   cloned.Sink.Debezium.SnapshotMode,
 			}
 		}
 		var openProtocolConfig *OpenProtocolConfig
@@ -916,6 +1372,8 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			DispatchRules:                    dispatchRules,
 			CSVConfig:                        csvConfig,
 			ColumnSelectors:                  columnSelectors,
+			Transforms:                       transforms,
+			DeadLetterQueue:                  deadLetterQueue,
 			EncoderConcurrency:               cloned.Sink.EncoderConcurrency,
 			Terminator:                       cloned.Sink.Terminator,
 			DateSeparator:                    cloned.Sink.DateSeparator,
@@ -1076,6 +1534,16 @@ func ToAPIReplicaConfig(c *config.ReplicaConfig) *ReplicaConfig {
 			res.SyncedStatus.CheckpointInterval = cloned.SyncedStatus.CheckpointInterval
 		}
 	}
+	if cloned.Validation != nil {
+		res.Validation = &ValidationConfig{
+			Enable:           cloned.Validation.Enable,
+			UpstreamURI:      cloned.Validation.UpstreamURI,
+			SampleTableCount: cloned.Validation.SampleTableCount,
+		}
+		if cloned.Validation.Interval != nil {
+			res.Validation.Interval = &JSONDuration{*cloned.Validation.Interval}
+		}
+	}
 	return res
 }
 
@@ -1090,6 +1558,7 @@ type FilterConfig struct {
 	Rules            []string          `json:"rules,omitempty" toml:"rules,omitempty"`
 	IgnoreTxnStartTs []uint64          `json:"ignore_txn_start_ts,omitempty" toml:"ignore-txn-start-ts,omitempty"`
 	EventFilters     []EventFilterRule `json:"event_filters,omitempty" toml:"event-filters,omitempty"`
+	DDLPolicies      []DDLPolicyRule   `json:"ddl_policies,omitempty" toml:"ddl-policies,omitempty"`
 }
 
 // MounterConfig represents mounter config for a changefeed
@@ -1160,6 +1629,60 @@ func ToAPIEventFilterRule(er *config.EventFilterRule) EventFilterRule {
 	return res
 }
 
+// DDLPolicyRule represents how the DDLs of the tables matched by the matcher
+// are handled
+type DDLPolicyRule struct {
+	Matcher []string `json:"matcher" toml:"matcher"`
+	Events  []string `json:"events,omitempty" toml:"events,omitempty"`
+	// regular expression
+	SQL                  []string `json:"sql,omitempty" toml:"sql,omitempty"`
+	Policy               string   `json:"policy" toml:"policy"`
+	TransformPattern     string   `json:"transform_pattern,omitempty" toml:"transform-pattern,omitempty"`
+	TransformReplacement string   `json:"transform_replacement,omitempty" toml:"transform-replacement,omitempty"`
+}
+
+// ToInternalDDLPolicyRule converts DDLPolicyRule to *config.DDLPolicyRule
+func (d DDLPolicyRule) ToInternalDDLPolicyRule() *config.DDLPolicyRule {
+	res := &config.DDLPolicyRule{
+		Matcher:              d.Matcher,
+		SQL:                  d.SQL,
+		Policy:               d.Policy,
+		TransformPattern:     d.TransformPattern,
+		TransformReplacement: d.TransformReplacement,
+	}
+	if len(d.Events) != 0 {
+		res.Events = make([]bf.EventType, len(d.Events))
+		for i, et := range d.Events {
+			res.Events[i] = bf.EventType(et)
+		}
+	}
+	return res
+}
+
+// ToAPIDDLPolicyRule converts *config.DDLPolicyRule to API DDLPolicyRule
+func ToAPIDDLPolicyRule(dp *config.DDLPolicyRule) DDLPolicyRule {
+	res := DDLPolicyRule{
+		Policy:               dp.Policy,
+		TransformPattern:     dp.TransformPattern,
+		TransformReplacement: dp.TransformReplacement,
+	}
+	if len(dp.Matcher) != 0 {
+		res.Matcher = make([]string, len(dp.Matcher))
+		copy(res.Matcher, dp.Matcher)
+	}
+	if len(dp.SQL) != 0 {
+		res.SQL = make([]string, len(dp.SQL))
+		copy(res.SQL, dp.SQL)
+	}
+	if len(dp.Events) != 0 {
+		res.Events = make([]string, len(dp.Events))
+		for i, et := range dp.Events {
+			res.Events[i] = string(et)
+		}
+	}
+	return res
+}
+
 // Table represents a qualified table name.
 type Table struct {
 	// Schema is the name of the schema (database) containing this table.
@@ -1171,17 +1694,19 @@ type Table struct {
 // SinkConfig represents sink config for a changefeed
 // This is a duplicate of config.SinkConfig
 type SinkConfig struct {
-	Protocol                 *string           `json:"protocol,omitempty" toml:"protocol,omitempty"`
-	SchemaRegistry           *string           `json:"schema_registry,omitempty" toml:"schema-registry,omitempty"`
-	CSVConfig                *CSVConfig        `json:"csv,omitempty" toml:"csv,omitempty"`
-	DispatchRules            []*DispatchRule   `json:"dispatchers,omitempty" toml:"dispatchers,omitempty"`
-	ColumnSelectors          []*ColumnSelector `json:"column_selectors,omitempty" toml:"column-selectors,omitempty"`
-	TxnAtomicity             *string           `json:"transaction_atomicity,omitempty" toml:"transaction-atomicity,omitempty"`
-	EncoderConcurrency       *int              `json:"encoder_concurrency,omitempty" toml:"encoder-concurrency,omitempty"`
-	Terminator               *string           `json:"terminator,omitempty" toml:"terminator,omitempty"`
-	DateSeparator            *string           `json:"date_separator,omitempty" toml:"date-separator,omitempty"`
-	EnablePartitionSeparator *bool             `json:"enable_partition_separator,omitempty" toml:"enable-partition-separator,omitempty"`
-	FileIndexWidth           *int              `json:"file_index_width,omitempty" toml:"file-index-digit,omitempty"`
+	Protocol                 *string                `json:"protocol,omitempty" toml:"protocol,omitempty"`
+	SchemaRegistry           *string                `json:"schema_registry,omitempty" toml:"schema-registry,omitempty"`
+	CSVConfig                *CSVConfig             `json:"csv,omitempty" toml:"csv,omitempty"`
+	DispatchRules            []*DispatchRule        `json:"dispatchers,omitempty" toml:"dispatchers,omitempty"`
+	ColumnSelectors          []*ColumnSelector      `json:"column_selectors,omitempty" toml:"column-selectors,omitempty"`
+	Transforms               []*TransformRule       `json:"transforms,omitempty" toml:"transforms,omitempty"`
+	DeadLetterQueue          *DeadLetterQueueConfig `json:"dead_letter_queue,omitempty" toml:"dead-letter-queue,omitempty"`
+	TxnAtomicity             *string                `json:"transaction_atomicity,omitempty" toml:"transaction-atomicity,omitempty"`
+	EncoderConcurrency       *int                   `json:"encoder_concurrency,omitempty" toml:"encoder-concurrency,omitempty"`
+	Terminator               *string                `json:"terminator,omitempty" toml:"terminator,omitempty"`
+	DateSeparator            *string                `json:"date_separator,omitempty" toml:"date-separator,omitempty"`
+	EnablePartitionSeparator *bool                  `json:"enable_partition_separator,omitempty" toml:"enable-partition-separator,omitempty"`
+	FileIndexWidth           *int                   `json:"file_index_width,omitempty" toml:"file-index-digit,omitempty"`
 	// deprecated: it's become useless since v9.0.0
 	EnableKafkaSinkV2                *bool               `json:"enable_kafka_sink_v2,omitempty" toml:"enable-kafka-sink-v2,omitempty"`
 	OnlyOutputUpdatedColumns         *bool               `json:"only_output_updated_columns,omitempty" toml:"only-output-updated-columns,omitempty"`
@@ -1256,6 +1781,34 @@ type ColumnSelector struct {
 	Columns []string `json:"columns,omitempty" toml:"columns,omitempty"`
 }
 
+// TransformRule represents the column transformations of the tables.
+// This is a duplicate of config.TransformRule
+type TransformRule struct {
+	Matcher []string           `json:"matcher,omitempty" toml:"matcher,omitempty"`
+	Columns []*ColumnTransform `json:"columns,omitempty" toml:"columns,omitempty"`
+}
+
+// ColumnTransform represents the transformation of a column.
+// This is a duplicate of config.ColumnTransform
+type ColumnTransform struct {
+	Column     string  `json:"column" toml:"column"`
+	Type       string  `json:"type" toml:"type"`
+	KeepPrefix int     `json:"keep_prefix,omitempty" toml:"keep-prefix,omitempty"`
+	KeepSuffix int     `json:"keep_suffix,omitempty" toml:"keep-suffix,omitempty"`
+	MaskChar   string  `json:"mask_char,omitempty" toml:"mask-char,omitempty"`
+	Length     int     `json:"length,omitempty" toml:"length,omitempty"`
+	Value      *string `json:"value,omitempty" toml:"value,omitempty"`
+	Expression string  `json:"expression,omitempty" toml:"expression,omitempty"`
+}
+
+// DeadLetterQueueConfig represents the dead letter queue of a sink.
+// This is a duplicate of config.DeadLetterQueueConfig
+type DeadLetterQueueConfig struct {
+	URI        string `json:"uri" toml:"uri"`
+	Table      string `json:"table,omitempty" toml:"table,omitempty"`
+	MaxRetries *int   `json:"max_retries,omitempty" toml:"max-retries,omitempty"`
+}
+
 // ConsistentConfig represents replication consistency config for a changefeed
 // This is a duplicate of config.ConsistentConfig
 type ConsistentConfig struct {
@@ -1345,6 +1898,8 @@ type ChangeFeedInfo struct {
 	StartTs uint64 `json:"start_ts,omitempty" toml:"start-ts,omitempty"`
 	// The ChangeFeed will exits until sync to timestamp TargetTs
 	TargetTs uint64 `json:"target_ts,omitempty" toml:"target-ts,omitempty"`
+	// StopPolicy stops the changefeed automatically when it fires
+	StopPolicy *StopPolicy `json:"stop_policy,omitempty" toml:"stop-policy,omitempty"`
 	// used for admin job notification, trigger watch event in capture
 	AdminJobType   config.AdminJobType  `json:"admin_job_type,omitempty" toml:"admin-job-type,omitempty"`
 	Config         *ReplicaConfig       `json:"config,omitempty" toml:"config,omitempty"`
@@ -1534,6 +2089,26 @@ type MySQLConfig struct {
 	EnableBatchDML               *bool   `json:"enable_batch_dml,omitempty" toml:"enable-batch-dml,omitempty"`
 	EnableMultiStatement         *bool   `json:"enable_multi_statement,omitempty" toml:"enable-multi-statement,omitempty"`
 	EnableCachePreparedStatement *bool   `json:"enable_cache_prepared_statement,omitempty" toml:"enable-cache-prepared-statement,omitempty"`
+
+	ExtraColumns     []*ExtraColumn `json:"extra_columns,omitempty" toml:"extra-columns,omitempty"`
+	SoftDeleteColumn *string        `json:"soft_delete_column,omitempty" toml:"soft-delete-column,omitempty"`
+
+	ConflictPolicies []*ConflictPolicyRule `json:"conflict_policies,omitempty" toml:"conflict-policies,omitempty"`
+}
+
+// ExtraColumn represents a metadata column written by the MySQL sink
+type ExtraColumn struct {
+	Name  string `json:"name" toml:"name"`
+	Type  string `json:"type" toml:"type"`
+	Value string `json:"value,omitempty" toml:"value,omitempty"`
+}
+
+// ConflictPolicyRule represents the conflict policy of the tables matched by the matcher
+type ConflictPolicyRule struct {
+	Matcher         []string `json:"matcher" toml:"matcher"`
+	Policy          string   `json:"policy" toml:"policy"`
+	TimestampColumn string   `json:"timestamp_column,omitempty" toml:"timestamp-column,omitempty"`
+	DeadLetterTable string   `json:"dead_letter_table,omitempty" toml:"dead-letter-table,omitempty"`
 }
 
 // CloudStorageConfig represents a cloud storage sink configuration
@@ -1549,6 +2124,7 @@ type CloudStorageConfig struct {
 	FlushConcurrency     *int    `json:"flush_concurrency,omitempty" toml:"flush-concurrency,omitempty"`
 	OutputRawChangeEvent *bool   `json:"output_raw_change_event,omitempty" toml:"output-raw-change-event,omitempty"`
 	UseTableIDAsPath     *bool   `json:"use_table_id_as_path,omitempty" toml:"use-table-id-as-path,omitempty"`
+	TableFormat          *string `json:"table_format,omitempty" toml:"table-format,omitempty"`
 }
 
 // ChangefeedStatus holds common information of a changefeed in cdc
@@ -1580,7 +2156,8 @@ type OpenProtocolConfig struct {
 
 // DebeziumConfig represents the configurations for debezium protocol encoding
 type DebeziumConfig struct {
-	OutputOldValue bool `json:"output_old_value" toml:"output-old-value"`
+	OutputOldValue bool   `json:"output_old_value" toml:"output-old-value"`
+	SnapshotMode   string `json:"snapshot_mode,omitempty" toml:"snapshot-mode,omitempty"`
 }
 
 type DispatcherCount struct {
@@ -14,6 +14,7 @@ package v2
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pingcap/ticdc/pkg/config"
 	"github.com/pingcap/ticdc/pkg/util"
@@ -41,6 +42,29 @@ func TestReplicaConfigConversion(t *testing.T) {
 				SpoolDiskQuota:   util.AddressOf(int64(1024)),
 				SpoolBaseDir:     util.AddressOf("/tmp/ticdc-spool"),
 			},
+			Transforms: []*TransformRule{{
+				Matcher: []string{"test.users"},
+				Columns: []*ColumnTransform{
+					{Column: "email", Type: "hash"},
+					{Column: "phone", Type: "mask", KeepPrefix: 3, KeepSuffix: 2},
+				},
+			}},
+			DeadLetterQueue: &DeadLetterQueueConfig{
+				URI:        "s3://bucket/dlq",
+				MaxRetries: util.AddressOf(5),
+			},
+			MySQLConfig: &MySQLConfig{
+				ExtraColumns: []*ExtraColumn{
+					{Name: "_source_cluster", Type: "source-cluster", Value: "cluster-a"},
+					{Name: "_commit_ts", Type: "commit-ts"},
+				},
+				SoftDeleteColumn: util.AddressOf("_is_deleted"),
+				ConflictPolicies: []*ConflictPolicyRule{{
+					Matcher:         []string{"test.*"},
+					Policy:          "last-writer-wins",
+					TimestampColumn: "updated_at",
+				}},
+			},
 		},
 		Mounter: &MounterConfig{
 			WorkerNum: util.AddressOf(16),
@@ -59,6 +83,12 @@ func TestReplicaConfigConversion(t *testing.T) {
 			FlushIntervalInMs: util.AddressOf(int64(2000)),
 			Storage:           util.AddressOf("s3://test"),
 		},
+		Validation: &ValidationConfig{
+			Enable:           util.AddressOf(true),
+			UpstreamURI:      util.AddressOf("mysql://root@127.0.0.1:4000/"),
+			SampleTableCount: util.AddressOf(5),
+			Interval:         &JSONDuration{duration: time.Hour},
+		},
 	}
 
 	internalCfg := apiCfg.ToInternalReplicaConfig()
@@ -73,6 +103,25 @@ func TestReplicaConfigConversion(t *testing.T) {
 	require.True(t, util.GetOrZero(internalCfg.Sink.CloudStorageConfig.UseTableIDAsPath))
 	require.Equal(t, int64(1024), util.GetOrZero(internalCfg.Sink.CloudStorageConfig.SpoolDiskQuota))
 	require.Equal(t, "/tmp/ticdc-spool", util.GetOrZero(internalCfg.Sink.CloudStorageConfig.SpoolBaseDir))
+	require.Equal(t, []*config.TransformRule{{
+		Matcher: []string{"test.users"},
+		Columns: []*config.ColumnTransform{
+			{Column: "email", Type: "hash"},
+			{Column: "phone", Type: "mask", KeepPrefix: 3, KeepSuffix: 2},
+		},
+	}}, internalCfg.Sink.Transforms)
+	require.Equal(t, []*config.ExtraColumn{
+		{Name: "_source_cluster", Type: "source-cluster", Value: "cluster-a"},
+		{Name: "_commit_ts", Type: "commit-ts"},
+	}, internalCfg.Sink.MySQLConfig.ExtraColumns)
+	require.Equal(t, "_is_deleted", internalCfg.Sink.MySQLConfig.GetSoftDeleteColumn())
+	require.Equal(t, []*config.ConflictPolicyRule{{
+		Matcher:         []string{"test.*"},
+		Policy:          "last-writer-wins",
+		TimestampColumn: "updated_at",
+	}}, internalCfg.Sink.MySQLConfig.ConflictPolicies)
+	require.Equal(t, "s3://bucket/dlq", internalCfg.Sink.DeadLetterQueue.URI)
+	require.Equal(t, 5, internalCfg.Sink.DeadLetterQueue.GetMaxRetries())
 	require.Equal(t, internalCfg.Mounter.WorkerNum, *apiCfg.Mounter.WorkerNum)
 	require.True(t, util.GetOrZero(internalCfg.Scheduler.EnableTableAcrossNodes))
 	require.Equal(t, 1000, util.GetOrZero(internalCfg.Scheduler.RegionThreshold))
@@ -82,6 +131,10 @@ func TestReplicaConfigConversion(t *testing.T) {
 	require.Equal(t, int64(128), util.GetOrZero(internalCfg.Consistent.MaxLogSize))
 	require.Equal(t, int64(2000), util.GetOrZero(internalCfg.Consistent.FlushIntervalInMs))
 	require.Equal(t, "s3://test", util.GetOrZero(internalCfg.Consistent.Storage))
+	require.True(t, internalCfg.Validation.IsEnabled())
+	require.Equal(t, "mysql://root@127.0.0.1:4000/", util.GetOrZero(internalCfg.Validation.UpstreamURI))
+	require.Equal(t, 5, util.GetOrZero(internalCfg.Validation.SampleTableCount))
+	require.Equal(t, time.Hour, util.GetOrZero(internalCfg.Validation.Interval))
 
 	// Test case 2: Nil fields (should use defaults or be nil)
 	apiCfgNil := &ReplicaConfig{}
@@ -100,10 +153,18 @@ func TestReplicaConfigConversion(t *testing.T) {
 	require.True(t, *apiCfgBack.Sink.CloudStorageConfig.UseTableIDAsPath)
 	require.Equal(t, int64(1024), *apiCfgBack.Sink.CloudStorageConfig.SpoolDiskQuota)
 	require.Equal(t, "/tmp/ticdc-spool", *apiCfgBack.Sink.CloudStorageConfig.SpoolBaseDir)
+	require.Equal(t, apiCfg.Sink.Transforms, apiCfgBack.Sink.Transforms)
+	require.Equal(t, apiCfg.Sink.MySQLConfig.ExtraColumns, apiCfgBack.Sink.MySQLConfig.ExtraColumns)
+	require.Equal(t, "_is_deleted", *apiCfgBack.Sink.MySQLConfig.SoftDeleteColumn)
+	require.Equal(t, apiCfg.Sink.MySQLConfig.ConflictPolicies, apiCfgBack.Sink.MySQLConfig.ConflictPolicies)
+	require.Equal(t, apiCfg.Sink.DeadLetterQueue, apiCfgBack.Sink.DeadLetterQueue)
 	require.Equal(t, 16, *apiCfgBack.Mounter.WorkerNum)
 	require.True(t, *apiCfgBack.Scheduler.EnableTableAcrossNodes)
 	require.Equal(t, "correctness", *apiCfgBack.Integrity.IntegrityCheckLevel)
 	require.Equal(t, "eventual", *apiCfgBack.Consistent.Level)
+	require.True(t, *apiCfgBack.Validation.Enable)
+	require.Equal(t, 5, *apiCfgBack.Validation.SampleTableCount)
+	require.Equal(t, time.Hour, apiCfgBack.Validation.Interval.duration)
 
 	// Test case 4: batch fields round trip and nil preservation
 	apiBatchCfg := &ReplicaConfig{
@@ -38,6 +38,11 @@ func newCmdChangefeed(f factory.Factory) *cobra.Command {
 	cmds.AddCommand(newCmdMoveSplitTable(f))
 	cmds.AddCommand(newCmdSplitTableByRegionCount(f))
 	cmds.AddCommand(newCmdMergeTable(f))
+	cmds.AddCommand(newCmdChangefeedHistory(f))
+	cmds.AddCommand(newCmdRollbackChangefeed(f))
+	cmds.AddCommand(newCmdChangefeedTemplate(f))
+	cmds.AddCommand(newCmdVerifyChangefeed(f))
+	cmds.AddCommand(newCmdChangefeedDDL(f))
 
 	return cmds
 }
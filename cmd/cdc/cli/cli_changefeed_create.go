@@ -48,6 +48,8 @@ type changefeedCommonOptions struct {
 	upstreamCaPath   string
 	upstreamCertPath string
 	upstreamKeyPath  string
+
+	stopPolicy stopPolicyOptions
 }
 
 // newChangefeedCommonOptions creates new changefeed common options.
@@ -74,6 +76,7 @@ func (o *changefeedCommonOptions) addFlags(cmd *cobra.Command) {
 		"Certificate path for TLS connection to upstream")
 	cmd.PersistentFlags().StringVar(&o.upstreamKeyPath, "upstream-key", "",
 		"Private key path for TLS connection to upstream")
+	o.stopPolicy.addFlags(cmd)
 	_ = cmd.PersistentFlags().MarkHidden("sort-dir")
 	// we don't support specify these flags below when cdc version >= 6.2.0
 	_ = cmd.PersistentFlags().MarkHidden("sort-engine")
@@ -108,7 +111,8 @@ type createChangefeedOptions struct {
 	timezone                string
 	verbose                 bool
 
-	cfg *config.ReplicaConfig
+	cfg        *config.ReplicaConfig
+	stopPolicy *v2.StopPolicy
 }
 
 // newCreateChangefeedOptions creates new options for the `cli changefeed create` command.
@@ -210,6 +214,15 @@ func (o *createChangefeedOptions) validate(cmd *cobra.Command) error {
 		o.commonChangefeedOptions.sortEngine = config.SortUnified
 	}
 
+	stopPolicy := &v2.StopPolicy{}
+	changed, err := o.commonChangefeedOptions.stopPolicy.apply(cmd, stopPolicy)
+	if err != nil {
+		return err
+	}
+	if changed {
+		o.stopPolicy = stopPolicy
+	}
+
 	return nil
 }
 
@@ -223,6 +236,7 @@ func (o *createChangefeedOptions) getChangefeedConfig() *v2.ChangefeedConfig {
 		TargetTs:      o.commonChangefeedOptions.targetTs,
 		SinkURI:       o.commonChangefeedOptions.sinkURI,
 		ReplicaConfig: replicaConfig,
+		StopPolicy:    o.stopPolicy,
 		PDConfig:      upstreamConfig.PDConfig,
 	}
 }
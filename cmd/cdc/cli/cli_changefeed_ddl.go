@@ -0,0 +1,139 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cmd/cdc/factory"
+	"github.com/pingcap/ticdc/cmd/util"
+	apiv2client "github.com/pingcap/ticdc/pkg/api/v2"
+	"github.com/spf13/cobra"
+)
+
+// changefeedDDLOptions defines flags for the `cli changefeed ddl` commands.
+type changefeedDDLOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	keyspace     string
+	commitTs     uint64
+	query        string
+}
+
+// newChangefeedDDLOptions creates new options for the `cli changefeed ddl` commands.
+func newChangefeedDDLOptions() *changefeedDDLOptions {
+	return &changefeedDDLOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to the ddl approval to it.
+func (o *changefeedDDLOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.keyspace, "keyspace", "k", "", "Replication task (changefeed) Keyspace")
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	cmd.PersistentFlags().Uint64Var(&o.commitTs, "commit-ts", 0, "The commit ts of the DDL waiting for the approval")
+	cmd.PersistentFlags().StringVar(&o.query, "query", "",
+		"The query of the DDL waiting for the approval, required if several DDLs are waiting at the commit ts")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+	_ = cmd.MarkPersistentFlagRequired("commit-ts")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *changefeedDDLOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// runApprove the `cli changefeed ddl approve` command.
+func (o *changefeedDDLOptions) runApprove(cmd *cobra.Command) error {
+	if o.commitTs == 0 {
+		return errors.New("the commit ts of the ddl must be specified by --commit-ts")
+	}
+	err := o.apiClient.Changefeeds().ApproveDDL(cmd.Context(), o.keyspace, o.changefeedID, o.commitTs, o.query)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Approve ddl successfully! \nID: %s\nCommitTs: %d\n", o.changefeedID, o.commitTs)
+	return nil
+}
+
+// runSkip the `cli changefeed ddl skip` command.
+func (o *changefeedDDLOptions) runSkip(cmd *cobra.Command) error {
+	if o.commitTs == 0 {
+		return errors.New("the commit ts of the ddl must be specified by --commit-ts")
+	}
+	err := o.apiClient.Changefeeds().SkipDDL(cmd.Context(), o.keyspace, o.changefeedID, o.commitTs, o.query)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Skip ddl successfully! \nID: %s\nCommitTs: %d\n", o.changefeedID, o.commitTs)
+	return nil
+}
+
+// newCmdChangefeedDDL creates the `cli changefeed ddl` command.
+func newCmdChangefeedDDL(f factory.Factory) *cobra.Command {
+	cmds := &cobra.Command{
+		Use:   "ddl",
+		Short: "Approve or skip the DDLs waiting for the approval of a replication task (changefeed)",
+		Long: "The DDLs matched by a pause ddl policy block the replication task (changefeed) until they are " +
+			"approved or skipped, the pending DDLs are listed by `cli changefeed query`",
+		Args: cobra.NoArgs,
+	}
+
+	cmds.AddCommand(newCmdApproveChangefeedDDL(f))
+	cmds.AddCommand(newCmdSkipChangefeedDDL(f))
+
+	return cmds
+}
+
+// newCmdApproveChangefeedDDL creates the `cli changefeed ddl approve` command.
+func newCmdApproveChangefeedDDL(f factory.Factory) *cobra.Command {
+	o := newChangefeedDDLOptions()
+
+	command := &cobra.Command{
+		Use:   "approve",
+		Short: "Approve a DDL waiting for the approval, it's written to the downstream",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.runApprove(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
+
+// newCmdSkipChangefeedDDL creates the `cli changefeed ddl skip` command.
+func newCmdSkipChangefeedDDL(f factory.Factory) *cobra.Command {
+	o := newChangefeedDDLOptions()
+
+	command := &cobra.Command{
+		Use:   "skip",
+		Short: "Skip a DDL waiting for the approval, it's not written to the downstream",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.runSkip(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedDDLCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdApproveChangefeedDDL(f)
+	cf.EXPECT().ApproveDDL(gomock.Any(), "default", "abc", uint64(100), "").Return(nil)
+	os.Args = []string{"approve", "--changefeed-id=abc", "--keyspace=default", "--commit-ts=100"}
+	require.Nil(t, cmd.Execute())
+
+	cmd = newCmdSkipChangefeedDDL(f)
+	cf.EXPECT().SkipDDL(gomock.Any(), "default", "abc", uint64(100), "DROP TABLE t").Return(nil)
+	os.Args = []string{"skip", "--changefeed-id=abc", "--keyspace=default", "--commit-ts=100", "--query=DROP TABLE t"}
+	require.Nil(t, cmd.Execute())
+
+	o := newChangefeedDDLOptions()
+	o.changefeedID = "abc"
+	require.Nil(t, o.complete(f))
+	// the commit ts is required
+	require.NotNil(t, o.runApprove(cmd))
+	require.NotNil(t, o.runSkip(cmd))
+
+	o.commitTs = 200
+	cf.EXPECT().ApproveDDL(gomock.Any(), "", "abc", uint64(200), "").Return(errors.New("test"))
+	require.NotNil(t, o.runApprove(cmd))
+	cf.EXPECT().SkipDDL(gomock.Any(), "", "abc", uint64(200), "").Return(errors.New("test"))
+	require.NotNil(t, o.runSkip(cmd))
+}
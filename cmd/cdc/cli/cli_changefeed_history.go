@@ -0,0 +1,118 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/ticdc/cmd/cdc/factory"
+	"github.com/pingcap/ticdc/cmd/util"
+	apiv2client "github.com/pingcap/ticdc/pkg/api/v2"
+	"github.com/spf13/cobra"
+)
+
+// changefeedHistoryOptions defines flags for the `cli changefeed history`
+// and the `cli changefeed rollback` commands.
+type changefeedHistoryOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	changefeedID string
+	keyspace     string
+	revision     uint64
+}
+
+// newChangefeedHistoryOptions creates new options for the `cli changefeed history` command.
+func newChangefeedHistoryOptions() *changefeedHistoryOptions {
+	return &changefeedHistoryOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *changefeedHistoryOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.keyspace, "keyspace", "k", "", "Replication task (changefeed) Keyspace")
+	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
+	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *changefeedHistoryOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// runHistory the `cli changefeed history` command.
+func (o *changefeedHistoryOptions) runHistory(cmd *cobra.Command) error {
+	history, err := o.apiClient.Changefeeds().History(cmd.Context(), o.keyspace, o.changefeedID)
+	if err != nil {
+		return err
+	}
+	return util.JSONPrint(cmd, history)
+}
+
+// runRollback the `cli changefeed rollback` command.
+func (o *changefeedHistoryOptions) runRollback(cmd *cobra.Command) error {
+	if o.revision == 0 {
+		return errors.New("the revision to rollback to must be specified by --revision")
+	}
+	info, err := o.apiClient.Changefeeds().Rollback(cmd.Context(), o.keyspace, o.changefeedID, o.revision)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Rollback changefeed config successfully! "+
+		"\nID: %s\nRevision: %d\nInfo: ", info.ID, o.revision)
+	return util.JSONPrint(cmd, info)
+}
+
+// newCmdChangefeedHistory creates the `cli changefeed history` command.
+func newCmdChangefeedHistory(f factory.Factory) *cobra.Command {
+	o := newChangefeedHistoryOptions()
+
+	command := &cobra.Command{
+		Use:   "history",
+		Short: "List the config revisions of a replication task (changefeed)",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.runHistory(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
+
+// newCmdRollbackChangefeed creates the `cli changefeed rollback` command.
+func newCmdRollbackChangefeed(f factory.Factory) *cobra.Command {
+	o := newChangefeedHistoryOptions()
+
+	command := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback the config of a stopped replication task (changefeed) to a revision",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.runRollback(cmd))
+		},
+	}
+
+	o.addFlags(command)
+	command.PersistentFlags().Uint64Var(&o.revision, "revision", 0, "The config revision to rollback to")
+	_ = command.MarkPersistentFlagRequired("revision")
+
+	return command
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/ticdc/api/v2"
+	"github.com/pingcap/ticdc/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedHistoryCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdChangefeedHistory(f)
+	cf.EXPECT().History(gomock.Any(), "default", "abc").Return([]v2.ChangefeedConfigRevision{
+		{Revision: 1, SinkURI: "blackhole://"},
+		{Revision: 2, Author: "root", SinkURI: "kafka://127.0.0.1:9092/test"},
+	}, nil)
+	os.Args = []string{"history", "--changefeed-id=abc", "--keyspace=default"}
+	require.Nil(t, cmd.Execute())
+
+	o := newChangefeedHistoryOptions()
+	o.changefeedID = "abc"
+	require.Nil(t, o.complete(f))
+	cf.EXPECT().History(gomock.Any(), "", "abc").Return(nil, errors.New("test"))
+	require.NotNil(t, o.runHistory(cmd))
+}
+
+func TestChangefeedRollbackCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdRollbackChangefeed(f)
+	cf.EXPECT().Rollback(gomock.Any(), "default", "abc", uint64(2)).
+		Return(&v2.ChangeFeedInfo{ID: "abc"}, nil)
+	os.Args = []string{"rollback", "--changefeed-id=abc", "--keyspace=default", "--revision=2"}
+	require.Nil(t, cmd.Execute())
+
+	o := newChangefeedHistoryOptions()
+	o.changefeedID = "abc"
+	require.Nil(t, o.complete(f))
+	// the revision is required
+	require.NotNil(t, o.runRollback(cmd))
+
+	o.revision = 3
+	cf.EXPECT().Rollback(gomock.Any(), "", "abc", uint64(3)).Return(nil, errors.New("test"))
+	require.NotNil(t, o.runRollback(cmd))
+}
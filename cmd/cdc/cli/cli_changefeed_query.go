@@ -15,6 +15,7 @@ package cli
 
 import (
 	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
 	v2 "github.com/pingcap/ticdc/api/v2"
 	"github.com/pingcap/ticdc/cmd/cdc/factory"
 	"github.com/pingcap/ticdc/cmd/util"
@@ -23,6 +24,7 @@ import (
 	"github.com/pingcap/ticdc/pkg/config"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
 	"github.com/spf13/cobra"
+	"go.uber.org/zap"
 )
 
 // cfMeta holds changefeed info and changefeed status.
@@ -36,6 +38,7 @@ type cfMeta struct {
 	StartTs        uint64                     `json:"start_ts"`
 	ResolvedTs     uint64                     `json:"resolved_ts"`
 	TargetTs       uint64                     `json:"target_ts"`
+	StopPolicy     *v2.StopPolicy             `json:"stop_policy,omitempty"`
 	CheckpointTSO  uint64                     `json:"checkpoint_tso"`
 	CheckpointTime api.JSONTime               `json:"checkpoint_time"`
 	Engine         config.SortEngine          `json:"sort_engine,omitempty"`
@@ -44,6 +47,7 @@ type cfMeta struct {
 	ErrorHis       []int64                    `json:"error_history,omitempty"`
 	CreatorVersion string                     `json:"creator_version"`
 	TaskStatus     []config.CaptureTaskStatus `json:"task_status,omitempty"`
+	PendingDDLs    []v2.PendingDDL            `json:"pending_ddls,omitempty"`
 }
 
 // queryChangefeedOptions defines flags for the `cli changefeed query` command.
@@ -108,6 +112,7 @@ func (o *queryChangefeedOptions) run(cmd *cobra.Command) error {
 		StartTs:        detail.StartTs,
 		ResolvedTs:     detail.ResolvedTs,
 		TargetTs:       detail.TargetTs,
+		StopPolicy:     detail.StopPolicy,
 		CheckpointTSO:  detail.CheckpointTs,
 		CheckpointTime: detail.CheckpointTime,
 		FeedState:      detail.State,
@@ -115,6 +120,15 @@ func (o *queryChangefeedOptions) run(cmd *cobra.Command) error {
 		CreatorVersion: detail.CreatorVersion,
 		TaskStatus:     detail.TaskStatus,
 	}
+	// The pending DDLs are only available when the changefeed is running,
+	// it's not an error if they can not be listed.
+	pendingDDLs, err := o.apiClientV2.Changefeeds().ListPendingDDLs(ctx, o.keyspace, o.changefeedID)
+	if err != nil {
+		log.Warn("failed to list the pending ddls of the changefeed",
+			zap.String("changefeed", o.changefeedID), zap.Error(err))
+	} else {
+		meta.PendingDDLs = pendingDDLs
+	}
 	return util.JSONPrint(cmd, meta)
 }
 
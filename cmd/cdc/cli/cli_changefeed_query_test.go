@@ -72,6 +72,9 @@ func TestChangefeedQueryCli(t *testing.T) {
 
 	// query success
 	cfV2.EXPECT().Get(gomock.Any(), gomock.Any(), "bcd").Return(&v2.ChangeFeedInfo{}, nil)
+	cfV2.EXPECT().ListPendingDDLs(gomock.Any(), gomock.Any(), "bcd").Return([]v2.PendingDDL{
+		{CommitTs: 100, Schema: "test", Table: "t", Query: "DROP TABLE t"},
+	}, nil)
 
 	o.simplified = false
 	o.changefeedID = "bcd"
@@ -82,6 +85,7 @@ func TestChangefeedQueryCli(t *testing.T) {
 	require.Nil(t, err)
 	// make sure config is printed
 	require.Contains(t, string(out), "config")
+	require.Contains(t, string(out), "pending_ddls")
 
 	// query failed
 	cfV2.EXPECT().Get(gomock.Any(), gomock.Any(), "bcd").Return(nil, errors.New("test"))
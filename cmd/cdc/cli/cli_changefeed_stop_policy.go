@@ -0,0 +1,105 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"time"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/ticdc/api/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// stopPolicyOptions defines the flags of the stop policy of a changefeed,
+// they're shared by the `cli changefeed create` and `cli changefeed update` commands.
+type stopPolicyOptions struct {
+	targetTs   uint64
+	deadline   string
+	dailyTime  string
+	timeZone   string
+	onceSynced bool
+	action     string
+	webhookURL string
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to the stop policy to it.
+func (o *stopPolicyOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Uint64Var(&o.targetTs, "stop-at-ts", 0,
+		"Stop the changefeed when its checkpoint ts reaches the ts")
+	cmd.PersistentFlags().StringVar(&o.deadline, "stop-at-time", "",
+		"Stop the changefeed at the wall-clock time, in RFC3339 format, e.g. 2026-01-02T15:04:05+08:00")
+	cmd.PersistentFlags().StringVar(&o.dailyTime, "stop-daily-at", "",
+		"Stop the changefeed every day at the time, in HH:MM format")
+	cmd.PersistentFlags().StringVar(&o.timeZone, "stop-time-zone", "",
+		"Time zone of --stop-daily-at, the time zone of the cdc server is used by default")
+	cmd.PersistentFlags().BoolVar(&o.onceSynced, "stop-once-synced", false,
+		"Stop the changefeed once all data is synced to the downstream")
+	cmd.PersistentFlags().StringVar(&o.action, "stop-action", "",
+		"The state of the changefeed after it's stopped by the stop policy, finish or pause, finish by default")
+	cmd.PersistentFlags().StringVar(&o.webhookURL, "stop-webhook", "",
+		"The webhook url notified by a POST request when the changefeed is stopped by the stop policy")
+}
+
+// isStopPolicyFlag returns true if the flag is defined by stopPolicyOptions.
+func isStopPolicyFlag(name string) bool {
+	switch name {
+	case "stop-at-ts", "stop-at-time", "stop-daily-at", "stop-time-zone",
+		"stop-once-synced", "stop-action", "stop-webhook":
+		return true
+	}
+	return false
+}
+
+// apply applies the stop policy flags set by the user to policy,
+// it returns true if any of the flags is set.
+func (o *stopPolicyOptions) apply(cmd *cobra.Command, policy *v2.StopPolicy) (bool, error) {
+	var (
+		changed bool
+		err     error
+	)
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if !isStopPolicyFlag(flag.Name) {
+			return
+		}
+		changed = true
+		switch flag.Name {
+		case "stop-at-ts":
+			policy.TargetTs = o.targetTs
+		case "stop-at-time":
+			if o.deadline == "" {
+				policy.Deadline = nil
+				return
+			}
+			deadline, parseErr := time.Parse(time.RFC3339, o.deadline)
+			if parseErr != nil {
+				err = errors.Annotate(parseErr, "invalid --stop-at-time")
+				return
+			}
+			policy.Deadline = &deadline
+		case "stop-daily-at":
+			policy.DailyTime = o.dailyTime
+		case "stop-time-zone":
+			policy.TimeZone = o.timeZone
+		case "stop-once-synced":
+			policy.OnceSynced = o.onceSynced
+		case "stop-action":
+			policy.Action = o.action
+		case "stop-webhook":
+			policy.WebhookURL = o.webhookURL
+		}
+	})
+	return changed, err
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	v2 "github.com/pingcap/ticdc/api/v2"
+	"github.com/pingcap/ticdc/cmd/cdc/factory"
+	"github.com/pingcap/ticdc/cmd/util"
+	apiv2client "github.com/pingcap/ticdc/pkg/api/v2"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/filter"
+	"github.com/spf13/cobra"
+)
+
+// changefeedTemplateOptions defines flags for the `cli changefeed template` commands.
+type changefeedTemplateOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	name        string
+	sinkURI     string
+	description string
+	configFile  string
+}
+
+// newChangefeedTemplateOptions creates new options for the `cli changefeed template` commands.
+func newChangefeedTemplateOptions() *changefeedTemplateOptions {
+	return &changefeedTemplateOptions{}
+}
+
+// addNameFlag binds the template name flag to the command.
+func (o *changefeedTemplateOptions) addNameFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.name, "template-name", "n", "", "Changefeed template name")
+	_ = cmd.MarkPersistentFlagRequired("template-name")
+}
+
+// addFlags binds the flags used to create or update a template to the command.
+func (o *changefeedTemplateOptions) addFlags(cmd *cobra.Command) {
+	o.addNameFlag(cmd)
+	cmd.PersistentFlags().StringVar(&o.sinkURI, "sink-uri", "",
+		"Sink uri pattern, {changefeed_id}, {keyspace} and user defined {var} are replaced when the template is applied")
+	cmd.PersistentFlags().StringVar(&o.description, "description", "", "Description of the template")
+	cmd.PersistentFlags().StringVar(&o.configFile, "config", "", "Path of the changefeed configuration file")
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *changefeedTemplateOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// decodeConfig decodes the configuration file into cfg and verifies the filter rules.
+func (o *changefeedTemplateOptions) decodeConfig(cfg *config.ReplicaConfig) error {
+	if err := util.StrictDecodeFile(o.configFile, "TiCDC changefeed template", cfg); err != nil {
+		return err
+	}
+	_, err := filter.VerifyTableRules(cfg.Filter)
+	return err
+}
+
+// runCreate runs the `cli changefeed template create` command.
+func (o *changefeedTemplateOptions) runCreate(cmd *cobra.Command) error {
+	cfg := config.GetDefaultReplicaConfig()
+	if o.configFile != "" {
+		if err := o.decodeConfig(cfg); err != nil {
+			return err
+		}
+	}
+	tpl, err := o.apiClient.ChangefeedTemplates().Create(cmd.Context(), &v2.ChangefeedTemplate{
+		Name:          o.name,
+		Description:   o.description,
+		SinkURI:       o.sinkURI,
+		ReplicaConfig: v2.ToAPIReplicaConfig(cfg),
+	})
+	if err != nil {
+		return err
+	}
+	return util.JSONPrint(cmd, tpl)
+}
+
+// runUpdate runs the `cli changefeed template update` command, only the
+// specified fields are changed.
+func (o *changefeedTemplateOptions) runUpdate(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	newTpl := &v2.ChangefeedTemplate{Name: o.name}
+	if cmd.Flags().Changed("sink-uri") {
+		newTpl.SinkURI = o.sinkURI
+	}
+	if cmd.Flags().Changed("description") {
+		newTpl.Description = o.description
+	}
+	if cmd.Flags().Changed("config") {
+		// Decode the configuration file over the old one, so the items that
+		// are not specified in the file are kept.
+		oldTpl, err := o.apiClient.ChangefeedTemplates().Get(ctx, o.name)
+		if err != nil {
+			return err
+		}
+		cfg := config.GetDefaultReplicaConfig()
+		if oldTpl.ReplicaConfig != nil {
+			cfg = oldTpl.ReplicaConfig.ToInternalReplicaConfig()
+		}
+		if err = o.decodeConfig(cfg); err != nil {
+			return err
+		}
+		newTpl.ReplicaConfig = v2.ToAPIReplicaConfig(cfg)
+	}
+	tpl, err := o.apiClient.ChangefeedTemplates().Update(ctx, newTpl, o.name)
+	if err != nil {
+		return err
+	}
+	return util.JSONPrint(cmd, tpl)
+}
+
+// newCmdChangefeedTemplate creates the `cli changefeed template` command.
+func newCmdChangefeedTemplate(f factory.Factory) *cobra.Command {
+	cmds := &cobra.Command{
+		Use:   "template",
+		Short: "Manage changefeed templates and apply them to changefeeds in bulk",
+		Args:  cobra.NoArgs,
+	}
+
+	cmds.AddCommand(newCmdCreateChangefeedTemplate(f))
+	cmds.AddCommand(newCmdUpdateChangefeedTemplate(f))
+	cmds.AddCommand(newCmdListChangefeedTemplate(f))
+	cmds.AddCommand(newCmdQueryChangefeedTemplate(f))
+	cmds.AddCommand(newCmdRemoveChangefeedTemplate(f))
+	cmds.AddCommand(newCmdBulkChangefeed(f, bulkCreate))
+	cmds.AddCommand(newCmdBulkChangefeed(f, bulkUpdate))
+	cmds.AddCommand(newCmdBulkChangefeed(f, bulkPause))
+	cmds.AddCommand(newCmdBulkChangefeed(f, bulkResume))
+
+	return cmds
+}
+
+// newCmdCreateChangefeedTemplate creates the `cli changefeed template create` command.
+func newCmdCreateChangefeedTemplate(f factory.Factory) *cobra.Command {
+	o := newChangefeedTemplateOptions()
+
+	command := &cobra.Command{
+		Use:   "create",
+		Short: "Create a changefeed template",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.runCreate(cmd))
+		},
+	}
+	o.addFlags(command)
+	_ = command.MarkPersistentFlagRequired("sink-uri")
+
+	return command
+}
+
+// newCmdUpdateChangefeedTemplate creates the `cli changefeed template update` command.
+func newCmdUpdateChangefeedTemplate(f factory.Factory) *cobra.Command {
+	o := newChangefeedTemplateOptions()
+
+	command := &cobra.Command{
+		Use:   "update",
+		Short: "Update a changefeed template, the changefeeds created from it are not changed",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.runUpdate(cmd))
+		},
+	}
+	o.addFlags(command)
+
+	return command
+}
+
+// newCmdListChangefeedTemplate creates the `cli changefeed template list` command.
+func newCmdListChangefeedTemplate(f factory.Factory) *cobra.Command {
+	o := newChangefeedTemplateOptions()
+
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List all changefeed templates",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			templates, err := o.apiClient.ChangefeedTemplates().List(cmd.Context())
+			util.CheckErr(err)
+			util.CheckErr(util.JSONPrint(cmd, templates))
+		},
+	}
+
+	return command
+}
+
+// newCmdQueryChangefeedTemplate creates the `cli changefeed template query` command.
+func newCmdQueryChangefeedTemplate(f factory.Factory) *cobra.Command {
+	o := newChangefeedTemplateOptions()
+
+	command := &cobra.Command{
+		Use:   "query",
+		Short: "Query a changefeed template",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			tpl, err := o.apiClient.ChangefeedTemplates().Get(cmd.Context(), o.name)
+			util.CheckErr(err)
+			util.CheckErr(util.JSONPrint(cmd, tpl))
+		},
+	}
+	o.addNameFlag(command)
+
+	return command
+}
+
+// newCmdRemoveChangefeedTemplate creates the `cli changefeed template remove` command.
+func newCmdRemoveChangefeedTemplate(f factory.Factory) *cobra.Command {
+	o := newChangefeedTemplateOptions()
+
+	command := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a changefeed template, the changefeeds created from it are not affected",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.apiClient.ChangefeedTemplates().Delete(cmd.Context(), o.name))
+			cmd.Printf("Remove changefeed template %s successfully\n", o.name)
+		},
+	}
+	o.addNameFlag(command)
+
+	return command
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pingcap/errors"
+	v2 "github.com/pingcap/ticdc/api/v2"
+	"github.com/pingcap/ticdc/cmd/cdc/factory"
+	"github.com/pingcap/ticdc/cmd/util"
+	apiv2client "github.com/pingcap/ticdc/pkg/api/v2"
+	"github.com/spf13/cobra"
+)
+
+// bulkOperation is the kind of a bulk changefeed operation.
+type bulkOperation string
+
+const (
+	bulkCreate bulkOperation = "create"
+	bulkUpdate bulkOperation = "update"
+	bulkPause  bulkOperation = "pause"
+	bulkResume bulkOperation = "resume"
+)
+
+// needTemplate returns true if the operation applies a template to the changefeeds.
+func (op bulkOperation) needTemplate() bool {
+	return op == bulkCreate || op == bulkUpdate
+}
+
+// bulkChangefeedOptions defines flags for the `cli changefeed template bulk-*` commands.
+type bulkChangefeedOptions struct {
+	apiClient apiv2client.APIV2Interface
+
+	op            bulkOperation
+	templateName  string
+	keyspace      string
+	changefeedIDs string
+	itemsFile     string
+	vars          []string
+	startTs       uint64
+	targetTs      uint64
+}
+
+// newBulkChangefeedOptions creates new options for the `cli changefeed template bulk-*` commands.
+func newBulkChangefeedOptions(op bulkOperation) *bulkChangefeedOptions {
+	return &bulkChangefeedOptions{op: op}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *bulkChangefeedOptions) addFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&o.keyspace, "keyspace", "k", "",
+		"Keyspace of the changefeeds which don't specify the keyspace in the items file")
+	cmd.PersistentFlags().StringVar(&o.changefeedIDs, "changefeed-ids", "",
+		"Replication task (changefeed) IDs, use ',' to separate multiple IDs")
+	cmd.PersistentFlags().StringVar(&o.itemsFile, "items-file", "",
+		"Path of a JSON file which contains an array of changefeeds, "+
+			`e.g. [{"changefeed_id": "cf-1", "keyspace": "ks1", "variables": {"db": "db1"}}]`)
+	if !o.op.needTemplate() {
+		return
+	}
+	cmd.PersistentFlags().StringVarP(&o.templateName, "template-name", "n", "", "Changefeed template name")
+	cmd.PersistentFlags().StringArrayVar(&o.vars, "var", nil,
+		"Variable used to render the sink uri pattern for all changefeeds in the form of key=value, "+
+			"the variables in the items file take precedence")
+	cmd.PersistentFlags().Uint64Var(&o.targetTs, "target-ts", 0, "Target ts of the changefeeds")
+	_ = cmd.MarkPersistentFlagRequired("template-name")
+	if o.op == bulkCreate {
+		cmd.PersistentFlags().Uint64Var(&o.startTs, "start-ts", 0,
+			"Start ts of the changefeeds, the current tso is used if it's not specified")
+	}
+}
+
+// complete adapts from the command line args to the data and client required.
+func (o *bulkChangefeedOptions) complete(f factory.Factory) error {
+	apiClient, err := f.APIV2Client()
+	if err != nil {
+		return err
+	}
+	o.apiClient = apiClient
+	return nil
+}
+
+// buildConfig builds the bulk request from the flags and the items file.
+func (o *bulkChangefeedOptions) buildConfig() (*v2.BulkChangefeedConfig, error) {
+	if (o.itemsFile == "") == (o.changefeedIDs == "") {
+		return nil, errors.New("exactly one of --items-file and --changefeed-ids must be specified")
+	}
+	vars := make(map[string]string, len(o.vars))
+	for _, kv := range o.vars {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || key == "" {
+			return nil, errors.Errorf("invalid variable %s, the format should be key=value", kv)
+		}
+		vars[key] = value
+	}
+
+	var items []v2.BulkChangefeedItem
+	if o.itemsFile != "" {
+		data, err := os.ReadFile(o.itemsFile)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if err = json.Unmarshal(data, &items); err != nil {
+			return nil, errors.Annotatef(err, "decode items file %s", o.itemsFile)
+		}
+	} else {
+		for _, id := range strings.Split(o.changefeedIDs, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				items = append(items, v2.BulkChangefeedItem{ID: id})
+			}
+		}
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no changefeed is specified")
+	}
+	for i := range items {
+		if len(vars) == 0 {
+			break
+		}
+		merged := make(map[string]string, len(vars)+len(items[i].Variables))
+		for k, v := range vars {
+			merged[k] = v
+		}
+		for k, v := range items[i].Variables {
+			merged[k] = v
+		}
+		items[i].Variables = merged
+	}
+
+	return &v2.BulkChangefeedConfig{
+		TemplateName: o.templateName,
+		StartTs:      o.startTs,
+		TargetTs:     o.targetTs,
+		Items:        items,
+	}, nil
+}
+
+// run the `cli changefeed template bulk-*` command.
+func (o *bulkChangefeedOptions) run(cmd *cobra.Command) error {
+	cfg, err := o.buildConfig()
+	if err != nil {
+		return err
+	}
+
+	var bulk func(context.Context, *v2.BulkChangefeedConfig, string) (*v2.BulkChangefeedResponse, error)
+	changefeeds := o.apiClient.Changefeeds()
+	switch o.op {
+	case bulkCreate:
+		bulk = changefeeds.BulkCreate
+	case bulkUpdate:
+		bulk = changefeeds.BulkUpdate
+	case bulkPause:
+		bulk = changefeeds.BulkPause
+	case bulkResume:
+		bulk = changefeeds.BulkResume
+	default:
+		return errors.Errorf("unknown bulk operation %s", o.op)
+	}
+	resp, err := bulk(cmd.Context(), cfg, o.keyspace)
+	if err != nil {
+		return err
+	}
+	if err = util.JSONPrint(cmd, resp); err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		return errors.Errorf("bulk %s changefeeds failed, no changefeed is changed", o.op)
+	}
+	return nil
+}
+
+// newCmdBulkChangefeed creates the `cli changefeed template bulk-*` command.
+func newCmdBulkChangefeed(f factory.Factory, op bulkOperation) *cobra.Command {
+	o := newBulkChangefeedOptions(op)
+
+	var short string
+	switch op {
+	case bulkCreate:
+		short = "Create replication tasks (changefeeds) from a template atomically"
+	case bulkUpdate:
+		short = "Apply a template to stopped replication tasks (changefeeds) atomically"
+	case bulkPause:
+		short = "Pause replication tasks (changefeeds) atomically"
+	case bulkResume:
+		short = "Resume replication tasks (changefeeds) atomically"
+	}
+	command := &cobra.Command{
+		Use:   "bulk-" + string(op),
+		Short: short,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.complete(f))
+			util.CheckErr(o.run(cmd))
+		},
+	}
+
+	o.addFlags(command)
+
+	return command
+}
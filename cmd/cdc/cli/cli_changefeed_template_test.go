@@ -0,0 +1,107 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v2 "github.com/pingcap/ticdc/api/v2"
+	"github.com/pingcap/ticdc/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedTemplateCreateCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tpls := mock.NewMockChangefeedTemplateInterface(ctrl)
+	f := &mockFactory{templates: tpls}
+
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "cf.toml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+[filter]
+rules = ['{db}.*']
+`), 0o644))
+
+	tpls.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, tpl *v2.ChangefeedTemplate) (*v2.ChangefeedTemplate, error) {
+			require.Equal(t, "tenant", tpl.Name)
+			require.Equal(t, "kafka://127.0.0.1:9092/{keyspace}-{changefeed_id}", tpl.SinkURI)
+			require.Equal(t, []string{"{db}.*"}, tpl.ReplicaConfig.Filter.Rules)
+			return tpl, nil
+		})
+	cmd := newCmdCreateChangefeedTemplate(f)
+	os.Args = []string{
+		"create", "--template-name=tenant", "--config=" + configPath,
+		"--sink-uri=kafka://127.0.0.1:9092/{keyspace}-{changefeed_id}",
+	}
+	require.NoError(t, cmd.Execute())
+}
+
+func TestChangefeedTemplateBulkCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cf.EXPECT().BulkCreate(gomock.Any(), gomock.Any(), "ks1").DoAndReturn(
+		func(_ any, cfg *v2.BulkChangefeedConfig, _ string) (*v2.BulkChangefeedResponse, error) {
+			require.Equal(t, "tenant", cfg.TemplateName)
+			require.Equal(t, uint64(100), cfg.StartTs)
+			require.Equal(t, []v2.BulkChangefeedItem{
+				{ID: "cf-1", Variables: map[string]string{"db": "test"}},
+				{ID: "cf-2", Variables: map[string]string{"db": "test"}},
+			}, cfg.Items)
+			return &v2.BulkChangefeedResponse{Succeeded: true}, nil
+		})
+	cmd := newCmdBulkChangefeed(f, bulkCreate)
+	os.Args = []string{
+		"bulk-create", "--template-name=tenant", "-k=ks1", "--start-ts=100",
+		"--changefeed-ids=cf-1, cf-2", "--var=db=test",
+	}
+	require.NoError(t, cmd.Execute())
+
+	// The variables in the items file take precedence.
+	itemsPath := filepath.Join(t.TempDir(), "items.json")
+	require.NoError(t, os.WriteFile(itemsPath, []byte(
+		`[{"changefeed_id": "cf-1", "keyspace": "ks2", "variables": {"db": "db1"}}]`), 0o644))
+	o := newBulkChangefeedOptions(bulkPause)
+	o.itemsFile = itemsPath
+	o.vars = []string{"db=test", "topic=t"}
+	cfg, err := o.buildConfig()
+	require.NoError(t, err)
+	require.Equal(t, []v2.BulkChangefeedItem{{
+		ID:        "cf-1",
+		Keyspace:  "ks2",
+		Variables: map[string]string{"db": "db1", "topic": "t"},
+	}}, cfg.Items)
+
+	// A failed bulk operation returns an error.
+	cf.EXPECT().BulkPause(gomock.Any(), gomock.Any(), "").Return(&v2.BulkChangefeedResponse{
+		Results: []v2.BulkChangefeedResult{{ID: "cf-1", Keyspace: "ks2", Error: "not exists"}},
+	}, nil)
+	require.NoError(t, o.complete(f))
+	require.Error(t, o.run(cmd))
+
+	o = newBulkChangefeedOptions(bulkResume)
+	_, err = o.buildConfig()
+	require.Error(t, err)
+	o.changefeedIDs = "cf-1"
+	o.vars = []string{"invalid"}
+	_, err = o.buildConfig()
+	require.Error(t, err)
+}
@@ -37,6 +37,11 @@ type updateChangefeedOptions struct {
 	changefeedID            string
 	keyspace                string
 	verbose                 bool
+	removeStopPolicy        bool
+	dryRun                  bool
+
+	// stopPolicyChanged is true if the stop policy is changed by the flags
+	stopPolicyChanged bool
 }
 
 // newUpdateChangefeedOptions creates new options for the `cli changefeed update` command.
@@ -53,6 +58,8 @@ func (o *updateChangefeedOptions) addFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringVarP(&o.keyspace, "keyspace", "k", "default", "Replication task (changefeed) Keyspace")
 	cmd.PersistentFlags().StringVarP(&o.changefeedID, "changefeed-id", "c", "", "Replication task (changefeed) ID")
 	cmd.PersistentFlags().BoolVarP(&o.verbose, "verbose", "v", false, "Print verbose information when updating a changefeed. Caution: This will list all tables to be replicated by the changefeed. If the number of tables is extremely large, it may flood your screen.")
+	cmd.PersistentFlags().BoolVar(&o.removeStopPolicy, "remove-stop-policy", false, "Remove the stop policy of the changefeed")
+	cmd.PersistentFlags().BoolVar(&o.dryRun, "dry-run", false, "Preview the tables added, removed and rerouted by the new config at the checkpoint ts, without applying the changes")
 	_ = cmd.MarkPersistentFlagRequired("changefeed-id")
 }
 
@@ -65,6 +72,13 @@ func (o *updateChangefeedOptions) getChangefeedConfig(cmd *cobra.Command,
 		SinkURI:       info.SinkURI,
 		ReplicaConfig: replicaConfig,
 	}
+	if o.stopPolicyChanged {
+		// an empty stop policy removes the stop policy of the changefeed
+		res.StopPolicy = &v2.StopPolicy{}
+		if info.StopPolicy != nil {
+			res.StopPolicy = info.StopPolicy
+		}
+	}
 	cmd.Flags().Visit(func(flag *pflag.Flag) {
 		switch flag.Name {
 		case "upstream-pd":
@@ -121,6 +135,10 @@ func (o *updateChangefeedOptions) run(cmd *cobra.Command) error {
 		cmd.Printf("%+v\n", change)
 	}
 
+	if o.dryRun {
+		return o.previewFilter(cmd, o.getChangefeedConfig(cmd, newInfo))
+	}
+
 	if !o.commonChangefeedOptions.noConfirm {
 		cmd.Printf("Could you agree to apply changes above to changefeed [Y/N]\n")
 		confirmed := readYOrN(cmd)
@@ -184,6 +202,27 @@ func (o *updateChangefeedOptions) run(cmd *cobra.Command) error {
 	return nil
 }
 
+// previewFilter prints the table set change of the new config, nothing is
+// applied to the changefeed.
+func (o *updateChangefeedOptions) previewFilter(cmd *cobra.Command, cfg *v2.ChangefeedConfig) error {
+	preview, err := o.apiV2Client.Changefeeds().FilterPreview(cmd.Context(), cfg, o.keyspace, o.changefeedID)
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Tables changed by the new config at checkpoint ts %d:\n", preview.CheckpointTs)
+	cmd.Printf("AddedTables: %s\n", formatTableNames(preview.AddedTables))
+	cmd.Printf("RemovedTables: %s\n", formatTableNames(preview.RemovedTables))
+	cmd.Printf("IneligibleTables: %s\n", formatTableNames(preview.IneligibleTables))
+	cmd.Printf("RouteChanges:\n")
+	for _, change := range preview.RouteChanges {
+		cmd.Printf("  %s.%s: %s.%s -> %s.%s\n", change.Schema, change.Table,
+			change.OldTargetSchema, change.OldTargetTable,
+			change.NewTargetSchema, change.NewTargetTable)
+	}
+	cmd.Printf("Dry run, no update to changefeed.\n")
+	return nil
+}
+
 // applyChanges applies the new changes to the old changefeed.
 func (o *updateChangefeedOptions) applyChanges(oldInfo *v2.ChangeFeedInfo,
 	cmd *cobra.Command,
@@ -210,14 +249,20 @@ func (o *updateChangefeedOptions) applyChanges(oldInfo *v2.ChangeFeedInfo,
 		case "sort-engine":
 		case "sort-dir":
 			log.Warn("this flag cannot be updated and will be ignored", zap.String("flagName", flag.Name))
-		case "changefeed-id", "no-confirm":
+		case "changefeed-id", "no-confirm", "dry-run":
 			// Do nothing, these are some flags from the changefeed command,
 			// we don't use it to update, but we do use these flags.
 		case "pd", "log-level", "key", "cert", "ca", "server":
 		// Do nothing, this is a flags from the cli command
 		// we don't use it to update, but we do use these flags.
 		case "upstream-pd", "upstream-ca", "upstream-cert", "upstream-key", "keyspace":
+		case "remove-stop-policy":
+			// handled after all flags are visited
 		default:
+			if isStopPolicyFlag(flag.Name) {
+				// handled after all flags are visited
+				return
+			}
 			// use this default branch to prevent new added parameter is not added
 			log.Warn("unsupported flag, please report a bug", zap.String("flagName", flag.Name))
 		}
@@ -225,6 +270,24 @@ func (o *updateChangefeedOptions) applyChanges(oldInfo *v2.ChangeFeedInfo,
 	if err != nil {
 		return nil, err
 	}
+
+	if o.removeStopPolicy {
+		newInfo.StopPolicy = nil
+		o.stopPolicyChanged = oldInfo.StopPolicy != nil
+		return newInfo, nil
+	}
+	stopPolicy := newInfo.StopPolicy
+	if stopPolicy == nil {
+		stopPolicy = &v2.StopPolicy{}
+	}
+	changed, err := o.commonChangefeedOptions.stopPolicy.apply(cmd, stopPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		newInfo.StopPolicy = stopPolicy
+		o.stopPolicyChanged = true
+	}
 	return newInfo, nil
 }
 
@@ -80,6 +80,49 @@ func TestApplyChanges(t *testing.T) {
 		newInfo.Config.Sink.SchemaRegistry)
 }
 
+func TestApplyChangesStopPolicy(t *testing.T) {
+	t.Parallel()
+
+	cmd := NewCmdCli()
+	o := newUpdateChangefeedOptions(newChangefeedCommonOptions())
+	o.addFlags(cmd)
+
+	oldInfo := &v2.ChangeFeedInfo{
+		SinkURI:    "blackhole://",
+		StopPolicy: &v2.StopPolicy{OnceSynced: true, WebhookURL: "http://127.0.0.1:8080/hook"},
+	}
+	require.Nil(t, cmd.ParseFlags([]string{"--stop-daily-at=02:00", "--stop-action=pause"}))
+	newInfo, err := o.applyChanges(oldInfo, cmd)
+	require.Nil(t, err)
+	require.True(t, o.stopPolicyChanged)
+	require.Equal(t, &v2.StopPolicy{
+		OnceSynced: true,
+		DailyTime:  "02:00",
+		Action:     "pause",
+		WebhookURL: "http://127.0.0.1:8080/hook",
+	}, newInfo.StopPolicy)
+	// the old info is not changed
+	require.Equal(t, "", oldInfo.StopPolicy.DailyTime)
+	require.Equal(t, newInfo.StopPolicy, o.getChangefeedConfig(cmd, newInfo).StopPolicy)
+
+	cmd = NewCmdCli()
+	o = newUpdateChangefeedOptions(newChangefeedCommonOptions())
+	o.addFlags(cmd)
+	require.Nil(t, cmd.ParseFlags([]string{"--stop-at-time=2026-01-02T15:04:05"}))
+	_, err = o.applyChanges(oldInfo, cmd)
+	require.NotNil(t, err)
+
+	// remove the stop policy by an empty one
+	cmd = NewCmdCli()
+	o = newUpdateChangefeedOptions(newChangefeedCommonOptions())
+	o.addFlags(cmd)
+	require.Nil(t, cmd.ParseFlags([]string{"--remove-stop-policy"}))
+	newInfo, err = o.applyChanges(oldInfo, cmd)
+	require.Nil(t, err)
+	require.Nil(t, newInfo.StopPolicy)
+	require.Equal(t, &v2.StopPolicy{}, o.getChangefeedConfig(cmd, newInfo).StopPolicy)
+}
+
 func initTestLogger(filename string) (func(), error) {
 	logConfig := &log.Config{
 		File: log.FileLogConfig{
@@ -173,3 +216,39 @@ func TestChangefeedUpdateCli(t *testing.T) {
 	o.keyspace = "ks"
 	require.NotNil(t, o.run(cmd))
 }
+
+func TestChangefeedUpdateDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f := newMockFactory(ctrl)
+
+	cmd := newCmdUpdateChangefeed(f)
+	f.changefeeds.EXPECT().Get(gomock.Any(), "ks", "abc").
+		Return(&v2.ChangeFeedInfo{
+			ID:     "abc",
+			Config: &v2.ReplicaConfig{Sink: &v2.SinkConfig{}},
+		}, nil)
+	f.changefeeds.EXPECT().FilterPreview(gomock.Any(), gomock.Any(), "ks", "abc").
+		Return(&v2.FilterPreview{
+			CheckpointTs:  100,
+			AddedTables:   []v2.TableName{{Schema: "test", Table: "t2"}},
+			RemovedTables: []v2.TableName{{Schema: "test", Table: "t1"}},
+			RouteChanges: []v2.TableRouteChange{{
+				Schema: "test", Table: "t3",
+				OldTargetSchema: "test", OldTargetTable: "t3",
+				NewTargetSchema: "dst", NewTargetTable: "t3",
+			}},
+		}, nil)
+	// the changefeed is not updated in dry run mode.
+	f.changefeeds.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	var out strings.Builder
+	cmd.SetOut(&out)
+	os.Args = []string{"update", "--dry-run", "--target-ts=10", "-c", "abc", "-k", "ks"}
+	require.Nil(t, cmd.Execute())
+	require.Contains(t, out.String(), "AddedTables: [test.t2]")
+	require.Contains(t, out.String(), "RemovedTables: [test.t1]")
+	require.Contains(t, out.String(), "IneligibleTables: []")
+	require.Contains(t, out.String(), "test.t3: test.t3 -> dst.t3")
+	require.Contains(t, out.String(), "Dry run, no update to changefeed.")
+}
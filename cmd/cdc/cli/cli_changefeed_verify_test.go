@@ -0,0 +1,94 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	v2 "github.com/pingcap/ticdc/api/v2"
+	"github.com/pingcap/ticdc/pkg/api/v2/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedVerifyCli(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cf := mock.NewMockChangefeedInterface(ctrl)
+	f := &mockFactory{changefeeds: cf}
+
+	cmd := newCmdVerifyChangefeed(f)
+	// the job is queried until it's finished.
+	cf.EXPECT().Verify(gomock.Any(), &v2.VerifyChangefeedConfig{
+		UpstreamURI:   "mysql://root@127.0.0.1:4000/",
+		SyncpointTs:   100,
+		Tables:        []string{"test.*"},
+		CheckpointURI: "mysql://root@127.0.0.1:3306/",
+	}, "default", "abc").Return(&v2.VerifyChangefeedJob{State: v2.VerifyJobStateRunning}, nil)
+	cf.EXPECT().GetVerifyJob(gomock.Any(), "default", "abc").
+		Return(&v2.VerifyChangefeedJob{State: v2.VerifyJobStateRunning}, nil)
+	cf.EXPECT().GetVerifyJob(gomock.Any(), "default", "abc").Return(&v2.VerifyChangefeedJob{
+		State: v2.VerifyJobStateFinished,
+		Result: &v2.VerifyChangefeedResult{
+			PrimaryTs:   100,
+			SecondaryTs: 105,
+			Consistent:  true,
+			Tables:      []v2.VerifyTableResult{{Schema: "test", Table: "t", StructEqual: true, DataEqual: true}},
+		},
+	}, nil)
+	os.Args = []string{
+		"verify", "--changefeed-id=abc", "--keyspace=default",
+		"--upstream-uri=mysql://root@127.0.0.1:4000/", "--syncpoint-ts=100", "--tables=test.*",
+		"--checkpoint-uri=mysql://root@127.0.0.1:3306/", "--poll-interval=10ms",
+	}
+	require.Nil(t, cmd.Execute())
+
+	// the fix sql is written to the file and the inconsistency is reported.
+	fixSQLFile := filepath.Join(t.TempDir(), "fix.sql")
+	o := newVerifyChangefeedOptions()
+	o.changefeedID = "abc"
+	o.upstreamURI = "mysql://root@127.0.0.1:4000/"
+	o.fixSQLFile = fixSQLFile
+	require.Nil(t, o.complete(f))
+	result := &v2.VerifyChangefeedResult{
+		PrimaryTs:   100,
+		SecondaryTs: 105,
+		Tables: []v2.VerifyTableResult{{
+			Schema: "test", Table: "t", TargetSchema: "test", TargetTable: "t",
+			StructEqual: true,
+			MismatchedChunks: []v2.VerifyChunk{
+				{ID: 1, Range: "((`id` > 1) AND (`id` <= 10))", State: "failed"},
+			},
+			FixSQL: []string{"REPLACE INTO `test`.`t`(`id`) VALUES (2);"},
+		}},
+	}
+	cf.EXPECT().Verify(gomock.Any(), &v2.VerifyChangefeedConfig{
+		UpstreamURI: "mysql://root@127.0.0.1:4000/",
+		FixSQL:      true,
+	}, "", "abc").Return(&v2.VerifyChangefeedJob{State: v2.VerifyJobStateFinished, Result: result}, nil)
+	require.NotNil(t, o.run(cmd))
+	require.Nil(t, result.Tables[0].FixSQL)
+	content, err := os.ReadFile(fixSQLFile)
+	require.NoError(t, err)
+	require.Equal(t, "-- fix SQL of table `test`.`t`\nREPLACE INTO `test`.`t`(`id`) VALUES (2);\n", string(content))
+
+	// the failed job is reported.
+	cf.EXPECT().Verify(gomock.Any(), gomock.Any(), "", "abc").Return(&v2.VerifyChangefeedJob{
+		State: v2.VerifyJobStateFailed,
+		Error: "syncpoint not found",
+	}, nil)
+	require.ErrorContains(t, o.run(cmd), "syncpoint not found")
+}
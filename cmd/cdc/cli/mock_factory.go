@@ -24,6 +24,7 @@ type mockAPIV2Client struct {
 	apiv2client.APIV2Interface
 	tso         apiv2client.TsoInterface
 	changefeeds apiv2client.ChangefeedInterface
+	templates   apiv2client.ChangefeedTemplateInterface
 	unsafes     apiv2client.UnsafeInterface
 	captures    apiv2client.CaptureInterface
 	processors  apiv2client.ProcessorInterface
@@ -33,6 +34,10 @@ func (f *mockAPIV2Client) Changefeeds() apiv2client.ChangefeedInterface {
 	return f.changefeeds
 }
 
+func (f *mockAPIV2Client) ChangefeedTemplates() apiv2client.ChangefeedTemplateInterface {
+	return f.templates
+}
+
 func (f *mockAPIV2Client) Tso() apiv2client.TsoInterface {
 	return f.tso
 }
@@ -53,6 +58,7 @@ type mockFactory struct {
 	factory.Factory
 	captures    *mock.MockCaptureInterface
 	changefeeds *mock.MockChangefeedInterface
+	templates   *mock.MockChangefeedTemplateInterface
 	processors  *mock.MockProcessorInterface
 	status      *mock.MockStatusInterface
 	tso         *mock.MockTsoInterface
@@ -63,12 +69,14 @@ func newMockFactory(ctrl *gomock.Controller) *mockFactory {
 	cps := mock.NewMockCaptureInterface(ctrl)
 	processor := mock.NewMockProcessorInterface(ctrl)
 	cf := mock.NewMockChangefeedInterface(ctrl)
+	templates := mock.NewMockChangefeedTemplateInterface(ctrl)
 	statuses := mock.NewMockStatusInterface(ctrl)
 	unsafes := mock.NewMockUnsafeInterface(ctrl)
 	tso := mock.NewMockTsoInterface(ctrl)
 	return &mockFactory{
 		captures:    cps,
 		changefeeds: cf,
+		templates:   templates,
 		processors:  processor,
 		status:      statuses,
 		tso:         tso,
@@ -80,6 +88,7 @@ func (f *mockFactory) APIV2Client() (apiv2client.APIV2Interface, error) {
 	return &mockAPIV2Client{
 		captures:    f.captures,
 		changefeeds: f.changefeeds,
+		templates:   f.templates,
 		tso:         f.tso,
 		unsafes:     f.unsafes,
 		processors:  f.processors,
@@ -21,7 +21,9 @@ import (
 	"time"
 
 	"github.com/pingcap/log"
+	cmdUtil "github.com/pingcap/ticdc/cmd/util"
 	"github.com/pingcap/ticdc/pkg/applier"
+	"github.com/pingcap/ticdc/pkg/config"
 	cerror "github.com/pingcap/ticdc/pkg/errors"
 	"github.com/pingcap/ticdc/pkg/logger"
 	"github.com/pingcap/ticdc/pkg/util"
@@ -33,9 +35,14 @@ import (
 type applyRedoOptions struct {
 	options
 	sinkURI              string
+	configFile           string
+	targetTs             uint64
+	tables               []string
 	enableProfiling      bool
 	memoryLimitInGiBytes int64
 	logLevel             string
+
+	sinkConfig *config.SinkConfig
 }
 
 // newapplyRedoOptions creates new applyRedoOptions for the `redo apply` command.
@@ -46,9 +53,12 @@ func newapplyRedoOptions() *applyRedoOptions {
 // addFlags receives a *cobra.Command reference and binds
 // flags related to template printing to it.
 func (o *applyRedoOptions) addFlags(cmd *cobra.Command) {
-	cmd.Flags().StringVar(&o.sinkURI, "sink-uri", "", "target database sink-uri")
+	cmd.Flags().StringVar(&o.sinkURI, "sink-uri", "", "target sink-uri, such as mysql, kafka, storage and blackhole sink-uri")
 	// the possible error returned from MarkFlagRequired is `no such flag`
 	cmd.MarkFlagRequired("sink-uri") //nolint:errcheck
+	cmd.Flags().StringVar(&o.configFile, "config", "", "changefeed configuration file, only the sink config is used by the non mysql sinks")
+	cmd.Flags().Uint64Var(&o.targetTs, "target-ts", 0, "apply the redo log to the specified consistent ts instead of the resolved ts of the redo log")
+	cmd.Flags().StringSliceVar(&o.tables, "tables", nil, "table filter rules of the tables to apply, such as \"db.tbl,db2.*\", all tables are applied by default")
 	cmd.Flags().BoolVar(&o.enableProfiling, "enable-profiling", true, "enable pprof profiling")
 	cmd.Flags().Int64Var(&o.memoryLimitInGiBytes, "memory-limit", 10, "memory limit in GiB")
 	cmd.Flags().StringVar(&o.logLevel, "log-level", "info", "log level (etc: debug|info|warn|error)")
@@ -61,12 +71,21 @@ func (o *applyRedoOptions) complete(cmd *cobra.Command) error {
 	if err != nil {
 		return cerror.WrapError(cerror.ErrSinkURIInvalid, err)
 	}
-	rawQuery := sinkURI.Query()
-	// set safe-mode to true if not set
-	if rawQuery.Get("safe-mode") != "true" {
-		rawQuery.Set("safe-mode", "true")
-		sinkURI.RawQuery = rawQuery.Encode()
-		o.sinkURI = sinkURI.String()
+	// set safe-mode to true if not set, since the redo log may be applied repeatedly
+	if config.IsMySQLCompatibleScheme(config.GetScheme(sinkURI)) {
+		rawQuery := sinkURI.Query()
+		if rawQuery.Get("safe-mode") != "true" {
+			rawQuery.Set("safe-mode", "true")
+			sinkURI.RawQuery = rawQuery.Encode()
+			o.sinkURI = sinkURI.String()
+		}
+	}
+	if o.configFile != "" {
+		cfg := config.GetDefaultReplicaConfig()
+		if err = cmdUtil.StrictDecodeFile(o.configFile, "TiCDC redo apply", cfg); err != nil {
+			return err
+		}
+		o.sinkConfig = cfg.Sink
 	}
 
 	totalMemory, err := util.GetMemoryLimit()
@@ -106,9 +125,12 @@ func (o *applyRedoOptions) run(cmd *cobra.Command) error {
 	}
 
 	cfg := &applier.RedoApplierConfig{
-		Storage: o.storage,
-		SinkURI: o.sinkURI,
-		Dir:     o.dir,
+		Storage:    o.storage,
+		SinkURI:    o.sinkURI,
+		Dir:        o.dir,
+		SinkConfig: o.sinkConfig,
+		TargetTs:   o.targetTs,
+		Tables:     o.tables,
 	}
 	ap := applier.NewRedoApplier(cfg)
 	err = ap.Apply(ctx)
@@ -125,6 +147,8 @@ func newCmdApply(opt *options) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "apply",
 		Short: "Apply redo logs in target sink",
+		Long: "Apply redo logs in target sink, the target sink can be any sink supported by changefeeds.\n" +
+			"Use --target-ts to stop at an earlier consistent point and --tables to restore a subset of tables.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			o.options = *opt
 			if err := o.complete(cmd); err != nil {
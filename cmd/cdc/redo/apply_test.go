@@ -14,8 +14,12 @@
 package redo
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/pingcap/ticdc/pkg/util"
+
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
@@ -39,4 +43,22 @@ func TestComplete(t *testing.T) {
 	err = o.complete(cmd)
 	require.NoError(t, err)
 	require.Equal(t, "mysql://root@127.0.0.1:3306?time-zone=UTC&safe-mode=true", o.sinkURI)
+
+	// safe-mode is only for the mysql sink
+	o.sinkURI = "kafka://127.0.0.1:9092/topic?protocol=canal-json"
+	err = o.complete(cmd)
+	require.NoError(t, err)
+	require.Equal(t, "kafka://127.0.0.1:9092/topic?protocol=canal-json", o.sinkURI)
+	require.Nil(t, o.sinkConfig)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+[sink]
+protocol = "avro"
+`), 0o644))
+	o.configFile = path
+	err = o.complete(cmd)
+	require.NoError(t, err)
+	require.Equal(t, "avro", util.GetOrZero(o.sinkConfig.Protocol))
 }
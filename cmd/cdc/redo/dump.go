@@ -0,0 +1,381 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	pevent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+	"github.com/pingcap/ticdc/pkg/redo"
+	"github.com/pingcap/ticdc/pkg/redo/reader"
+	tfilter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"github.com/spf13/cobra"
+)
+
+const (
+	dumpFormatJSON = "json"
+	dumpFormatSQL  = "sql"
+)
+
+// dumpOptions defines flags for the `redo dump` command.
+type dumpOptions struct {
+	options
+	list     bool
+	fileType string
+	startTs  uint64
+	endTs    uint64
+	tables   []string
+	format   string
+	output   string
+
+	uri         url.URL
+	tableFilter tfilter.Filter
+}
+
+// newDumpOptions creates new dumpOptions for the `redo dump` command.
+func newDumpOptions() *dumpOptions {
+	return &dumpOptions{}
+}
+
+// addFlags receives a *cobra.Command reference and binds
+// flags related to template printing to it.
+func (o *dumpOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.list, "list", false, "only list the redo log files in the storage")
+	cmd.Flags().StringVar(&o.fileType, "file-type", "", "type of the redo log files to dump, row or ddl, both are dumped by default")
+	cmd.Flags().Uint64Var(&o.startTs, "start-ts", 0, "dump the logs whose commit ts is greater than start-ts")
+	cmd.Flags().Uint64Var(&o.endTs, "end-ts", 0, "dump the logs whose commit ts is less than or equal to end-ts, 0 means no limit")
+	cmd.Flags().StringSliceVar(&o.tables, "tables", nil, "table filter rules of the tables to dump, such as \"db.tbl,db2.*\", all tables are dumped by default")
+	cmd.Flags().StringVar(&o.format, "format", dumpFormatJSON, "output format of the logs, json or sql")
+	cmd.Flags().StringVar(&o.output, "output", "", "file to write the output to, the standard output is used by default")
+}
+
+func (o *dumpOptions) complete() error {
+	uri, err := url.Parse(o.storage)
+	if err != nil {
+		return errors.WrapError(errors.ErrConsistentStorage, err)
+	}
+	redo.FixLocalScheme(uri)
+	if !redo.IsExternalStorage(uri.Scheme) {
+		return errors.ErrConsistentStorage.GenWithStackByArgs(uri.Scheme)
+	}
+	o.uri = *uri
+
+	if len(o.tables) != 0 {
+		f, err := filter.VerifyTableRules(&config.FilterConfig{Rules: o.tables})
+		if err != nil {
+			return err
+		}
+		o.tableFilter = tfilter.CaseInsensitive(f)
+	}
+	return nil
+}
+
+func (o *dumpOptions) validate() error {
+	switch o.fileType {
+	case "", redo.RedoRowLogFileType, redo.RedoDDLLogFileType:
+	default:
+		return errors.ErrRedoConfigInvalid.GenWithStack(
+			"invalid file type %s, only row and ddl are supported", o.fileType)
+	}
+	switch o.format {
+	case dumpFormatJSON, dumpFormatSQL:
+	default:
+		return errors.ErrRedoConfigInvalid.GenWithStack(
+			"invalid format %s, only json and sql are supported", o.format)
+	}
+	if o.endTs != 0 && o.endTs <= o.startTs {
+		return errors.ErrRedoConfigInvalid.GenWithStack(
+			"end-ts %d must be greater than start-ts %d", o.endTs, o.startTs)
+	}
+	return nil
+}
+
+// run runs the `redo dump` command.
+func (o *dumpOptions) run(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+
+	var w io.Writer = cmd.OutOrStdout()
+	if o.output != "" {
+		f, err := os.Create(o.output)
+		if err != nil {
+			return errors.WrapError(errors.ErrRedoFileOp, err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if o.list {
+		files, err := reader.ListLogFiles(ctx, o.uri)
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			fmt.Fprintf(w, "%s\ttype:%s\tmax-commit-ts:%d\tsize:%d\n",
+				f.Name, f.FileType, f.MaxCommitTs, f.Size)
+		}
+		return nil
+	}
+
+	count := 0
+	err := reader.DumpLogs(ctx, &reader.DumpConfig{
+		URI:         o.uri,
+		FileType:    o.fileType,
+		StartTs:     o.startTs,
+		EndTs:       o.endTs,
+		TableFilter: o.tableFilter,
+	}, func(file string, log *pevent.RedoLog) error {
+		var (
+			data string
+			err  error
+		)
+		if o.format == dumpFormatSQL {
+			data = formatLogAsSQL(log)
+		} else {
+			data, err = formatLogAsJSON(file, log)
+		}
+		if err != nil {
+			return err
+		}
+		if _, err = io.WriteString(w, data+"\n"); err != nil {
+			return errors.WrapError(errors.ErrRedoFileOp, err)
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	cmd.PrintErrf("dump %d redo logs successfully\n", count)
+	return nil
+}
+
+// newCmdDump creates the `redo dump` command.
+func newCmdDump(opt *options) *cobra.Command {
+	o := newDumpOptions()
+	command := &cobra.Command{
+		Use:   "dump",
+		Short: "Inspect and export the redo logs",
+		Long: `Decode the row and ddl redo logs in the storage and export them as JSON lines or SQL statements.
+Both the logs written by the file writer and the lz4 compressed logs written by the memory writer
+are supported. The logs are ordered by commit ts in each log file, but not across log files.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.options = *opt
+			if err := o.validate(); err != nil {
+				return err
+			}
+			if err := o.complete(); err != nil {
+				return err
+			}
+			return o.run(cmd)
+		},
+	}
+	o.addFlags(command)
+
+	return command
+}
+
+// dumpRecord is the JSON representation of a redo log.
+type dumpRecord struct {
+	File       string         `json:"file"`
+	Type       string         `json:"type"`
+	StartTs    uint64         `json:"start_ts"`
+	CommitTs   uint64         `json:"commit_ts"`
+	Schema     string         `json:"schema,omitempty"`
+	Table      string         `json:"table,omitempty"`
+	TableID    int64          `json:"table_id,omitempty"`
+	Query      string         `json:"query,omitempty"`
+	RowType    string         `json:"row_type,omitempty"`
+	Columns    map[string]any `json:"columns,omitempty"`
+	PreColumns map[string]any `json:"pre_columns,omitempty"`
+}
+
+func formatLogAsJSON(file string, log *pevent.RedoLog) (string, error) {
+	record := &dumpRecord{File: file}
+	switch log.Type {
+	case pevent.RedoLogTypeRow:
+		row := log.RedoRow
+		record.Type = "row"
+		record.StartTs = row.Row.StartTs
+		record.CommitTs = row.Row.CommitTs
+		if row.Row.Table != nil {
+			record.Schema = row.Row.Table.Schema
+			record.Table = row.Row.Table.Table
+			record.TableID = row.Row.Table.TableID
+		}
+		record.RowType = rowType(row)
+		record.Columns = columnsToMap(row.Row.Columns, row.Columns)
+		record.PreColumns = columnsToMap(row.Row.PreColumns, row.PreColumns)
+	case pevent.RedoLogTypeDDL:
+		ddl := log.RedoDDL
+		record.Type = "ddl"
+		record.StartTs = ddl.DDL.StartTs
+		record.CommitTs = ddl.DDL.CommitTs
+		record.Schema = ddl.TableName.Schema
+		record.Table = ddl.TableName.Table
+		record.TableID = ddl.TableName.TableID
+		record.Query = ddl.DDL.Query
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", errors.WrapError(errors.ErrMarshalFailed, err)
+	}
+	return string(data), nil
+}
+
+func rowType(row *pevent.RedoDMLEvent) string {
+	switch {
+	case row.IsInsert():
+		return "insert"
+	case row.IsUpdate():
+		return "update"
+	case row.IsDelete():
+		return "delete"
+	}
+	return ""
+}
+
+func columnsToMap(cols []*pevent.RedoColumn, values []pevent.RedoColumnValue) map[string]any {
+	if len(values) == 0 {
+		return nil
+	}
+	result := make(map[string]any, len(values))
+	for i, v := range values {
+		if i >= len(cols) {
+			break
+		}
+		value := v.Value
+		// the string columns are stored as bytes, show them as strings if possible.
+		if b, ok := value.([]byte); ok && utf8.Valid(b) {
+			value = string(b)
+		}
+		result[cols[i].Name] = value
+	}
+	return result
+}
+
+func formatLogAsSQL(log *pevent.RedoLog) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "/* commit-ts: %d */ ", log.GetCommitTs())
+	switch log.Type {
+	case pevent.RedoLogTypeDDL:
+		b.WriteString(strings.TrimSuffix(strings.TrimSpace(log.RedoDDL.DDL.Query), ";"))
+	case pevent.RedoLogTypeRow:
+		row := log.RedoRow
+		if row.Row.Table == nil {
+			b.WriteString("/* unknown table */")
+			break
+		}
+		table := common.QuoteSchema(row.Row.Table.Schema, row.Row.Table.Table)
+		switch {
+		case row.IsInsert():
+			names, values := make([]string, 0, len(row.Columns)), make([]string, 0, len(row.Columns))
+			for i, col := range row.Columns {
+				if i >= len(row.Row.Columns) {
+					break
+				}
+				names = append(names, common.QuoteName(row.Row.Columns[i].Name))
+				values = append(values, sqlLiteral(col.Value))
+			}
+			fmt.Fprintf(&b, "INSERT INTO %s (%s) VALUES (%s)",
+				table, strings.Join(names, ","), strings.Join(values, ","))
+		case row.IsUpdate():
+			assigns := make([]string, 0, len(row.Columns))
+			for i, col := range row.Columns {
+				if i >= len(row.Row.Columns) {
+					break
+				}
+				assigns = append(assigns, common.QuoteName(row.Row.Columns[i].Name)+" = "+sqlLiteral(col.Value))
+			}
+			fmt.Fprintf(&b, "UPDATE %s SET %s WHERE %s LIMIT 1",
+				table, strings.Join(assigns, ","), whereClause(row.Row.PreColumns, row.PreColumns))
+		case row.IsDelete():
+			fmt.Fprintf(&b, "DELETE FROM %s WHERE %s LIMIT 1",
+				table, whereClause(row.Row.PreColumns, row.PreColumns))
+		default:
+			b.WriteString("/* empty row */")
+		}
+	}
+	b.WriteString(";")
+	return b.String()
+}
+
+// whereClause builds the where clause by the handle key columns, all columns
+// are used if there is no handle key.
+func whereClause(cols []*pevent.RedoColumn, values []pevent.RedoColumnValue) string {
+	conds := make([]string, 0, len(values))
+	build := func(handleOnly bool) {
+		for i, v := range values {
+			if i >= len(cols) {
+				break
+			}
+			if handleOnly && !common.ColumnFlagType(v.Flag).IsHandleKey() {
+				continue
+			}
+			name := common.QuoteName(cols[i].Name)
+			if v.Value == nil {
+				conds = append(conds, name+" IS NULL")
+			} else {
+				conds = append(conds, name+" = "+sqlLiteral(v.Value))
+			}
+		}
+	}
+	build(true)
+	if len(conds) == 0 {
+		build(false)
+	}
+	return strings.Join(conds, " AND ")
+}
+
+func sqlLiteral(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return quoteString(v)
+	case []byte:
+		if utf8.Valid(v) {
+			return quoteString(string(v))
+		}
+		return "x'" + hex.EncodeToString(v) + "'"
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func quoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `'`, `''`)
+	return "'" + s + "'"
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	pevent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/stretchr/testify/require"
+)
+
+func newDumpTestRow(columns, preColumns []pevent.RedoColumnValue) *pevent.RedoLog {
+	meta := []*pevent.RedoColumn{{Name: "id"}, {Name: "name"}}
+	row := &pevent.RedoDMLEvent{
+		Row: &pevent.DMLEventInRedoLog{
+			StartTs:  100,
+			CommitTs: 101,
+			Table:    &common.TableName{Schema: "test", Table: "t", TableID: 10},
+		},
+		Columns:    columns,
+		PreColumns: preColumns,
+	}
+	if len(columns) != 0 {
+		row.Row.Columns = meta
+	}
+	if len(preColumns) != 0 {
+		row.Row.PreColumns = meta
+	}
+	return &pevent.RedoLog{RedoRow: row, Type: pevent.RedoLogTypeRow}
+}
+
+func TestFormatLogAsSQL(t *testing.T) {
+	t.Parallel()
+
+	handleFlag := uint64(common.HandleKeyFlag)
+	insert := newDumpTestRow([]pevent.RedoColumnValue{
+		{Value: int64(1), Flag: handleFlag}, {Value: []byte("it's")},
+	}, nil)
+	require.Equal(t, "/* commit-ts: 101 */ INSERT INTO `test`.`t` (`id`,`name`) VALUES (1,'it''s');",
+		formatLogAsSQL(insert))
+
+	update := newDumpTestRow(
+		[]pevent.RedoColumnValue{{Value: int64(1), Flag: handleFlag}, {Value: nil}},
+		[]pevent.RedoColumnValue{{Value: int64(1), Flag: handleFlag}, {Value: "a"}})
+	require.Equal(t, "/* commit-ts: 101 */ UPDATE `test`.`t` SET `id` = 1,`name` = NULL WHERE `id` = 1 LIMIT 1;",
+		formatLogAsSQL(update))
+
+	// all columns are used in the where clause if there is no handle key.
+	del := newDumpTestRow(nil, []pevent.RedoColumnValue{{Value: int64(2)}, {Value: nil}})
+	require.Equal(t, "/* commit-ts: 101 */ DELETE FROM `test`.`t` WHERE `id` = 2 AND `name` IS NULL LIMIT 1;",
+		formatLogAsSQL(del))
+
+	binary := newDumpTestRow([]pevent.RedoColumnValue{{Value: int64(3)}, {Value: []byte{0xff, 0x00}}}, nil)
+	require.Equal(t, "/* commit-ts: 101 */ INSERT INTO `test`.`t` (`id`,`name`) VALUES (3,x'ff00');",
+		formatLogAsSQL(binary))
+
+	// the values without the column meta of a corrupted log are ignored.
+	corrupted := newDumpTestRow(
+		[]pevent.RedoColumnValue{{Value: int64(4)}, {Value: "d"}, {Value: "extra"}},
+		[]pevent.RedoColumnValue{{Value: int64(4), Flag: handleFlag}, {Value: "c"}, {Value: "extra", Flag: handleFlag}})
+	require.Equal(t, "/* commit-ts: 101 */ UPDATE `test`.`t` SET `id` = 4,`name` = 'd' WHERE `id` = 4 LIMIT 1;",
+		formatLogAsSQL(corrupted))
+	corrupted.RedoRow.PreColumns = nil
+	corrupted.RedoRow.Row.PreColumns = nil
+	require.Equal(t, "/* commit-ts: 101 */ INSERT INTO `test`.`t` (`id`,`name`) VALUES (4,'d');",
+		formatLogAsSQL(corrupted))
+
+	ddl := &pevent.RedoLog{
+		RedoDDL: &pevent.RedoDDLEvent{
+			DDL: &pevent.DDLEventInRedoLog{CommitTs: 102, Query: "create table test.t(id int primary key);"},
+		},
+		Type: pevent.RedoLogTypeDDL,
+	}
+	require.Equal(t, "/* commit-ts: 102 */ create table test.t(id int primary key);", formatLogAsSQL(ddl))
+}
+
+func TestFormatLogAsJSON(t *testing.T) {
+	t.Parallel()
+
+	update := newDumpTestRow(
+		[]pevent.RedoColumnValue{{Value: int64(1)}, {Value: []byte("b")}},
+		[]pevent.RedoColumnValue{{Value: int64(1)}, {Value: []byte("a")}})
+	data, err := formatLogAsJSON("row.log", update)
+	require.NoError(t, err)
+	record := &dumpRecord{}
+	require.NoError(t, json.Unmarshal([]byte(data), record))
+	require.Equal(t, "row.log", record.File)
+	require.Equal(t, "row", record.Type)
+	require.Equal(t, "update", record.RowType)
+	require.Equal(t, uint64(101), record.CommitTs)
+	require.Equal(t, "test", record.Schema)
+	require.Equal(t, "t", record.Table)
+	require.Equal(t, map[string]any{"id": float64(1), "name": "b"}, record.Columns)
+	require.Equal(t, map[string]any{"id": float64(1), "name": "a"}, record.PreColumns)
+}
+
+func TestDumpOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	o := newDumpOptions()
+	o.format = dumpFormatJSON
+	require.NoError(t, o.validate())
+
+	o.fileType = "meta"
+	require.Error(t, o.validate())
+	o.fileType = ""
+
+	o.format = "csv"
+	require.Error(t, o.validate())
+	o.format = dumpFormatSQL
+
+	o.startTs, o.endTs = 10, 10
+	require.Error(t, o.validate())
+	o.endTs = 11
+	require.NoError(t, o.validate())
+
+	o.storage = "local:///tmp/redo"
+	o.tables = []string{"test.*"}
+	require.NoError(t, o.complete())
+	require.Equal(t, "file", o.uri.Scheme)
+	require.True(t, o.tableFilter.MatchTable("TEST", "t"))
+
+	o.storage = "blackhole://"
+	require.Error(t, o.complete())
+}
@@ -509,7 +509,7 @@ func (w *writer) onDDL(ddl *event.DDLEvent) {
 	}
 	switch w.protocol {
 	case config.ProtocolCanalJSON, config.ProtocolOpen, config.ProtocolAvro, config.ProtocolSimple,
-		config.ProtocolDebezium, config.ProtocolDebeziumAvro:
+		config.ProtocolDebezium, config.ProtocolDebeziumAvro, config.ProtocolMaxwell, config.ProtocolCraft:
 	default:
 		return
 	}
@@ -48,6 +48,9 @@ type Backoff struct {
 	// failed is true when the changefeed is not need to retry,
 	// it will be set to true when the backoff is stopped and will be reset when resume the changefeed
 	failed *atomic.Bool
+	// errorStuck is true when the changefeed is failed because it has been retrying
+	// an error for longer than the changefeedErrorStuckDuration
+	errorStuck *atomic.Bool
 
 	// retrying is true when the changefeed is in the process of retrying
 	retrying *atomic.Bool
@@ -77,6 +80,7 @@ func NewBackoff(
 		changefeedErrorStuckDuration: changefeedErrorStuckDuration,
 		isRestarting:                 atomic.NewBool(false),
 		failed:                       atomic.NewBool(false),
+		errorStuck:                   atomic.NewBool(false),
 		retrying:                     atomic.NewBool(false),
 		nextRetryTime:                atomic.NewTime(time.Time{}),
 		checkpointTs:                 checkpointTs,
@@ -107,6 +111,7 @@ func (m *Backoff) resetErrRetry() {
 	m.errBackoff.Reset()
 	m.nextRetryTime.Store(time.Time{})
 	m.failed.Store(false)
+	m.errorStuck.Store(false)
 	m.retrying.Store(false)
 }
 
@@ -236,6 +241,7 @@ func (m *Backoff) HandleError(errs []*heartbeatpb.RunningError) (bool, *heartbea
 			zap.Uint64("checkpointTs", m.checkpointTs),
 			zap.Time("nextRetryTime", m.nextRetryTime.Load()),
 		)
+		m.errorStuck.Store(true)
 		return true, lastError
 	}
 	// if any error is occurred , we should set the changefeed state to warning and stop the changefeed
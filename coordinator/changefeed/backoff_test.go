@@ -227,6 +227,7 @@ func TestFailedWhenRetry(t *testing.T) {
 	require.Equal(t, config.StateWarning, state)
 	require.True(t, backoff.retrying.Load())
 	require.True(t, backoff.isRestarting.Load())
+	require.False(t, backoff.errorStuck.Load())
 
 	mc.Set(time.Now().Add(time.Minute))
 	// failed
@@ -241,6 +242,7 @@ func TestFailedWhenRetry(t *testing.T) {
 	require.Equal(t, config.StateFailed, state)
 	require.True(t, backoff.retrying.Load())
 	require.True(t, backoff.isRestarting.Load())
+	require.True(t, backoff.errorStuck.Load())
 	backoff.StartFinished()
 	require.False(t, backoff.isRestarting.Load())
 }
@@ -16,6 +16,7 @@ package changefeed
 import (
 	"net/url"
 	"sync"
+	"time"
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/log"
@@ -46,6 +47,12 @@ type Changefeed struct {
 	// the heartbeatpb.MaintainerStatus is read only
 	status *atomic.Pointer[heartbeatpb.MaintainerStatus]
 
+	// loadedAt is the time the changefeed is loaded, the stop policy takes effect
+	// from it if the armed time is not persisted with the policy.
+	loadedAt time.Time
+	// stopReason is the reason of the fired stop policy, empty if it's not fired
+	stopReason *atomic.String
+
 	backoff *Backoff
 }
 
@@ -80,7 +87,9 @@ func NewChangefeed(cfID common.ChangeFeedID,
 				CheckpointTs: checkpointTs,
 				FeedState:    string(info.State),
 			}),
-		backoff: NewBackoff(cfID, *info.Config.ChangefeedErrorStuckDuration, checkpointTs),
+		loadedAt:   time.Now(),
+		stopReason: atomic.NewString(""),
+		backoff:    NewBackoff(cfID, *info.Config.ChangefeedErrorStuckDuration, checkpointTs),
 	}
 	// Must set retrying to true when the changefeed is in warning state.
 	if info.State == config.StateWarning {
@@ -156,6 +165,12 @@ func (c *Changefeed) ShouldRun() bool {
 	return c.backoff.ShouldRun()
 }
 
+// IsErrorStuck returns true if the changefeed is failed because it has been
+// retrying an error for longer than the changefeed-error-stuck-duration.
+func (c *Changefeed) IsErrorStuck() bool {
+	return c.backoff.errorStuck.Load()
+}
+
 // UpdateStatus updates the changefeed status
 // It returns true if the status is changed
 // It returns false if the status is not changed
@@ -186,6 +201,25 @@ func (c *Changefeed) UpdateStatus(newStatus *heartbeatpb.MaintainerStatus) (bool
 		if info.TargetTs != 0 && newStatus.CheckpointTs >= info.TargetTs {
 			return true, config.StateFinished, nil
 		}
+		if policy := info.StopPolicy; policy != nil {
+			reason := policy.Evaluate(config.StopPolicyStatus{
+				Now:           time.Now(),
+				ArmedAt:       c.loadedAt,
+				CheckpointTs:  newStatus.CheckpointTs,
+				LastSyncedTs:  newStatus.LastSyncedTs,
+				BootstrapDone: newStatus.BootstrapDone,
+				SyncedStatus:  info.Config.SyncedStatus,
+			})
+			if reason != "" {
+				if c.stopReason.Swap(reason) == "" {
+					log.Info("changefeed stop policy fired",
+						zap.Stringer("changefeed", c.ID),
+						zap.String("reason", reason),
+						zap.Uint64("checkpointTs", newStatus.CheckpointTs))
+				}
+				return true, policy.TargetState(), nil
+			}
+		}
 
 		return c.backoff.CheckStatus(newStatus)
 	}
@@ -193,6 +227,18 @@ func (c *Changefeed) UpdateStatus(newStatus *heartbeatpb.MaintainerStatus) (bool
 	return false, config.StateNormal, nil
 }
 
+// ResetStopReason clears the reason of the fired stop policy, it's called when
+// the changefeed is resumed.
+func (c *Changefeed) ResetStopReason() {
+	c.stopReason.Store("")
+}
+
+// GetStopReason returns the reason of the fired stop policy, or an empty string
+// if the stop policy is not fired.
+func (c *Changefeed) GetStopReason() string {
+	return c.stopReason.Load()
+}
+
 func (c *Changefeed) GetLogCoordinatorResolvedTs() uint64 {
 	return c.logCoordinatorResolvedTs.Load()
 }
@@ -332,5 +378,14 @@ func getSinkType(scheme string) common.SinkType {
 	if config.IsStorageScheme(scheme) {
 		return common.CloudStorageSinkType
 	}
+	if config.IsElasticsearchScheme(scheme) {
+		return common.ElasticsearchSinkType
+	}
+	if config.IsWebhookScheme(scheme) {
+		return common.WebhookSinkType
+	}
+	if config.IsRedisScheme(scheme) {
+		return common.RedisSinkType
+	}
 	return common.BlackHoleSinkType
 }
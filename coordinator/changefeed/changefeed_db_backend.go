@@ -41,8 +41,17 @@ type Backend interface {
 	GetChangefeedInfo(ctx context.Context, id common.ChangeFeedID) (*config.ChangeFeedInfo, error)
 	// CreateChangefeed saves changefeed info and status to db
 	CreateChangefeed(ctx context.Context, info *config.ChangeFeedInfo) error
-	// UpdateChangefeed updates changefeed info  to db
-	UpdateChangefeed(ctx context.Context, info *config.ChangeFeedInfo, checkpointTs uint64, progress config.Progress) error
+	// UpdateChangefeed updates changefeed info  to db, the config revision is saved
+	// in the same transaction if it's not nil
+	UpdateChangefeed(
+		ctx context.Context,
+		info *config.ChangeFeedInfo,
+		checkpointTs uint64,
+		progress config.Progress,
+		revision *config.ChangefeedConfigRevision,
+	) error
+	// GetChangefeedConfigHistory returns the config revisions of a changefeed, sorted by the revision
+	GetChangefeedConfigHistory(ctx context.Context, id common.ChangeFeedID) ([]*config.ChangefeedConfigRevision, error)
 	// ResumeChangefeed persists the resumed status with a new owner epoch.
 	ResumeChangefeed(ctx context.Context, id common.ChangeFeedID, candidateEpoch uint64, checkpointTs uint64) (*config.ChangeFeedInfo, error)
 	// BumpChangefeedEpoch is the low-level ownership boundary used before a
@@ -57,6 +66,17 @@ type Backend interface {
 	SetChangefeedProgress(ctx context.Context, id common.ChangeFeedID, progress config.Progress) error
 	// UpdateChangefeedCheckpointTs persists the checkpointTs for changefeeds
 	UpdateChangefeedCheckpointTs(ctx context.Context, checkpointTs map[common.ChangeFeedID]uint64) error
+
+	// GetAllChangefeedTemplates returns all changefeed templates from the backend db
+	GetAllChangefeedTemplates(ctx context.Context) ([]*config.ChangefeedTemplate, error)
+	// GetChangefeedTemplate returns a changefeed template from the backend db
+	GetChangefeedTemplate(ctx context.Context, name string) (*config.ChangefeedTemplate, error)
+	// CreateChangefeedTemplate saves a new changefeed template to db
+	CreateChangefeedTemplate(ctx context.Context, template *config.ChangefeedTemplate) error
+	// UpdateChangefeedTemplate overwrites an existing changefeed template in db
+	UpdateChangefeedTemplate(ctx context.Context, template *config.ChangefeedTemplate) error
+	// DeleteChangefeedTemplate removes a changefeed template from db
+	DeleteChangefeedTemplate(ctx context.Context, name string) error
 }
 
 // ChangefeedMetaWrapper is a wrapper for the changefeed load from the DB
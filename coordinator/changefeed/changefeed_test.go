@@ -16,6 +16,7 @@ package changefeed
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/pingcap/ticdc/heartbeatpb"
 	"github.com/pingcap/ticdc/pkg/common"
@@ -110,6 +111,44 @@ func TestChangefeed_UpdateStatus(t *testing.T) {
 	require.Equal(t, newStatus, cf.GetStatus())
 }
 
+func TestChangefeed_UpdateStatusStopPolicy(t *testing.T) {
+	cfID := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	info := &config.ChangeFeedInfo{
+		SinkURI: "kafka://127.0.0.1:9092",
+		State:   config.StateNormal,
+		Config:  config.GetDefaultReplicaConfig(),
+		StopPolicy: &config.StopPolicy{
+			TargetTs: 300,
+			Action:   config.StopPolicyActionPause,
+		},
+	}
+	cf := NewChangefeed(cfID, info, 100, true)
+
+	updated, state, err := cf.UpdateStatus(&heartbeatpb.MaintainerStatus{CheckpointTs: 200})
+	require.False(t, updated)
+	require.Equal(t, config.StateNormal, state)
+	require.Nil(t, err)
+	require.Empty(t, cf.GetStopReason())
+
+	updated, state, err = cf.UpdateStatus(&heartbeatpb.MaintainerStatus{CheckpointTs: 300})
+	require.True(t, updated)
+	require.Equal(t, config.StateStopped, state)
+	require.Nil(t, err)
+	require.Equal(t, config.StopReasonTargetTs, cf.GetStopReason())
+
+	// the deadline policy finishes the changefeed
+	deadline := time.Now().Add(-time.Minute)
+	info.StopPolicy = &config.StopPolicy{Deadline: &deadline}
+	cf.SetInfo(info)
+	cf.ResetStopReason()
+	require.Empty(t, cf.GetStopReason())
+	updated, state, err = cf.UpdateStatus(&heartbeatpb.MaintainerStatus{CheckpointTs: 300})
+	require.True(t, updated)
+	require.Equal(t, config.StateFinished, state)
+	require.Nil(t, err)
+	require.Equal(t, config.StopReasonDeadline, cf.GetStopReason())
+}
+
 func TestChangefeed_UpdateStatusFastFailWhenBootstrapDoneChanges(t *testing.T) {
 	cfID := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
 	info := &config.ChangeFeedInfo{
@@ -175,7 +175,13 @@ func (b *EtcdBackend) CreateChangefeed(ctx context.Context,
 	return nil
 }
 
-func (b *EtcdBackend) UpdateChangefeed(ctx context.Context, info *config.ChangeFeedInfo, checkpointTs uint64, progress config.Progress) error {
+func (b *EtcdBackend) UpdateChangefeed(
+	ctx context.Context,
+	info *config.ChangeFeedInfo,
+	checkpointTs uint64,
+	progress config.Progress,
+	revision *config.ChangefeedConfigRevision,
+) error {
 	infoKey := etcd.GetEtcdKeyChangeFeedInfo(b.etcdClient.GetClusterID(), info.ChangefeedID.DisplayName)
 	newStr, err := info.Marshal()
 	if err != nil {
@@ -195,8 +201,21 @@ func (b *EtcdBackend) UpdateChangefeed(ctx context.Context, info *config.ChangeF
 		clientv3.OpPut(infoKey, newStr),
 		clientv3.OpPut(jobKey, statusStr),
 	)
+	cmps := []clientv3.Cmp{}
+	if revision != nil {
+		revisionStr, err := revision.Marshal()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		// The revision number must not be used, otherwise another update
+		// recorded the same revision concurrently.
+		revisionKey := etcd.GetEtcdKeyChangefeedConfigRevision(
+			b.etcdClient.GetClusterID(), info.ChangefeedID.DisplayName, revision.Revision)
+		cmps = append(cmps, clientv3.Compare(clientv3.CreateRevision(revisionKey), "=", 0))
+		opsThen = append(opsThen, clientv3.OpPut(revisionKey, revisionStr))
+	}
 
-	putResp, err := b.etcdClient.GetEtcdClient().Txn(ctx, []clientv3.Cmp{}, opsThen, []clientv3.Op{})
+	putResp, err := b.etcdClient.GetEtcdClient().Txn(ctx, cmps, opsThen, []clientv3.Op{})
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -207,6 +226,27 @@ func (b *EtcdBackend) UpdateChangefeed(ctx context.Context, info *config.ChangeF
 	return nil
 }
 
+// GetChangefeedConfigHistory returns the config revisions of a changefeed, sorted by the revision.
+func (b *EtcdBackend) GetChangefeedConfigHistory(
+	ctx context.Context, id common.ChangeFeedID,
+) ([]*config.ChangefeedConfigRevision, error) {
+	prefix := etcd.ChangefeedConfigHistoryKeyPrefix(b.etcdClient.GetClusterID(), id.DisplayName)
+	resp, err := b.etcdClient.GetEtcdClient().Get(ctx, prefix,
+		clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	history := make([]*config.ChangefeedConfigRevision, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		revision := &config.ChangefeedConfigRevision{}
+		if err = revision.Unmarshal(kv.Value); err != nil {
+			return nil, errors.Trace(err)
+		}
+		history = append(history, revision)
+	}
+	return history, nil
+}
+
 // BumpChangefeedEpoch atomically persists a strictly newer ownership epoch.
 // It can optionally update status in the same transaction so state changes and
 // the new owner fence are observed together after coordinator failover.
@@ -386,6 +426,9 @@ func (b *EtcdBackend) DeleteChangefeed(ctx context.Context,
 	opsThen := []clientv3.Op{}
 	opsThen = append(opsThen, clientv3.OpDelete(infoKey))
 	opsThen = append(opsThen, clientv3.OpDelete(jobKey))
+	opsThen = append(opsThen, clientv3.OpDelete(
+		etcd.ChangefeedConfigHistoryKeyPrefix(b.etcdClient.GetClusterID(), changefeedID.DisplayName),
+		clientv3.WithPrefix()))
 	resp, err := b.etcdClient.GetEtcdClient().Txn(ctx, []clientv3.Cmp{}, opsThen, []clientv3.Op{})
 	if err != nil {
 		return errors.Trace(err)
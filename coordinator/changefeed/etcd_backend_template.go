@@ -0,0 +1,109 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changefeed
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/etcd"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+func (b *EtcdBackend) GetAllChangefeedTemplates(ctx context.Context) ([]*config.ChangefeedTemplate, error) {
+	resp, err := b.etcdClient.GetEtcdClient().Get(ctx,
+		etcd.ChangefeedTemplateKeyPrefix(b.etcdClient.GetClusterID())+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	templates := make([]*config.ChangefeedTemplate, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		tpl := &config.ChangefeedTemplate{}
+		if err = tpl.Unmarshal(kv.Value); err != nil {
+			log.Warn("failed to unmarshal changefeed template, ignore",
+				zap.String("key", string(kv.Key)), zap.Error(err))
+			continue
+		}
+		templates = append(templates, tpl)
+	}
+	return templates, nil
+}
+
+func (b *EtcdBackend) GetChangefeedTemplate(ctx context.Context, name string) (*config.ChangefeedTemplate, error) {
+	resp, err := b.etcdClient.GetEtcdClient().Get(ctx,
+		etcd.GetEtcdKeyChangefeedTemplate(b.etcdClient.GetClusterID(), name))
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, cerror.ErrChangefeedTemplateNotExists.GenWithStackByArgs(name)
+	}
+	tpl := &config.ChangefeedTemplate{}
+	if err = tpl.Unmarshal(resp.Kvs[0].Value); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return tpl, nil
+}
+
+func (b *EtcdBackend) CreateChangefeedTemplate(ctx context.Context, tpl *config.ChangefeedTemplate) error {
+	key := etcd.GetEtcdKeyChangefeedTemplate(b.etcdClient.GetClusterID(), tpl.Name)
+	value, err := tpl.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resp, err := b.etcdClient.GetEtcdClient().Txn(ctx,
+		[]clientv3.Cmp{clientv3.Compare(clientv3.CreateRevision(key), "=", 0)},
+		[]clientv3.Op{clientv3.OpPut(key, value)}, []clientv3.Op{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !resp.Succeeded {
+		return cerror.ErrChangefeedTemplateAlreadyExists.GenWithStackByArgs(tpl.Name)
+	}
+	return nil
+}
+
+func (b *EtcdBackend) UpdateChangefeedTemplate(ctx context.Context, tpl *config.ChangefeedTemplate) error {
+	key := etcd.GetEtcdKeyChangefeedTemplate(b.etcdClient.GetClusterID(), tpl.Name)
+	value, err := tpl.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resp, err := b.etcdClient.GetEtcdClient().Txn(ctx,
+		[]clientv3.Cmp{clientv3.Compare(clientv3.CreateRevision(key), "!=", 0)},
+		[]clientv3.Op{clientv3.OpPut(key, value)}, []clientv3.Op{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !resp.Succeeded {
+		return cerror.ErrChangefeedTemplateNotExists.GenWithStackByArgs(tpl.Name)
+	}
+	return nil
+}
+
+func (b *EtcdBackend) DeleteChangefeedTemplate(ctx context.Context, name string) error {
+	resp, err := b.etcdClient.GetEtcdClient().Delete(ctx,
+		etcd.GetEtcdKeyChangefeedTemplate(b.etcdClient.GetClusterID(), name))
+	if err != nil {
+		return cerror.WrapError(cerror.ErrPDEtcdAPIError, err)
+	}
+	if resp.Deleted == 0 {
+		return cerror.ErrChangefeedTemplateNotExists.GenWithStackByArgs(name)
+	}
+	return nil
+}
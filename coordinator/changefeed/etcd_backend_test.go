@@ -16,12 +16,14 @@ package changefeed
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/ticdc/pkg/common"
 	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
 	"github.com/pingcap/ticdc/pkg/etcd"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/etcd/api/v3/mvccpb"
@@ -129,12 +131,12 @@ func TestUpdateChangefeed(t *testing.T) {
 	backend := NewEtcdBackend(cdcClient)
 
 	etcdClient.EXPECT().Txn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("txn failed")).Times(1)
-	require.NotNil(t, backend.UpdateChangefeed(context.Background(), &config.ChangeFeedInfo{}, 0, config.ProgressStopping))
+	require.NotNil(t, backend.UpdateChangefeed(context.Background(), &config.ChangeFeedInfo{}, 0, config.ProgressStopping, nil))
 
 	// txn fail
 	etcdClient.EXPECT().Txn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(&clientv3.TxnResponse{Succeeded: false}, nil).Times(1)
-	require.NotNil(t, backend.UpdateChangefeed(context.Background(), &config.ChangeFeedInfo{}, 0, config.ProgressStopping))
+	require.NotNil(t, backend.UpdateChangefeed(context.Background(), &config.ChangeFeedInfo{}, 0, config.ProgressStopping, nil))
 
 	etcdClient.EXPECT().Txn(gomock.Any(), gomock.Len(0), NewFuncMatcher(func(i interface{}) bool {
 		ops := i.([]clientv3.Op)
@@ -143,7 +145,23 @@ func TestUpdateChangefeed(t *testing.T) {
 		require.True(t, ops[1].IsPut())
 		return true
 	}), gomock.Any()).Return(&clientv3.TxnResponse{Succeeded: true}, nil).Times(1)
-	require.Nil(t, backend.UpdateChangefeed(context.Background(), &config.ChangeFeedInfo{}, 2, config.ProgressStopping))
+	require.Nil(t, backend.UpdateChangefeed(context.Background(), &config.ChangeFeedInfo{}, 2, config.ProgressStopping, nil))
+
+	// the config revision is recorded in the same txn
+	etcdClient.EXPECT().Txn(gomock.Any(), gomock.Len(1), NewFuncMatcher(func(i interface{}) bool {
+		ops := i.([]clientv3.Op)
+		require.Len(t, ops, 3)
+		require.True(t, ops[2].IsPut())
+		require.True(t, strings.HasSuffix(string(ops[2].KeyBytes()), "/changefeed-config-history/test/00000000000000000003"))
+		return true
+	}), gomock.Any()).Return(&clientv3.TxnResponse{Succeeded: true}, nil).Times(1)
+	info := &config.ChangeFeedInfo{
+		ChangefeedID: common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName),
+		Config:       config.GetDefaultReplicaConfig(),
+	}
+	revision := config.NewChangefeedConfigRevision(info, "alice")
+	revision.Revision = 3
+	require.Nil(t, backend.UpdateChangefeed(context.Background(), info, 2, config.ProgressNone, revision))
 }
 
 func TestBumpChangefeedEpoch(t *testing.T) {
@@ -430,9 +448,10 @@ func TestDeleteChangefeed(t *testing.T) {
 
 	etcdClient.EXPECT().Txn(gomock.Any(), gomock.Any(), NewFuncMatcher(func(i interface{}) bool {
 		ops := i.([]clientv3.Op)
-		require.Len(t, ops, 2)
+		require.Len(t, ops, 3)
 		require.True(t, ops[0].IsDelete())
 		require.True(t, ops[1].IsDelete())
+		require.True(t, ops[2].IsDelete())
 		return true
 	}), gomock.Any()).Return(&clientv3.TxnResponse{Succeeded: true}, nil).Times(1)
 
@@ -534,3 +553,81 @@ func (f *FuncMarcher) Matches(x any) bool {
 func (f *FuncMarcher) String() string {
 	return "func"
 }
+
+func TestEtcdBackendChangefeedTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cdcClient := etcd.NewMockCDCEtcdClient(ctrl)
+	etcdClient := etcd.NewMockClient(ctrl)
+	cdcClient.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cdcClient.EXPECT().GetClusterID().Return("test-cluster-id").AnyTimes()
+	backend := NewEtcdBackend(cdcClient)
+
+	tpl := &config.ChangefeedTemplate{Name: "tpl", SinkURI: "blackhole://", Config: config.GetDefaultReplicaConfig()}
+	value, err := tpl.Marshal()
+	require.NoError(t, err)
+	key := etcd.GetEtcdKeyChangefeedTemplate("test-cluster-id", "tpl")
+
+	// create the template, and create it again
+	etcdClient.EXPECT().Txn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&clientv3.TxnResponse{Succeeded: true}, nil).Times(1)
+	require.NoError(t, backend.CreateChangefeedTemplate(context.Background(), tpl))
+	etcdClient.EXPECT().Txn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&clientv3.TxnResponse{Succeeded: false}, nil).Times(1)
+	err = backend.CreateChangefeedTemplate(context.Background(), tpl)
+	require.True(t, cerror.ErrChangefeedTemplateAlreadyExists.Equal(err))
+
+	etcdClient.EXPECT().Get(gomock.Any(), key).
+		Return(&clientv3.GetResponse{Kvs: []*mvccpb.KeyValue{{Key: []byte(key), Value: []byte(value)}}}, nil).Times(1)
+	got, err := backend.GetChangefeedTemplate(context.Background(), "tpl")
+	require.NoError(t, err)
+	require.Equal(t, "blackhole://", got.SinkURI)
+
+	// the broken template is ignored when listing the templates
+	etcdClient.EXPECT().Get(gomock.Any(), etcd.ChangefeedTemplateKeyPrefix("test-cluster-id")+"/", gomock.Any()).
+		Return(&clientv3.GetResponse{Kvs: []*mvccpb.KeyValue{
+			{Key: []byte(key), Value: []byte(value)},
+			{Key: []byte(key + "-broken"), Value: []byte("invalid json")},
+		}}, nil).Times(1)
+	templates, err := backend.GetAllChangefeedTemplates(context.Background())
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+
+	etcdClient.EXPECT().Delete(gomock.Any(), key).Return(&clientv3.DeleteResponse{Deleted: 0}, nil).Times(1)
+	err = backend.DeleteChangefeedTemplate(context.Background(), "tpl")
+	require.True(t, cerror.ErrChangefeedTemplateNotExists.Equal(err))
+}
+
+func TestEtcdBackendGetChangefeedConfigHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	cdcClient := etcd.NewMockCDCEtcdClient(ctrl)
+	etcdClient := etcd.NewMockClient(ctrl)
+	cdcClient.EXPECT().GetEtcdClient().Return(etcdClient).AnyTimes()
+	cdcClient.EXPECT().GetClusterID().Return("test-cluster-id").AnyTimes()
+	backend := NewEtcdBackend(cdcClient)
+
+	changefeedID := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	info := &config.ChangeFeedInfo{ChangefeedID: changefeedID, SinkURI: "blackhole://"}
+	var kvs []*mvccpb.KeyValue
+	for i := uint64(1); i <= 2; i++ {
+		revision := config.NewChangefeedConfigRevision(info, "alice")
+		revision.Revision = i
+		value, err := revision.Marshal()
+		require.NoError(t, err)
+		key := etcd.GetEtcdKeyChangefeedConfigRevision("test-cluster-id", changefeedID.DisplayName, i)
+		kvs = append(kvs, &mvccpb.KeyValue{Key: []byte(key), Value: []byte(value)})
+	}
+
+	prefix := etcd.ChangefeedConfigHistoryKeyPrefix("test-cluster-id", changefeedID.DisplayName)
+	etcdClient.EXPECT().Get(gomock.Any(), prefix, gomock.Any()).
+		Return(&clientv3.GetResponse{Kvs: kvs}, nil).Times(1)
+	history, err := backend.GetChangefeedConfigHistory(context.Background(), changefeedID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, uint64(1), history[0].Revision)
+	require.Equal(t, "alice", history[1].Author)
+
+	etcdClient.EXPECT().Get(gomock.Any(), prefix, gomock.Any()).
+		Return(nil, errors.New("get failed")).Times(1)
+	_, err = backend.GetChangefeedConfigHistory(context.Background(), changefeedID)
+	require.Error(t, err)
+}
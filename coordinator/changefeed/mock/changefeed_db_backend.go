@@ -66,6 +66,20 @@ func (mr *MockBackendMockRecorder) CreateChangefeed(ctx, info interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangefeed", reflect.TypeOf((*MockBackend)(nil).CreateChangefeed), ctx, info)
 }
 
+// CreateChangefeedTemplate mocks base method.
+func (m *MockBackend) CreateChangefeedTemplate(ctx context.Context, template *config.ChangefeedTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangefeedTemplate", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateChangefeedTemplate indicates an expected call of CreateChangefeedTemplate.
+func (mr *MockBackendMockRecorder) CreateChangefeedTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangefeedTemplate", reflect.TypeOf((*MockBackend)(nil).CreateChangefeedTemplate), ctx, template)
+}
+
 // DeleteChangefeed mocks base method.
 func (m *MockBackend) DeleteChangefeed(ctx context.Context, id common.ChangeFeedID) error {
 	m.ctrl.T.Helper()
@@ -80,6 +94,35 @@ func (mr *MockBackendMockRecorder) DeleteChangefeed(ctx, id interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangefeed", reflect.TypeOf((*MockBackend)(nil).DeleteChangefeed), ctx, id)
 }
 
+// DeleteChangefeedTemplate mocks base method.
+func (m *MockBackend) DeleteChangefeedTemplate(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangefeedTemplate", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangefeedTemplate indicates an expected call of DeleteChangefeedTemplate.
+func (mr *MockBackendMockRecorder) DeleteChangefeedTemplate(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangefeedTemplate", reflect.TypeOf((*MockBackend)(nil).DeleteChangefeedTemplate), ctx, name)
+}
+
+// GetAllChangefeedTemplates mocks base method.
+func (m *MockBackend) GetAllChangefeedTemplates(ctx context.Context) ([]*config.ChangefeedTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllChangefeedTemplates", ctx)
+	ret0, _ := ret[0].([]*config.ChangefeedTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllChangefeedTemplates indicates an expected call of GetAllChangefeedTemplates.
+func (mr *MockBackendMockRecorder) GetAllChangefeedTemplates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllChangefeedTemplates", reflect.TypeOf((*MockBackend)(nil).GetAllChangefeedTemplates), ctx)
+}
+
 // GetAllChangefeeds mocks base method.
 func (m *MockBackend) GetAllChangefeeds(ctx context.Context) (map[common.ChangeFeedID]*changefeed.ChangefeedMetaWrapper, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +138,21 @@ func (mr *MockBackendMockRecorder) GetAllChangefeeds(ctx interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllChangefeeds", reflect.TypeOf((*MockBackend)(nil).GetAllChangefeeds), ctx)
 }
 
+// GetChangefeedConfigHistory mocks base method.
+func (m *MockBackend) GetChangefeedConfigHistory(ctx context.Context, id common.ChangeFeedID) ([]*config.ChangefeedConfigRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangefeedConfigHistory", ctx, id)
+	ret0, _ := ret[0].([]*config.ChangefeedConfigRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangefeedConfigHistory indicates an expected call of GetChangefeedConfigHistory.
+func (mr *MockBackendMockRecorder) GetChangefeedConfigHistory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedConfigHistory", reflect.TypeOf((*MockBackend)(nil).GetChangefeedConfigHistory), ctx, id)
+}
+
 // GetChangefeedInfo mocks base method.
 func (m *MockBackend) GetChangefeedInfo(ctx context.Context, id common.ChangeFeedID) (*config.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +168,21 @@ func (mr *MockBackendMockRecorder) GetChangefeedInfo(ctx, id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedInfo", reflect.TypeOf((*MockBackend)(nil).GetChangefeedInfo), ctx, id)
 }
 
+// GetChangefeedTemplate mocks base method.
+func (m *MockBackend) GetChangefeedTemplate(ctx context.Context, name string) (*config.ChangefeedTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangefeedTemplate", ctx, name)
+	ret0, _ := ret[0].(*config.ChangefeedTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangefeedTemplate indicates an expected call of GetChangefeedTemplate.
+func (mr *MockBackendMockRecorder) GetChangefeedTemplate(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangefeedTemplate", reflect.TypeOf((*MockBackend)(nil).GetChangefeedTemplate), ctx, name)
+}
+
 // PauseChangefeed mocks base method.
 func (m *MockBackend) PauseChangefeed(ctx context.Context, id common.ChangeFeedID) error {
 	m.ctrl.T.Helper()
@@ -154,17 +227,17 @@ func (mr *MockBackendMockRecorder) SetChangefeedProgress(ctx, id, progress inter
 }
 
 // UpdateChangefeed mocks base method.
-func (m *MockBackend) UpdateChangefeed(ctx context.Context, info *config.ChangeFeedInfo, checkpointTs uint64, progress config.Progress) error {
+func (m *MockBackend) UpdateChangefeed(ctx context.Context, info *config.ChangeFeedInfo, checkpointTs uint64, progress config.Progress, revision *config.ChangefeedConfigRevision) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateChangefeed", ctx, info, checkpointTs, progress)
+	ret := m.ctrl.Call(m, "UpdateChangefeed", ctx, info, checkpointTs, progress, revision)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateChangefeed indicates an expected call of UpdateChangefeed.
-func (mr *MockBackendMockRecorder) UpdateChangefeed(ctx, info, checkpointTs, progress interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) UpdateChangefeed(ctx, info, checkpointTs, progress, revision interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChangefeed", reflect.TypeOf((*MockBackend)(nil).UpdateChangefeed), ctx, info, checkpointTs, progress)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChangefeed", reflect.TypeOf((*MockBackend)(nil).UpdateChangefeed), ctx, info, checkpointTs, progress, revision)
 }
 
 // UpdateChangefeedCheckpointTs mocks base method.
@@ -180,3 +253,17 @@ func (mr *MockBackendMockRecorder) UpdateChangefeedCheckpointTs(ctx, checkpointT
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChangefeedCheckpointTs", reflect.TypeOf((*MockBackend)(nil).UpdateChangefeedCheckpointTs), ctx, checkpointTs)
 }
+
+// UpdateChangefeedTemplate mocks base method.
+func (m *MockBackend) UpdateChangefeedTemplate(ctx context.Context, template *config.ChangefeedTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChangefeedTemplate", ctx, template)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChangefeedTemplate indicates an expected call of UpdateChangefeedTemplate.
+func (mr *MockBackendMockRecorder) UpdateChangefeedTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChangefeedTemplate", reflect.TypeOf((*MockBackend)(nil).UpdateChangefeedTemplate), ctx, template)
+}
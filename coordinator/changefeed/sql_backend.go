@@ -0,0 +1,512 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changefeed
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// sqlChangefeedTable is the table of the changefeed metadata in the meta database,
+// each row holds the info and the status of a changefeed.
+const sqlChangefeedTable = "ticdc_changefeed"
+
+const createSQLChangefeedTable = `CREATE TABLE IF NOT EXISTS ` + sqlChangefeedTable + ` (
+	cluster_id VARCHAR(128) NOT NULL,
+	keyspace VARCHAR(128) NOT NULL,
+	name VARCHAR(128) NOT NULL,
+	info LONGTEXT NOT NULL,
+	status LONGTEXT NOT NULL,
+	epoch BIGINT UNSIGNED NOT NULL DEFAULT 0,
+	PRIMARY KEY (cluster_id, keyspace, name)
+)`
+
+// sqlChangefeedTemplateTable is the table of the changefeed templates in the meta database.
+const sqlChangefeedTemplateTable = "ticdc_changefeed_template"
+
+const createSQLChangefeedTemplateTable = `CREATE TABLE IF NOT EXISTS ` + sqlChangefeedTemplateTable + ` (
+	cluster_id VARCHAR(128) NOT NULL,
+	name VARCHAR(128) NOT NULL,
+	template LONGTEXT NOT NULL,
+	PRIMARY KEY (cluster_id, name)
+)`
+
+// sqlChangefeedConfigHistoryTable is the table of the config revisions of the changefeeds,
+// each row holds a revision of a changefeed.
+const sqlChangefeedConfigHistoryTable = "ticdc_changefeed_config_history"
+
+const createSQLChangefeedConfigHistoryTable = `CREATE TABLE IF NOT EXISTS ` + sqlChangefeedConfigHistoryTable + ` (
+	cluster_id VARCHAR(128) NOT NULL,
+	keyspace VARCHAR(128) NOT NULL,
+	name VARCHAR(128) NOT NULL,
+	revision BIGINT UNSIGNED NOT NULL,
+	content LONGTEXT NOT NULL,
+	PRIMARY KEY (cluster_id, keyspace, name, revision)
+)`
+
+// sqlCheckpointBatchSize is the max number of changefeeds whose checkpointTs
+// are updated in a transaction.
+const sqlCheckpointBatchSize = 128
+
+// SQLBackend is the changefeed meta store using a MySQL compatible database as the storage.
+// The info and the status of a changefeed are in the same row, so the multi-key
+// transactions of the etcd backend are the single row transactions here, and the
+// compare-and-swap on the etcd revisions is the row lock taken by SELECT ... FOR UPDATE.
+type SQLBackend struct {
+	db        *sql.DB
+	clusterID string
+}
+
+// NewSQLBackend creates a SQLBackend, and creates the meta tables if they do not exist.
+func NewSQLBackend(ctx context.Context, db *sql.DB, clusterID string) (*SQLBackend, error) {
+	for _, stmt := range []string{
+		createSQLChangefeedTable,
+		createSQLChangefeedTemplateTable,
+		createSQLChangefeedConfigHistoryTable,
+	} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+	}
+	return &SQLBackend{
+		db:        db,
+		clusterID: clusterID,
+	}, nil
+}
+
+func (b *SQLBackend) GetAllChangefeeds(ctx context.Context) (map[common.ChangeFeedID]*ChangefeedMetaWrapper, error) {
+	rows, err := b.db.QueryContext(ctx,
+		"SELECT keyspace, name, info, status FROM "+sqlChangefeedTable+" WHERE cluster_id = ?", b.clusterID)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	defer rows.Close()
+
+	cfMap := make(map[common.ChangeFeedID]*ChangefeedMetaWrapper)
+	for rows.Next() {
+		var keyspace, name, infoValue, statusValue string
+		if err = rows.Scan(&keyspace, &name, &infoValue, &statusValue); err != nil {
+			return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+		displayName := common.NewChangeFeedDisplayName(name, keyspace)
+		info := &config.ChangeFeedInfo{}
+		if err = info.Unmarshal([]byte(infoValue)); err != nil {
+			log.Warn("failed to unmarshal change feed Info, ignore",
+				zap.Any("changefeed", displayName), zap.Error(err))
+			continue
+		}
+		if info.ChangefeedID.Name() == "" {
+			info.ChangefeedID = common.NewChangeFeedIDWithDisplayName(displayName)
+		}
+		status := &config.ChangeFeedStatus{}
+		if err = status.Unmarshal([]byte(statusValue)); err != nil {
+			log.Warn("failed to unmarshal change feed Status, use the start ts as the checkpoint",
+				zap.Any("changefeed", displayName), zap.Error(err))
+			status = &config.ChangeFeedStatus{
+				CheckpointTs: info.StartTs,
+				Progress:     config.ProgressNone,
+			}
+		}
+		cfMap[info.ChangefeedID] = &ChangefeedMetaWrapper{Info: info, Status: status}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return cfMap, nil
+}
+
+// GetChangefeedInfo returns the latest persisted changefeed info from the meta database.
+func (b *SQLBackend) GetChangefeedInfo(ctx context.Context, id common.ChangeFeedID) (*config.ChangeFeedInfo, error) {
+	row := b.db.QueryRowContext(ctx,
+		"SELECT info FROM "+sqlChangefeedTable+" WHERE cluster_id = ? AND keyspace = ? AND name = ?",
+		b.clusterID, id.Keyspace(), id.Name())
+	var infoValue string
+	if err := row.Scan(&infoValue); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs(id.Name())
+		}
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	info := &config.ChangeFeedInfo{}
+	if err := info.Unmarshal([]byte(infoValue)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if info.ChangefeedID.Name() == "" {
+		info.ChangefeedID = id
+	}
+	return info, nil
+}
+
+func (b *SQLBackend) CreateChangefeed(ctx context.Context, info *config.ChangeFeedInfo) error {
+	infoValue, err := info.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	status := &config.ChangeFeedStatus{
+		CheckpointTs: info.StartTs,
+		Progress:     config.ProgressNone,
+	}
+	statusValue, err := status.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// The existing row is kept by INSERT IGNORE, it's the same as the
+	// create revision comparison of the etcd backend.
+	result, err := b.db.ExecContext(ctx,
+		"INSERT IGNORE INTO "+sqlChangefeedTable+" (cluster_id, keyspace, name, info, status, epoch) VALUES (?, ?, ?, ?, ?, ?)",
+		b.clusterID, info.ChangefeedID.Keyspace(), info.ChangefeedID.Name(), infoValue, statusValue, info.Epoch)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	if affected == 0 {
+		err = cerror.ErrMetaOpFailed.GenWithStackByArgs(fmt.Sprintf("create changefeed %s", info.ChangefeedID.Name()))
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+func (b *SQLBackend) UpdateChangefeed(
+	ctx context.Context,
+	info *config.ChangeFeedInfo,
+	checkpointTs uint64,
+	progress config.Progress,
+	revision *config.ChangefeedConfigRevision,
+) error {
+	status := &config.ChangeFeedStatus{
+		CheckpointTs: checkpointTs,
+		Progress:     progress,
+	}
+	if revision == nil {
+		infoValue, err := info.Marshal()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		statusValue, err := status.Marshal()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		_, err = b.db.ExecContext(ctx,
+			"UPDATE "+sqlChangefeedTable+" SET info = ?, status = ?, epoch = ? WHERE cluster_id = ? AND keyspace = ? AND name = ?",
+			infoValue, statusValue, info.Epoch, b.clusterID, info.ChangefeedID.Keyspace(), info.ChangefeedID.Name())
+		if err != nil {
+			return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+		return nil
+	}
+
+	revisionValue, err := revision.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return b.withTxn(ctx, func(tx *sql.Tx) error {
+		// The existing revision is kept by INSERT IGNORE, it's the same as the
+		// create revision comparison of the etcd backend.
+		result, err := tx.ExecContext(ctx,
+			"INSERT IGNORE INTO "+sqlChangefeedConfigHistoryTable+" (cluster_id, keyspace, name, revision, content) VALUES (?, ?, ?, ?, ?)",
+			b.clusterID, info.ChangefeedID.Keyspace(), info.ChangefeedID.Name(), revision.Revision, revisionValue)
+		if err != nil {
+			return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+		if affected == 0 {
+			err = cerror.ErrMetaOpFailed.GenWithStackByArgs(fmt.Sprintf("update changefeed %s failed", info.ChangefeedID.Name()))
+			return errors.Trace(err)
+		}
+		return b.saveChangefeed(ctx, tx, info.ChangefeedID, info, status)
+	})
+}
+
+// GetChangefeedConfigHistory returns the config revisions of a changefeed, sorted by the revision.
+func (b *SQLBackend) GetChangefeedConfigHistory(
+	ctx context.Context, id common.ChangeFeedID,
+) ([]*config.ChangefeedConfigRevision, error) {
+	rows, err := b.db.QueryContext(ctx,
+		"SELECT content FROM "+sqlChangefeedConfigHistoryTable+
+			" WHERE cluster_id = ? AND keyspace = ? AND name = ? ORDER BY revision",
+		b.clusterID, id.Keyspace(), id.Name())
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	defer rows.Close()
+
+	var history []*config.ChangefeedConfigRevision
+	for rows.Next() {
+		var value string
+		if err = rows.Scan(&value); err != nil {
+			return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+		revision := &config.ChangefeedConfigRevision{}
+		if err = revision.Unmarshal([]byte(value)); err != nil {
+			return nil, errors.Trace(err)
+		}
+		history = append(history, revision)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return history, nil
+}
+
+// BumpChangefeedEpoch atomically persists a strictly newer ownership epoch.
+// The row of the changefeed is locked while the epoch is advanced, so concurrent
+// bumps from different coordinators are serialized by the meta database.
+func (b *SQLBackend) BumpChangefeedEpoch(
+	ctx context.Context,
+	id common.ChangeFeedID,
+	candidateEpoch uint64,
+	options EpochBumpOptions,
+) (*config.ChangeFeedInfo, error) {
+	var info *config.ChangeFeedInfo
+	err := b.withTxn(ctx, func(tx *sql.Tx) error {
+		var (
+			status *config.ChangeFeedStatus
+			err    error
+		)
+		info, status, err = b.lockChangefeed(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		// Keep compatibility defaults when the bumped info replaces the
+		// coordinator's in-memory copy after an upgrade.
+		info.VerifyAndComplete()
+		epoch, err := common.AdvanceChangefeedEpoch(candidateEpoch, info.Epoch)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		info.Epoch = epoch
+		if options.State != nil {
+			info.State = *options.State
+		}
+		if options.UpdateError {
+			info.Error = options.Error
+		}
+		if options.UpdateStatus {
+			status.CheckpointTs = options.CheckpointTs
+			status.Progress = options.Progress
+		}
+		return b.saveChangefeed(ctx, tx, id, info, status)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// ResumeChangefeed persists the resumed state with a new owner epoch.
+func (b *SQLBackend) ResumeChangefeed(
+	ctx context.Context,
+	id common.ChangeFeedID,
+	candidateEpoch uint64,
+	checkpointTs uint64,
+) (*config.ChangeFeedInfo, error) {
+	normalState := config.StateNormal
+	return b.BumpChangefeedEpoch(ctx, id, candidateEpoch, EpochBumpOptions{
+		CheckpointTs: checkpointTs,
+		Progress:     config.ProgressNone,
+		UpdateStatus: true,
+		State:        &normalState,
+		UpdateError:  true,
+	})
+}
+
+func (b *SQLBackend) PauseChangefeed(ctx context.Context, id common.ChangeFeedID) error {
+	return b.withTxn(ctx, func(tx *sql.Tx) error {
+		info, status, err := b.lockChangefeed(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		info.State = config.StateStopped
+		status.Progress = config.ProgressStopping
+		return b.saveChangefeed(ctx, tx, id, info, status)
+	})
+}
+
+func (b *SQLBackend) DeleteChangefeed(ctx context.Context, id common.ChangeFeedID) error {
+	return b.withTxn(ctx, func(tx *sql.Tx) error {
+		for _, table := range []string{sqlChangefeedTable, sqlChangefeedConfigHistoryTable} {
+			_, err := tx.ExecContext(ctx,
+				"DELETE FROM "+table+" WHERE cluster_id = ? AND keyspace = ? AND name = ?",
+				b.clusterID, id.Keyspace(), id.Name())
+			if err != nil {
+				return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (b *SQLBackend) SetChangefeedProgress(ctx context.Context, id common.ChangeFeedID, progress config.Progress) error {
+	return b.withTxn(ctx, func(tx *sql.Tx) error {
+		status, err := b.lockChangefeedStatus(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if status.Progress == progress {
+			return nil
+		}
+		status.Progress = progress
+		statusValue, err := status.Marshal()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		_, err = tx.ExecContext(ctx,
+			"UPDATE "+sqlChangefeedTable+" SET status = ? WHERE cluster_id = ? AND keyspace = ? AND name = ?",
+			statusValue, b.clusterID, id.Keyspace(), id.Name())
+		if err != nil {
+			return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+		return nil
+	})
+}
+
+func (b *SQLBackend) UpdateChangefeedCheckpointTs(ctx context.Context, cps map[common.ChangeFeedID]uint64) error {
+	ids := make([]common.ChangeFeedID, 0, sqlCheckpointBatchSize)
+	values := make([]string, 0, sqlCheckpointBatchSize)
+	flush := func() error {
+		err := b.withTxn(ctx, func(tx *sql.Tx) error {
+			for i, id := range ids {
+				_, err := tx.ExecContext(ctx,
+					"UPDATE "+sqlChangefeedTable+" SET status = ? WHERE cluster_id = ? AND keyspace = ? AND name = ?",
+					values[i], b.clusterID, id.Keyspace(), id.Name())
+				if err != nil {
+					return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+				}
+			}
+			return nil
+		})
+		ids, values = ids[:0], values[:0]
+		return err
+	}
+	for cfID, checkpointTs := range cps {
+		status := &config.ChangeFeedStatus{CheckpointTs: checkpointTs, Progress: config.ProgressNone}
+		statusValue, err := status.Marshal()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		ids = append(ids, cfID)
+		values = append(values, statusValue)
+		if len(ids) >= sqlCheckpointBatchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(ids) > 0 {
+		return flush()
+	}
+	return nil
+}
+
+// lockChangefeed reads the info and the status of the changefeed, and locks the row
+// until the transaction is finished.
+func (b *SQLBackend) lockChangefeed(
+	ctx context.Context, tx *sql.Tx, id common.ChangeFeedID,
+) (*config.ChangeFeedInfo, *config.ChangeFeedStatus, error) {
+	row := tx.QueryRowContext(ctx,
+		"SELECT info, status FROM "+sqlChangefeedTable+" WHERE cluster_id = ? AND keyspace = ? AND name = ? FOR UPDATE",
+		b.clusterID, id.Keyspace(), id.Name())
+	var infoValue, statusValue string
+	if err := row.Scan(&infoValue, &statusValue); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs(id.Name())
+		}
+		return nil, nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	info := &config.ChangeFeedInfo{}
+	if err := info.Unmarshal([]byte(infoValue)); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if info.ChangefeedID.Name() == "" {
+		info.ChangefeedID = id
+	}
+	status := &config.ChangeFeedStatus{}
+	if err := status.Unmarshal([]byte(statusValue)); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return info, status, nil
+}
+
+func (b *SQLBackend) lockChangefeedStatus(
+	ctx context.Context, tx *sql.Tx, id common.ChangeFeedID,
+) (*config.ChangeFeedStatus, error) {
+	row := tx.QueryRowContext(ctx,
+		"SELECT status FROM "+sqlChangefeedTable+" WHERE cluster_id = ? AND keyspace = ? AND name = ? FOR UPDATE",
+		b.clusterID, id.Keyspace(), id.Name())
+	var statusValue string
+	if err := row.Scan(&statusValue); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, cerror.ErrChangeFeedNotExists.GenWithStackByArgs(id.Name())
+		}
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	status := &config.ChangeFeedStatus{}
+	if err := status.Unmarshal([]byte(statusValue)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return status, nil
+}
+
+func (b *SQLBackend) saveChangefeed(
+	ctx context.Context, tx *sql.Tx, id common.ChangeFeedID,
+	info *config.ChangeFeedInfo, status *config.ChangeFeedStatus,
+) error {
+	infoValue, err := info.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	statusValue, err := status.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = tx.ExecContext(ctx,
+		"UPDATE "+sqlChangefeedTable+" SET info = ?, status = ?, epoch = ? WHERE cluster_id = ? AND keyspace = ? AND name = ?",
+		infoValue, statusValue, info.Epoch, b.clusterID, id.Keyspace(), id.Name())
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return nil
+}
+
+// withTxn runs fn in a transaction, the transaction is rolled back if fn fails.
+func (b *SQLBackend) withTxn(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+	}
+	if err = fn(tx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			log.Warn("failed to rollback the meta transaction", zap.Error(rbErr))
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changefeed
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (b *SQLBackend) GetAllChangefeedTemplates(ctx context.Context) ([]*config.ChangefeedTemplate, error) {
+	rows, err := b.db.QueryContext(ctx,
+		"SELECT name, template FROM "+sqlChangefeedTemplateTable+" WHERE cluster_id = ? ORDER BY name", b.clusterID)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	defer rows.Close()
+
+	var templates []*config.ChangefeedTemplate
+	for rows.Next() {
+		var name, value string
+		if err = rows.Scan(&name, &value); err != nil {
+			return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+		tpl := &config.ChangefeedTemplate{}
+		if err = tpl.Unmarshal([]byte(value)); err != nil {
+			log.Warn("failed to unmarshal changefeed template, ignore",
+				zap.String("template", name), zap.Error(err))
+			continue
+		}
+		templates = append(templates, tpl)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return templates, nil
+}
+
+func (b *SQLBackend) GetChangefeedTemplate(ctx context.Context, name string) (*config.ChangefeedTemplate, error) {
+	var value string
+	err := b.db.QueryRowContext(ctx,
+		"SELECT template FROM "+sqlChangefeedTemplateTable+" WHERE cluster_id = ? AND name = ?",
+		b.clusterID, name).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, cerror.ErrChangefeedTemplateNotExists.GenWithStackByArgs(name)
+		}
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	tpl := &config.ChangefeedTemplate{}
+	if err = tpl.Unmarshal([]byte(value)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return tpl, nil
+}
+
+func (b *SQLBackend) CreateChangefeedTemplate(ctx context.Context, tpl *config.ChangefeedTemplate) error {
+	value, err := tpl.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	affected, err := b.execTemplate(ctx,
+		"INSERT IGNORE INTO "+sqlChangefeedTemplateTable+" (cluster_id, name, template) VALUES (?, ?, ?)",
+		b.clusterID, tpl.Name, value)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return cerror.ErrChangefeedTemplateAlreadyExists.GenWithStackByArgs(tpl.Name)
+	}
+	return nil
+}
+
+func (b *SQLBackend) UpdateChangefeedTemplate(ctx context.Context, tpl *config.ChangefeedTemplate) error {
+	value, err := tpl.Marshal()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// The update time is always changed, so the affected rows is 0 only if the
+	// template does not exist.
+	affected, err := b.execTemplate(ctx,
+		"UPDATE "+sqlChangefeedTemplateTable+" SET template = ? WHERE cluster_id = ? AND name = ?",
+		value, b.clusterID, tpl.Name)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return cerror.ErrChangefeedTemplateNotExists.GenWithStackByArgs(tpl.Name)
+	}
+	return nil
+}
+
+func (b *SQLBackend) DeleteChangefeedTemplate(ctx context.Context, name string) error {
+	affected, err := b.execTemplate(ctx,
+		"DELETE FROM "+sqlChangefeedTemplateTable+" WHERE cluster_id = ? AND name = ?",
+		b.clusterID, name)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return cerror.ErrChangefeedTemplateNotExists.GenWithStackByArgs(name)
+	}
+	return nil
+}
+
+func (b *SQLBackend) execTemplate(ctx context.Context, query string, args ...any) (int64, error) {
+	result, err := b.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return affected, nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changefeed
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newSQLBackendForTest(t *testing.T) (*SQLBackend, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS ticdc_changefeed")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS ticdc_changefeed_template")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS ticdc_changefeed_config_history")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	backend, err := NewSQLBackend(context.Background(), db, "test-cluster-id")
+	require.NoError(t, err)
+	return backend, mock
+}
+
+func marshalInfoAndStatusForTest(
+	t *testing.T, info *config.ChangeFeedInfo, status *config.ChangeFeedStatus,
+) (string, string) {
+	infoValue, err := info.Marshal()
+	require.NoError(t, err)
+	statusValue, err := status.Marshal()
+	require.NoError(t, err)
+	return infoValue, statusValue
+}
+
+func marshalStatusForTest(t *testing.T, checkpointTs uint64, progress config.Progress) string {
+	status := &config.ChangeFeedStatus{CheckpointTs: checkpointTs, Progress: progress}
+	value, err := status.Marshal()
+	require.NoError(t, err)
+	return value
+}
+
+func TestSQLBackendCreateChangefeed(t *testing.T) {
+	backend, mock := newSQLBackendForTest(t)
+	id := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	info := &config.ChangeFeedInfo{ChangefeedID: id, StartTs: 100, SinkURI: "blackhole://"}
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO ticdc_changefeed")).
+		WithArgs("test-cluster-id", id.Keyspace(), id.Name(), sqlmock.AnyArg(), marshalStatusForTest(t, 100, config.ProgressNone), uint64(0)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, backend.CreateChangefeed(context.Background(), info))
+
+	// The changefeed already exists.
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO ticdc_changefeed")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err := backend.CreateChangefeed(context.Background(), info)
+	require.True(t, cerror.ErrMetaOpFailed.Equal(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLBackendGetAllChangefeeds(t *testing.T) {
+	backend, mock := newSQLBackendForTest(t)
+	id := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	info := &config.ChangeFeedInfo{ChangefeedID: id, StartTs: 100, SinkURI: "blackhole://"}
+	infoValue, statusValue := marshalInfoAndStatusForTest(t, info,
+		&config.ChangeFeedStatus{CheckpointTs: 200, Progress: config.ProgressStopping})
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT keyspace, name, info, status FROM ticdc_changefeed WHERE cluster_id = ?")).
+		WithArgs("test-cluster-id").
+		WillReturnRows(sqlmock.NewRows([]string{"keyspace", "name", "info", "status"}).
+			AddRow(id.Keyspace(), id.Name(), infoValue, statusValue).
+			AddRow(id.Keyspace(), "invalid", "invalid json", statusValue).
+			AddRow(id.Keyspace(), "invalid-status", `{"start-ts":50}`, "invalid json"))
+	cfs, err := backend.GetAllChangefeeds(context.Background())
+	require.NoError(t, err)
+	require.Len(t, cfs, 2)
+	require.Equal(t, uint64(200), cfs[id].Status.CheckpointTs)
+	require.Equal(t, config.ProgressStopping, cfs[id].Status.Progress)
+
+	// The checkpoint of the changefeed with the broken status starts from the start ts.
+	for cfID, cf := range cfs {
+		if cfID.Name() == "invalid-status" {
+			require.Equal(t, uint64(50), cf.Status.CheckpointTs)
+		}
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLBackendGetChangefeedInfoNotExists(t *testing.T) {
+	backend, mock := newSQLBackendForTest(t)
+	id := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT info FROM ticdc_changefeed")).
+		WithArgs("test-cluster-id", id.Keyspace(), id.Name()).
+		WillReturnRows(sqlmock.NewRows([]string{"info"}))
+	_, err := backend.GetChangefeedInfo(context.Background(), id)
+	require.True(t, cerror.ErrChangeFeedNotExists.Equal(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLBackendBumpChangefeedEpoch(t *testing.T) {
+	backend, mock := newSQLBackendForTest(t)
+	id := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	info := &config.ChangeFeedInfo{ChangefeedID: id, StartTs: 100, SinkURI: "blackhole://", Epoch: 10}
+	infoValue, statusValue := marshalInfoAndStatusForTest(t, info,
+		&config.ChangeFeedStatus{CheckpointTs: 200})
+
+	// The candidate epoch is older than the persisted one, so the epoch is advanced by one.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT info, status FROM ticdc_changefeed WHERE cluster_id = ? AND keyspace = ? AND name = ? FOR UPDATE")).
+		WithArgs("test-cluster-id", id.Keyspace(), id.Name()).
+		WillReturnRows(sqlmock.NewRows([]string{"info", "status"}).AddRow(infoValue, statusValue))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ticdc_changefeed SET info = ?, status = ?, epoch = ?")).
+		WithArgs(sqlmock.AnyArg(), marshalStatusForTest(t, 300, 0), uint64(11), "test-cluster-id", id.Keyspace(), id.Name()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	stopped := config.StateStopped
+	bumped, err := backend.BumpChangefeedEpoch(context.Background(), id, 5, EpochBumpOptions{
+		CheckpointTs: 300,
+		UpdateStatus: true,
+		State:        &stopped,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), bumped.Epoch)
+	require.Equal(t, config.StateStopped, bumped.State)
+
+	// The transaction is rolled back if the changefeed does not exist.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT info, status FROM ticdc_changefeed")).
+		WillReturnRows(sqlmock.NewRows([]string{"info", "status"}))
+	mock.ExpectRollback()
+	_, err = backend.BumpChangefeedEpoch(context.Background(), id, 20, EpochBumpOptions{})
+	require.True(t, cerror.ErrChangeFeedNotExists.Equal(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLBackendSetChangefeedProgress(t *testing.T) {
+	backend, mock := newSQLBackendForTest(t)
+	id := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	_, statusValue := marshalInfoAndStatusForTest(t, &config.ChangeFeedInfo{},
+		&config.ChangeFeedStatus{CheckpointTs: 200, Progress: config.ProgressStopping})
+
+	// The progress is already persisted.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT status FROM ticdc_changefeed")).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(statusValue))
+	mock.ExpectCommit()
+	require.NoError(t, backend.SetChangefeedProgress(context.Background(), id, config.ProgressStopping))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT status FROM ticdc_changefeed")).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(statusValue))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ticdc_changefeed SET status = ?")).
+		WithArgs(marshalStatusForTest(t, 200, config.ProgressRemoving), "test-cluster-id", id.Keyspace(), id.Name()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, backend.SetChangefeedProgress(context.Background(), id, config.ProgressRemoving))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLBackendChangefeedTemplate(t *testing.T) {
+	backend, mock := newSQLBackendForTest(t)
+	tpl := &config.ChangefeedTemplate{Name: "tpl", SinkURI: "blackhole://", Config: config.GetDefaultReplicaConfig()}
+	value, err := tpl.Marshal()
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO ticdc_changefeed_template")).
+		WithArgs("test-cluster-id", "tpl", value).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	require.NoError(t, backend.CreateChangefeedTemplate(context.Background(), tpl))
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO ticdc_changefeed_template")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err = backend.CreateChangefeedTemplate(context.Background(), tpl)
+	require.True(t, cerror.ErrChangefeedTemplateAlreadyExists.Equal(err))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT template FROM ticdc_changefeed_template")).
+		WithArgs("test-cluster-id", "tpl").
+		WillReturnRows(sqlmock.NewRows([]string{"template"}).AddRow(value))
+	got, err := backend.GetChangefeedTemplate(context.Background(), "tpl")
+	require.NoError(t, err)
+	require.Equal(t, "blackhole://", got.SinkURI)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT name, template FROM ticdc_changefeed_template")).
+		WithArgs("test-cluster-id").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "template"}).
+			AddRow("tpl", value).
+			AddRow("broken", "invalid json"))
+	templates, err := backend.GetAllChangefeedTemplates(context.Background())
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ticdc_changefeed_template SET template = ?")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	err = backend.UpdateChangefeedTemplate(context.Background(), tpl)
+	require.True(t, cerror.ErrChangefeedTemplateNotExists.Equal(err))
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM ticdc_changefeed_template")).
+		WithArgs("test-cluster-id", "tpl").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, backend.DeleteChangefeedTemplate(context.Background(), "tpl"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLBackendChangefeedConfigHistory(t *testing.T) {
+	backend, mock := newSQLBackendForTest(t)
+	id := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	info := &config.ChangeFeedInfo{ChangefeedID: id, StartTs: 100, SinkURI: "blackhole://"}
+	revision := config.NewChangefeedConfigRevision(info, "alice")
+	revision.Revision = 2
+	revisionValue, err := revision.Marshal()
+	require.NoError(t, err)
+
+	// The revision and the info are saved in the same transaction.
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO ticdc_changefeed_config_history")).
+		WithArgs("test-cluster-id", id.Keyspace(), id.Name(), uint64(2), revisionValue).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ticdc_changefeed SET info = ?, status = ?, epoch = ?")).
+		WithArgs(sqlmock.AnyArg(), marshalStatusForTest(t, 200, config.ProgressNone), uint64(0),
+			"test-cluster-id", id.Keyspace(), id.Name()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, backend.UpdateChangefeed(context.Background(), info, 200, config.ProgressNone, revision))
+
+	// The revision is recorded by another update.
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO ticdc_changefeed_config_history")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+	err = backend.UpdateChangefeed(context.Background(), info, 200, config.ProgressNone, revision)
+	require.True(t, cerror.ErrMetaOpFailed.Equal(err))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT content FROM ticdc_changefeed_config_history")).
+		WithArgs("test-cluster-id", id.Keyspace(), id.Name()).
+		WillReturnRows(sqlmock.NewRows([]string{"content"}).AddRow(revisionValue))
+	history, err := backend.GetChangefeedConfigHistory(context.Background(), id)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, uint64(2), history[0].Revision)
+	require.Equal(t, "alice", history[0].Author)
+
+	// The history is removed with the changefeed.
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM ticdc_changefeed WHERE")).
+		WithArgs("test-cluster-id", id.Keyspace(), id.Name()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM ticdc_changefeed_config_history WHERE")).
+		WithArgs("test-cluster-id", id.Keyspace(), id.Name()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, backend.DeleteChangefeed(context.Background(), id))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
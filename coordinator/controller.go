@@ -1050,7 +1050,25 @@ func (c *Controller) ResumeChangefeed(
 	if info == nil {
 		return errors.New("resumed changefeed info is nil")
 	}
+	if info.StopPolicy != nil {
+		// the stop policy takes effect from now on, and the passed deadline and
+		// target ts are cleared, otherwise the changefeed is stopped again right
+		// after the resume.
+		policy := info.StopPolicy.Rearm(time.Now(), checkpointTs)
+		info, err = info.Clone()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		info.StopPolicy = policy
+		if err = c.backend.UpdateChangefeed(ctx, info, checkpointTs, config.ProgressNone, nil); err != nil {
+			return errors.Trace(err)
+		}
+		log.Info("rearm the stop policy on resume",
+			zap.Stringer("changefeedID", id),
+			zap.Any("stopPolicy", policy))
+	}
 	cf.SetInfo(info)
+	cf.ResetStopReason()
 
 	status := cf.GetStatusForResume()
 	status.CheckpointTs = checkpointTs
@@ -1065,7 +1083,12 @@ func (c *Controller) ResumeChangefeed(
 	return nil
 }
 
-func (c *Controller) UpdateChangefeed(ctx context.Context, change *config.ChangeFeedInfo) error {
+// UpdateChangefeed persists the updated changefeed info. If revision is not nil,
+// it's recorded as the next config revision of the changefeed in the same
+// transaction, the revision number, the config snapshot and the diff are filled here.
+func (c *Controller) UpdateChangefeed(
+	ctx context.Context, change *config.ChangeFeedInfo, revision *config.ChangefeedConfigRevision,
+) error {
 	c.apiLock.Lock()
 	defer c.apiLock.Unlock()
 
@@ -1078,13 +1101,56 @@ func (c *Controller) UpdateChangefeed(ctx context.Context, change *config.Change
 	if state == config.StateFailed || state == config.StateFinished {
 		progress = config.ProgressStopping
 	}
-	if err := c.backend.UpdateChangefeed(ctx, change, cf.GetStatus().CheckpointTs, progress); err != nil {
+	checkpointTs := cf.GetStatus().CheckpointTs
+	if revision != nil {
+		if err := c.prepareConfigRevision(ctx, cf.GetInfo(), change, revision, checkpointTs, progress); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if err := c.backend.UpdateChangefeed(ctx, change, checkpointTs, progress, revision); err != nil {
 		return errors.Trace(err)
 	}
 	c.changefeedDB.ReplaceStoppedChangefeed(change)
 	return nil
 }
 
+// prepareConfigRevision fills the revision for the update from old to change.
+// The config history of a changefeed starts at its first update, the config before
+// the update is recorded as the initial revision, which has no author.
+func (c *Controller) prepareConfigRevision(
+	ctx context.Context,
+	old, change *config.ChangeFeedInfo,
+	revision *config.ChangefeedConfigRevision,
+	checkpointTs uint64,
+	progress config.Progress,
+) error {
+	history, err := c.backend.GetChangefeedConfigHistory(ctx, change.ChangefeedID)
+	if err != nil {
+		return err
+	}
+	var prev *config.ChangefeedConfigRevision
+	if len(history) == 0 {
+		prev = config.NewChangefeedConfigRevision(old, "")
+		prev.Revision = 1
+		prev.Time = old.CreateTime
+		// The info is not changed, it only records the initial revision.
+		if err = c.backend.UpdateChangefeed(ctx, old, checkpointTs, progress, prev); err != nil {
+			return err
+		}
+	} else {
+		prev = history[len(history)-1]
+	}
+
+	next := config.NewChangefeedConfigRevision(change, revision.Author)
+	next.Revision = prev.Revision + 1
+	next.RollbackTo = revision.RollbackTo
+	if err = next.ComputeDiff(prev); err != nil {
+		return err
+	}
+	*revision = *next
+	return nil
+}
+
 func (c *Controller) ListChangefeeds(_ context.Context, keyspace string) ([]*config.ChangeFeedInfo, []*config.ChangeFeedStatus, error) {
 	c.apiLock.RLock()
 	defer c.apiLock.RUnlock()
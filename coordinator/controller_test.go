@@ -766,6 +766,56 @@ func TestResumeChangefeedUsesBackendReturnedInfo(t *testing.T) {
 	require.Equal(t, config.StateNormal, changefeedDB.GetByID(cfID).GetInfo().State)
 }
 
+func TestResumeChangefeedClearsPassedStopPolicy(t *testing.T) {
+	// Scenario: a changefeed paused by the deadline of its stop policy is resumed.
+	// Steps: resume it and verify the passed deadline is cleared and the policy is
+	// rearmed and persisted, so the stop policy doesn't pause the changefeed again,
+	// while the daily time is kept.
+	ctrl := gomock.NewController(t)
+	backend := mock_changefeed.NewMockBackend(ctrl)
+	changefeedDB := changefeed.NewChangefeedDB(1216)
+	controller := &Controller{
+		backend:      backend,
+		changefeedDB: changefeedDB,
+	}
+	cfID := common.NewChangeFeedIDWithName("test-stop-policy", common.DefaultKeyspaceName)
+	deadline := time.Now().Add(-time.Minute)
+	armedAt := time.Now().Add(-48 * time.Hour)
+	cf := changefeed.NewChangefeed(cfID, &config.ChangeFeedInfo{
+		ChangefeedID: cfID,
+		Config:       config.GetDefaultReplicaConfig(),
+		State:        config.StateStopped,
+		SinkURI:      "mysql://127.0.0.1:3306",
+		StopPolicy: &config.StopPolicy{
+			Deadline:  &deadline,
+			DailyTime: "02:00",
+			Action:    config.StopPolicyActionPause,
+			ArmedAt:   &armedAt,
+		},
+	}, 100, true)
+	changefeedDB.AddStoppedChangefeed(cf)
+
+	expectResumeChangefeed(t, backend, cfID, cf, 100)
+	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), uint64(100), config.ProgressNone, nil).
+		DoAndReturn(func(_ context.Context, info *config.ChangeFeedInfo, _ uint64, _ config.Progress, _ *config.ChangefeedConfigRevision) error {
+			require.Equal(t, config.StateNormal, info.State)
+			require.Nil(t, info.StopPolicy.Deadline)
+			require.Equal(t, "02:00", info.StopPolicy.DailyTime)
+			require.True(t, info.StopPolicy.ArmedAt.After(armedAt))
+			return nil
+		}).Times(1)
+	require.NoError(t, controller.ResumeChangefeed(context.Background(), cfID, 100, false))
+
+	info := changefeedDB.GetByID(cfID).GetInfo()
+	require.Equal(t, config.StateNormal, info.State)
+	require.Nil(t, info.StopPolicy.Deadline)
+	require.Equal(t, "02:00", info.StopPolicy.DailyTime)
+	updated, state, _ := cf.UpdateStatus(&heartbeatpb.MaintainerStatus{CheckpointTs: 200})
+	require.False(t, updated)
+	require.Equal(t, config.StateNormal, state)
+	require.Empty(t, cf.GetStopReason())
+}
+
 func expectResumeChangefeed(
 	t *testing.T,
 	backend *mock_changefeed.MockBackend,
@@ -862,18 +912,72 @@ func TestUpdateChangefeed(t *testing.T) {
 	// no changefeed
 	require.NotNil(t, controller.UpdateChangefeed(context.Background(), &config.ChangeFeedInfo{
 		ChangefeedID: common.NewChangeFeedIDWithName("test1", common.DefaultKeyspaceName),
-	}))
+	}, nil))
 
-	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("failed")).Times(1)
-	require.NotNil(t, controller.UpdateChangefeed(context.Background(), newConfig))
+	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Nil()).Return(errors.New("failed")).Times(1)
+	require.NotNil(t, controller.UpdateChangefeed(context.Background(), newConfig, nil))
 	require.Equal(t, false, changefeedDB.GetByID(cfID).NeedCheckpointTsMessage())
 
-	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	require.Nil(t, controller.UpdateChangefeed(context.Background(), newConfig))
+	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Nil()).Return(nil).Times(1)
+	require.Nil(t, controller.UpdateChangefeed(context.Background(), newConfig, nil))
 	require.Equal(t, true, changefeedDB.GetByID(cfID).NeedCheckpointTsMessage())
 	require.Equal(t, 1, changefeedDB.GetStoppedSize())
 }
 
+func TestUpdateChangefeedRecordsConfigRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	backend := mock_changefeed.NewMockBackend(ctrl)
+	changefeedDB := changefeed.NewChangefeedDB(1216)
+	controller := &Controller{
+		backend:      backend,
+		changefeedDB: changefeedDB,
+	}
+	cfID := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	oldInfo := &config.ChangeFeedInfo{
+		ChangefeedID: cfID,
+		Config:       config.GetDefaultReplicaConfig(),
+		State:        config.StateStopped,
+		SinkURI:      "mysql://127.0.0.1:3306",
+	}
+	changefeedDB.AddStoppedChangefeed(changefeed.NewChangefeed(cfID, oldInfo, 1, true))
+	newInfo := &config.ChangeFeedInfo{
+		ChangefeedID: cfID,
+		Config:       config.GetDefaultReplicaConfig(),
+		State:        config.StateStopped,
+		SinkURI:      "kafka://127.0.0.1:9092",
+	}
+
+	// The config before the first update is recorded as the initial revision.
+	var recorded []*config.ChangefeedConfigRevision
+	backend.EXPECT().GetChangefeedConfigHistory(gomock.Any(), cfID).Return(nil, nil).Times(1)
+	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), uint64(1), config.ProgressNone, gomock.Not(gomock.Nil())).
+		DoAndReturn(func(_ context.Context, _ *config.ChangeFeedInfo, _ uint64, _ config.Progress, rev *config.ChangefeedConfigRevision) error {
+			recorded = append(recorded, rev)
+			return nil
+		}).Times(2)
+	revision := &config.ChangefeedConfigRevision{Author: "alice"}
+	require.NoError(t, controller.UpdateChangefeed(context.Background(), newInfo, revision))
+	require.Len(t, recorded, 2)
+	require.Equal(t, uint64(1), recorded[0].Revision)
+	require.Equal(t, "mysql://127.0.0.1:3306", recorded[0].SinkURI)
+	require.Equal(t, uint64(2), revision.Revision)
+	require.Equal(t, "alice", revision.Author)
+	require.Equal(t, []config.ChangefeedConfigChange{{
+		Path: "sink-uri",
+		From: "mysql://127.0.0.1:3306",
+		To:   "kafka://127.0.0.1:9092",
+	}}, revision.Diff)
+
+	// The next revision follows the latest one in the history.
+	backend.EXPECT().GetChangefeedConfigHistory(gomock.Any(), cfID).Return(recorded, nil).Times(1)
+	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), uint64(1), config.ProgressNone, gomock.Any()).Return(nil).Times(1)
+	revision = &config.ChangefeedConfigRevision{Author: "bob", RollbackTo: 1}
+	require.NoError(t, controller.UpdateChangefeed(context.Background(), oldInfo, revision))
+	require.Equal(t, uint64(3), revision.Revision)
+	require.Equal(t, uint64(1), revision.RollbackTo)
+	require.Len(t, revision.Diff, 1)
+}
+
 func TestGetChangefeed(t *testing.T) {
 	// Scenario: API-facing GetChangefeed must not expose coordinator-owned mutable info.
 	// Steps: fetch a changefeed, mutate the returned copy, and verify the in-memory
@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/coordinator/changefeed"
 	"github.com/pingcap/ticdc/coordinator/gccleaner"
+	"github.com/pingcap/ticdc/coordinator/notifier"
 	"github.com/pingcap/ticdc/pkg/common"
 	appcontext "github.com/pingcap/ticdc/pkg/common/context"
 	"github.com/pingcap/ticdc/pkg/config"
@@ -85,6 +86,10 @@ type coordinator struct {
 	pdClient pd.Client
 	pdClock  pdutil.Clock
 
+	// notifier sends the changefeed lifecycle events to the configured webhook or kafka topic.
+	notifier    *notifier.Notifier
+	lagDetector *notifier.LagDetector
+
 	// eventCh is used to receive the event from message center, basically these messages
 	// are from maintainer.
 	eventCh *chann.DrainableChann[*Event]
@@ -123,6 +128,7 @@ func New(node *node.Info,
 		changefeedChangeCh: make(chan []*changefeedChange, 1024),
 		backend:            backend,
 	}
+	c.notifier, c.lagDetector = newLifecycleNotifier(config.GetGlobalServerConfig())
 	// handle messages from message center
 	mc.RegisterHandler(messaging.CoordinatorTopic, c.recvMessages)
 	c.controller = NewController(
@@ -188,6 +194,16 @@ func (c *coordinator) Run(ctx context.Context) error {
 		return c.controller.collectMetrics(ctx)
 	})
 
+	eg.Go(func() error {
+		return c.notifier.Run(ctx)
+	})
+	if c.notifier.Enabled() {
+		c.notifier.Notify(notifier.NewOwnerChangedEvent(string(c.nodeInfo.ID), c.nodeInfo.AdvertiseAddr))
+		eg.Go(func() error {
+			return c.runLifecycleCheck(ctx)
+		})
+	}
+
 	return eg.Wait()
 }
 
@@ -282,6 +298,7 @@ func (c *coordinator) handleStateChange(
 		}
 		c.controller.operatorController.StopChangefeedWithMaintainerEpoch(ctx, event.changefeedID, false, currentMaintainerEpoch)
 		c.controller.moveChangefeedToSchedulingQueue(event.changefeedID, false, false)
+		c.notifyStateChange(cf, currentInfo.State, event, cf.GetStatus().CheckpointTs)
 		return nil
 	}
 
@@ -291,21 +308,30 @@ func (c *coordinator) handleStateChange(
 	}
 	cfInfo.State = event.state
 	cfInfo.Error = event.err
+	// the stopped state is only reported when the stop policy fires with the pause action
+	stopping := event.state == config.StateFailed || event.state == config.StateFinished ||
+		event.state == config.StateStopped
 	progress := config.ProgressNone
-	if event.state == config.StateFailed || event.state == config.StateFinished {
+	if stopping {
 		progress = config.ProgressStopping
 	}
-	if err = c.backend.UpdateChangefeed(ctx, cfInfo, cf.GetStatus().CheckpointTs, progress); err != nil {
+	checkpointTs := cf.GetStatus().CheckpointTs
+	if err = c.backend.UpdateChangefeed(ctx, cfInfo, checkpointTs, progress, nil); err != nil {
 		log.Error("failed to update changefeed state",
 			zap.Error(err))
 		return errors.Trace(err)
 	}
 	cf.SetInfo(cfInfo)
+	c.notifyStateChange(cf, currentInfo.State, event, checkpointTs)
 
-	if event.state == config.StateFailed || event.state == config.StateFinished {
+	if stopping {
 		failpoint.Inject("BlockBeforeStopChangefeed", func() {})
 		c.controller.operatorController.StopChangefeed(ctx, event.changefeedID, false)
 	}
+	if reason := cf.GetStopReason(); reason != "" && cfInfo.StopPolicy != nil &&
+		event.state == cfInfo.StopPolicy.TargetState() {
+		c.onStopPolicyFired(cfInfo, reason, checkpointTs)
+	}
 	return nil
 }
 
@@ -386,10 +412,12 @@ func (c *coordinator) CreateChangefeed(ctx context.Context, info *config.ChangeF
 }
 
 func (c *coordinator) RemoveChangefeed(ctx context.Context, id common.ChangeFeedID) (uint64, error) {
+	previous := c.changefeedState(id)
 	checkpointTs, err := c.controller.RemoveChangefeed(ctx, id)
 	if err != nil {
 		return 0, err
 	}
+	c.notifyOperation(id, previous, config.StateRemoved, checkpointTs)
 	if c.controller.calculateGlobalGCSafepoint() != math.MaxUint64 {
 		return checkpointTs, nil
 	}
@@ -406,24 +434,42 @@ func (c *coordinator) RemoveChangefeed(ctx context.Context, id common.ChangeFeed
 }
 
 func (c *coordinator) PauseChangefeed(ctx context.Context, id common.ChangeFeedID) error {
-	return c.controller.PauseChangefeed(ctx, id)
+	previous := c.changefeedState(id)
+	if err := c.controller.PauseChangefeed(ctx, id); err != nil {
+		return err
+	}
+	if cf := c.controller.getChangefeed(id); cf != nil {
+		c.notifyOperation(id, previous, config.StateStopped, cf.GetStatus().CheckpointTs)
+	}
+	return nil
 }
 
 func (c *coordinator) ResumeChangefeed(ctx context.Context, id common.ChangeFeedID, newCheckpointTs uint64, overwriteCheckpointTs bool) error {
+	previous := c.changefeedState(id)
 	if err := c.controller.ResumeChangefeed(ctx, id, newCheckpointTs, overwriteCheckpointTs); err != nil {
 		return err
 	}
+	cf := c.controller.getChangefeed(id)
+	if cf == nil {
+		return nil
+	}
 	if overwriteCheckpointTs {
-		cf := c.controller.getChangefeed(id)
-		if cf != nil {
-			c.gcCleaner.Add(id, cf.GetKeyspaceID(), gc.EnsureGCServiceResuming)
-		}
+		c.gcCleaner.Add(id, cf.GetKeyspaceID(), gc.EnsureGCServiceResuming)
 	}
+	c.notifyOperation(id, previous, cf.GetInfo().State, cf.GetStatus().CheckpointTs)
 	return nil
 }
 
-func (c *coordinator) UpdateChangefeed(ctx context.Context, change *config.ChangeFeedInfo) error {
-	return c.controller.UpdateChangefeed(ctx, change)
+func (c *coordinator) UpdateChangefeed(
+	ctx context.Context, change *config.ChangeFeedInfo, revision *config.ChangefeedConfigRevision,
+) error {
+	return c.controller.UpdateChangefeed(ctx, change, revision)
+}
+
+func (c *coordinator) GetChangefeedConfigHistory(
+	ctx context.Context, id common.ChangeFeedID,
+) ([]*config.ChangefeedConfigRevision, error) {
+	return c.backend.GetChangefeedConfigHistory(ctx, id)
 }
 
 func (c *coordinator) ListChangefeeds(ctx context.Context, keyspace string) ([]*config.ChangeFeedInfo, []*config.ChangeFeedStatus, error) {
@@ -438,6 +484,26 @@ func (c *coordinator) GetPersistedChangefeedInfo(ctx context.Context, id common.
 	return c.controller.GetPersistedChangefeedInfo(ctx, id)
 }
 
+func (c *coordinator) ListChangefeedTemplates(ctx context.Context) ([]*config.ChangefeedTemplate, error) {
+	return c.backend.GetAllChangefeedTemplates(ctx)
+}
+
+func (c *coordinator) GetChangefeedTemplate(ctx context.Context, name string) (*config.ChangefeedTemplate, error) {
+	return c.backend.GetChangefeedTemplate(ctx, name)
+}
+
+func (c *coordinator) CreateChangefeedTemplate(ctx context.Context, template *config.ChangefeedTemplate) error {
+	return c.backend.CreateChangefeedTemplate(ctx, template)
+}
+
+func (c *coordinator) UpdateChangefeedTemplate(ctx context.Context, template *config.ChangefeedTemplate) error {
+	return c.backend.UpdateChangefeedTemplate(ctx, template)
+}
+
+func (c *coordinator) RemoveChangefeedTemplate(ctx context.Context, name string) error {
+	return c.backend.DeleteChangefeedTemplate(ctx, name)
+}
+
 func (c *coordinator) DrainNode(ctx context.Context, target node.ID) (int, error) {
 	return c.controller.DrainNode(ctx, target)
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/http/pprof"
 	"strconv"
 	"sync"
@@ -30,6 +31,7 @@ import (
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/coordinator/changefeed"
 	mock_changefeed "github.com/pingcap/ticdc/coordinator/changefeed/mock"
+	"github.com/pingcap/ticdc/coordinator/notifier"
 	"github.com/pingcap/ticdc/coordinator/operator"
 	"github.com/pingcap/ticdc/heartbeatpb"
 	"github.com/pingcap/ticdc/pkg/common"
@@ -484,7 +486,7 @@ func TestCoordinatorScheduling(t *testing.T) {
 	backend := mock_changefeed.NewMockBackend(ctrl)
 	cfs := make(map[common.ChangeFeedID]*changefeed.ChangefeedMetaWrapper)
 	backend.EXPECT().GetAllChangefeeds(gomock.Any()).Return(cfs, nil).AnyTimes()
-	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockBumpChangefeedEpoch(backend, cfs)
 	for i := 0; i < cfSize; i++ {
 		cfID := common.NewChangeFeedIDWithDisplayName(common.ChangeFeedDisplayName{
@@ -557,7 +559,7 @@ func TestScaleNode(t *testing.T) {
 		}
 	}
 	backend.EXPECT().GetAllChangefeeds(gomock.Any()).Return(cfs, nil).AnyTimes()
-	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	backend.EXPECT().UpdateChangefeed(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	mockBumpChangefeedEpoch(backend, cfs)
 
 	cr := New(info, &mockPdClient{}, backend, serviceID, 100, 10000, time.Millisecond*1)
@@ -1065,8 +1067,8 @@ func TestHandleStateChangePersistsRuntimeStateWhenStateChanges(t *testing.T) {
 	changefeedDB.AddAbsentChangefeed(cf)
 
 	backend.EXPECT().
-		UpdateChangefeed(gomock.Any(), gomock.Any(), uint64(1), config.ProgressNone).
-		DoAndReturn(func(_ context.Context, info *config.ChangeFeedInfo, _ uint64, _ config.Progress) error {
+		UpdateChangefeed(gomock.Any(), gomock.Any(), uint64(1), config.ProgressNone, nil).
+		DoAndReturn(func(_ context.Context, info *config.ChangeFeedInfo, _ uint64, _ config.Progress, _ *config.ChangefeedConfigRevision) error {
 			require.Equal(t, config.StateNormal, info.State)
 			require.Nil(t, info.Error)
 			return nil
@@ -1079,3 +1081,76 @@ func TestHandleStateChangePersistsRuntimeStateWhenStateChanges(t *testing.T) {
 	require.Equal(t, config.StateNormal, cf.GetInfo().State)
 	require.Nil(t, cf.GetInfo().Error)
 }
+
+func TestNotifyOperationStateChange(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []*notifier.Event
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := &notifier.Event{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(event))
+		mu.Lock()
+		received = append(received, event)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := config.NewDefaultNotifierConfig()
+	cfg.WebhookURL = server.URL
+	n, err := notifier.New("default", cfg)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = n.Run(ctx)
+	}()
+
+	changefeedDB := changefeed.NewChangefeedDB(1216)
+	co := &coordinator{
+		controller: &Controller{changefeedDB: changefeedDB},
+		notifier:   n,
+	}
+	cfID := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	changefeedDB.AddAbsentChangefeed(changefeed.NewChangefeed(cfID, &config.ChangeFeedInfo{
+		ChangefeedID: cfID,
+		Config:       config.GetDefaultReplicaConfig(),
+		State:        config.StateNormal,
+		SinkURI:      "mysql://127.0.0.1:3306",
+	}, 100, false))
+
+	require.Equal(t, config.StateNormal, co.changefeedState(cfID))
+	require.Equal(t, config.StateUnInitialized,
+		co.changefeedState(common.NewChangeFeedIDWithName("unknown", common.DefaultKeyspaceName)))
+
+	co.notifyOperation(cfID, config.StateNormal, config.StateStopped, 100)
+	// the unchanged state is not reported
+	co.notifyOperation(cfID, config.StateStopped, config.StateStopped, 100)
+	co.notifyOperation(cfID, config.StateStopped, config.StateNormal, 100)
+	co.notifyOperation(cfID, config.StateNormal, config.StateRemoved, 200)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 3
+	}, 10*time.Second, 50*time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []struct {
+		previous     config.FeedState
+		state        config.FeedState
+		checkpointTs uint64
+	}{
+		{config.StateNormal, config.StateStopped, 100},
+		{config.StateStopped, config.StateNormal, 100},
+		{config.StateNormal, config.StateRemoved, 200},
+	}
+	for i, e := range expected {
+		require.Equal(t, notifier.EventStateChanged, received[i].Type)
+		require.Equal(t, "test", received[i].ChangefeedID)
+		require.Equal(t, e.previous, received[i].PreviousState)
+		require.Equal(t, e.state, received[i].State)
+		require.Equal(t, e.checkpointTs, received[i].CheckpointTs)
+	}
+}
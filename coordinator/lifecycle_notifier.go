@@ -0,0 +1,123 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/coordinator/changefeed"
+	"github.com/pingcap/ticdc/coordinator/notifier"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+)
+
+// lifecycleCheckInterval is the interval to check the checkpoint lag of changefeeds
+// for the checkpoint lag and gc safepoint risk events.
+const lifecycleCheckInterval = 10 * time.Second
+
+// newLifecycleNotifier creates the changefeed lifecycle notifier by the server config,
+// the notifier is disabled if it cannot be created, the coordinator works without it.
+func newLifecycleNotifier(cfg *config.ServerConfig) (*notifier.Notifier, *notifier.LagDetector) {
+	notifierCfg := cfg.Notifier
+	if notifierCfg == nil {
+		notifierCfg = config.NewDefaultNotifierConfig()
+	}
+	n, err := notifier.New(cfg.ClusterID, notifierCfg)
+	if err != nil {
+		log.Warn("failed to create the changefeed lifecycle notifier, it is disabled", zap.Error(err))
+		n, _ = notifier.New(cfg.ClusterID, nil)
+	}
+	gcRiskThreshold := time.Duration(float64(cfg.GcTTL) * notifierCfg.GCSafepointRiskRatio * float64(time.Second))
+	return n, notifier.NewLagDetector(time.Duration(notifierCfg.CheckpointLagThreshold), gcRiskThreshold)
+}
+
+// notifyStateChange sends the state changed event of the changefeed, a failed
+// changefeed which has been retrying an error for too long is reported as error stuck.
+func (c *coordinator) notifyStateChange(
+	cf *changefeed.Changefeed, previous config.FeedState, event *changefeedChange, checkpointTs uint64,
+) {
+	if !c.notifier.Enabled() || previous == event.state {
+		return
+	}
+	tp := notifier.EventStateChanged
+	if event.state == config.StateFailed && cf.IsErrorStuck() {
+		tp = notifier.EventErrorStuck
+	}
+	e := notifier.NewChangefeedEvent(tp, cf.ID)
+	e.State = event.state
+	e.PreviousState = previous
+	e.CheckpointTs = checkpointTs
+	e.Error = event.err
+	c.notifier.Notify(e)
+}
+
+// notifyOperation sends the state changed event of the changefeed paused, resumed
+// or removed by the API, these changes are not reported by the maintainers.
+func (c *coordinator) notifyOperation(
+	id common.ChangeFeedID, previous config.FeedState, state config.FeedState, checkpointTs uint64,
+) {
+	if !c.notifier.Enabled() || previous == state {
+		return
+	}
+	e := notifier.NewChangefeedEvent(notifier.EventStateChanged, id)
+	e.State = state
+	e.PreviousState = previous
+	e.CheckpointTs = checkpointTs
+	c.notifier.Notify(e)
+}
+
+// changefeedState returns the current state of the changefeed, it is empty if
+// the changefeed is not found.
+func (c *coordinator) changefeedState(id common.ChangeFeedID) config.FeedState {
+	cf := c.controller.getChangefeed(id)
+	if cf == nil || cf.GetInfo() == nil {
+		return config.StateUnInitialized
+	}
+	return cf.GetInfo().State
+}
+
+// runLifecycleCheck checks the checkpoint lag of all changefeeds periodically.
+func (c *coordinator) runLifecycleCheck(ctx context.Context) error {
+	ticker := time.NewTicker(lifecycleCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case <-ticker.C:
+			c.checkChangefeedLag(c.pdClock.CurrentTime())
+		}
+	}
+}
+
+func (c *coordinator) checkChangefeedLag(now time.Time) {
+	alive := make(map[common.ChangeFeedID]struct{})
+	c.controller.changefeedDB.Foreach(func(cf *changefeed.Changefeed) {
+		info := cf.GetInfo()
+		if info == nil {
+			return
+		}
+		alive[cf.ID] = struct{}{}
+		checkpointTs := cf.GetLastSavedCheckPointTs()
+		lag := now.Sub(oracle.GetTimeFromTS(checkpointTs))
+		for _, event := range c.lagDetector.Observe(info, checkpointTs, lag) {
+			c.notifier.Notify(event)
+		}
+	})
+	c.lagDetector.Retain(alive)
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+)
+
+// EventType is the type of a changefeed lifecycle event.
+type EventType string
+
+const (
+	// EventStateChanged is sent when the state of a changefeed is changed by the coordinator,
+	// such as normal -> warning, warning -> normal and warning -> failed, or by the
+	// pause, resume and remove operations of the API.
+	EventStateChanged EventType = "state-changed"
+	// EventErrorStuck is sent when a changefeed is failed because it has been retrying
+	// an error for longer than the changefeed-error-stuck-duration.
+	EventErrorStuck EventType = "error-stuck"
+	// EventOwnerChanged is sent when a node becomes the coordinator.
+	EventOwnerChanged EventType = "owner-changed"
+	// EventCheckpointLag is sent when the checkpoint lag of a changefeed exceeds the
+	// checkpoint-lag-threshold, or falls back below it.
+	EventCheckpointLag EventType = "checkpoint-lag"
+	// EventGCSafepointRisk is sent when the checkpoint lag of a changefeed is close to
+	// the gc-ttl, the changefeed fails once the gc safepoint is pushed over its checkpoint.
+	EventGCSafepointRisk EventType = "gc-safepoint-risk"
+)
+
+// Event is a structured changefeed lifecycle event sent by the notifier.
+type Event struct {
+	Type      EventType `json:"type"`
+	Time      time.Time `json:"time"`
+	ClusterID string    `json:"cluster_id"`
+
+	Keyspace      string               `json:"keyspace,omitempty"`
+	ChangefeedID  string               `json:"changefeed_id,omitempty"`
+	State         config.FeedState     `json:"state,omitempty"`
+	PreviousState config.FeedState     `json:"previous_state,omitempty"`
+	CheckpointTs  uint64               `json:"checkpoint_ts,omitempty"`
+	Error         *config.RunningError `json:"error,omitempty"`
+
+	// CheckpointLagSeconds is set by the checkpoint lag and gc safepoint risk events.
+	CheckpointLagSeconds float64 `json:"checkpoint_lag_seconds,omitempty"`
+	// Recovered is true if the checkpoint lag falls back below the threshold.
+	Recovered bool `json:"recovered,omitempty"`
+
+	// Owner and OwnerAddr are set by the owner changed event.
+	Owner     string `json:"owner,omitempty"`
+	OwnerAddr string `json:"owner_addr,omitempty"`
+}
+
+// NewChangefeedEvent returns an event of the changefeed.
+func NewChangefeedEvent(tp EventType, id common.ChangeFeedID) *Event {
+	return &Event{
+		Type:         tp,
+		Time:         time.Now(),
+		Keyspace:     id.Keyspace(),
+		ChangefeedID: id.Name(),
+	}
+}
+
+// NewOwnerChangedEvent returns an owner changed event of the new coordinator.
+func NewOwnerChangedEvent(owner string, addr string) *Event {
+	return &Event{
+		Type:      EventOwnerChanged,
+		Time:      time.Now(),
+		Owner:     owner,
+		OwnerAddr: addr,
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/errors"
+	codecCommon "github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"github.com/pingcap/ticdc/pkg/sink/kafka"
+	"go.uber.org/zap"
+)
+
+// kafkaNotifierID is the changefeed id used by the kafka client of the notifier
+// in logs and metrics, it is not a real changefeed.
+const kafkaNotifierID = "lifecycle-notifier"
+
+// kafkaSink produces the lifecycle events to a kafka topic. All events are
+// produced to the partition 0 to keep their order, and the message key is the
+// changefeed, so a compacted topic keeps the latest event of each changefeed.
+type kafkaSink struct {
+	sinkURI *url.URL
+	topic   string
+	id      common.ChangeFeedID
+
+	// producer is created on the first delivery and recreated after a failure.
+	producer kafka.SyncProducer
+}
+
+func newKafkaSink(uri string) (*kafkaSink, error) {
+	sinkURI, err := url.Parse(uri)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrKafkaInvalidConfig, err)
+	}
+	topic := strings.Trim(sinkURI.Path, "/")
+	if topic == "" {
+		return nil, errors.ErrKafkaInvalidConfig.GenWithStack("the topic is required in the kafka uri")
+	}
+	return &kafkaSink{
+		sinkURI: sinkURI,
+		topic:   topic,
+		id:      common.NewChangeFeedIDWithName(kafkaNotifierID, common.DefaultKeyspaceName),
+	}, nil
+}
+
+func (s *kafkaSink) Send(ctx context.Context, event *Event, value []byte) error {
+	if s.producer == nil {
+		producer, err := s.newProducer(ctx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		s.producer = producer
+	}
+	key := []byte(event.Keyspace + "/" + event.ChangefeedID)
+	if err := s.producer.SendMessage(s.topic, 0, codecCommon.NewMsg(key, value)); err != nil {
+		log.Warn("failed to produce the lifecycle event to kafka, recreate the producer",
+			zap.String("topic", s.topic), zap.Error(err))
+		s.Close()
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+func (s *kafkaSink) newProducer(ctx context.Context) (kafka.SyncProducer, error) {
+	options := kafka.NewOptions()
+	if err := options.Apply(s.id, s.sinkURI, nil); err != nil {
+		return nil, errors.Trace(err)
+	}
+	options.Topic = s.topic
+	factory, err := kafka.NewSaramaFactory(ctx, options, s.id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return factory.SyncProducer(ctx)
+}
+
+func (s *kafkaSink) Close() {
+	if s.producer != nil {
+		s.producer.Close()
+		s.producer = nil
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+)
+
+// LagDetector detects the changefeeds whose checkpoint lag crosses the checkpoint
+// lag threshold or the gc safepoint risk threshold. The events are edge triggered,
+// an event is returned when the lag goes above a threshold and when it falls back.
+// It is not thread safe.
+type LagDetector struct {
+	lagThreshold    time.Duration
+	gcRiskThreshold time.Duration
+
+	states map[common.ChangeFeedID]*lagState
+}
+
+type lagState struct {
+	lagging bool
+	gcRisk  bool
+}
+
+// NewLagDetector creates a LagDetector, a zero threshold disables the related event.
+func NewLagDetector(lagThreshold, gcRiskThreshold time.Duration) *LagDetector {
+	return &LagDetector{
+		lagThreshold:    lagThreshold,
+		gcRiskThreshold: gcRiskThreshold,
+		states:          make(map[common.ChangeFeedID]*lagState),
+	}
+}
+
+// Observe checks the checkpoint lag of the changefeed and returns the events of
+// the crossed thresholds. The checkpoint lag event is only sent for the running
+// changefeeds, since a stopped changefeed lags by design, but the gc safepoint
+// risk event is sent for all changefeeds blocking the gc.
+func (d *LagDetector) Observe(
+	info *config.ChangeFeedInfo, checkpointTs uint64, lag time.Duration,
+) []*Event {
+	state, ok := d.states[info.ChangefeedID]
+	if !ok {
+		state = &lagState{}
+		d.states[info.ChangefeedID] = state
+	}
+
+	var events []*Event
+	newEvent := func(tp EventType, recovered bool) {
+		event := NewChangefeedEvent(tp, info.ChangefeedID)
+		event.State = info.State
+		event.CheckpointTs = checkpointTs
+		event.CheckpointLagSeconds = lag.Seconds()
+		event.Recovered = recovered
+		events = append(events, event)
+	}
+
+	running := info.State == config.StateNormal || info.State == config.StateWarning
+	lagging := running && d.lagThreshold > 0 && lag > d.lagThreshold
+	if lagging != state.lagging {
+		// a changefeed stopped while lagging is not reported as recovered
+		if lagging || running {
+			newEvent(EventCheckpointLag, !lagging)
+		}
+		state.lagging = lagging
+	}
+
+	gcRisk := info.NeedBlockGC() && d.gcRiskThreshold > 0 && lag > d.gcRiskThreshold
+	if gcRisk != state.gcRisk {
+		newEvent(EventGCSafepointRisk, !gcRisk)
+		state.gcRisk = gcRisk
+	}
+	return events
+}
+
+// Retain removes the states of the changefeeds not in alive.
+func (d *LagDetector) Retain(alive map[common.ChangeFeedID]struct{}) {
+	for id := range d.states {
+		if _, ok := alive[id]; !ok {
+			delete(d.states, id)
+		}
+	}
+}
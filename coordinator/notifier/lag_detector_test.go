@@ -0,0 +1,80 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLagDetector(t *testing.T) {
+	t.Parallel()
+
+	d := NewLagDetector(time.Minute, 10*time.Minute)
+	id := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	info := &config.ChangeFeedInfo{ChangefeedID: id, State: config.StateNormal}
+
+	require.Empty(t, d.Observe(info, 100, 30*time.Second))
+
+	events := d.Observe(info, 100, 2*time.Minute)
+	require.Len(t, events, 1)
+	require.Equal(t, EventCheckpointLag, events[0].Type)
+	require.False(t, events[0].Recovered)
+	require.Equal(t, float64(120), events[0].CheckpointLagSeconds)
+	require.Equal(t, uint64(100), events[0].CheckpointTs)
+	// edge triggered, no more event while lagging
+	require.Empty(t, d.Observe(info, 100, 3*time.Minute))
+
+	events = d.Observe(info, 100, 11*time.Minute)
+	require.Len(t, events, 1)
+	require.Equal(t, EventGCSafepointRisk, events[0].Type)
+	require.False(t, events[0].Recovered)
+
+	events = d.Observe(info, 200, 10*time.Second)
+	require.Len(t, events, 2)
+	require.Equal(t, EventCheckpointLag, events[0].Type)
+	require.True(t, events[0].Recovered)
+	require.Equal(t, EventGCSafepointRisk, events[1].Type)
+	require.True(t, events[1].Recovered)
+}
+
+func TestLagDetectorStoppedChangefeed(t *testing.T) {
+	t.Parallel()
+
+	d := NewLagDetector(time.Minute, 10*time.Minute)
+	id := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	info := &config.ChangeFeedInfo{ChangefeedID: id, State: config.StateNormal}
+	require.Len(t, d.Observe(info, 100, 2*time.Minute), 1)
+
+	// a paused changefeed is not reported as lagging or recovered, but it still
+	// holds the gc safepoint.
+	info.State = config.StateStopped
+	require.Empty(t, d.Observe(info, 100, 5*time.Minute))
+	events := d.Observe(info, 100, 11*time.Minute)
+	require.Len(t, events, 1)
+	require.Equal(t, EventGCSafepointRisk, events[0].Type)
+
+	// the state is dropped once the changefeed is removed
+	d.Retain(map[common.ChangeFeedID]struct{}{})
+	require.Empty(t, d.states)
+
+	// a zero threshold disables the event
+	d = NewLagDetector(0, 0)
+	info.State = config.StateNormal
+	require.Empty(t, d.Observe(info, 100, time.Hour))
+}
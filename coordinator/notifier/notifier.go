@@ -0,0 +1,162 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/retry"
+	"go.uber.org/zap"
+)
+
+const (
+	// eventBufferSize is the number of events waiting for delivery, the events
+	// are dropped once the buffer is full, so a slow destination never blocks
+	// the coordinator.
+	eventBufferSize = 1024
+
+	deliverBaseDelayInMs = 500
+	deliverMaxDelayInMs  = 10_000
+)
+
+// Sink is a destination of the lifecycle events.
+type Sink interface {
+	// Send delivers the json encoded event once, it's retried by the notifier on error.
+	Send(ctx context.Context, event *Event, value []byte) error
+	// Close releases the resources of the sink.
+	Close()
+}
+
+// Notifier sends the changefeed lifecycle events to the configured sinks
+// asynchronously. A notifier without sinks drops all events.
+type Notifier struct {
+	clusterID string
+	sinks     []Sink
+	maxTries  uint64
+	eventCh   chan *Event
+}
+
+// New creates a notifier by the config, the sinks connect to the destinations
+// lazily, so New never blocks.
+func New(clusterID string, cfg *config.NotifierConfig) (*Notifier, error) {
+	if !cfg.IsEnabled() {
+		return newNotifier(clusterID, cfg), nil
+	}
+	var sinks []Sink
+	if cfg.WebhookURL != "" {
+		sink, err := newWebhookSink(cfg.WebhookURL, time.Duration(cfg.Timeout))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.KafkaURI != "" {
+		sink, err := newKafkaSink(cfg.KafkaURI)
+		if err != nil {
+			for _, s := range sinks {
+				s.Close()
+			}
+			return nil, errors.Trace(err)
+		}
+		sinks = append(sinks, sink)
+	}
+	return newNotifier(clusterID, cfg, sinks...), nil
+}
+
+func newNotifier(clusterID string, cfg *config.NotifierConfig, sinks ...Sink) *Notifier {
+	maxRetries := 0
+	if cfg != nil {
+		maxRetries = cfg.MaxRetries
+	}
+	return &Notifier{
+		clusterID: clusterID,
+		sinks:     sinks,
+		maxTries:  uint64(maxRetries) + 1,
+		eventCh:   make(chan *Event, eventBufferSize),
+	}
+}
+
+// Enabled returns whether the notifier has any sink, a nil notifier is disabled.
+func (n *Notifier) Enabled() bool {
+	return n != nil && len(n.sinks) > 0
+}
+
+// Notify queues the event for delivery, it never blocks.
+func (n *Notifier) Notify(event *Event) {
+	if !n.Enabled() {
+		return
+	}
+	event.ClusterID = n.clusterID
+	select {
+	case n.eventCh <- event:
+	default:
+		log.Warn("too many lifecycle events are waiting for delivery, drop the event",
+			zap.String("type", string(event.Type)),
+			zap.String("keyspace", event.Keyspace),
+			zap.String("changefeed", event.ChangefeedID))
+	}
+}
+
+// Run delivers the queued events until the context is canceled.
+func (n *Notifier) Run(ctx context.Context) error {
+	defer func() {
+		for _, sink := range n.sinks {
+			sink.Close()
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case event := <-n.eventCh:
+			n.deliver(ctx, event)
+		}
+	}
+}
+
+// deliver sends the event to all sinks, an event failed to be delivered after
+// all retries is dropped.
+func (n *Notifier) deliver(ctx context.Context, event *Event) {
+	value, err := json.Marshal(event)
+	if err != nil {
+		log.Warn("failed to marshal the lifecycle event", zap.Any("event", event), zap.Error(err))
+		return
+	}
+	for _, sink := range n.sinks {
+		err = retry.Do(ctx, func() error {
+			return sink.Send(ctx, event, value)
+		}, retry.WithBackoffBaseDelay(deliverBaseDelayInMs),
+			retry.WithBackoffMaxDelay(deliverMaxDelayInMs),
+			retry.WithMaxTries(n.maxTries),
+			retry.WithIsRetryableErr(errors.IsRetryableError))
+		if err != nil {
+			log.Warn("failed to deliver the lifecycle event",
+				zap.String("type", string(event.Type)),
+				zap.String("keyspace", event.Keyspace),
+				zap.String("changefeed", event.ChangefeedID),
+				zap.Uint64("maxTries", n.maxTries),
+				zap.Error(err))
+			continue
+		}
+		log.Debug("deliver the lifecycle event successfully",
+			zap.String("type", string(event.Type)),
+			zap.String("keyspace", event.Keyspace),
+			zap.String("changefeed", event.ChangefeedID))
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookServer is a stand-in of the webhook endpoint, it fails the first
+// failures requests and records the events received afterwards.
+type webhookServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	failures int
+	requests int
+	events   []*Event
+}
+
+func newWebhookServer(t *testing.T, failures int) *webhookServer {
+	s := &webhookServer{failures: failures}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests++
+		if s.failures > 0 {
+			s.failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		event := &Event{}
+		require.NoError(t, json.Unmarshal(body, event))
+		require.Equal(t, string(event.Type), r.Header.Get("X-TiCDC-Event-Type"))
+		s.events = append(s.events, event)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *webhookServer) received() ([]*Event, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Event(nil), s.events...), s.requests
+}
+
+func runNotifier(t *testing.T, cfg *config.NotifierConfig) *Notifier {
+	require.NoError(t, cfg.ValidateAndAdjust())
+	n, err := New("default", cfg)
+	require.NoError(t, err)
+	require.True(t, n.Enabled())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = n.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return n
+}
+
+func TestNotifierDeliverToWebhook(t *testing.T) {
+	t.Parallel()
+
+	server := newWebhookServer(t, 2)
+	cfg := config.NewDefaultNotifierConfig()
+	cfg.WebhookURL = server.URL
+	n := runNotifier(t, cfg)
+
+	id := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	event := NewChangefeedEvent(EventErrorStuck, id)
+	event.State = config.StateFailed
+	event.PreviousState = config.StateWarning
+	event.CheckpointTs = 100
+	event.Error = &config.RunningError{Code: "CDC:ErrSinkURIInvalid", Message: "sink uri invalid"}
+	n.Notify(event)
+	n.Notify(NewOwnerChangedEvent("node-1", "127.0.0.1:8300"))
+
+	// the first event is delivered after 2 retries
+	require.Eventually(t, func() bool {
+		events, _ := server.received()
+		return len(events) == 2
+	}, 10*time.Second, 50*time.Millisecond)
+	events, requests := server.received()
+	require.Equal(t, 4, requests)
+
+	require.Equal(t, EventErrorStuck, events[0].Type)
+	require.Equal(t, "default", events[0].ClusterID)
+	require.Equal(t, common.DefaultKeyspaceName, events[0].Keyspace)
+	require.Equal(t, "test", events[0].ChangefeedID)
+	require.Equal(t, config.StateFailed, events[0].State)
+	require.Equal(t, config.StateWarning, events[0].PreviousState)
+	require.Equal(t, uint64(100), events[0].CheckpointTs)
+	require.Equal(t, "CDC:ErrSinkURIInvalid", events[0].Error.Code)
+
+	require.Equal(t, EventOwnerChanged, events[1].Type)
+	require.Equal(t, "node-1", events[1].Owner)
+	require.Equal(t, "127.0.0.1:8300", events[1].OwnerAddr)
+}
+
+func TestNotifierDropAfterMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	server := newWebhookServer(t, 3)
+	cfg := config.NewDefaultNotifierConfig()
+	cfg.WebhookURL = server.URL
+	cfg.MaxRetries = 1
+	n := runNotifier(t, cfg)
+
+	id := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	n.Notify(NewChangefeedEvent(EventStateChanged, id))
+	n.Notify(NewChangefeedEvent(EventCheckpointLag, id))
+	n.Notify(NewChangefeedEvent(EventGCSafepointRisk, id))
+
+	// the first event is dropped after 2 tries, the second one is delivered
+	// on the second try, and the third one on the first try.
+	require.Eventually(t, func() bool {
+		events, _ := server.received()
+		return len(events) == 2
+	}, 10*time.Second, 50*time.Millisecond)
+	events, requests := server.received()
+	require.Equal(t, 5, requests)
+	require.Equal(t, EventCheckpointLag, events[0].Type)
+	require.Equal(t, EventGCSafepointRisk, events[1].Type)
+}
+
+func TestNotifierDisabled(t *testing.T) {
+	t.Parallel()
+
+	n, err := New("default", config.NewDefaultNotifierConfig())
+	require.NoError(t, err)
+	require.False(t, n.Enabled())
+	// events are dropped without blocking even if the notifier is not running
+	for i := 0; i < eventBufferSize+1; i++ {
+		n.Notify(NewOwnerChangedEvent("node-1", "127.0.0.1:8300"))
+	}
+	require.Len(t, n.eventCh, 0)
+
+	var nilNotifier *Notifier
+	require.False(t, nilNotifier.Enabled())
+	nilNotifier.Notify(NewOwnerChangedEvent("node-1", "127.0.0.1:8300"))
+}
+
+func TestNewKafkaSink(t *testing.T) {
+	t.Parallel()
+
+	_, err := newKafkaSink("kafka://127.0.0.1:9092")
+	require.ErrorContains(t, err, "topic is required")
+
+	sink, err := newKafkaSink("kafka://127.0.0.1:9092/ticdc-events?kafka-version=2.4.0")
+	require.NoError(t, err)
+	require.Equal(t, "ticdc-events", sink.topic)
+	require.Nil(t, sink.producer)
+	sink.Close()
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/httputil"
+)
+
+// webhookSink posts the lifecycle events to a http endpoint, a response with
+// a non 2xx status code is treated as a delivery failure.
+type webhookSink struct {
+	url    string
+	client *httputil.Client
+}
+
+func newWebhookSink(url string, timeout time.Duration) (*webhookSink, error) {
+	client, err := httputil.NewClient(nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	client.SetTimeout(timeout)
+	return &webhookSink{url: url, client: client}, nil
+}
+
+func (s *webhookSink) Send(ctx context.Context, event *Event, value []byte) error {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set("X-TiCDC-Event-Type", string(event.Type))
+	_, err := s.client.DoRequest(ctx, s.url, http.MethodPost, header, bytes.NewReader(value))
+	return err
+}
+
+func (s *webhookSink) Close() {
+	s.client.CloseIdleConnections()
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/httputil"
+	"github.com/pingcap/ticdc/pkg/retry"
+	"go.uber.org/zap"
+)
+
+const (
+	stopPolicyWebhookTimeout  = 10 * time.Second
+	stopPolicyWebhookMaxTries = 3
+	// stopPolicyWebhookNotifyTimeout limits the time of notifying the webhook with retries.
+	stopPolicyWebhookNotifyTimeout = time.Minute
+)
+
+// stopPolicyEvent is posted to the webhook of the stop policy when it fires.
+type stopPolicyEvent struct {
+	ChangefeedID string           `json:"changefeed_id"`
+	Keyspace     string           `json:"keyspace"`
+	State        config.FeedState `json:"state"`
+	Reason       string           `json:"reason"`
+	CheckpointTs uint64           `json:"checkpoint_ts"`
+	Time         time.Time        `json:"time"`
+}
+
+func newStopPolicyEvent(
+	id common.ChangeFeedID, state config.FeedState, reason string, checkpointTs uint64,
+) *stopPolicyEvent {
+	return &stopPolicyEvent{
+		ChangefeedID: id.Name(),
+		Keyspace:     id.Keyspace(),
+		State:        state,
+		Reason:       reason,
+		CheckpointTs: checkpointTs,
+		Time:         time.Now(),
+	}
+}
+
+// notifyStopPolicyWebhook posts the event to the webhook, it's retried a few times
+// since the webhook is usually used to trigger the next step of a migration.
+func notifyStopPolicyWebhook(ctx context.Context, webhookURL string, event *stopPolicyEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return errors.WrapError(errors.ErrMarshalFailed, err)
+	}
+	client, err := httputil.NewClient(nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client.SetTimeout(stopPolicyWebhookTimeout)
+	defer client.CloseIdleConnections()
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	return retry.Do(ctx, func() error {
+		_, err := client.DoRequest(ctx, webhookURL, http.MethodPost, header, bytes.NewReader(body))
+		return err
+	}, retry.WithBackoffBaseDelay(500),
+		retry.WithBackoffMaxDelay(5000),
+		retry.WithMaxTries(stopPolicyWebhookMaxTries),
+		retry.WithIsRetryableErr(errors.IsRetryableError))
+}
+
+// onStopPolicyFired calls the webhook of the stop policy asynchronously, so a
+// slow webhook never blocks the coordinator. The notification has its own context,
+// since it outlives the handling of the state change which fires the policy.
+func (c *coordinator) onStopPolicyFired(
+	info *config.ChangeFeedInfo, reason string, checkpointTs uint64,
+) {
+	if info.StopPolicy == nil || info.StopPolicy.WebhookURL == "" {
+		return
+	}
+	event := newStopPolicyEvent(info.ChangefeedID, info.State, reason, checkpointTs)
+	webhookURL := info.StopPolicy.WebhookURL
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), stopPolicyWebhookNotifyTimeout)
+		defer cancel()
+		if err := notifyStopPolicyWebhook(ctx, webhookURL, event); err != nil {
+			log.Warn("failed to notify the webhook of the changefeed stop policy",
+				zap.String("keyspace", event.Keyspace),
+				zap.String("changefeed", event.ChangefeedID),
+				zap.String("reason", reason),
+				zap.Error(err))
+			return
+		}
+		log.Info("notify the webhook of the changefeed stop policy successfully",
+			zap.String("keyspace", event.Keyspace),
+			zap.String("changefeed", event.ChangefeedID),
+			zap.String("reason", reason))
+	}()
+}
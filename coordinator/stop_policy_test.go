@@ -0,0 +1,62 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestNotifyStopPolicyWebhook(t *testing.T) {
+	requests := atomic.NewInt32(0)
+	// the first request fails, the event is delivered by the retry
+	failures := atomic.NewInt32(1)
+	var received stopPolicyEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Inc()
+		if failures.Dec() >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	id := common.NewChangeFeedIDWithName("cf-1", "ks1")
+	event := newStopPolicyEvent(id, config.StateFinished, config.StopReasonSynced, 100)
+	require.NoError(t, notifyStopPolicyWebhook(context.Background(), server.URL, event))
+	require.Equal(t, int32(2), requests.Load())
+	require.Equal(t, "cf-1", received.ChangefeedID)
+	require.Equal(t, "ks1", received.Keyspace)
+	require.Equal(t, config.StateFinished, received.State)
+	require.Equal(t, config.StopReasonSynced, received.Reason)
+	require.Equal(t, uint64(100), received.CheckpointTs)
+
+	// give up after the max tries
+	requests.Store(0)
+	failures.Store(100)
+	require.Error(t, notifyStopPolicyWebhook(context.Background(), server.URL, event))
+	require.Equal(t, int32(stopPolicyWebhookMaxTries), requests.Load())
+}
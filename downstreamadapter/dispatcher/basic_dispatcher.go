@@ -59,6 +59,7 @@ type DispatcherService interface {
 	HandleEvents(events []DispatcherEvent, wakeCallback func()) (block bool)
 	IsOutputRawChangeEvent() bool
 	EnableIgnoreUpdateOnlyColumns() bool
+	GetInitialSnapshotTs() uint64
 }
 
 // Dispatcher defines the interface for event dispatchers that are responsible for receiving events
@@ -305,6 +306,12 @@ func (d *BasicDispatcher) AddDMLEventsToSink(events []*commonEvent.DMLEvent, wak
 		if skip || filtered == nil {
 			continue
 		}
+		// Transforms are applied before the event is handed over to the sink,
+		// so every sink writes or encodes the transformed values.
+		if err := d.sharedInfo.transformers.Apply(filtered); err != nil {
+			d.HandleError(err)
+			continue
+		}
 		filteredEvents = append(filteredEvents, filtered)
 	}
 	if len(filteredEvents) == 0 {
@@ -373,7 +380,45 @@ func (d *BasicDispatcher) AddBlockEventToSink(event commonEvent.BlockEvent) erro
 		}
 	}
 	d.tableProgress.Add(event)
-	return d.sink.WriteBlockEvent(event)
+	err := d.sink.WriteBlockEvent(event)
+	if err != nil {
+		return d.retryOrDeadLetterBlockEvent(event, err)
+	}
+	return nil
+}
+
+// retryOrDeadLetterBlockEvent retries the DDL rejected by the downstream, such
+// as a DDL the downstream can not parse. If it's still rejected after the
+// retries, it's written to the dead letter queue and the changefeed moves on
+// instead of failing on it. Other errors are returned as they are.
+func (d *BasicDispatcher) retryOrDeadLetterBlockEvent(event commonEvent.BlockEvent, err error) error {
+	queue := d.sharedInfo.deadLetterQueue
+	ddl, ok := event.(*commonEvent.DDLEvent)
+	if queue == nil || !ok || !queue.IsRejectedError(err) {
+		return err
+	}
+	for attempt := 1; attempt <= queue.MaxRetries(); attempt++ {
+		log.Info("ddl is rejected by the downstream, retry it",
+			zap.Stringer("changefeedID", d.sharedInfo.changefeedID),
+			zap.Stringer("dispatcher", d.id),
+			zap.String("ddl", ddl.GetDDLQuery()),
+			zap.Int("retry", attempt),
+			zap.Error(err))
+		err = d.sink.WriteBlockEvent(event)
+		if err == nil {
+			return nil
+		}
+		if !queue.IsRejectedError(err) {
+			return err
+		}
+	}
+	if err = queue.WriteDDLEvent(ddl, err); err != nil {
+		return err
+	}
+	// The dead lettered DDL is skipped, it's removed from the table progress
+	// like a flushed one.
+	event.PostFlush()
+	return nil
 }
 
 // PassBlockEventToSink advances local progress for a block event without writing it downstream.
@@ -992,14 +1037,83 @@ func (d *BasicDispatcher) routeTableAdmissionsForBlockState(event commonEvent.Bl
 	return admissions
 }
 
+// applyDDLPolicy applies the ddl policy to a DDL before it's handled. The
+// skipped DDLs are passed like the DDLs ignored by the event filter, and the
+// transformed DDLs are written with the rewritten query. The paused DDLs are
+// marked as NeedApproval, they block at the barrier until an operator
+// approves or skips them.
+//
+// The redo dispatchers only skip and transform the DDLs, the approval is
+// waited by the barrier of the default mode.
+func (d *BasicDispatcher) applyDDLPolicy(event commonEvent.BlockEvent) {
+	ddl, ok := event.(*commonEvent.DDLEvent)
+	if !ok || ddl.NotSync {
+		return
+	}
+	policy, query := d.sharedInfo.ddlPolicy.Decide(
+		ddl.GetSchemaName(), ddl.GetTableName(), ddl.GetDDLQuery(), ddl.GetDDLType())
+	switch policy {
+	case config.DDLPolicySkip:
+		log.Info("skip DDL by ddl policy",
+			zap.Stringer("dispatcher", d.id),
+			zap.Int64("mode", d.mode),
+			zap.Uint64("commitTs", ddl.GetCommitTs()),
+			zap.String("ddl", ddl.GetDDLQuery()))
+		ddl.NotSync = true
+	case config.DDLPolicyTransform:
+		log.Info("transform DDL by ddl policy",
+			zap.Stringer("dispatcher", d.id),
+			zap.Int64("mode", d.mode),
+			zap.Uint64("commitTs", ddl.GetCommitTs()),
+			zap.String("ddl", ddl.GetDDLQuery()),
+			zap.String("transformed", query))
+		ddl.Query = query
+	case config.DDLPolicyPause:
+		if common.IsDefaultMode(d.mode) {
+			log.Info("DDL needs approval by ddl policy",
+				zap.Stringer("dispatcher", d.id),
+				zap.Uint64("commitTs", ddl.GetCommitTs()),
+				zap.String("ddl", ddl.GetDDLQuery()))
+			ddl.NeedApproval = true
+		}
+	}
+}
+
+// setDDLApproval attaches the DDL waiting for the approval to a block state,
+// so that the maintainer can hold it and show it to the operator. The DDL
+// blocking no table is only handled by the table trigger event dispatcher,
+// so it blocks the dispatcher itself at the barrier.
+func setDDLApproval(state *heartbeatpb.State, event commonEvent.BlockEvent) {
+	ddl, ok := event.(*commonEvent.DDLEvent)
+	if !ok || !ddl.NeedApproval {
+		return
+	}
+	if state.BlockTables == nil {
+		state.BlockTables = &heartbeatpb.InfluencedTables{
+			InfluenceType: heartbeatpb.InfluenceType_Normal,
+			TableIDs:      []int64{common.DDLSpanTableID},
+		}
+	}
+	state.NeedApproval = true
+	state.DDLQuery = ddl.GetDDLQuery()
+	state.DDLSchemaName = ddl.GetSchemaName()
+	state.DDLTableName = ddl.GetTableName()
+}
+
 // shouldBlock check whether the event should be blocked(to wait maintainer response)
 // For the ddl event with more than one blockedTable, it should block.
-// For the ddl event with only one blockedTable, it should block only if the table is not complete span.
+// For the ddl event with only one blockedTable, it should block only if the table is not complete span
+// or the ddl needs approval.
 // Sync point event should always block.
 func (d *BasicDispatcher) shouldBlock(event commonEvent.BlockEvent) bool {
 	switch event.GetType() {
 	case commonEvent.TypeDDLEvent:
 		ddlEvent := event.(*commonEvent.DDLEvent)
+		if ddlEvent.NeedApproval {
+			// the ddl waits for the approval at the barrier, even if it
+			// blocks no table.
+			return true
+		}
 		blockTables := ddlEvent.GetBlockedTables()
 		if blockTables == nil {
 			return false
@@ -1070,6 +1184,7 @@ func hasTableScheduleChanges(
 //     The dispatcher flushes prior DMLs, then reports WAITING to the
 //     maintainer. The maintainer will later coordinate Write/Pass for this event.
 func (d *BasicDispatcher) DealWithBlockEvent(event commonEvent.BlockEvent) {
+	d.applyDDLPolicy(event)
 	shouldBlock := d.shouldBlock(event)
 	shouldHoldBlocked := d.shouldHoldBlockEvent(event)
 	if shouldBlock && shouldHoldBlocked {
@@ -1297,6 +1412,7 @@ func (d *BasicDispatcher) reportBlockedEventToMaintainer(event commonEvent.Block
 		},
 		Mode: d.GetMode(),
 	}
+	setDDLApproval(status.State, event)
 	d.resendTaskMap.Set(identifier, newResendTask(d, status, nil))
 	d.offerBlockStatus(status)
 }
@@ -1328,7 +1444,7 @@ func (d *BasicDispatcher) GetBlockEventStatus() *heartbeatpb.State {
 		return nil
 	}
 
-	return &heartbeatpb.State{
+	state := &heartbeatpb.State{
 		IsBlocked:            true,
 		BlockTs:              pendingEvent.GetCommitTs(),
 		BlockTables:          pendingEvent.GetBlockedTables().ToPB(),
@@ -1339,6 +1455,8 @@ func (d *BasicDispatcher) GetBlockEventStatus() *heartbeatpb.State {
 		IsSyncPoint:          pendingEvent.GetType() == commonEvent.TypeSyncPointEvent,
 		Stage:                blockStage,
 	}
+	setDDLApproval(state, pendingEvent)
+	return state
 }
 
 func (d *BasicDispatcher) Remove() {
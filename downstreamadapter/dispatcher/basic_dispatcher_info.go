@@ -18,11 +18,14 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/pingcap/ticdc/downstreamadapter/sink/deadletter"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/transformer"
 	"github.com/pingcap/ticdc/downstreamadapter/syncpoint"
 	"github.com/pingcap/ticdc/eventpb"
 	"github.com/pingcap/ticdc/heartbeatpb"
 	"github.com/pingcap/ticdc/pkg/common"
 	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/filter"
 	"github.com/pingcap/ticdc/pkg/metrics"
 	"github.com/pingcap/ticdc/pkg/routing"
 	"github.com/prometheus/client_golang/prometheus"
@@ -38,6 +41,9 @@ type SharedInfo struct {
 	bdrMode              bool
 	enableActiveActive   bool
 	outputRawChangeEvent bool
+	// initialSnapshotTs is the ts to read the initial snapshot of the tables,
+	// 0 means the changefeed does not need the initial snapshot.
+	initialSnapshotTs uint64
 
 	// Configuration objects
 	integrityConfig *eventpb.IntegrityConfig
@@ -59,6 +65,15 @@ type SharedInfo struct {
 	// router is used to route source schema/table names to target schema/table names.
 	// It is used to apply routing to TableInfo before storing it.
 	router routing.Router
+	// transformers are used to transform the column values of the DML events
+	// before they are written to the sink.
+	transformers *transformer.Transformers
+	// deadLetterQueue is the queue of the DDLs rejected by the downstream, it
+	// is nil if the dead letter queue is not configured.
+	deadLetterQueue *deadletter.Queue
+	// ddlPolicy skips, transforms or pauses the DDLs matched by the ddl
+	// policies, it is nil if no ddl policy is configured.
+	ddlPolicy *filter.DDLPolicy
 	// Normal event dispatchers inherit these shared batch defaults.
 	eventCollectorBatchCount int
 	eventCollectorBatchBytes int
@@ -91,12 +106,16 @@ func NewSharedInfo(
 	bdrMode bool,
 	enableActiveActive bool,
 	outputRawChangeEvent bool,
+	initialSnapshotTs uint64,
 	integrityConfig *eventpb.IntegrityConfig,
 	filterConfig *eventpb.FilterConfig,
 	syncPointConfig *syncpoint.SyncPointConfig,
 	txnAtomicity *config.AtomicityLevel,
 	enableSplittableCheck bool,
 	router routing.Router,
+	transformers *transformer.Transformers,
+	deadLetterQueue *deadletter.Queue,
+	ddlPolicy *filter.DDLPolicy,
 	eventCollectorBatchCount int,
 	eventCollectorBatchBytes int,
 	statusesChan chan TableSpanStatusWithSeq,
@@ -109,11 +128,15 @@ func NewSharedInfo(
 		bdrMode:                  bdrMode,
 		enableActiveActive:       enableActiveActive,
 		outputRawChangeEvent:     outputRawChangeEvent,
+		initialSnapshotTs:        initialSnapshotTs,
 		integrityConfig:          integrityConfig,
 		filterConfig:             filterConfig,
 		syncPointConfig:          syncPointConfig,
 		enableSplittableCheck:    enableSplittableCheck,
 		router:                   router,
+		transformers:             transformers,
+		deadLetterQueue:          deadLetterQueue,
+		ddlPolicy:                ddlPolicy,
 		eventCollectorBatchCount: eventCollectorBatchCount,
 		eventCollectorBatchBytes: eventCollectorBatchBytes,
 		statusesChan:             statusesChan,
@@ -199,6 +222,18 @@ func (d *BasicDispatcher) EnableIgnoreUpdateOnlyColumns() bool {
 	return d.sink.SinkType() == common.KafkaSinkType
 }
 
+// GetInitialSnapshotTs returns the ts to read the initial snapshot of the table span.
+// The snapshot is only required if the dispatcher is not started after the snapshot ts,
+// so a table whose checkpoint has not passed the snapshot ts gets its snapshot again
+// after restarting, and the others just continue the incremental replication.
+func (d *BasicDispatcher) GetInitialSnapshotTs() uint64 {
+	if d.sharedInfo.initialSnapshotTs == 0 || common.IsRedoMode(d.mode) ||
+		d.GetStartTs() > d.sharedInfo.initialSnapshotTs {
+		return 0
+	}
+	return d.sharedInfo.initialSnapshotTs
+}
+
 func (d *BasicDispatcher) GetRouter() routing.Router {
 	return d.sharedInfo.GetRouter()
 }
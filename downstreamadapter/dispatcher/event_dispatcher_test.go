@@ -155,12 +155,16 @@ func newTestSharedInfo(
 		false,
 		enableActiveActive,
 		false,
+		0,
 		nil,
 		nil,
 		syncPointConfig,
 		&defaultAtomicity,
 		enableSplittableCheck,
 		routing.Router{},
+		nil,
+		nil,
+		nil,
 		0,
 		0,
 		make(chan TableSpanStatusWithSeq, 128),
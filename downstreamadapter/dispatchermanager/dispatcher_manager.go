@@ -25,8 +25,10 @@ import (
 	"github.com/pingcap/ticdc/downstreamadapter/dispatcher"
 	"github.com/pingcap/ticdc/downstreamadapter/eventcollector"
 	"github.com/pingcap/ticdc/downstreamadapter/sink"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/deadletter"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/mysql"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/redo"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/transformer"
 	"github.com/pingcap/ticdc/downstreamadapter/syncpoint"
 	"github.com/pingcap/ticdc/eventpb"
 	"github.com/pingcap/ticdc/heartbeatpb"
@@ -35,6 +37,7 @@ import (
 	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
 	"github.com/pingcap/ticdc/pkg/config"
 	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
 	"github.com/pingcap/ticdc/pkg/metrics"
 	"github.com/pingcap/ticdc/pkg/node"
 	"github.com/pingcap/ticdc/pkg/pdutil"
@@ -319,6 +322,13 @@ func NewDispatcherManager(
 		outputRawChangeEvent = manager.config.SinkConfig.KafkaConfig.GetOutputRawChangeEvent()
 	}
 
+	// The initial snapshot is read at the start-ts of the changefeed,
+	// so the rows existing before the changefeed is created are also sent.
+	var initialSnapshotTs uint64
+	if manager.config.SinkConfig.IsInitialSnapshotEnabled() {
+		initialSnapshotTs = manager.config.StartTS
+	}
+
 	router, err := routing.NewRouter(
 		manager.changefeedID,
 		util.GetOrZero(manager.config.SinkConfig.CaseSensitive),
@@ -328,7 +338,22 @@ func NewDispatcherManager(
 		return nil, err
 	}
 
+	transformers, err := transformer.New(manager.config.SinkConfig, manager.config.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	ddlPolicy, err := filter.NewDDLPolicy(cfConfig.Filter, cfConfig.CaseSensitive)
+	if err != nil {
+		return nil, err
+	}
+
 	batchCounts, batchBytes := manager.getEventCollectorBatchCountAndBytes(manager.sink)
+	// The sink owns the dead letter queue, the dispatchers use it for the rejected DDLs.
+	var deadLetterQueue *deadletter.Queue
+	if dlSink, ok := manager.sink.(sink.DeadLetterSink); ok {
+		deadLetterQueue = dlSink.DeadLetterQueue()
+	}
 	// Create shared info for all dispatchers
 	sharedInfo := dispatcher.NewSharedInfo(
 		manager.changefeedID,
@@ -336,12 +361,16 @@ func NewDispatcherManager(
 		manager.config.BDRMode,
 		manager.config.EnableActiveActive,
 		outputRawChangeEvent,
+		initialSnapshotTs,
 		integrityCfg,
 		filterCfg,
 		syncPointConfig,
 		manager.config.SinkConfig.TxnAtomicity,
 		manager.config.EnableSplittableCheck,
 		router,
+		transformers,
+		deadLetterQueue,
+		ddlPolicy,
 		batchCounts,
 		batchBytes,
 		make(chan dispatcher.TableSpanStatusWithSeq, 8192),
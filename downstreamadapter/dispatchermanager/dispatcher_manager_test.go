@@ -126,12 +126,16 @@ func createTestManager(t *testing.T) *DispatcherManager {
 		manager.config.BDRMode,
 		manager.config.EnableActiveActive,
 		false, // outputRawChangeEvent
+		0,     // initialSnapshotTs
 		nil,   // integrityConfig
 		nil,   // filterConfig
 		nil,   // syncPointConfig
 		&defaultAtomicity,
 		false,
 		routing.Router{},
+		nil,
+		nil,
+		nil,
 		0,
 		0,
 		make(chan dispatcher.TableSpanStatusWithSeq, 8192),
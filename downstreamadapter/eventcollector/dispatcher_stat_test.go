@@ -159,6 +159,10 @@ func (m *mockDispatcher) EnableIgnoreUpdateOnlyColumns() bool {
 	return m.enableIgnoreUpdateOnlyColumns
 }
 
+func (m *mockDispatcher) GetInitialSnapshotTs() uint64 {
+	return 0
+}
+
 func (m *mockDispatcher) GetRouter() routing.Router {
 	return m.router
 }
@@ -149,6 +149,10 @@ func (m *mockEventDispatcher) EnableIgnoreUpdateOnlyColumns() bool {
 	return false
 }
 
+func (m *mockEventDispatcher) GetInitialSnapshotTs() uint64 {
+	return 0
+}
+
 func newMessage(id node.ID, msg messaging.IOTypeT) *messaging.TargetMessage {
 	targetMessage := messaging.NewSingleTargetMessage(id, messaging.EventCollectorTopic, msg)
 	targetMessage.From = id
@@ -180,6 +180,9 @@ type tableBatch struct {
 	size      uint64
 	tableInfo *common.TableInfo
 	entries   []*spool.Entry
+	// minCommitTs and maxCommitTs are the commit ts range of the rows in the batch.
+	minCommitTs uint64
+	maxCommitTs uint64
 }
 
 func newTableBatches() tableBatches {
@@ -196,12 +199,15 @@ func (t *tableBatches) addEntry(event *task, entry *spool.Entry) {
 	table := event.versionedTable
 	if _, ok := t.tables[table]; !ok {
 		t.tables[table] = &tableBatch{
-			size:      0,
-			tableInfo: event.tableInfo,
+			size:        0,
+			tableInfo:   event.tableInfo,
+			minCommitTs: event.commitTs,
 		}
 	}
 
 	tableTask := t.tables[table]
+	tableTask.minCommitTs = min(tableTask.minCommitTs, event.commitTs)
+	tableTask.maxCommitTs = max(tableTask.maxCommitTs, event.commitTs)
 	tableTask.size += entry.FileBytes()
 	tableTask.entries = append(tableTask.entries, entry)
 	t.nBytes += entry.FileBytes()
@@ -288,6 +294,8 @@ func (b *payloadBuilder) Build() *payload {
 		nBytes:             b.nBytes,
 		entries:            b.batch.entries,
 		postFlushCallbacks: b.postFlushCallbacks,
+		minCommitTs:        b.batch.minCommitTs,
+		maxCommitTs:        b.batch.maxCommitTs,
 	}
 }
 
@@ -24,6 +24,7 @@ import (
 	commonType "github.com/pingcap/ticdc/pkg/common"
 	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
 	"github.com/pingcap/ticdc/pkg/metrics"
+	"github.com/pingcap/ticdc/pkg/sink/codec"
 	"github.com/pingcap/ticdc/pkg/sink/codec/common"
 	"github.com/pingcap/ticdc/utils/chann"
 	"github.com/pingcap/tidb/pkg/objstore/storeapi"
@@ -57,6 +58,7 @@ func newDMLWriters(
 	extension string,
 	statistics *metrics.Statistics,
 	columnSelector *columnselector.ColumnSelectors,
+	committer *icebergCommitter,
 ) (*dmlWriters, error) {
 	messageCh := chann.NewUnlimitedChannelDefault[*task]()
 	encoderGroup := newEncoderGroup(
@@ -73,9 +75,11 @@ func newDMLWriters(
 		return nil, err
 	}
 
+	fileEncoder := codec.NewFileEncoder(encoderConfig)
 	writers := make([]*writer, config.WorkerCount)
 	for i := 0; i < config.WorkerCount; i++ {
-		writers[i] = newWriter(i, changefeedID, storage, config, extension, statistics, spool)
+		writers[i] = newWriter(i, changefeedID, storage, config, extension, fileEncoder, statistics, spool)
+		writers[i].icebergCommitter = committer
 	}
 
 	return &dmlWriters{
@@ -57,6 +57,8 @@ type sink struct {
 	storage              storeapi.Storage
 
 	dmlWriters *dmlWriters
+	// icebergCommitter is nil unless the table-format is iceberg.
+	icebergCommitter *icebergCommitter
 
 	// checkpointChan is a bounded best-effort queue. It is not closed
 	// explicitly; both senders and the background checkpoint worker stop on ctx.
@@ -87,6 +89,9 @@ func Verify(ctx context.Context, changefeedID common.ChangeFeedID, sinkURI *url.
 	if err != nil {
 		return err
 	}
+	if err = checkTableFormat(cfg, protocol); err != nil {
+		return err
+	}
 	if _, err = columnselector.New(sinkConfig); err != nil {
 		return err
 	}
@@ -119,6 +124,9 @@ func New(
 	if err != nil {
 		return nil, err
 	}
+	if err = checkTableFormat(cfg, protocol); err != nil {
+		return nil, err
+	}
 	// get cloud storage file extension according to the specific protocol.
 	ext := helper.GetFileExtension(protocol)
 	// Message size limits are mainly for MQ batch protocols. Cloud storage uses
@@ -142,7 +150,11 @@ func New(
 			storage.Close()
 		}
 	}()
-	dmlWriters, err := newDMLWriters(changefeedID, storage, cfg, encoderConfig, ext, statistics, columnSelectors)
+	var committer *icebergCommitter
+	if cfg.TableFormat == config.TableFormatIceberg {
+		committer = newIcebergCommitter(changefeedID, storage, cfg)
+	}
+	dmlWriters, err := newDMLWriters(changefeedID, storage, cfg, encoderConfig, ext, statistics, columnSelectors, committer)
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +165,7 @@ func New(
 		cleanupJobs:              cleanupJobs,
 		storage:                  storage,
 		dmlWriters:               dmlWriters,
+		icebergCommitter:         committer,
 		checkpointChan:           make(chan uint64, 16),
 		lastSendCheckpointTsTime: time.Now(),
 		outputRawChangeEvent:     sinkConfig.CloudStorageConfig.GetOutputRawChangeEvent(),
@@ -163,6 +176,17 @@ func New(
 	}, nil
 }
 
+// checkTableFormat checks whether the protocol can be used with the table-format,
+// the iceberg tables only accept the parquet data files.
+func checkTableFormat(cfg *cloudstorage.Config, protocol config.Protocol) error {
+	if cfg.TableFormat == config.TableFormatIceberg && protocol != config.ProtocolParquet {
+		return errors.ErrStorageSinkInvalidConfig.GenWithStack(
+			"table-format %s is only supported by the %s protocol, but got %s",
+			cfg.TableFormat, config.ProtocolParquet.String(), protocol.String())
+	}
+	return nil
+}
+
 func (s *sink) SinkType() common.SinkType {
 	return common.CloudStorageSinkType
 }
@@ -183,6 +207,12 @@ func (s *sink) Run(ctx context.Context) error {
 		return s.sendCheckpointTs(ctx)
 	})
 
+	if s.icebergCommitter != nil {
+		g.Go(func() error {
+			return s.icebergCommitter.run(ctx)
+		})
+	}
+
 	g.Go(func() error {
 		if err := s.initCron(ctx, s.sinkURI, s.cleanupJobs); err != nil {
 			return err
@@ -356,32 +386,53 @@ func (s *sink) sendCheckpointTs(ctx context.Context) error {
 		}
 
 		start := time.Now()
-		message, err := json.Marshal(map[string]uint64{"checkpoint-ts": checkpoint})
-		if err != nil {
-			log.Panic("cloud storage sink marshal checkpoint failed, this should never happen",
-				zap.String("keyspace", keyspace),
-				zap.String("changefeed", changefeed),
-				zap.Uint64("checkpoint", checkpoint),
-				zap.Duration("duration", time.Since(start)),
-				zap.Error(err))
-		}
-		err = s.storage.WriteFile(ctx, "metadata", message)
-		if err != nil {
-			log.Error("cloud storage sink write file failed",
-				zap.String("keyspace", keyspace),
-				zap.String("changefeed", changefeed),
-				zap.Duration("duration", time.Since(start)),
-				zap.Error(err))
+		if err := s.writeCheckpointTs(ctx, checkpoint); err != nil {
 			return err
 		}
 		s.lastSendCheckpointTsTime = time.Now()
-		s.lastCheckpointTs.Store(checkpoint)
 
 		checkpointTsMessageCount.Inc()
 		checkpointTsMessageDuration.Observe(time.Since(start).Seconds())
 	}
 }
 
+// FlushCheckpointTs writes the checkpoint ts to the metadata file synchronously,
+// regardless of the interval between the checkpoint writes.
+func (s *sink) FlushCheckpointTs(ctx context.Context, ts uint64) error {
+	if ts < s.lastCheckpointTs.Load() {
+		return nil
+	}
+	return s.writeCheckpointTs(ctx, ts)
+}
+
+// writeCheckpointTs writes the checkpoint ts to the metadata file.
+func (s *sink) writeCheckpointTs(ctx context.Context, checkpoint uint64) error {
+	start := time.Now()
+	message, err := json.Marshal(map[string]uint64{"checkpoint-ts": checkpoint})
+	if err != nil {
+		log.Panic("cloud storage sink marshal checkpoint failed, this should never happen",
+			zap.String("keyspace", s.changefeedID.Keyspace()),
+			zap.String("changefeed", s.changefeedID.Name()),
+			zap.Uint64("checkpoint", checkpoint),
+			zap.Duration("duration", time.Since(start)),
+			zap.Error(err))
+	}
+	err = s.storage.WriteFile(ctx, "metadata", message)
+	if err != nil {
+		log.Error("cloud storage sink write file failed",
+			zap.String("keyspace", s.changefeedID.Keyspace()),
+			zap.String("changefeed", s.changefeedID.Name()),
+			zap.Duration("duration", time.Since(start)),
+			zap.Error(err))
+		return err
+	}
+	s.lastCheckpointTs.Store(checkpoint)
+	if s.icebergCommitter != nil {
+		s.icebergCommitter.notifyCheckpoint(checkpoint)
+	}
+	return nil
+}
+
 func (s *sink) SetTableSchemaStore(_ *commonEvent.TableSchemaStore) {
 }
 
@@ -0,0 +1,302 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/cloudstorage"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/tidb/pkg/objstore/storeapi"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// icebergStagingDir keeps the data files which are written but not committed yet.
+const icebergStagingDir = "iceberg-staging"
+
+// icebergCommitter commits the data files written by the writers into the
+// iceberg metadata of the tables.
+//
+// A data file is staged in the storage once it is written, and its post flush
+// callbacks are called after that, so the checkpoint is not blocked by the
+// commits. After a checkpoint is persisted, the staged files whose rows are all
+// at or before the checkpoint are committed. The staged files are shared by all
+// nodes of the changefeed and survive restarts, so a data file passed by the
+// checkpoint is always committed by the node which persists the checkpoint.
+type icebergCommitter struct {
+	changefeedID common.ChangeFeedID
+	storage      storeapi.Storage
+	config       *cloudstorage.Config
+
+	// recovered records the tables whose staged files left by the previous
+	// committer have been checked against the current snapshot.
+	recovered map[string]struct{}
+
+	checkpointTs atomic.Uint64
+	notifyCh     chan struct{}
+}
+
+// stagedDataFile is a data file waiting for commit, it is persisted in the staging dir.
+type stagedDataFile struct {
+	MetadataDir  string                       `json:"metadata-dir"`
+	TableVersion uint64                       `json:"table-version"`
+	SchemaFile   string                       `json:"schema-file"`
+	Columns      []cloudstorage.IcebergColumn `json:"columns"`
+	File         cloudstorage.IcebergDataFile `json:"file"`
+	MinCommitTs  uint64                       `json:"min-commit-ts"`
+	MaxCommitTs  uint64                       `json:"max-commit-ts"`
+
+	path string
+}
+
+func newIcebergCommitter(
+	changefeedID common.ChangeFeedID,
+	storage storeapi.Storage,
+	config *cloudstorage.Config,
+) *icebergCommitter {
+	return &icebergCommitter{
+		changefeedID: changefeedID,
+		storage:      storage,
+		config:       config,
+		recovered:    make(map[string]struct{}),
+		notifyCh:     make(chan struct{}, 1),
+	}
+}
+
+// stageDataFile persists the data file written successfully into the staging
+// dir, the post flush callbacks of the file can be called once it returns.
+func (c *icebergCommitter) stageDataFile(
+	ctx context.Context,
+	table cloudstorage.VersionedTableName,
+	dataFilePath string,
+	columns []cloudstorage.IcebergColumn,
+	p *payload,
+) error {
+	var schemaFile cloudstorage.SchemaFile
+	schemaFile.Build(&commonEvent.DDLEvent{
+		SchemaName: p.tableInfo.GetTargetSchemaName(),
+		TableName:  p.tableInfo.GetTargetTableName(),
+		TableInfo:  p.tableInfo,
+		FinishedTs: table.TableInfoVersion,
+	}, c.config.OutputColumnID)
+	tableID := table.TableNameWithPhysicTableID.TableID
+
+	staged := &stagedDataFile{
+		MetadataDir: cloudstorage.IcebergMetadataDir(
+			table.TableNameWithPhysicTableID.Schema,
+			table.TableNameWithPhysicTableID.Table,
+			tableID, c.config.UseTableIDAsPath),
+		TableVersion: table.TableInfoVersion,
+		SchemaFile:   schemaFile.Path(c.config.UseTableIDAsPath, tableID),
+		Columns:      columns,
+		File: cloudstorage.IcebergDataFile{
+			FilePath:        dataFilePath,
+			FileFormat:      "PARQUET",
+			RecordCount:     int64(p.rowsCount),
+			FileSizeInBytes: p.nBytes,
+		},
+		MinCommitTs: p.minCommitTs,
+		MaxCommitTs: p.maxCommitTs,
+	}
+	data, err := json.Marshal(staged)
+	if err != nil {
+		return errors.WrapError(errors.ErrMarshalFailed, err)
+	}
+	// the file names start with the min commit ts, so the files are listed in order.
+	stagingPath := path.Join(icebergStagingDir, fmt.Sprintf("%020d-%s.json", p.minCommitTs, uuid.NewString()))
+	return c.storage.WriteFile(ctx, stagingPath, data)
+}
+
+// notifyCheckpoint triggers a commit after the checkpoint is persisted.
+func (c *icebergCommitter) notifyCheckpoint(checkpointTs uint64) {
+	c.checkpointTs.Store(checkpointTs)
+	select {
+	case c.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+func (c *icebergCommitter) run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Trace(context.Cause(ctx))
+		case <-c.notifyCh:
+		}
+		if err := c.commit(ctx); err != nil {
+			log.Error("failed to commit iceberg metadata",
+				zap.String("keyspace", c.changefeedID.Keyspace()),
+				zap.String("changefeed", c.changefeedID.Name()),
+				zap.Error(err))
+			return err
+		}
+	}
+}
+
+// commit commits the staged data files whose rows are all at or before the
+// persisted checkpoint, the files of a table are committed in the order they
+// are written, one snapshot for each schema version.
+func (c *icebergCommitter) commit(ctx context.Context) error {
+	checkpointTs := c.checkpointTs.Load()
+	if checkpointTs == 0 {
+		return nil
+	}
+	staged, err := c.loadStagedFiles(ctx)
+	if err != nil {
+		return err
+	}
+	tables := make(map[string][]*stagedDataFile)
+	for _, file := range staged {
+		tables[file.MetadataDir] = append(tables[file.MetadataDir], file)
+	}
+	for metadataDir, files := range tables {
+		if err = c.commitTable(ctx, metadataDir, files, checkpointTs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *icebergCommitter) commitTable(
+	ctx context.Context, metadataDir string, files []*stagedDataFile, checkpointTs uint64,
+) error {
+	if _, ok := c.recovered[metadataDir]; !ok {
+		var err error
+		files, err = c.removeCommittedFiles(ctx, metadataDir, files)
+		if err != nil {
+			return err
+		}
+		c.recovered[metadataDir] = struct{}{}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].TableVersion != files[j].TableVersion {
+			return files[i].TableVersion < files[j].TableVersion
+		}
+		return files[i].path < files[j].path
+	})
+
+	// the checkpoint recorded in the snapshots is the ts before which all rows
+	// of the table are visible, the files with a row after it are not committed.
+	var (
+		ready      []*stagedDataFile
+		recordedTs = checkpointTs
+	)
+	for _, file := range files {
+		if file.MaxCommitTs <= checkpointTs {
+			ready = append(ready, file)
+		} else if file.MinCommitTs <= recordedTs {
+			recordedTs = file.MinCommitTs - 1
+		}
+	}
+	for len(ready) > 0 {
+		end := 1
+		for end < len(ready) && ready[end].SchemaFile == ready[0].SchemaFile {
+			end++
+		}
+		commit := &cloudstorage.IcebergCommit{
+			Columns:      ready[0].Columns,
+			SchemaFile:   ready[0].SchemaFile,
+			CheckpointTs: recordedTs,
+		}
+		stagingPaths := make([]string, 0, end)
+		for _, file := range ready[:end] {
+			commit.DataFiles = append(commit.DataFiles, file.File)
+			stagingPaths = append(stagingPaths, file.path)
+		}
+		snapshot, err := cloudstorage.CommitIcebergSnapshot(ctx, c.storage, metadataDir, commit)
+		if err != nil {
+			return err
+		}
+		if err = c.storage.DeleteFiles(ctx, stagingPaths); err != nil {
+			return err
+		}
+		log.Debug("commit iceberg snapshot success",
+			zap.String("keyspace", c.changefeedID.Keyspace()),
+			zap.String("changefeed", c.changefeedID.Name()),
+			zap.String("metadataDir", metadataDir),
+			zap.Int64("snapshotID", snapshot.SnapshotID),
+			zap.Uint64("checkpointTs", recordedTs),
+			zap.Int("dataFiles", end))
+		ready = ready[end:]
+	}
+	return nil
+}
+
+// removeCommittedFiles removes the staged files which are committed by the
+// current snapshot of the table, they are left if the previous committer
+// exits before removing them.
+func (c *icebergCommitter) removeCommittedFiles(
+	ctx context.Context, metadataDir string, files []*stagedDataFile,
+) ([]*stagedDataFile, error) {
+	committed, err := cloudstorage.LoadIcebergSnapshotDataFiles(ctx, c.storage, metadataDir)
+	if err != nil || len(committed) == 0 {
+		return files, err
+	}
+	var (
+		remaining []*stagedDataFile
+		removed   []string
+	)
+	for _, file := range files {
+		if _, ok := committed[file.File.FilePath]; ok {
+			removed = append(removed, file.path)
+			continue
+		}
+		remaining = append(remaining, file)
+	}
+	if len(removed) == 0 {
+		return remaining, nil
+	}
+	log.Info("remove the staged iceberg data files which are already committed",
+		zap.String("keyspace", c.changefeedID.Keyspace()),
+		zap.String("changefeed", c.changefeedID.Name()),
+		zap.String("metadataDir", metadataDir),
+		zap.Strings("files", removed))
+	return remaining, c.storage.DeleteFiles(ctx, removed)
+}
+
+func (c *icebergCommitter) loadStagedFiles(ctx context.Context) ([]*stagedDataFile, error) {
+	var paths []string
+	err := c.storage.WalkDir(ctx, &storeapi.WalkOption{SubDir: icebergStagingDir}, func(filePath string, _ int64) error {
+		if strings.HasSuffix(filePath, ".json") {
+			paths = append(paths, filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	files := make([]*stagedDataFile, 0, len(paths))
+	for _, filePath := range paths {
+		data, err := c.storage.ReadFile(ctx, filePath)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		file := &stagedDataFile{path: filePath}
+		if err = json.Unmarshal(data, file); err != nil {
+			return nil, errors.WrapError(errors.ErrUnmarshalFailed, err)
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
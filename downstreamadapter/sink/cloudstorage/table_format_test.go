@@ -0,0 +1,143 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/ticdc/pkg/cloudstorage"
+	commonType "github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/sink/codec/parquet"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/parser/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIcebergCommitter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	storage, err := util.GetExternalStorageWithDefaultTimeout(ctx, fmt.Sprintf("file:///%s", t.TempDir()))
+	require.NoError(t, err)
+	defer storage.Close()
+
+	cfg := cloudstorage.NewConfig()
+	cfg.TableFormat = config.TableFormatIceberg
+	changefeedID := commonType.NewChangefeedID4Test("test", t.Name())
+	committer := newIcebergCommitter(changefeedID, storage, cfg)
+
+	newTableInfo := func(columns ...string) *commonType.TableInfo {
+		tidbTableInfo := &model.TableInfo{ID: 100, Name: ast.NewCIStr("t1")}
+		for idx, name := range columns {
+			tidbTableInfo.Columns = append(tidbTableInfo.Columns, &model.ColumnInfo{
+				ID: int64(idx + 1), Name: ast.NewCIStr(name), FieldType: *types.NewFieldType(mysql.TypeLong),
+			})
+		}
+		return commonType.WrapTableInfo("test", tidbTableInfo)
+	}
+	table := cloudstorage.VersionedTableName{
+		TableNameWithPhysicTableID: commonType.TableName{Schema: "test", Table: "t1", TableID: 100},
+		TableInfoVersion:           10,
+	}
+
+	columns := []cloudstorage.IcebergColumn{
+		{Name: parquet.OperationColumnName, Type: "string", Required: true},
+		{Name: parquet.CommitTsColumnName, Type: "long", Required: true},
+		{Name: "c1", Type: "int"},
+	}
+	stage := func(path string, tableInfo *commonType.TableInfo, rows int, minCommitTs, maxCommitTs uint64) {
+		require.NoError(t, committer.stageDataFile(ctx, table, path, columns, &payload{
+			tableInfo:   tableInfo,
+			rowsCount:   rows,
+			nBytes:      int64(rows * 10),
+			minCommitTs: minCommitTs,
+			maxCommitTs: maxCommitTs,
+		}))
+	}
+	tableInfo := newTableInfo("c1")
+	stage("test/t1/10/CDC000001.parquet", tableInfo, 2, 1, 3)
+	stage("test/t1/10/CDC000002.parquet", tableInfo, 1, 4, 6)
+	// the files written after a DDL are committed in another snapshot.
+	table.TableInfoVersion = 20
+	columns = append(columns, cloudstorage.IcebergColumn{Name: "c2", Type: "int"})
+	stage("test/t1/20/CDC000001.parquet", newTableInfo("c1", "c2"), 4, 8, 9)
+
+	// nothing is committed before the checkpoint is persisted.
+	require.NoError(t, committer.commit(ctx))
+	metadataDir := cloudstorage.IcebergMetadataDir("test", "t1", 100, false)
+	metadata, _, err := cloudstorage.LoadIcebergTableMetadata(ctx, storage, metadataDir)
+	require.NoError(t, err)
+	require.Nil(t, metadata)
+
+	// only the files whose rows are all before the checkpoint are committed, and
+	// the recorded checkpoint excludes the rows of the file which is not committed.
+	committer.notifyCheckpoint(5)
+	require.NoError(t, committer.commit(ctx))
+	metadata, version, err := cloudstorage.LoadIcebergTableMetadata(ctx, storage, metadataDir)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+	require.Equal(t, "2", metadata.Snapshots[0].Summary["added-records"])
+	require.Equal(t, "3", metadata.Snapshots[0].Summary["ticdc.checkpoint-ts"])
+	staged, err := committer.loadStagedFiles(ctx)
+	require.NoError(t, err)
+	require.Len(t, staged, 2)
+
+	committer.notifyCheckpoint(10)
+	require.NoError(t, committer.commit(ctx))
+	metadata, version, err = cloudstorage.LoadIcebergTableMetadata(ctx, storage, metadataDir)
+	require.NoError(t, err)
+	require.Equal(t, 3, version)
+	require.Len(t, metadata.Snapshots, 3)
+	require.Equal(t, "1", metadata.Snapshots[1].Summary["added-records"])
+	require.Equal(t, "4", metadata.Snapshots[2].Summary["added-records"])
+	require.Equal(t, "10", metadata.Snapshots[2].Summary["ticdc.checkpoint-ts"])
+	staged, err = committer.loadStagedFiles(ctx)
+	require.NoError(t, err)
+	require.Len(t, staged, 0)
+
+	schema := metadata.Schemas[metadata.CurrentSchemaID]
+	require.Len(t, schema.Fields, 4)
+	require.Equal(t, parquet.OperationColumnName, schema.Fields[0].Name)
+	require.Equal(t, parquet.CommitTsColumnName, schema.Fields[1].Name)
+	require.Equal(t, "c2", schema.Fields[3].Name)
+
+	// the staged file left after it is committed is removed by a new committer.
+	stage("test/t1/20/CDC000001.parquet", newTableInfo("c1", "c2"), 4, 8, 9)
+	committer = newIcebergCommitter(changefeedID, storage, cfg)
+	committer.notifyCheckpoint(20)
+	require.NoError(t, committer.commit(ctx))
+	_, version, err = cloudstorage.LoadIcebergTableMetadata(ctx, storage, metadataDir)
+	require.NoError(t, err)
+	require.Equal(t, 3, version)
+	staged, err = committer.loadStagedFiles(ctx)
+	require.NoError(t, err)
+	require.Len(t, staged, 0)
+}
+
+func TestCheckTableFormat(t *testing.T) {
+	t.Parallel()
+
+	cfg := cloudstorage.NewConfig()
+	require.NoError(t, checkTableFormat(cfg, config.ProtocolCsv))
+
+	cfg.TableFormat = config.TableFormatIceberg
+	require.NoError(t, checkTableFormat(cfg, config.ProtocolParquet))
+	require.Error(t, checkTableFormat(cfg, config.ProtocolCanalJSON))
+}
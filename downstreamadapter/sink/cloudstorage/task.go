@@ -41,6 +41,7 @@ type task struct {
 	postEnqueue    func()                          // Transaction enqueue callback.
 	tableInfo      *commonType.TableInfo           // Table info used after event is released.
 	versionedTable cloudstorage.VersionedTableName // Versioned output identity for the DML event.
+	commitTs       uint64                          // Commit ts of the DML event.
 	rowEvents      []*commonEvent.RowEvent         // Row events to encode and flush.
 	encodedMsgs    []*common.Message               // Encoded result built from event.
 
@@ -59,6 +60,7 @@ func newDMLTask(
 		postEnqueue:    postEnqueue,
 		tableInfo:      event.TableInfo,
 		versionedTable: version,
+		commitTs:       event.CommitTs,
 		// Storage txn encoders attach only the last row callback to the built
 		// batch message, so the callback is triggered once per encoded txn
 		// message. Kafka uses row-level callbacks and counts all rows before
@@ -26,6 +26,8 @@ import (
 	"github.com/pingcap/ticdc/pkg/common"
 	"github.com/pingcap/ticdc/pkg/errors"
 	pmetrics "github.com/pingcap/ticdc/pkg/metrics"
+	codecCommon "github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"github.com/pingcap/ticdc/pkg/sink/codec/parquet"
 	"github.com/pingcap/tidb/pkg/objstore/storeapi"
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
@@ -39,6 +41,10 @@ type writer struct {
 	config        *cloudstorage.Config
 	spool         *spool.Spool
 	bufferManager *bufferManager
+	// fileEncoder is nil unless the protocol can only be written as a whole file, such as parquet.
+	fileEncoder codecCommon.FileEncoder
+	// icebergCommitter is nil unless the table-format is iceberg.
+	icebergCommitter *icebergCommitter
 
 	// flushCh is owned by writer. bufferManager emits flush work only through
 	// writer methods. Both writer and bufferManager stop on the shared ctx, so
@@ -66,6 +72,8 @@ type payload struct {
 	nBytes             int64
 	entries            []*spool.Entry
 	postFlushCallbacks []func()
+	minCommitTs        uint64
+	maxCommitTs        uint64
 }
 
 func newWriter(
@@ -74,6 +82,7 @@ func newWriter(
 	storage storeapi.Storage,
 	config *cloudstorage.Config,
 	extension string,
+	fileEncoder codecCommon.FileEncoder,
 	statistics *pmetrics.Statistics,
 	spoolBuffer *spool.Spool,
 ) *writer {
@@ -87,6 +96,7 @@ func newWriter(
 		storage:           storage,
 		config:            config,
 		spool:             spoolBuffer,
+		fileEncoder:       fileEncoder,
 		flushCh:           make(chan flushTask, 64),
 		statistics:        statistics,
 		filePathGenerator: cloudstorage.NewFilePathGenerator(changefeedID, config, storage, extension),
@@ -186,6 +196,32 @@ func (d *writer) flushMessages(ctx context.Context) error {
 				if err != nil {
 					return err
 				}
+				var (
+					icebergColumns     []cloudstorage.IcebergColumn
+					postFlushCallbacks []func()
+				)
+				if d.icebergCommitter != nil {
+					// the columns are resolved from the buffered data before it is encoded.
+					icebergColumns, err = parquet.IcebergColumns(payload.tableInfo, payload.data)
+					if err != nil {
+						return err
+					}
+					// the callbacks are called after the data file is staged for the commit.
+					postFlushCallbacks, payload.postFlushCallbacks = payload.postFlushCallbacks, nil
+				}
+				if d.fileEncoder != nil {
+					payload.data, err = d.fileEncoder.EncodeFile(payload.tableInfo, payload.data)
+					if err != nil {
+						log.Error("failed to encode data file",
+							zap.String("keyspace", keyspace),
+							zap.String("changefeed", changefeed),
+							zap.String("path", dataFilePath),
+							zap.Int("shardID", d.shardID),
+							zap.Error(err))
+						return err
+					}
+					payload.nBytes = int64(len(payload.data))
+				}
 				if err := d.writeDataFile(ctx, dataFilePath, indexFilePath, payload); err != nil {
 					log.Error("failed to write data file to external storage",
 						zap.String("keyspace", keyspace),
@@ -195,6 +231,23 @@ func (d *writer) flushMessages(ctx context.Context) error {
 						zap.Error(err))
 					return err
 				}
+				if d.icebergCommitter != nil {
+					err = d.icebergCommitter.stageDataFile(ctx, table, dataFilePath, icebergColumns, payload)
+					if err != nil {
+						log.Error("failed to stage data file for the iceberg commit",
+							zap.String("keyspace", keyspace),
+							zap.String("changefeed", changefeed),
+							zap.String("path", dataFilePath),
+							zap.Int("shardID", d.shardID),
+							zap.Error(err))
+						return err
+					}
+					for _, postFlushCallback := range postFlushCallbacks {
+						if postFlushCallback != nil {
+							postFlushCallback()
+						}
+					}
+				}
 				tableTask.entries = nil
 
 				log.Debug("write file to storage success",
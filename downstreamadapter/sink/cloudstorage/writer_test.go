@@ -62,7 +62,7 @@ func testWriter(ctx context.Context, t *testing.T, dir string) *writer {
 	statistics := metrics.NewStatistics(changefeedID, commonType.DefaultKeyspaceID, t.Name())
 	spoolBuffer := newTestSpool(t, changefeedID, cfg)
 	d := newWriter(1, changefeedID, storage,
-		cfg, ".json", statistics, spoolBuffer)
+		cfg, ".json", nil, statistics, spoolBuffer)
 	return d
 }
 
@@ -483,7 +483,7 @@ func TestWriterStoresPendingMessagesInSpoolBeforeFlush(t *testing.T) {
 	setPDClockForTest(t, pdutil.NewClock4Test())
 
 	spoolBuffer := newTestSpool(t, changefeedID, cfg)
-	d := newWriter(1, changefeedID, storage, cfg, ".json", statistics, spoolBuffer)
+	d := newWriter(1, changefeedID, storage, cfg, ".json", nil, statistics, spoolBuffer)
 
 	tidbTableInfo := &model.TableInfo{
 		ID:   100,
@@ -651,7 +651,7 @@ func TestWriterIndexWriteError(t *testing.T) {
 	statistics := metrics.NewStatistics(changefeedID, commonType.DefaultKeyspaceID, t.Name())
 	setPDClockForTest(t, pdutil.NewClock4Test())
 	spoolBuffer := newTestSpool(t, changefeedID, cfg)
-	d := newWriter(1, changefeedID, storage, cfg, ".json", statistics, spoolBuffer)
+	d := newWriter(1, changefeedID, storage, cfg, ".json", nil, statistics, spoolBuffer)
 
 	tableInfo := commonType.WrapTableInfo("test", &model.TableInfo{
 		ID:   100,
@@ -717,7 +717,7 @@ func TestWriterDataFileCloseError(t *testing.T) {
 	statistics := metrics.NewStatistics(changefeedID, commonType.DefaultKeyspaceID, t.Name())
 	setPDClockForTest(t, pdutil.NewClock4Test())
 	spoolBuffer := newTestSpool(t, changefeedID, cfg)
-	d := newWriter(1, changefeedID, storage, cfg, ".json", statistics, spoolBuffer)
+	d := newWriter(1, changefeedID, storage, cfg, ".json", nil, statistics, spoolBuffer)
 
 	tableInfo := commonType.WrapTableInfo("test", &model.TableInfo{
 		ID:   100,
@@ -0,0 +1,83 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/pingcap/ticdc/downstreamadapter/sink/helper"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/errors"
+	codecCommon "github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"github.com/pingcap/ticdc/pkg/sink/kafka"
+)
+
+// kafkaBackend sends the records as json messages to a kafka topic, the id
+// of the record is the key of the message. The topic is consumed by the
+// users, so the records can not be listed or replayed by TiCDC.
+type kafkaBackend struct {
+	topic    string
+	producer kafka.SyncProducer
+}
+
+func newKafkaBackend(ctx context.Context, changefeedID common.ChangeFeedID, uri *url.URL) (*kafkaBackend, error) {
+	topic, err := helper.GetTopic(uri)
+	if err != nil {
+		return nil, err
+	}
+	options := kafka.NewOptions()
+	if err = options.Apply(changefeedID, uri, nil); err != nil {
+		return nil, err
+	}
+	options.Topic = topic
+
+	factory, err := kafka.NewSaramaFactory(ctx, options, changefeedID)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := factory.SyncProducer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaBackend{topic: topic, producer: producer}, nil
+}
+
+func (b *kafkaBackend) write(_ context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errors.WrapError(errors.ErrMarshalFailed, err)
+	}
+	return b.producer.SendMessage(b.topic, 0, codecCommon.NewMsg([]byte(record.ID), data))
+}
+
+func (b *kafkaBackend) list(context.Context, int) ([]*Record, error) {
+	return nil, errors.ErrDeadLetterQueueFailed.GenWithStackByArgs(
+		"listing the kafka dead letter queue is not supported, consume the topic instead")
+}
+
+func (b *kafkaBackend) get(context.Context, []string) ([]*Record, error) {
+	return nil, errors.ErrDeadLetterQueueFailed.GenWithStackByArgs(
+		"reading the kafka dead letter queue is not supported, consume the topic instead")
+}
+
+func (b *kafkaBackend) remove(context.Context, []string) error {
+	return errors.ErrDeadLetterQueueFailed.GenWithStackByArgs(
+		"removing from the kafka dead letter queue is not supported")
+}
+
+func (b *kafkaBackend) close() {
+	b.producer.Close()
+}
@@ -0,0 +1,251 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	dmysql "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/metrics"
+	"github.com/pingcap/ticdc/pkg/sink/kafka"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// backend is the destination of the dead letter queue.
+type backend interface {
+	write(ctx context.Context, record *Record) error
+	// list returns at most limit records of the changefeed, sorted by the id.
+	list(ctx context.Context, limit int) ([]*Record, error)
+	get(ctx context.Context, ids []string) ([]*Record, error)
+	remove(ctx context.Context, ids []string) error
+	close()
+}
+
+// Queue writes the events rejected by the downstream to the dead letter
+// queue, so the changefeed moves on instead of failing on them.
+type Queue struct {
+	ctx          context.Context
+	changefeedID common.ChangeFeedID
+	maxRetries   int
+	backend      backend
+
+	// metricWriteDuration is nil if the queue is opened by Open.
+	metricWriteDuration prometheus.Observer
+}
+
+// New creates the dead letter queue of the changefeed, it returns nil if the
+// dead letter queue is not configured.
+func New(
+	ctx context.Context, changefeedID common.ChangeFeedID, sinkConfig *config.SinkConfig,
+) (*Queue, error) {
+	q, err := Open(ctx, changefeedID, sinkConfig)
+	if err != nil || q == nil {
+		return nil, err
+	}
+	q.metricWriteDuration = metrics.DeadLetterWriteDuration.
+		WithLabelValues(changefeedID.Keyspace(), changefeedID.Name())
+	log.Info("dead letter queue created",
+		zap.String("keyspace", changefeedID.Keyspace()),
+		zap.String("changefeed", changefeedID.Name()),
+		zap.String("uri", util.MaskSensitiveDataInURI(sinkConfig.DeadLetterQueue.URI)),
+		zap.Int("maxRetries", q.maxRetries))
+	return q, nil
+}
+
+// Open opens the dead letter queue of the changefeed to list, get and remove
+// the records, it returns nil if the dead letter queue is not configured.
+// The events should be written by the queue created by New.
+func Open(
+	ctx context.Context, changefeedID common.ChangeFeedID, sinkConfig *config.SinkConfig,
+) (*Queue, error) {
+	if sinkConfig == nil || sinkConfig.DeadLetterQueue == nil {
+		return nil, nil
+	}
+	cfg := sinkConfig.DeadLetterQueue
+	uri, err := url.Parse(cfg.URI)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrSinkURIInvalid, err, util.MaskSensitiveDataInURI(cfg.URI))
+	}
+
+	var b backend
+	scheme := config.GetScheme(uri)
+	switch {
+	case config.IsMySQLCompatibleScheme(scheme):
+		b, err = newTableBackend(ctx, changefeedID, uri, cfg.Table, sinkConfig)
+	case scheme == config.KafkaScheme || scheme == config.KafkaSSLScheme:
+		b, err = newKafkaBackend(ctx, changefeedID, uri)
+	case config.IsStorageScheme(scheme):
+		b, err = newStorageBackend(ctx, changefeedID, cfg.URI)
+	default:
+		return nil, errors.ErrDeadLetterQueueFailed.GenWithStackByArgs(
+			"unsupported destination " + scheme)
+	}
+	if err != nil {
+		log.Error("failed to open the dead letter queue",
+			zap.String("keyspace", changefeedID.Keyspace()),
+			zap.String("changefeed", changefeedID.Name()),
+			zap.String("uri", util.MaskSensitiveDataInURI(cfg.URI)),
+			zap.Error(err))
+		return nil, err
+	}
+	return &Queue{
+		ctx:          ctx,
+		changefeedID: changefeedID,
+		maxRetries:   cfg.GetMaxRetries(),
+		backend:      b,
+	}, nil
+}
+
+// MaxRetries returns the number of times an event rejected by the downstream
+// is retried before it is written to the dead letter queue.
+func (q *Queue) MaxRetries() int {
+	return q.maxRetries
+}
+
+// IsRejectedError returns whether the event is rejected by the downstream.
+func (q *Queue) IsRejectedError(err error) bool {
+	return IsRejectedError(err)
+}
+
+// WriteDMLEvent writes the transaction rejected by the MySQL compatible
+// downstream, with the statements and the arguments executed for it.
+func (q *Queue) WriteDMLEvent(
+	event *commonEvent.DMLEvent, sqls []string, args [][]interface{}, cause error,
+) error {
+	return q.write(newDMLRecord(q.changefeedID, event, sqls, args, q.maxRetries, cause))
+}
+
+// WriteDDLEvent writes the DDL rejected by the downstream.
+func (q *Queue) WriteDDLEvent(event *commonEvent.DDLEvent, cause error) error {
+	return q.write(newDDLRecord(q.changefeedID, event, q.maxRetries, cause))
+}
+
+// WriteRowEvent writes the row rejected by the mq downstream. The encoding of
+// a row is deterministic, so the row is not retried.
+func (q *Queue) WriteRowEvent(event *commonEvent.RowEvent, cause error) error {
+	return q.write(newRowRecord(q.changefeedID, event, 0, cause))
+}
+
+func (q *Queue) write(record *Record) error {
+	start := time.Now()
+	if err := q.backend.write(q.ctx, record); err != nil {
+		return errors.WrapError(errors.ErrDeadLetterQueueFailed, err)
+	}
+	if q.metricWriteDuration != nil {
+		q.metricWriteDuration.Observe(time.Since(start).Seconds())
+	}
+	metrics.DeadLetterEventCounter.
+		WithLabelValues(q.changefeedID.Keyspace(), q.changefeedID.Name(), string(record.EventType)).Inc()
+	log.Warn("event rejected by the downstream is written to the dead letter queue",
+		zap.String("keyspace", q.changefeedID.Keyspace()),
+		zap.String("changefeed", q.changefeedID.Name()),
+		zap.String("id", record.ID),
+		zap.String("eventType", string(record.EventType)),
+		zap.String("schema", record.Schema),
+		zap.String("table", record.Table),
+		zap.Uint64("commitTs", record.CommitTs),
+		zap.String("error", record.Error))
+	return nil
+}
+
+// List returns at most limit records of the changefeed, sorted by the commit ts.
+func (q *Queue) List(ctx context.Context, limit int) ([]*Record, error) {
+	records, err := q.backend.list(ctx, limit)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrDeadLetterQueueFailed, err)
+	}
+	return records, nil
+}
+
+// Get returns the records of the ids, the records not found are ignored.
+func (q *Queue) Get(ctx context.Context, ids []string) ([]*Record, error) {
+	for _, id := range ids {
+		if err := ValidateRecordID(id); err != nil {
+			return nil, err
+		}
+	}
+	records, err := q.backend.get(ctx, ids)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrDeadLetterQueueFailed, err)
+	}
+	return records, nil
+}
+
+// Remove removes the records from the dead letter queue.
+func (q *Queue) Remove(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		if err := ValidateRecordID(id); err != nil {
+			return err
+		}
+	}
+	if err := q.backend.remove(ctx, ids); err != nil {
+		return errors.WrapError(errors.ErrDeadLetterQueueFailed, err)
+	}
+	return nil
+}
+
+// Close closes the dead letter queue.
+func (q *Queue) Close() {
+	if q == nil {
+		return
+	}
+	q.backend.close()
+	// The queue opened to manage the records does not own the metrics.
+	if q.metricWriteDuration == nil {
+		return
+	}
+	metrics.DeadLetterWriteDuration.DeleteLabelValues(q.changefeedID.Keyspace(), q.changefeedID.Name())
+	for _, eventType := range []EventType{EventTypeDML, EventTypeDDL, EventTypeRow} {
+		metrics.DeadLetterEventCounter.DeleteLabelValues(
+			q.changefeedID.Keyspace(), q.changefeedID.Name(), string(eventType))
+	}
+}
+
+// IsRejectedError returns whether the error means the event is rejected by
+// the downstream, including the messages rejected by the kafka brokers. Such
+// an event fails again however many times it's retried, so it's written to
+// the dead letter queue. The errors caused by the network
+// or an unavailable downstream are never rejected errors, and neither is the
+// duplicate entry error, which is resolved by retrying the rows in safe mode.
+func IsRejectedError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.ErrMessageTooLarge.Equal(errors.Cause(err)) || kafka.IsMessageRejected(err) {
+		return true
+	}
+	var mysqlErr *dmysql.MySQLError
+	if !errors.As(errors.Cause(err), &mysqlErr) {
+		return false
+	}
+	switch mysqlErr.Number {
+	case mysql.ErrDataTooLong, mysql.ErrTruncatedWrongValue, mysql.ErrTruncatedWrongValueForField,
+		mysql.ErrWarnDataOutOfRange, mysql.ErrInvalidCharacterString, mysql.ErrInvalidJSONText,
+		mysql.ErrWrongValueForType, mysql.ErrBadNull, mysql.ErrNoDefaultForField, mysql.ErrNoReferencedRow,
+		mysql.ErrNoReferencedRow2, mysql.ErrRowIsReferenced, mysql.ErrRowIsReferenced2,
+		mysql.ErrCheckConstraintViolated, mysql.ErrParse, mysql.ErrSyntax:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	dmysql "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenWithoutDeadLetterQueue(t *testing.T) {
+	t.Parallel()
+
+	changefeedID := common.NewChangefeedID4Test("default", "test")
+	q, err := New(context.Background(), changefeedID, &config.SinkConfig{})
+	require.NoError(t, err)
+	require.Nil(t, q)
+	// closing the nil queue is a no-op
+	q.Close()
+
+	_, err = Open(context.Background(), changefeedID, &config.SinkConfig{
+		DeadLetterQueue: &config.DeadLetterQueueConfig{URI: "pulsar://127.0.0.1:6650/dlq"},
+	})
+	require.ErrorContains(t, err, "unsupported destination pulsar")
+}
+
+func TestStorageQueue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	changefeedID := common.NewChangefeedID4Test("default", "test")
+	sinkConfig := &config.SinkConfig{
+		DeadLetterQueue: &config.DeadLetterQueueConfig{URI: "file://" + t.TempDir()},
+	}
+	q, err := New(ctx, changefeedID, sinkConfig)
+	require.NoError(t, err)
+	defer q.Close()
+	require.Equal(t, config.DefaultDeadLetterQueueMaxRetries, q.MaxRetries())
+
+	cause := &dmysql.MySQLError{Number: mysql.ErrDataTooLong, Message: "Data too long"}
+	for _, commitTs := range []uint64{30, 10, 20} {
+		require.NoError(t, q.WriteDDLEvent(&commonEvent.DDLEvent{
+			Type:       byte(timodel.ActionAddColumn),
+			Query:      fmt.Sprintf("ALTER TABLE t ADD COLUMN c%d varchar(10)", commitTs),
+			SchemaName: "test",
+			TableName:  "t",
+			StartTs:    commitTs - 1,
+			FinishedTs: commitTs,
+		}, cause))
+	}
+
+	// the records are sorted by the commit ts
+	records, err := q.List(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	for i, commitTs := range []uint64{10, 20, 30} {
+		record := records[i]
+		require.NoError(t, ValidateRecordID(record.ID))
+		require.Equal(t, EventTypeDDL, record.EventType)
+		require.Equal(t, "default", record.Keyspace)
+		require.Equal(t, "test", record.Changefeed)
+		require.Equal(t, "test", record.Schema)
+		require.Equal(t, "t", record.Table)
+		require.Equal(t, commitTs, record.CommitTs)
+		require.Equal(t, config.DefaultDeadLetterQueueMaxRetries, record.Retries)
+		require.Equal(t, cause.Error(), record.Error)
+		require.Equal(t, []*Statement{
+			{Query: "USE `test`"},
+			{Query: fmt.Sprintf("ALTER TABLE t ADD COLUMN c%d varchar(10)", commitTs)},
+		}, record.Statements)
+	}
+	limited, err := q.List(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, records[:2], limited)
+
+	// the records of other changefeeds are not visible
+	other, err := Open(ctx, common.NewChangefeedID4Test("default", "other"), sinkConfig)
+	require.NoError(t, err)
+	defer other.Close()
+	otherRecords, err := other.List(ctx, 0)
+	require.NoError(t, err)
+	require.Empty(t, otherRecords)
+
+	// the missing records are ignored
+	missing := fmt.Sprintf("%020d-00000000-0000-0000-0000-000000000000", 1)
+	got, err := q.Get(ctx, []string{records[1].ID, missing})
+	require.NoError(t, err)
+	require.Equal(t, []*Record{records[1]}, got)
+
+	require.NoError(t, q.Remove(ctx, []string{records[0].ID, records[2].ID}))
+	records, err = q.List(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, got, records)
+
+	_, err = q.Get(ctx, []string{"../../etc/passwd"})
+	require.ErrorContains(t, err, "invalid record id")
+	require.ErrorContains(t, q.Remove(ctx, []string{"1"}), "invalid record id")
+}
+
+func TestIsRejectedError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		rejected bool
+	}{
+		{name: "nil", err: nil},
+		{
+			name:     "data too long",
+			err:      &dmysql.MySQLError{Number: mysql.ErrDataTooLong},
+			rejected: true,
+		},
+		{
+			name: "wrapped bad null",
+			err: errors.WrapError(errors.ErrMySQLTxnError,
+				&dmysql.MySQLError{Number: mysql.ErrBadNull}),
+			rejected: true,
+		},
+		{
+			name: "duplicate entry",
+			err: errors.WrapError(errors.ErrMySQLTxnError,
+				&dmysql.MySQLError{Number: mysql.ErrDupEntry}),
+		},
+		{
+			name:     "check constraint",
+			err:      &dmysql.MySQLError{Number: mysql.ErrCheckConstraintViolated},
+			rejected: true,
+		},
+		{
+			name:     "message too large",
+			err:      errors.ErrMessageTooLarge.GenWithStackByArgs("test.t", 2048, 1024),
+			rejected: true,
+		},
+		{
+			name:     "message size too large",
+			err:      errors.WrapError(errors.ErrKafkaSendMessage, sarama.ErrMessageSizeTooLarge),
+			rejected: true,
+		},
+		{name: "not leader", err: errors.WrapError(errors.ErrKafkaSendMessage, sarama.ErrNotLeaderForPartition)},
+		{name: "no such table", err: &dmysql.MySQLError{Number: mysql.ErrNoSuchTable}},
+		{name: "bad connection", err: dmysql.ErrInvalidConn},
+		{name: "context canceled", err: context.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.rejected, IsRejectedError(tt.err))
+		})
+	}
+}
+
+func TestArgsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	event := &commonEvent.DMLEvent{StartTs: 1, CommitTs: 2}
+	record := newDMLRecord(common.NewChangefeedID4Test("default", "test"), event,
+		[]string{"INSERT INTO `test`.`t` (`id`,`name`,`data`) VALUES (?,?,?)", "COMMIT"},
+		[][]interface{}{{int64(1), nil, []byte{0, 1}}}, 3, nil)
+	require.Equal(t, EventTypeDML, record.EventType)
+	require.Empty(t, record.Error)
+	require.Len(t, record.Statements, 2)
+	require.Equal(t, []interface{}{[]byte("1"), nil, []byte{0, 1}}, record.Statements[0].args())
+	require.Nil(t, record.Statements[1].args())
+}
+
+func TestArgToBytes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		arg      interface{}
+		expected []byte
+	}{
+		{name: "nil", arg: nil, expected: nil},
+		{name: "bytes", arg: []byte{0, 1}, expected: []byte{0, 1}},
+		{name: "string", arg: "a'b", expected: []byte("a'b")},
+		{name: "int8", arg: int8(-8), expected: []byte("-8")},
+		{name: "int64", arg: int64(math.MinInt64), expected: []byte("-9223372036854775808")},
+		{name: "uint32", arg: uint32(math.MaxUint32), expected: []byte("4294967295")},
+		{name: "uint64", arg: uint64(math.MaxUint64), expected: []byte("18446744073709551615")},
+		{name: "float32", arg: float32(0.5), expected: []byte("0.5")},
+		{name: "float64", arg: 1e21, expected: []byte("1e+21")},
+		{name: "bool", arg: true, expected: []byte("1")},
+		{
+			name:     "time",
+			arg:      time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC),
+			expected: []byte("2026-01-02 03:04:05.123456"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, argToBytes(tt.arg))
+		})
+	}
+}
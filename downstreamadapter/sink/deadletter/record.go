@@ -0,0 +1,239 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"go.uber.org/zap"
+)
+
+// EventType is the type of the event in a dead letter record.
+type EventType string
+
+const (
+	// EventTypeDML is a transaction rejected by the MySQL compatible downstream.
+	EventTypeDML EventType = "dml"
+	// EventTypeDDL is a DDL rejected by the downstream.
+	EventTypeDDL EventType = "ddl"
+	// EventTypeRow is a row rejected by the mq downstream.
+	EventTypeRow EventType = "row"
+)
+
+// timeFormat is the format of the time arguments, it keeps the microseconds.
+const timeFormat = "2006-01-02 15:04:05.999999"
+
+// idPattern matches the id of the records, the commit ts is the prefix of the
+// id, so the records are sorted by the commit ts.
+var idPattern = regexp.MustCompile(`^[0-9]{20}-[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// Statement is a statement executed by the MySQL compatible downstream.
+type Statement struct {
+	Query string `json:"query"`
+	// Args are the arguments of the query, the nil ones are NULL. The values
+	// are kept as bytes, so they are replayed without losing the precision.
+	Args [][]byte `json:"args,omitempty"`
+}
+
+// Record is an event written to the dead letter queue.
+type Record struct {
+	ID         string    `json:"id"`
+	Keyspace   string    `json:"keyspace"`
+	Changefeed string    `json:"changefeed"`
+	EventType  EventType `json:"event_type"`
+	Schema     string    `json:"schema"`
+	Table      string    `json:"table"`
+	StartTs    uint64    `json:"start_ts"`
+	CommitTs   uint64    `json:"commit_ts"`
+	// Statements are the statements of the event, they are replayed to the
+	// downstream after the cause of the error is fixed.
+	Statements []*Statement `json:"statements,omitempty"`
+	// PreRow and Row are the column values of the row rejected by the mq
+	// downstream.
+	PreRow     map[string]interface{} `json:"pre_row,omitempty"`
+	Row        map[string]interface{} `json:"row,omitempty"`
+	Error      string                 `json:"error"`
+	Retries    int                    `json:"retries"`
+	CreateTime time.Time              `json:"create_time"`
+}
+
+func newRecord(
+	changefeedID common.ChangeFeedID, eventType EventType, commitTs uint64, retries int, cause error,
+) *Record {
+	record := &Record{
+		ID:         fmt.Sprintf("%020d-%s", commitTs, uuid.NewString()),
+		Keyspace:   changefeedID.Keyspace(),
+		Changefeed: changefeedID.Name(),
+		EventType:  eventType,
+		CommitTs:   commitTs,
+		Retries:    retries,
+		CreateTime: time.Now(),
+	}
+	if cause != nil {
+		record.Error = cause.Error()
+	}
+	return record
+}
+
+func newDMLRecord(
+	changefeedID common.ChangeFeedID,
+	event *commonEvent.DMLEvent,
+	sqls []string,
+	args [][]interface{},
+	retries int,
+	cause error,
+) *Record {
+	record := newRecord(changefeedID, EventTypeDML, event.CommitTs, retries, cause)
+	record.StartTs = event.StartTs
+	if event.TableInfo != nil {
+		record.Schema = event.TableInfo.GetSchemaName()
+		record.Table = event.TableInfo.GetTableName()
+	}
+	record.Statements = make([]*Statement, 0, len(sqls))
+	for i, query := range sqls {
+		stmt := &Statement{Query: query}
+		if i < len(args) && len(args[i]) > 0 {
+			stmt.Args = make([][]byte, 0, len(args[i]))
+			for _, arg := range args[i] {
+				stmt.Args = append(stmt.Args, argToBytes(arg))
+			}
+		}
+		record.Statements = append(record.Statements, stmt)
+	}
+	return record
+}
+
+func newDDLRecord(
+	changefeedID common.ChangeFeedID, event *commonEvent.DDLEvent, retries int, cause error,
+) *Record {
+	record := newRecord(changefeedID, EventTypeDDL, event.GetCommitTs(), retries, cause)
+	record.StartTs = event.GetStartTs()
+	record.Schema = event.GetSchemaName()
+	record.Table = event.GetTableName()
+	// The DDL is executed in the target schema, except the DDLs of the schema itself.
+	schema := event.GetTargetSchemaName()
+	if schema != "" &&
+		event.GetDDLType() != timodel.ActionCreateSchema && event.GetDDLType() != timodel.ActionDropSchema {
+		record.Statements = append(record.Statements, &Statement{Query: "USE " + common.QuoteName(schema)})
+	}
+	record.Statements = append(record.Statements, &Statement{Query: event.GetDDLQuery()})
+	return record
+}
+
+func newRowRecord(
+	changefeedID common.ChangeFeedID, event *commonEvent.RowEvent, retries int, cause error,
+) *Record {
+	record := newRecord(changefeedID, EventTypeRow, event.CommitTs, retries, cause)
+	record.StartTs = event.StartTs
+	if event.TableInfo != nil {
+		record.Schema = event.TableInfo.GetSchemaName()
+		record.Table = event.TableInfo.GetTableName()
+		record.PreRow = rowValues(event.TableInfo, &event.Event.PreRow)
+		record.Row = rowValues(event.TableInfo, &event.Event.Row)
+	}
+	return record
+}
+
+func rowValues(tableInfo *common.TableInfo, row *chunk.Row) map[string]interface{} {
+	if row.IsEmpty() {
+		return nil
+	}
+	columns := tableInfo.GetColumns()
+	values := make(map[string]interface{}, len(columns))
+	for i, col := range columns {
+		if col == nil {
+			continue
+		}
+		values[col.Name.O] = common.ExtractColVal(row, col, i)
+	}
+	return values
+}
+
+// argToBytes converts an argument of the statement to bytes, the nil one is
+// kept as nil, so it is replayed as NULL. The argument is converted by the
+// driver's default converter and formatted like the driver interpolates it,
+// so the downstream converts it to the same column value when it's replayed.
+func argToBytes(arg interface{}) []byte {
+	// The default converter rejects the uint64 values overflowing int64.
+	if v, ok := arg.(uint64); ok {
+		return strconv.AppendUint(nil, v, 10)
+	}
+	value, err := driver.DefaultParameterConverter.ConvertValue(arg)
+	if err != nil {
+		// The argument can not be executed by the driver either, so the
+		// statement is never rejected by the downstream with it.
+		log.Warn("unsupported dead letter statement argument",
+			zap.String("type", fmt.Sprintf("%T", arg)), zap.Error(err))
+		return []byte(fmt.Sprint(arg))
+	}
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		b := make([]byte, len(v))
+		copy(b, v)
+		return b
+	case string:
+		return []byte(v)
+	case int64:
+		return strconv.AppendInt(nil, v, 10)
+	case float64:
+		return strconv.AppendFloat(nil, v, 'g', -1, 64)
+	case bool:
+		if v {
+			return []byte("1")
+		}
+		return []byte("0")
+	case time.Time:
+		return []byte(v.Format(timeFormat))
+	default:
+		return []byte(fmt.Sprint(v))
+	}
+}
+
+// args returns the arguments of the statement which are passed to the driver.
+func (s *Statement) args() []interface{} {
+	if len(s.Args) == 0 {
+		return nil
+	}
+	args := make([]interface{}, 0, len(s.Args))
+	for _, arg := range s.Args {
+		if arg == nil {
+			args = append(args, nil)
+			continue
+		}
+		args = append(args, arg)
+	}
+	return args
+}
+
+// ValidateRecordID checks the id of a record, it's used to check the ids given
+// by the users before they are used as the file names or the query arguments.
+func ValidateRecordID(id string) error {
+	if !idPattern.MatchString(id) {
+		return errors.ErrDeadLetterQueueFailed.GenWithStackByArgs(fmt.Sprintf("invalid record id %s", id))
+	}
+	return nil
+}
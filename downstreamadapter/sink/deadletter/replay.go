@@ -0,0 +1,91 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/sink/mysql"
+	"github.com/pingcap/ticdc/pkg/util"
+	"go.uber.org/zap"
+)
+
+// OpenDownstream opens the MySQL compatible downstream of the changefeed,
+// which the records are replayed to.
+func OpenDownstream(changefeedID common.ChangeFeedID, cfConfig *config.ChangefeedConfig) (*sql.DB, error) {
+	sinkURI, err := url.Parse(cfConfig.SinkURI)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrSinkURIInvalid, err,
+			util.MaskSensitiveDataInURIForError(cfConfig.SinkURI))
+	}
+	if !config.IsMySQLCompatibleScheme(config.GetScheme(sinkURI)) {
+		return nil, errors.ErrDeadLetterQueueFailed.GenWithStackByArgs(
+			"the records can only be replayed to a MySQL compatible downstream")
+	}
+	mysqlCfg := mysql.New()
+	if err = mysqlCfg.Apply(sinkURI, changefeedID, cfConfig); err != nil {
+		return nil, err
+	}
+	dsn, err := mysql.GenBasicDSN(mysqlCfg)
+	if err != nil {
+		return nil, err
+	}
+	return mysql.CreateMysqlDBConn(dsn.FormatDSN())
+}
+
+// Replay executes the statements of the record in a transaction against the
+// MySQL compatible downstream. It's used after the cause of the error, such as
+// a too narrow column, is fixed in the downstream.
+func Replay(ctx context.Context, db *sql.DB, record *Record) error {
+	if record.EventType == EventTypeRow || len(record.Statements) == 0 {
+		return errors.ErrDeadLetterQueueFailed.GenWithStackByArgs(
+			"the record " + record.ID + " has no statement to replay")
+	}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return errors.WrapError(errors.ErrMySQLConnectionError, err)
+	}
+	defer conn.Close()
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WrapError(errors.ErrMySQLTxnError, err)
+	}
+	for _, stmt := range record.Statements {
+		if _, err = tx.ExecContext(ctx, stmt.Query, stmt.args()...); err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				log.Warn("failed to rollback the replayed dead letter record",
+					zap.String("id", record.ID), zap.Error(rbErr))
+			}
+			return errors.WrapError(errors.ErrMySQLTxnError, err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return errors.WrapError(errors.ErrMySQLTxnError, err)
+	}
+	log.Info("dead letter record replayed",
+		zap.String("keyspace", record.Keyspace),
+		zap.String("changefeed", record.Changefeed),
+		zap.String("id", record.ID),
+		zap.String("schema", record.Schema),
+		zap.String("table", record.Table),
+		zap.Uint64("commitTs", record.CommitTs))
+	return nil
+}
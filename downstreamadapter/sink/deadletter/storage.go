@@ -0,0 +1,131 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/pingcap/tidb/pkg/objstore/storeapi"
+)
+
+const recordFileExt = ".json"
+
+// storageBackend writes each record as a json file to the external storage,
+// the files of a changefeed are in the <keyspace>/<changefeed> directory.
+type storageBackend struct {
+	storage storeapi.Storage
+	dir     string
+}
+
+func newStorageBackend(ctx context.Context, changefeedID common.ChangeFeedID, uri string) (*storageBackend, error) {
+	storage, err := util.GetExternalStorageWithDefaultTimeout(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	return &storageBackend{
+		storage: storage,
+		dir:     path.Join(changefeedID.Keyspace(), changefeedID.Name()),
+	}, nil
+}
+
+func (b *storageBackend) fileName(id string) string {
+	return path.Join(b.dir, id+recordFileExt)
+}
+
+func (b *storageBackend) write(ctx context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errors.WrapError(errors.ErrMarshalFailed, err)
+	}
+	return b.storage.WriteFile(ctx, b.fileName(record.ID), data)
+}
+
+func (b *storageBackend) list(ctx context.Context, limit int) ([]*Record, error) {
+	var files []string
+	err := b.storage.WalkDir(ctx, &storeapi.WalkOption{SubDir: b.dir}, func(filePath string, _ int64) error {
+		if strings.HasSuffix(filePath, recordFileExt) {
+			files = append(files, filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// The file names start with the commit ts, so they are sorted by the commit ts.
+	sort.Strings(files)
+	if limit > 0 && len(files) > limit {
+		files = files[:limit]
+	}
+
+	records := make([]*Record, 0, len(files))
+	for _, file := range files {
+		record, err := b.readRecord(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (b *storageBackend) get(ctx context.Context, ids []string) ([]*Record, error) {
+	records := make([]*Record, 0, len(ids))
+	for _, id := range ids {
+		exists, err := b.storage.FileExists(ctx, b.fileName(id))
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !exists {
+			continue
+		}
+		record, err := b.readRecord(ctx, b.fileName(id))
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (b *storageBackend) readRecord(ctx context.Context, fileName string) (*Record, error) {
+	data, err := b.storage.ReadFile(ctx, fileName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	record := &Record{}
+	if err = json.Unmarshal(data, record); err != nil {
+		return nil, errors.WrapError(errors.ErrUnmarshalFailed, err)
+	}
+	return record, nil
+}
+
+func (b *storageBackend) remove(ctx context.Context, ids []string) error {
+	for _, id := range ids {
+		if err := b.storage.DeleteFile(ctx, b.fileName(id)); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (b *storageBackend) close() {
+	b.storage.Close()
+}
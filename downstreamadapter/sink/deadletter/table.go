@@ -0,0 +1,194 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deadletter
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+	"github.com/pingcap/ticdc/pkg/sink/mysql"
+	"go.uber.org/zap"
+)
+
+// tableBackend writes the records to a table in the tidb_cdc schema of a
+// MySQL compatible database.
+type tableBackend struct {
+	db           *sql.DB
+	table        string
+	changefeedID common.ChangeFeedID
+}
+
+func newTableBackend(
+	ctx context.Context,
+	changefeedID common.ChangeFeedID,
+	uri *url.URL,
+	table string,
+	sinkConfig *config.SinkConfig,
+) (*tableBackend, error) {
+	mysqlCfg := mysql.New()
+	if err := mysqlCfg.Apply(uri, changefeedID, &config.ChangefeedConfig{SinkConfig: sinkConfig}); err != nil {
+		return nil, err
+	}
+	dsn, err := mysql.GenBasicDSN(mysqlCfg)
+	if err != nil {
+		return nil, err
+	}
+	db, err := mysql.CreateMysqlDBConn(dsn.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+	if table == "" {
+		table = config.DefaultDeadLetterQueueTable
+	}
+	b := &tableBackend{db: db, table: table, changefeedID: changefeedID}
+	if err = b.createTable(ctx); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *tableBackend) quotedTable() string {
+	return common.QuoteSchema(filter.TiCDCSystemSchema, b.table)
+}
+
+func buildDeadLetterTableQuery(quotedTable string) string {
+	query := `CREATE TABLE IF NOT EXISTS %s
+	(
+		id varchar(64) NOT NULL,
+		keyspace varchar(255) NOT NULL,
+		changefeed varchar(255) NOT NULL,
+		event_type varchar(16) NOT NULL,
+		source_schema varchar(255) NOT NULL,
+		source_table varchar(255) NOT NULL,
+		commit_ts bigint unsigned NOT NULL,
+		record longtext NOT NULL,
+		error_message text,
+		created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (keyspace, changefeed, id)
+	);`
+	return fmt.Sprintf(query, quotedTable)
+}
+
+func (b *tableBackend) createTable(ctx context.Context) error {
+	_, err := b.db.ExecContext(ctx,
+		"CREATE DATABASE IF NOT EXISTS "+common.QuoteName(filter.TiCDCSystemSchema))
+	if err != nil {
+		return errors.WrapError(errors.ErrMySQLTxnError, err)
+	}
+	if _, err = b.db.ExecContext(ctx, buildDeadLetterTableQuery(b.quotedTable())); err != nil {
+		return errors.WrapError(errors.ErrMySQLTxnError, err)
+	}
+	return nil
+}
+
+func (b *tableBackend) write(ctx context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return errors.WrapError(errors.ErrMarshalFailed, err)
+	}
+	_, err = b.db.ExecContext(ctx,
+		"INSERT INTO "+b.quotedTable()+
+			" (id, keyspace, changefeed, event_type, source_schema, source_table, commit_ts, record, error_message)"+
+			" VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		record.ID, record.Keyspace, record.Changefeed, string(record.EventType),
+		record.Schema, record.Table, record.CommitTs, string(data), record.Error)
+	if err != nil {
+		return errors.WrapError(errors.ErrMySQLTxnError, err)
+	}
+	return nil
+}
+
+func (b *tableBackend) list(ctx context.Context, limit int) ([]*Record, error) {
+	query := "SELECT record FROM " + b.quotedTable() + " WHERE keyspace = ? AND changefeed = ? ORDER BY id"
+	args := []interface{}{b.changefeedID.Keyspace(), b.changefeedID.Name()}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+	return b.queryRecords(ctx, query, args...)
+}
+
+func (b *tableBackend) get(ctx context.Context, ids []string) ([]*Record, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	query, args := b.buildIDsCondition(ids)
+	return b.queryRecords(ctx, "SELECT record FROM "+b.quotedTable()+query+" ORDER BY id", args...)
+}
+
+func (b *tableBackend) queryRecords(ctx context.Context, query string, args ...interface{}) ([]*Record, error) {
+	rows, err := b.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	defer rows.Close()
+
+	var records []*Record
+	for rows.Next() {
+		var data string
+		if err = rows.Scan(&data); err != nil {
+			return nil, errors.WrapError(errors.ErrMySQLQueryError, err)
+		}
+		record := &Record{}
+		if err = json.Unmarshal([]byte(data), record); err != nil {
+			return nil, errors.WrapError(errors.ErrUnmarshalFailed, err)
+		}
+		records = append(records, record)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	return records, nil
+}
+
+// buildIDsCondition builds the where clause to match the records of the ids.
+func (b *tableBackend) buildIDsCondition(ids []string) (string, []interface{}) {
+	args := make([]interface{}, 0, len(ids)+2)
+	args = append(args, b.changefeedID.Keyspace(), b.changefeedID.Name())
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	return " WHERE keyspace = ? AND changefeed = ? AND id IN (" +
+		strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",") + ")", args
+}
+
+func (b *tableBackend) remove(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	query, args := b.buildIDsCondition(ids)
+	if _, err := b.db.ExecContext(ctx, "DELETE FROM "+b.quotedTable()+query, args...); err != nil {
+		return errors.WrapError(errors.ErrMySQLTxnError, err)
+	}
+	return nil
+}
+
+func (b *tableBackend) close() {
+	if err := b.db.Close(); err != nil {
+		log.Warn("failed to close the dead letter queue db",
+			zap.String("keyspace", b.changefeedID.Keyspace()),
+			zap.String("changefeed", b.changefeedID.Name()),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/httputil"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+const (
+	contentTypeJSON   = "application/json"
+	contentTypeNDJSON = "application/x-ndjson"
+)
+
+// client is a thin wrapper of the elasticsearch REST API, it's compatible
+// with both elasticsearch and opensearch.
+type client struct {
+	httpClient *httputil.Client
+	endpoints  []string
+	username   string
+	password   string
+	// next is the index of the endpoint used by the next request,
+	// the requests may be sent concurrently.
+	next atomic.Int64
+}
+
+func newClient(opts *options) (*client, error) {
+	httpClient, err := httputil.NewClient(opts.credential)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrElasticsearchInvalidConfig, err)
+	}
+	httpClient.SetTimeout(opts.requestTimeout)
+	return &client{
+		httpClient: httpClient,
+		endpoints:  opts.endpoints,
+		username:   opts.username,
+		password:   opts.password,
+	}, nil
+}
+
+// do sends the request to the endpoints in turn until one of them responds,
+// it returns the status code and the body of the response.
+func (c *client) do(
+	ctx context.Context, method, path, contentType string, body []byte,
+) (int, []byte, error) {
+	var lastErr error
+	for i := 0; i < len(c.endpoints); i++ {
+		next := c.next.Load()
+		endpoint := c.endpoints[next]
+		req, err := http.NewRequestWithContext(ctx, method, endpoint+path, bytes.NewReader(body))
+		if err != nil {
+			return 0, nil, errors.WrapError(errors.ErrElasticsearchRequestFailed, err)
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		if c.username != "" {
+			req.SetBasicAuth(c.username, c.password)
+		}
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return 0, nil, errors.Trace(ctx.Err())
+			}
+			log.Warn("elasticsearch request failed, try the next endpoint",
+				zap.String("endpoint", endpoint), zap.Error(err))
+			c.next.CompareAndSwap(next, (next+1)%int64(len(c.endpoints)))
+			lastErr = err
+			continue
+		}
+		content, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return 0, nil, errors.WrapError(errors.ErrElasticsearchRequestFailed, err)
+		}
+		return resp.StatusCode, content, nil
+	}
+	return 0, nil, errors.WrapError(errors.ErrElasticsearchRequestFailed, lastErr)
+}
+
+// doJSON is like do, but it treats the status codes other than 2xx as errors.
+func (c *client) doJSON(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	status, content, err := c.do(ctx, method, path, contentTypeJSON, body)
+	if err != nil {
+		return nil, err
+	}
+	if status/100 != 2 {
+		return nil, errors.ErrElasticsearchRequestFailed.GenWithStack(
+			"%s %s: [%d] %s", method, path, status, content)
+	}
+	return content, nil
+}
+
+// ping checks whether the cluster is reachable.
+func (c *client) ping(ctx context.Context) error {
+	_, err := c.doJSON(ctx, http.MethodGet, "/", nil)
+	return err
+}
+
+// ensureIndex creates the index with the given mapping if it doesn't exist.
+func (c *client) ensureIndex(ctx context.Context, index string, properties map[string]any) error {
+	path := "/" + url.PathEscape(index)
+	status, _, err := c.do(ctx, http.MethodHead, path, "", nil)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusOK {
+		return c.putMapping(ctx, index, properties)
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"mappings": map[string]any{"properties": properties},
+	})
+	if err != nil {
+		return errors.WrapError(errors.ErrElasticsearchRequestFailed, err)
+	}
+	status, content, err := c.do(ctx, http.MethodPut, path, contentTypeJSON, body)
+	if err != nil {
+		return err
+	}
+	// the index may be created by others concurrently.
+	if status/100 != 2 && !strings.Contains(string(content), "resource_already_exists_exception") {
+		return errors.ErrElasticsearchRequestFailed.GenWithStack(
+			"create index %s: [%d] %s", index, status, content)
+	}
+	return nil
+}
+
+// putMapping adds the fields into the mapping of the index. The type of an
+// existing field can't be changed in elasticsearch, such conflicts are only
+// logged since the documents can still be indexed in most cases.
+func (c *client) putMapping(ctx context.Context, index string, properties map[string]any) error {
+	if len(properties) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(map[string]any{"properties": properties})
+	if err != nil {
+		return errors.WrapError(errors.ErrElasticsearchRequestFailed, err)
+	}
+	status, content, err := c.do(ctx, http.MethodPut,
+		"/"+url.PathEscape(index)+"/_mapping", contentTypeJSON, body)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusBadRequest {
+		log.Warn("elasticsearch sink can't update the mapping, ignore it",
+			zap.String("index", index), zap.ByteString("response", content))
+		return nil
+	}
+	if status/100 != 2 {
+		return errors.ErrElasticsearchRequestFailed.GenWithStack(
+			"put mapping %s: [%d] %s", index, status, content)
+	}
+	return nil
+}
+
+// deleteIndex deletes the index, it's ok if the index doesn't exist.
+func (c *client) deleteIndex(ctx context.Context, index string) error {
+	status, content, err := c.do(ctx, http.MethodDelete, "/"+url.PathEscape(index), "", nil)
+	if err != nil {
+		return err
+	}
+	if status/100 != 2 && status != http.StatusNotFound {
+		return errors.ErrElasticsearchRequestFailed.GenWithStack(
+			"delete index %s: [%d] %s", index, status, content)
+	}
+	return nil
+}
+
+// deleteAllDocuments deletes all documents in the index, but keeps the mapping.
+func (c *client) deleteAllDocuments(ctx context.Context, index string) error {
+	body := []byte(`{"query":{"match_all":{}}}`)
+	status, content, err := c.do(ctx, http.MethodPost,
+		"/"+url.PathEscape(index)+"/_delete_by_query?refresh=true", contentTypeJSON, body)
+	if err != nil {
+		return err
+	}
+	if status/100 != 2 && status != http.StatusNotFound {
+		return errors.ErrElasticsearchRequestFailed.GenWithStack(
+			"delete documents in %s: [%d] %s", index, status, content)
+	}
+	return nil
+}
+
+type bulkResponse struct {
+	Errors bool                                `json:"errors"`
+	Items  []map[string]bulkResponseItemResult `json:"items"`
+}
+
+type bulkResponseItemResult struct {
+	Index  string          `json:"_index"`
+	ID     string          `json:"_id"`
+	Status int             `json:"status"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// bulk sends the actions in one bulk request. Deleting a document which
+// doesn't exist is not treated as an error.
+func (c *client) bulk(ctx context.Context, body []byte) error {
+	status, content, err := c.do(ctx, http.MethodPost, "/_bulk", contentTypeNDJSON, body)
+	if err != nil {
+		return err
+	}
+	if status/100 != 2 {
+		return errors.ErrElasticsearchRequestFailed.GenWithStack("bulk: [%d] %s", status, content)
+	}
+	var resp bulkResponse
+	if err = json.Unmarshal(content, &resp); err != nil {
+		return errors.WrapError(errors.ErrElasticsearchRequestFailed, err)
+	}
+	if !resp.Errors {
+		return nil
+	}
+	for _, item := range resp.Items {
+		for action, result := range item {
+			if result.Status/100 == 2 {
+				continue
+			}
+			if action == bulkActionDelete && result.Status == http.StatusNotFound {
+				continue
+			}
+			return errors.ErrElasticsearchRequestFailed.GenWithStack(
+				"bulk %s document %s in %s: [%d] %s",
+				action, result.ID, result.Index, result.Status, result.Error)
+		}
+	}
+	return nil
+}
+
+func (c *client) close() {
+	c.httpClient.CloseIdleConnections()
+}
+
+// bulkRequestBuilder builds the NDJSON body of a bulk request.
+type bulkRequestBuilder struct {
+	buf   bytes.Buffer
+	count int
+}
+
+const (
+	bulkActionIndex  = "index"
+	bulkActionDelete = "delete"
+)
+
+func (b *bulkRequestBuilder) addIndex(index, id string, doc map[string]any) error {
+	b.writeAction(bulkActionIndex, index, id)
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return errors.WrapError(errors.ErrElasticsearchRequestFailed, err)
+	}
+	b.buf.Write(data)
+	b.buf.WriteByte('\n')
+	return nil
+}
+
+func (b *bulkRequestBuilder) addDelete(index, id string) {
+	b.writeAction(bulkActionDelete, index, id)
+}
+
+func (b *bulkRequestBuilder) writeAction(action, index, id string) {
+	meta, _ := json.Marshal(map[string]map[string]string{
+		action: {"_index": index, "_id": id},
+	})
+	b.buf.Write(meta)
+	b.buf.WriteByte('\n')
+	b.count++
+}
+
+func (b *bulkRequestBuilder) reset() {
+	b.buf.Reset()
+	b.count = 0
+}
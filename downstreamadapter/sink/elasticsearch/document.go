@@ -0,0 +1,199 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"math"
+	"strings"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+// documentIDSeparator joins the values of the handle key columns, it's the
+// unit separator which is unlikely to appear in the key values.
+const documentIDSeparator = "\x1f"
+
+// genDocumentID generates the document ID from the handle key columns of the row,
+// so the same row is always mapped to the same document.
+func genDocumentID(row *chunk.Row, tableInfo *common.TableInfo) (string, error) {
+	colIDs := tableInfo.GetOrderedHandleKeyColumnIDs()
+	if len(colIDs) == 0 {
+		return "", errors.ErrElasticsearchInvalidConfig.GenWithStack(
+			"table %s.%s has neither primary key nor not null unique key, "+
+				"it can't be replicated to elasticsearch",
+			tableInfo.GetSchemaName(), tableInfo.GetTableName())
+	}
+	values := make([]string, 0, len(colIDs))
+	for _, colID := range colIDs {
+		// chunk.Row is laid out in schema column order, see genKeyList in the mysql sink.
+		offset := tableInfo.MustGetColumnOffsetByID(colID)
+		col := tableInfo.GetColumns()[offset]
+		value := common.ExtractColVal(row, col, offset)
+		values = append(values, common.ColumnValueString(value))
+	}
+	return strings.Join(values, documentIDSeparator), nil
+}
+
+// genDocument converts the row into a document, the fields are named after
+// the columns. Virtual generated columns are not included.
+func genDocument(row *chunk.Row, tableInfo *common.TableInfo) (map[string]any, error) {
+	columns := tableInfo.GetColumns()
+	doc := make(map[string]any, len(columns))
+	for offset, col := range columns {
+		if col == nil || col.IsVirtualGenerated() {
+			continue
+		}
+		value, err := extractFieldValue(row, col, offset)
+		if err != nil {
+			return nil, err
+		}
+		doc[col.Name.O] = value
+	}
+	return doc, nil
+}
+
+// extractFieldValue extracts the value of the column in the form accepted by
+// the field type returned by fieldMapping.
+func extractFieldValue(row *chunk.Row, col *model.ColumnInfo, offset int) (any, error) {
+	if row.IsNull(offset) {
+		return nil, nil
+	}
+	switch col.GetType() {
+	case mysql.TypeDate, mysql.TypeNewDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		t := row.GetTime(offset)
+		// the zero date can't be indexed as a date field.
+		if t.IsZero() || t.InvalidZero() {
+			return nil, nil
+		}
+		// keep a fixed number of fractional digits to match the field format.
+		if col.GetType() != mysql.TypeDate && col.GetType() != mysql.TypeNewDate && t.Fsp() > 0 {
+			t.SetFsp(types.MaxFsp)
+		}
+		return t.String(), nil
+	case mysql.TypeFloat:
+		v := float64(row.GetFloat32(offset))
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, nil
+		}
+		return v, nil
+	case mysql.TypeDouble:
+		v := row.GetFloat64(offset)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, nil
+		}
+		return v, nil
+	case mysql.TypeEnum:
+		// the name is not set if the row is decoded from the redo log,
+		// so it's resolved from the value.
+		enum, err := types.ParseEnumValue(col.GetElems(), row.GetEnum(offset).Value)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrEncodeFailed, err)
+		}
+		return enum.Name, nil
+	case mysql.TypeSet:
+		set, err := types.ParseSetValue(col.GetElems(), row.GetSet(offset).Value)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrEncodeFailed, err)
+		}
+		return set.Name, nil
+	}
+	value := common.ExtractColVal(row, col, offset)
+	// the string returned by ExtractColVal shares the memory with the chunk.
+	if s, ok := value.(string); ok {
+		return strings.Clone(s), nil
+	}
+	return value, nil
+}
+
+// genMapping returns the properties of the index mapping for the table.
+func genMapping(tableInfo *common.TableInfo) map[string]any {
+	columns := tableInfo.GetColumns()
+	properties := make(map[string]any, len(columns))
+	for _, col := range columns {
+		if col == nil || col.IsVirtualGenerated() {
+			continue
+		}
+		properties[col.Name.O] = fieldMapping(col)
+	}
+	return properties
+}
+
+// fieldMapping maps the column type to the elasticsearch field type.
+func fieldMapping(col *model.ColumnInfo) map[string]any {
+	unsigned := mysql.HasUnsignedFlag(col.GetFlag())
+	switch col.GetType() {
+	case mysql.TypeTiny:
+		if unsigned {
+			return map[string]any{"type": "short"}
+		}
+		return map[string]any{"type": "byte"}
+	case mysql.TypeShort:
+		if unsigned {
+			return map[string]any{"type": "integer"}
+		}
+		return map[string]any{"type": "short"}
+	case mysql.TypeInt24:
+		return map[string]any{"type": "integer"}
+	case mysql.TypeLong:
+		if unsigned {
+			return map[string]any{"type": "long"}
+		}
+		return map[string]any{"type": "integer"}
+	case mysql.TypeLonglong:
+		if unsigned {
+			return map[string]any{"type": "unsigned_long"}
+		}
+		return map[string]any{"type": "long"}
+	case mysql.TypeYear:
+		return map[string]any{"type": "short"}
+	case mysql.TypeBit:
+		return map[string]any{"type": "unsigned_long"}
+	case mysql.TypeFloat:
+		return map[string]any{"type": "float"}
+	case mysql.TypeDouble, mysql.TypeNewDecimal:
+		// the decimal is sent as a string, elasticsearch coerces it into a double.
+		return map[string]any{"type": "double"}
+	case mysql.TypeDate, mysql.TypeNewDate:
+		return map[string]any{"type": "date", "format": "yyyy-MM-dd"}
+	case mysql.TypeDatetime, mysql.TypeTimestamp:
+		return map[string]any{
+			"type":   "date",
+			"format": "yyyy-MM-dd HH:mm:ss||yyyy-MM-dd HH:mm:ss.SSSSSS||strict_date_optional_time",
+		}
+	case mysql.TypeDuration, mysql.TypeEnum, mysql.TypeSet:
+		return map[string]any{"type": "keyword"}
+	case mysql.TypeJSON:
+		return map[string]any{"type": "text"}
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		// the binary values are encoded in base64 by json.Marshal.
+		if col.GetCharset() == charset.CharsetBin {
+			return map[string]any{"type": "binary"}
+		}
+		return map[string]any{
+			"type": "text",
+			"fields": map[string]any{
+				"keyword": map[string]any{"type": "keyword", "ignore_above": 256},
+			},
+		}
+	default:
+		return map[string]any{"type": "keyword"}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"testing"
+
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenDocument(t *testing.T) {
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+
+	helper.DDL2Event(`create table t (
+		a int, b varchar(16), c bigint unsigned, d double, e decimal(10, 2),
+		f date, g datetime(3), h enum('x', 'y'), i set('x', 'y'), j varbinary(8),
+		k json, l int as (a + 1) virtual,
+		primary key (a, b))`)
+	event := helper.DML2Event("test", "t", `insert into t (a, b, c, d, e, f, g, h, i, j, k) values
+		(1, 'b1', 18446744073709551615, 1.5, 12.34, '2026-01-02', '2026-01-02 03:04:05.6',
+		'y', 'x,y', 0x0102, '{"k": 1}')`)
+	row, ok := event.GetNextRow()
+	require.True(t, ok)
+
+	id, err := genDocumentID(&row.Row, event.TableInfo)
+	require.NoError(t, err)
+	require.Equal(t, "1"+documentIDSeparator+"b1", id)
+
+	doc, err := genDocument(&row.Row, event.TableInfo)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"a": int64(1),
+		"b": "b1",
+		"c": uint64(18446744073709551615),
+		"d": 1.5,
+		"e": "12.34",
+		"f": "2026-01-02",
+		"g": "2026-01-02 03:04:05.600000",
+		"h": "y",
+		"i": "x,y",
+		"j": []byte{0x01, 0x02},
+		"k": `{"k": 1}`,
+	}, doc)
+
+	// the rows decoded from the redo log only carry the values of enum and set.
+	enumCol, setCol := event.TableInfo.GetColumns()[7], event.TableInfo.GetColumns()[8]
+	chk := chunk.NewChunkWithCapacity([]*types.FieldType{&enumCol.FieldType, &setCol.FieldType}, 1)
+	chk.AppendEnum(0, types.Enum{Value: 2})
+	chk.AppendSet(1, types.Set{Value: 3})
+	redoRow := chk.GetRow(0)
+	value, err := extractFieldValue(&redoRow, enumCol, 0)
+	require.NoError(t, err)
+	require.Equal(t, "y", value)
+	value, err = extractFieldValue(&redoRow, setCol, 1)
+	require.NoError(t, err)
+	require.Equal(t, "x,y", value)
+
+	properties := genMapping(event.TableInfo)
+	require.NotContains(t, properties, "l")
+	require.Equal(t, map[string]any{"type": "unsigned_long"}, properties["c"])
+	require.Equal(t, map[string]any{"type": "double"}, properties["e"])
+	require.Equal(t, map[string]any{"type": "date", "format": "yyyy-MM-dd"}, properties["f"])
+	require.Equal(t, map[string]any{"type": "keyword"}, properties["h"])
+	require.Equal(t, map[string]any{"type": "binary"}, properties["j"])
+	require.Equal(t, "text", properties["b"].(map[string]any)["type"])
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/security"
+)
+
+const (
+	// defaultBatchSize is the default value of max-batch-size.
+	defaultBatchSize = 1024
+	// maxBatchSize is the upper limit of max-batch-size.
+	maxBatchSize = 10000
+	// defaultRequestTimeout is the default value of request-timeout.
+	defaultRequestTimeout = 30 * time.Second
+)
+
+type urlConfig struct {
+	MaxBatchSize        *int    `form:"max-batch-size"`
+	RequestTimeout      *string `form:"request-timeout"`
+	AllowDestructiveDDL *bool   `form:"allow-destructive-ddl"`
+	CA                  *string `form:"ca"`
+	Cert                *string `form:"cert"`
+	Key                 *string `form:"key"`
+}
+
+// options is the configuration of the elasticsearch sink, which is parsed
+// from the sink URI, such as:
+// es://user:password@127.0.0.1:9200,127.0.0.1:9201/default-index?max-batch-size=1024
+type options struct {
+	endpoints    []string
+	username     string
+	password     string
+	defaultIndex string
+
+	// batchSize is the maximum number of actions in a bulk request.
+	batchSize      int
+	requestTimeout time.Duration
+	// allowDestructiveDDL controls whether the DROP TABLE and TRUNCATE TABLE
+	// are applied to the index, it's disabled by default since an index could
+	// be shared by several tables.
+	allowDestructiveDDL bool
+	credential          *security.Credential
+}
+
+func newOptions() *options {
+	return &options{
+		batchSize:      defaultBatchSize,
+		requestTimeout: defaultRequestTimeout,
+		credential:     &security.Credential{},
+	}
+}
+
+func (o *options) apply(sinkURI *url.URL) error {
+	scheme := config.GetScheme(sinkURI)
+	if !config.IsElasticsearchScheme(scheme) {
+		return errors.ErrElasticsearchInvalidConfig.GenWithStack(
+			"can't create elasticsearch sink with unsupported scheme: %s", scheme)
+	}
+	httpScheme := "http"
+	if scheme == config.ElasticsearchSSLScheme || scheme == config.OpenSearchSSLScheme {
+		httpScheme = "https"
+	}
+	if sinkURI.Host == "" {
+		return errors.ErrElasticsearchInvalidConfig.GenWithStack("no endpoint is specified in sink-uri")
+	}
+	for _, host := range strings.Split(sinkURI.Host, ",") {
+		o.endpoints = append(o.endpoints, httpScheme+"://"+host)
+	}
+	if sinkURI.User != nil {
+		o.username = sinkURI.User.Username()
+		o.password, _ = sinkURI.User.Password()
+	}
+	o.defaultIndex = strings.ToLower(strings.Trim(sinkURI.Path, "/"))
+	if o.defaultIndex == "" {
+		return errors.ErrElasticsearchInvalidConfig.GenWithStack("no index is specified in sink-uri")
+	}
+
+	req := &http.Request{URL: sinkURI}
+	params := &urlConfig{}
+	if err := binding.Query.Bind(req, params); err != nil {
+		return errors.WrapError(errors.ErrElasticsearchInvalidConfig, err)
+	}
+	if params.MaxBatchSize != nil {
+		size := *params.MaxBatchSize
+		if size <= 0 || size > maxBatchSize {
+			return errors.ErrElasticsearchInvalidConfig.GenWithStack(
+				"invalid max-batch-size %d, it must be in the range (0, %d]", size, maxBatchSize)
+		}
+		o.batchSize = size
+	}
+	if params.RequestTimeout != nil && *params.RequestTimeout != "" {
+		timeout, err := time.ParseDuration(*params.RequestTimeout)
+		if err != nil {
+			return errors.WrapError(errors.ErrElasticsearchInvalidConfig, err)
+		}
+		if timeout <= 0 {
+			return errors.ErrElasticsearchInvalidConfig.GenWithStack(
+				"invalid request-timeout %s, it must be greater than 0", timeout)
+		}
+		o.requestTimeout = timeout
+	}
+	if params.AllowDestructiveDDL != nil {
+		o.allowDestructiveDDL = *params.AllowDestructiveDDL
+	}
+	if params.CA != nil {
+		o.credential.CAPath = *params.CA
+	}
+	if params.Cert != nil {
+		o.credential.CertPath = *params.Cert
+	}
+	if params.Key != nil {
+		o.credential.KeyPath = *params.Key
+	}
+	return nil
+}
@@ -0,0 +1,361 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/eventrouter"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/metrics"
+	"github.com/pingcap/ticdc/utils/chann"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// sink writes the row changes into elasticsearch or opensearch by the bulk API.
+// Each row is mapped to a document identified by the handle key of the row,
+// and the index of the document is decided by the topic rule of the dispatchers,
+// the default index is the path of the sink URI.
+type sink struct {
+	changefeedID common.ChangeFeedID
+	ctx          context.Context
+
+	options     *options
+	client      *client
+	eventRouter *eventrouter.EventRouter
+	statistics  *metrics.Statistics
+	eventCh     *chann.UnlimitedChannel[*commonEvent.DMLEvent, any]
+
+	// indicesMu protects the knownIndices, which caches the indices
+	// that have been created with the mapping of the table.
+	indicesMu    sync.Mutex
+	knownIndices map[string]struct{}
+
+	// isNormal indicate whether the sink is in the normal state.
+	isNormal *atomic.Bool
+}
+
+func newSinkComponents(
+	sinkURI *url.URL, sinkConfig *config.SinkConfig,
+) (*options, *eventrouter.EventRouter, error) {
+	opts := newOptions()
+	if err := opts.apply(sinkURI); err != nil {
+		return nil, nil, err
+	}
+	eventRouter, err := eventrouter.NewEventRouter(sinkConfig, opts.defaultIndex, false, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return opts, eventRouter, nil
+}
+
+// Verify checks the sink URI and whether the cluster is reachable.
+func Verify(ctx context.Context, sinkURI *url.URL, sinkConfig *config.SinkConfig) error {
+	opts, _, err := newSinkComponents(sinkURI, sinkConfig)
+	if err != nil {
+		return err
+	}
+	client, err := newClient(opts)
+	if err != nil {
+		return err
+	}
+	defer client.close()
+	return client.ping(ctx)
+}
+
+// New creates an elasticsearch sink.
+func New(
+	ctx context.Context,
+	changefeedID common.ChangeFeedID,
+	sinkURI *url.URL,
+	sinkConfig *config.SinkConfig,
+	keyspaceID uint32,
+) (*sink, error) {
+	opts, eventRouter, err := newSinkComponents(sinkURI, sinkConfig)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	if err = client.ping(ctx); err != nil {
+		client.close()
+		return nil, err
+	}
+	return &sink{
+		changefeedID: changefeedID,
+		ctx:          ctx,
+		options:      opts,
+		client:       client,
+		eventRouter:  eventRouter,
+		statistics:   metrics.NewStatistics(changefeedID, keyspaceID, "sink"),
+		eventCh:      chann.NewUnlimitedChannelDefault[*commonEvent.DMLEvent](),
+		knownIndices: make(map[string]struct{}),
+		isNormal:     atomic.NewBool(true),
+	}, nil
+}
+
+func (s *sink) SinkType() common.SinkType {
+	return common.ElasticsearchSinkType
+}
+
+func (s *sink) IsNormal() bool {
+	return s.isNormal.Load()
+}
+
+func (s *sink) SetTableSchemaStore(_ *commonEvent.TableSchemaStore) {
+}
+
+func (s *sink) AddDMLEvent(event *commonEvent.DMLEvent) {
+	s.eventCh.Push(event)
+}
+
+func (s *sink) FlushDMLBeforeBlock(_ commonEvent.BlockEvent) error {
+	return nil
+}
+
+func (s *sink) WriteBlockEvent(event commonEvent.BlockEvent) error {
+	var err error
+	switch v := event.(type) {
+	case *commonEvent.DDLEvent:
+		err = s.writeDDLEvent(s.ctx, v)
+	default:
+		log.Error("unsupported elasticsearch sink block event type",
+			zap.String("keyspace", s.changefeedID.Keyspace()),
+			zap.String("changefeed", s.changefeedID.Name()),
+			zap.String("eventType", commonEvent.TypeToString(event.GetType())))
+		return errors.ErrInvalidEventType.GenWithStackByArgs(commonEvent.TypeToString(event.GetType()))
+	}
+	if err != nil {
+		s.isNormal.Store(false)
+		return err
+	}
+	event.PostFlush()
+	return nil
+}
+
+func (s *sink) AddCheckpointTs(_ uint64) {
+}
+
+func (s *sink) Run(ctx context.Context) error {
+	buffer := make([]*commonEvent.DMLEvent, 0, s.BatchCount())
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		default:
+		}
+		// GetMultipleNoGroup blocks until there are events or the channel is closed.
+		events, ok := s.eventCh.GetMultipleNoGroup(buffer)
+		if !ok {
+			return nil
+		}
+		if err := s.writeDMLEvents(ctx, events); err != nil {
+			s.isNormal.Store(false)
+			log.Error("elasticsearch sink write events failed",
+				zap.String("keyspace", s.changefeedID.Keyspace()),
+				zap.String("changefeed", s.changefeedID.Name()),
+				zap.Error(err))
+			return err
+		}
+		for _, event := range events {
+			event.PostFlush()
+		}
+		buffer = buffer[:0]
+	}
+}
+
+func (s *sink) writeDMLEvents(ctx context.Context, events []*commonEvent.DMLEvent) error {
+	return s.statistics.RecordBatchExecution(func() (int, int64, error) {
+		var (
+			builder bulkRequestBuilder
+			rows    int
+			size    int64
+		)
+		for _, event := range events {
+			index := s.indexName(event.TableInfo.GetTargetSchemaName(), event.TableInfo.GetTargetTableName())
+			if err := s.ensureIndex(ctx, index, event.TableInfo); err != nil {
+				return 0, 0, err
+			}
+			if err := s.appendRows(&builder, index, event); err != nil {
+				return 0, 0, err
+			}
+			if builder.count >= s.options.batchSize {
+				if err := s.client.bulk(ctx, builder.buf.Bytes()); err != nil {
+					return 0, 0, err
+				}
+				builder.reset()
+			}
+			rows += int(event.Len())
+			size += event.GetSize()
+		}
+		if builder.count > 0 {
+			if err := s.client.bulk(ctx, builder.buf.Bytes()); err != nil {
+				return 0, 0, err
+			}
+		}
+		return rows, size, nil
+	})
+}
+
+// appendRows converts the rows of the event into bulk actions. The update
+// which changes the handle key is split into a delete and an index, since
+// the document ID is changed.
+func (s *sink) appendRows(builder *bulkRequestBuilder, index string, event *commonEvent.DMLEvent) error {
+	defer event.Rewind()
+	tableInfo := event.TableInfo
+	for {
+		row, ok := event.GetNextRow()
+		if !ok {
+			return nil
+		}
+		switch row.RowType {
+		case common.RowTypeDelete:
+			id, err := genDocumentID(&row.PreRow, tableInfo)
+			if err != nil {
+				return err
+			}
+			builder.addDelete(index, id)
+		case common.RowTypeInsert, common.RowTypeUpdate:
+			id, err := genDocumentID(&row.Row, tableInfo)
+			if err != nil {
+				return err
+			}
+			if row.RowType == common.RowTypeUpdate {
+				preID, err := genDocumentID(&row.PreRow, tableInfo)
+				if err != nil {
+					return err
+				}
+				if preID != id {
+					builder.addDelete(index, preID)
+				}
+			}
+			doc, err := genDocument(&row.Row, tableInfo)
+			if err != nil {
+				return err
+			}
+			if err = builder.addIndex(index, id, doc); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *sink) writeDDLEvent(ctx context.Context, event *commonEvent.DDLEvent) error {
+	for _, e := range event.GetEvents() {
+		err := s.statistics.RecordDDLExecution(func() (string, error) {
+			return e.GetDDLType().String(), s.execDDL(ctx, e)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execDDL applies the DDL to the index of the table. Only the DDLs which can
+// be expressed by the index API are applied, the others are skipped.
+func (s *sink) execDDL(ctx context.Context, event *commonEvent.DDLEvent) error {
+	if event.GetTargetTableName() == "" {
+		log.Info("elasticsearch sink skip the schema level DDL",
+			zap.String("keyspace", s.changefeedID.Keyspace()),
+			zap.String("changefeed", s.changefeedID.Name()),
+			zap.String("query", event.GetDDLQuery()))
+		return nil
+	}
+	index := s.indexName(event.GetTargetSchemaName(), event.GetTargetTableName())
+	switch event.GetDDLType() {
+	case model.ActionCreateTable:
+		return s.ensureIndex(ctx, index, event.TableInfo)
+	case model.ActionAddColumn, model.ActionModifyColumn, model.ActionRenameColumn,
+		model.ActionMultiSchemaChange:
+		if err := s.client.putMapping(ctx, index, genMapping(event.TableInfo)); err != nil {
+			return err
+		}
+		s.markIndex(index)
+		return nil
+	case model.ActionTruncateTable:
+		if s.options.allowDestructiveDDL {
+			return s.client.deleteAllDocuments(ctx, index)
+		}
+	case model.ActionDropTable:
+		if s.options.allowDestructiveDDL {
+			if err := s.client.deleteIndex(ctx, index); err != nil {
+				return err
+			}
+			s.indicesMu.Lock()
+			delete(s.knownIndices, index)
+			s.indicesMu.Unlock()
+			return nil
+		}
+	}
+	log.Info("elasticsearch sink skip the DDL",
+		zap.String("keyspace", s.changefeedID.Keyspace()),
+		zap.String("changefeed", s.changefeedID.Name()),
+		zap.String("index", index),
+		zap.String("query", event.GetDDLQuery()),
+		zap.Bool("allowDestructiveDDL", s.options.allowDestructiveDDL))
+	return nil
+}
+
+// indexName returns the index of the table, the index name must be lowercase.
+func (s *sink) indexName(schema, table string) string {
+	return strings.ToLower(s.eventRouter.GetTopicForRowChange(schema, table))
+}
+
+// ensureIndex creates the index with the mapping of the table at the first time
+// the index is written.
+func (s *sink) ensureIndex(ctx context.Context, index string, tableInfo *common.TableInfo) error {
+	s.indicesMu.Lock()
+	_, ok := s.knownIndices[index]
+	s.indicesMu.Unlock()
+	if ok {
+		return nil
+	}
+	if err := s.client.ensureIndex(ctx, index, genMapping(tableInfo)); err != nil {
+		return err
+	}
+	s.markIndex(index)
+	return nil
+}
+
+func (s *sink) markIndex(index string) {
+	s.indicesMu.Lock()
+	defer s.indicesMu.Unlock()
+	s.knownIndices[index] = struct{}{}
+}
+
+func (s *sink) Close() {
+	s.eventCh.Close()
+	s.client.close()
+	s.statistics.Close()
+}
+
+func (s *sink) BatchCount() int {
+	return 4096
+}
+
+func (s *sink) BatchBytes() int {
+	return 0
+}
@@ -0,0 +1,310 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+// fakeServer is an in-process stand-in of the elasticsearch REST API.
+type fakeServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	indices  map[string]map[string]any
+	bulks    []string
+	requests []string
+	// bulkResponse overrides the response of the bulk API if it's not empty.
+	bulkResponse string
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	s := &fakeServer{indices: make(map[string]map[string]any)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		switch {
+		case r.URL.Path == "/":
+			_, _ = w.Write([]byte(`{"version":{"number":"8.13.0"}}`))
+		case r.URL.Path == "/_bulk":
+			s.bulks = append(s.bulks, string(body))
+			if s.bulkResponse != "" {
+				_, _ = w.Write([]byte(s.bulkResponse))
+				return
+			}
+			_, _ = w.Write([]byte(`{"errors":false,"items":[]}`))
+		case len(parts) == 1 && r.Method == http.MethodHead:
+			if _, ok := s.indices[parts[0]]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case len(parts) == 1 && r.Method == http.MethodPut:
+			var req struct {
+				Mappings struct {
+					Properties map[string]any `json:"properties"`
+				} `json:"mappings"`
+			}
+			require.NoError(t, json.Unmarshal(body, &req))
+			s.indices[parts[0]] = req.Mappings.Properties
+		case len(parts) == 1 && r.Method == http.MethodDelete:
+			delete(s.indices, parts[0])
+		case len(parts) == 2 && parts[1] == "_mapping":
+			var req struct {
+				Properties map[string]any `json:"properties"`
+			}
+			require.NoError(t, json.Unmarshal(body, &req))
+			for name, field := range req.Properties {
+				s.indices[parts[0]][name] = field
+			}
+		case len(parts) == 2 && parts[1] == "_delete_by_query":
+			_, _ = w.Write([]byte(`{"deleted":0}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeServer) getBulks() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.bulks...)
+}
+
+func (s *fakeServer) getRequests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func (s *fakeServer) getIndex(index string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	properties, ok := s.indices[index]
+	return properties, ok
+}
+
+func newSinkForTest(
+	ctx context.Context, t *testing.T, server *fakeServer, query string, sinkConfig *config.SinkConfig,
+) *sink {
+	sinkURI, err := url.Parse("es://" + strings.TrimPrefix(server.URL, "http://") + "/default" + query)
+	require.NoError(t, err)
+	if sinkConfig == nil {
+		sinkConfig = &config.SinkConfig{}
+	}
+	s, err := New(ctx, common.NewChangefeedID4Test("test", t.Name()), sinkURI, sinkConfig, common.DefaultKeyspaceID)
+	require.NoError(t, err)
+	return s
+}
+
+func TestElasticsearchSinkWriteEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t)
+	s := newSinkForTest(ctx, t, server, "?max-batch-size=2", nil)
+	defer s.Close()
+	go s.Run(ctx)
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+
+	var flushed atomic.Int32
+	ddlEvent := helper.DDL2Event("create table t (id int primary key, name varchar(32))")
+	ddlEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	require.NoError(t, s.WriteBlockEvent(ddlEvent))
+	require.EqualValues(t, 1, flushed.Load())
+
+	properties, ok := server.getIndex("default")
+	require.True(t, ok)
+	require.Equal(t, map[string]any{"type": "integer"}, properties["id"])
+	require.Equal(t, "text", properties["name"].(map[string]any)["type"])
+
+	insertEvent := helper.DML2Event("test", "t",
+		"insert into t values (1, 'a')",
+		"insert into t values (2, 'b')",
+		"insert into t values (3, 'c')")
+	insertEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	updateEvent, _ := helper.DML2UpdateEvent("test", "t",
+		"insert into t values (4, 'd')",
+		"update t set name = 'e' where id = 4")
+	updateEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	deleteEvent := helper.DML2DeleteEvent("test", "t",
+		"insert into t values (6, 'f')",
+		"delete from t where id = 6")
+	deleteEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+
+	s.AddDMLEvent(insertEvent)
+	s.AddDMLEvent(updateEvent)
+	s.AddDMLEvent(deleteEvent)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 4
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the actions are split into several bulk requests by max-batch-size.
+	body := strings.Join(server.getBulks(), "")
+	require.Equal(t, strings.Join([]string{
+		`{"index":{"_id":"1","_index":"default"}}`,
+		`{"id":1,"name":"a"}`,
+		`{"index":{"_id":"2","_index":"default"}}`,
+		`{"id":2,"name":"b"}`,
+		`{"index":{"_id":"3","_index":"default"}}`,
+		`{"id":3,"name":"c"}`,
+		`{"index":{"_id":"4","_index":"default"}}`,
+		`{"id":4,"name":"e"}`,
+		`{"delete":{"_id":"6","_index":"default"}}`,
+	}, "\n")+"\n", body)
+	require.True(t, s.IsNormal())
+}
+
+func TestElasticsearchSinkIndexRouting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t)
+	sinkConfig := &config.SinkConfig{
+		DispatchRules: []*config.DispatchRule{
+			{Matcher: []string{"test.*"}, TopicRule: "ES_{schema}_{table}"},
+		},
+	}
+	s := newSinkForTest(ctx, t, server, "", sinkConfig)
+	defer s.Close()
+	go s.Run(ctx)
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+	helper.DDL2Event("create table t1 (id int primary key)")
+
+	var flushed atomic.Int32
+	dmlEvent := helper.DML2Event("test", "t1", "insert into t1 values (1)")
+	dmlEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	s.AddDMLEvent(dmlEvent)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the index is created with the mapping of the table at the first write,
+	// and the index name is lowercased.
+	_, ok := server.getIndex("es_test_t1")
+	require.True(t, ok)
+	require.Contains(t, server.getBulks()[0], `"_index":"es_test_t1"`)
+}
+
+func TestElasticsearchSinkDDL(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+
+	server := newFakeServer(t)
+	s := newSinkForTest(ctx, t, server, "", nil)
+	defer s.Close()
+
+	require.NoError(t, s.WriteBlockEvent(helper.DDL2Event("create table t (id int primary key)")))
+	require.NoError(t, s.WriteBlockEvent(helper.DDL2Event("alter table t add column c datetime(3)")))
+	properties, ok := server.getIndex("default")
+	require.True(t, ok)
+	require.Equal(t, "date", properties["c"].(map[string]any)["type"])
+
+	// the destructive DDLs are skipped by default.
+	require.NoError(t, s.WriteBlockEvent(helper.DDL2Event("truncate table t")))
+	require.NoError(t, s.WriteBlockEvent(helper.DDL2Event("drop table t")))
+	_, ok = server.getIndex("default")
+	require.True(t, ok)
+
+	destructive := newSinkForTest(ctx, t, server, "?allow-destructive-ddl=true", nil)
+	defer destructive.Close()
+	require.NoError(t, destructive.WriteBlockEvent(helper.DDL2Event("create table t2 (id int primary key)")))
+	require.NoError(t, destructive.WriteBlockEvent(helper.DDL2Event("truncate table t2")))
+	require.Contains(t, server.getRequests(), "POST /default/_delete_by_query")
+	require.NoError(t, destructive.WriteBlockEvent(helper.DDL2Event("drop table t2")))
+	_, ok = server.getIndex("default")
+	require.False(t, ok)
+}
+
+func TestElasticsearchSinkBulkError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t)
+	server.bulkResponse = `{"errors":true,"items":[` +
+		`{"delete":{"_index":"default","_id":"1","status":404}},` +
+		`{"index":{"_index":"default","_id":"2","status":400,"error":{"type":"mapper_parsing_exception"}}}]}`
+	s := newSinkForTest(ctx, t, server, "", nil)
+	defer s.Close()
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+	helper.DDL2Event("create table t (id int primary key)")
+
+	s.AddDMLEvent(helper.DML2Event("test", "t", "insert into t values (2)"))
+	err := s.Run(ctx)
+	require.True(t, errors.ErrElasticsearchRequestFailed.Equal(err))
+	require.Contains(t, err.Error(), "mapper_parsing_exception")
+	require.False(t, s.IsNormal())
+}
+
+func TestElasticsearchSinkWithoutHandleKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t)
+	s := newSinkForTest(ctx, t, server, "", nil)
+	defer s.Close()
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+	helper.DDL2Event("create table t (id int, name varchar(32))")
+
+	s.AddDMLEvent(helper.DML2Event("test", "t", "insert into t values (1, 'a')"))
+	err := s.Run(ctx)
+	require.True(t, errors.ErrElasticsearchInvalidConfig.Equal(err))
+}
+
+func TestElasticsearchVerify(t *testing.T) {
+	server := newFakeServer(t)
+	sinkURI, err := url.Parse("opensearch://" + strings.TrimPrefix(server.URL, "http://") + "/default")
+	require.NoError(t, err)
+	require.NoError(t, Verify(context.Background(), sinkURI, &config.SinkConfig{}))
+
+	server.Close()
+	require.Error(t, Verify(context.Background(), sinkURI, &config.SinkConfig{}))
+}
@@ -61,6 +61,7 @@ func NewMQRowEvents(
 				Callback:        callback,
 				ColumnSelector:  selector,
 				Checksum:        row.Checksum,
+				IsSnapshot:      event.IsSnapshot,
 			},
 		})
 	}
@@ -93,6 +94,7 @@ func NewRowEvents(
 			Callback:        callback,
 			ColumnSelector:  selector,
 			Checksum:        row.Checksum,
+			IsSnapshot:      event.IsSnapshot,
 		})
 	}
 	return events
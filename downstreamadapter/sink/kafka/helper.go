@@ -18,6 +18,7 @@ import (
 	"net/url"
 
 	"github.com/pingcap/ticdc/downstreamadapter/sink/columnselector"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/deadletter"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/eventrouter"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/helper"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/topicmanager"
@@ -39,6 +40,8 @@ type components struct {
 	adminClient    kafka.ClusterAdminClient
 	factory        kafka.Factory
 	claimCheck     *claimcheck.ClaimCheck
+	// deadLetterQueue is nil if the dead letter queue is not configured.
+	deadLetterQueue *deadletter.Queue
 }
 
 func (c components) close() {
@@ -51,6 +54,7 @@ func (c components) close() {
 	if c.claimCheck != nil {
 		c.claimCheck.Close()
 	}
+	c.deadLetterQueue.Close()
 }
 
 func newKafkaSinkComponent(
@@ -90,7 +94,7 @@ func newKafkaSinkComponent(
 		return comp, protocol, err
 	}
 
-	isAvroLike := protocol == config.ProtocolAvro || protocol == config.ProtocolDebeziumAvro
+	isAvroLike := protocol.IsSchemaRegistryBased()
 	comp.eventRouter, err = eventrouter.NewEventRouter(
 		sinkConfig, topic, false, isAvroLike)
 	if err != nil {
@@ -115,7 +119,18 @@ func newKafkaSinkComponent(
 		return comp, protocol, err
 	}
 
-	comp.encoderGroup, err = codec.NewEncoderGroup(ctx, sinkConfig, encoderConfig, comp.claimCheck, changefeedID)
+	comp.deadLetterQueue, err = deadletter.New(ctx, changefeedID, sinkConfig)
+	if err != nil {
+		return comp, protocol, err
+	}
+	// Avoid passing a typed nil queue to the encoder group.
+	var rowDeadLetterQueue codec.DeadLetterQueue
+	if comp.deadLetterQueue != nil {
+		rowDeadLetterQueue = comp.deadLetterQueue
+	}
+
+	comp.encoderGroup, err = codec.NewEncoderGroup(
+		ctx, sinkConfig, encoderConfig, comp.claimCheck, rowDeadLetterQueue, changefeedID)
 	if err != nil {
 		return comp, protocol, err
 	}
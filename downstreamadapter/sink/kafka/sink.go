@@ -20,6 +20,7 @@ import (
 
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/columnselector"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/deadletter"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/eventrouter"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/helper"
 	"github.com/pingcap/ticdc/pkg/common"
@@ -102,7 +103,7 @@ func Verify(ctx context.Context, changefeedID common.ChangeFeedID, uri *url.URL,
 	}
 	defer claimCheck.Close()
 
-	isAvroLike := protocol == config.ProtocolAvro || protocol == config.ProtocolDebeziumAvro
+	isAvroLike := protocol.IsSchemaRegistryBased()
 	if _, err = eventrouter.NewEventRouter(sinkConfig, topic, false, isAvroLike); err != nil {
 		return err
 	}
@@ -194,6 +195,17 @@ func newWithComponents(
 	if err != nil {
 		return nil, err
 	}
+	if comp.deadLetterQueue != nil {
+		deadLetterQueue := comp.deadLetterQueue
+		asyncProducer.SetRejectedMessageHandler(func(events []*commonEvent.RowEvent, cause error) error {
+			for _, event := range events {
+				if err := deadLetterQueue.WriteRowEvent(event, cause); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
 
 	syncProducer, err = comp.factory.SyncProducer(ctx)
 	if err != nil {
@@ -264,13 +276,23 @@ func (s *sink) WriteBlockEvent(event commonEvent.BlockEvent) error {
 		return errors.ErrInvalidEventType.GenWithStackByArgs(commonEvent.TypeToString(event.GetType()))
 	}
 	if err != nil {
-		s.isNormal.Store(false)
+		// The rejected DDL is retried or dead lettered by the dispatcher, so
+		// the sink is still normal.
+		if s.comp.deadLetterQueue == nil || !s.comp.deadLetterQueue.IsRejectedError(err) {
+			s.isNormal.Store(false)
+		}
 		return err
 	}
 	event.PostFlush()
 	return nil
 }
 
+// DeadLetterQueue returns the dead letter queue of the sink, it is nil if
+// the dead letter queue is not configured.
+func (s *sink) DeadLetterQueue() *deadletter.Queue {
+	return s.comp.deadLetterQueue
+}
+
 func (s *sink) close() {
 	s.eventChan.Close()
 	s.rowChan.Close()
@@ -510,11 +532,6 @@ func (s *sink) sendCheckpoint(ctx context.Context) error {
 		metrics.CheckpointTsMessageCount.DeleteLabelValues(s.changefeedID.Keyspace(), s.changefeedID.Name())
 	}()
 
-	var (
-		msg          *codecCommon.Message
-		partitionNum int32
-		err          error
-	)
 	for {
 		select {
 		case <-ctx.Done():
@@ -525,48 +542,53 @@ func (s *sink) sendCheckpoint(ctx context.Context) error {
 			}
 
 			start := time.Now()
-			msg, err = s.comp.encoder.EncodeCheckpointEvent(ts)
-			if err != nil {
+			if err := s.emitCheckpoint(ctx, ts); err != nil {
 				return err
 			}
-			if msg == nil {
-				continue
-			}
-			codecCommon.SetCheckpointMessageLogInfo(msg, ts)
-
-			tableNames := s.getAllTableNames(ts)
-			// NOTICE: When there are no tables to replicate,
-			// we need to send checkpoint ts to the default topic.
-			// This will be compatible with the old behavior.
-			if len(tableNames) == 0 {
-				topic := s.comp.eventRouter.GetDefaultTopic()
-				partitionNum, err = s.comp.topicManager.GetPartitionNum(ctx, topic)
-				if err != nil {
-					return err
-				}
-				err = s.ddlProducer.SendMessages(topic, partitionNum, msg)
-				if err != nil {
-					return err
-				}
-			} else {
-				topics := s.comp.eventRouter.GetActiveTopics(tableNames)
-				for _, topic := range topics {
-					partitionNum, err = s.comp.topicManager.GetPartitionNum(ctx, topic)
-					if err != nil {
-						return err
-					}
-					err = s.ddlProducer.SendMessages(topic, partitionNum, msg)
-					if err != nil {
-						return err
-					}
-				}
-			}
 			checkpointTsMessageCount.Inc()
 			checkpointTsMessageDuration.Observe(time.Since(start).Seconds())
 		}
 	}
 }
 
+// FlushCheckpointTs sends the checkpoint ts to the topics synchronously.
+func (s *sink) FlushCheckpointTs(ctx context.Context, ts uint64) error {
+	return s.emitCheckpoint(ctx, ts)
+}
+
+// emitCheckpoint sends the checkpoint message of the ts to the active topics,
+// or to the default topic if there are no tables to replicate.
+func (s *sink) emitCheckpoint(ctx context.Context, ts uint64) error {
+	msg, err := s.comp.encoder.EncodeCheckpointEvent(ts)
+	if err != nil {
+		return err
+	}
+	if msg == nil {
+		return nil
+	}
+	codecCommon.SetCheckpointMessageLogInfo(msg, ts)
+
+	tableNames := s.getAllTableNames(ts)
+	// NOTICE: When there are no tables to replicate,
+	// we need to send checkpoint ts to the default topic.
+	// This will be compatible with the old behavior.
+	topics := []string{s.comp.eventRouter.GetDefaultTopic()}
+	if len(tableNames) != 0 {
+		topics = s.comp.eventRouter.GetActiveTopics(tableNames)
+	}
+	for _, topic := range topics {
+		partitionNum, err := s.comp.topicManager.GetPartitionNum(ctx, topic)
+		if err != nil {
+			return err
+		}
+		err = s.ddlProducer.SendMessages(topic, partitionNum, msg)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *sink) SetTableSchemaStore(tableSchemaStore *commonEvent.TableSchemaStore) {
 	s.tableSchemaStore = tableSchemaStore
 }
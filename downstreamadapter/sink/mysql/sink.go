@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/deadletter"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/mysql/causality"
 	"github.com/pingcap/ticdc/pkg/common"
 	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
@@ -70,6 +71,10 @@ type Sink struct {
 	// variable @@tidb_cdc_active_active_sync_stats and is shared by all DML writers.
 	// It is nil when disabled or unsupported by downstream.
 	activeActiveSyncStatsCollector *mysql.ActiveActiveSyncStatsCollector
+
+	// deadLetterQueue is the queue of the events rejected by the downstream,
+	// it is nil if the dead letter queue is not configured.
+	deadLetterQueue *deadletter.Queue
 }
 
 // Verify is used to verify the sink URI and config are valid.
@@ -113,7 +118,16 @@ func New(
 		metrics.ChangefeedDownstreamIsTiDBGauge.DeleteLabelValues(keyspace, name)
 	}
 
-	return newMySQLSinkWithControlDB(ctx, changefeedID, cfg, dmlDB, controlDB, config.BDRMode, config.EnableActiveActive, config.ActiveActiveProgressInterval, keyspaceID), nil
+	deadLetterQueue, err := deadletter.New(ctx, changefeedID, config.SinkConfig)
+	if err != nil {
+		_ = dmlDB.Close()
+		_ = controlDB.Close()
+		return nil, err
+	}
+
+	s := newMySQLSinkWithControlDB(ctx, changefeedID, cfg, dmlDB, controlDB, config.BDRMode, config.EnableActiveActive, config.ActiveActiveProgressInterval, keyspaceID)
+	s.setDeadLetterQueue(deadLetterQueue)
+	return s, nil
 }
 
 func NewMySQLSink(
@@ -207,6 +221,24 @@ func newMySQLSinkWithDBs(
 	return result
 }
 
+// setDeadLetterQueue sets the dead letter queue of the transactions rejected
+// by the downstream, the DDLs are dead lettered by the dispatcher.
+func (s *Sink) setDeadLetterQueue(queue *deadletter.Queue) {
+	if queue == nil {
+		return
+	}
+	s.deadLetterQueue = queue
+	for _, w := range s.dmlWriter {
+		w.SetDeadLetterQueue(queue)
+	}
+}
+
+// DeadLetterQueue returns the dead letter queue of the sink, it is nil if
+// the dead letter queue is not configured.
+func (s *Sink) DeadLetterQueue() *deadletter.Queue {
+	return s.deadLetterQueue
+}
+
 func (s *Sink) Run(ctx context.Context) error {
 	g, ctx := errgroup.WithContext(ctx)
 	g.Go(func() error {
@@ -357,7 +389,13 @@ func (s *Sink) WriteBlockEvent(event commonEvent.BlockEvent) error {
 			zap.Any("event", event))
 	}
 	if err != nil {
-		s.isNormal.Store(false)
+		// The rejected DDL is retried or dead lettered by the dispatcher, so
+		// the sink is still normal. The other block events are never dead
+		// lettered, the sink is abnormal once they fail.
+		if event.GetType() != commonEvent.TypeDDLEvent ||
+			s.deadLetterQueue == nil || !s.deadLetterQueue.IsRejectedError(err) {
+			s.isNormal.Store(false)
+		}
 		return errors.Trace(err)
 	}
 	event.PostFlush()
@@ -456,6 +494,7 @@ func (s *Sink) Close() {
 	if s.activeActiveSyncStatsCollector != nil {
 		s.activeActiveSyncStatsCollector.Close()
 	}
+	s.deadLetterQueue.Close()
 	s.statistics.Close()
 
 	metrics.ChangefeedDownstreamIsTiDBGauge.DeleteLabelValues(s.changefeedID.Keyspace(), s.changefeedID.Name())
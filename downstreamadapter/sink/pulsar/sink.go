@@ -290,10 +290,6 @@ func (s *sink) sendCheckpoint(ctx context.Context) error {
 		metrics.CheckpointTsMessageDuration.DeleteLabelValues(s.changefeedID.Keyspace(), s.changefeedID.Name())
 		metrics.CheckpointTsMessageCount.DeleteLabelValues(s.changefeedID.Keyspace(), s.changefeedID.Name())
 	}()
-	var (
-		msg *common.Message
-		err error
-	)
 	for {
 		select {
 		case <-ctx.Done():
@@ -307,50 +303,53 @@ func (s *sink) sendCheckpoint(ctx context.Context) error {
 			}
 
 			start := time.Now()
-			msg, err = s.comp.encoder.EncodeCheckpointEvent(ts)
-			if err != nil {
+			if err := s.emitCheckpoint(ctx, ts); err != nil {
 				return errors.Trace(err)
 			}
-
-			if msg == nil {
-				continue
-			}
-			common.SetCheckpointMessageLogInfo(msg, ts)
-
-			tableNames := s.getAllTableNames(ts)
-			// NOTICE: When there are no tables to replicate,
-			// we need to send checkpoint ts to the default topic.
-			// This will be compatible with the old behavior.
-			if len(tableNames) == 0 {
-				topic := s.comp.eventRouter.GetDefaultTopic()
-				_, err = s.comp.topicManager.GetPartitionNum(ctx, topic)
-				if err != nil {
-					return errors.Trace(err)
-				}
-				err = s.ddlProducer.syncBroadcastMessage(ctx, topic, msg, common.MessageTypeResolved)
-				if err != nil {
-					return errors.Trace(err)
-				}
-			} else {
-				topics := s.comp.eventRouter.GetActiveTopics(tableNames)
-				for _, topic := range topics {
-					_, err = s.comp.topicManager.GetPartitionNum(ctx, topic)
-					if err != nil {
-						return errors.Trace(err)
-					}
-					err = s.ddlProducer.syncBroadcastMessage(ctx, topic, msg, common.MessageTypeResolved)
-					if err != nil {
-						return errors.Trace(err)
-					}
-				}
-			}
-
 			checkpointTsMessageCount.Inc()
 			checkpointTsMessageDuration.Observe(time.Since(start).Seconds())
 		}
 	}
 }
 
+// FlushCheckpointTs sends the checkpoint ts to the topics synchronously.
+func (s *sink) FlushCheckpointTs(ctx context.Context, ts uint64) error {
+	return errors.Trace(s.emitCheckpoint(ctx, ts))
+}
+
+// emitCheckpoint broadcasts the checkpoint message of the ts to the active
+// topics, or to the default topic if there are no tables to replicate.
+func (s *sink) emitCheckpoint(ctx context.Context, ts uint64) error {
+	msg, err := s.comp.encoder.EncodeCheckpointEvent(ts)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if msg == nil {
+		return nil
+	}
+	common.SetCheckpointMessageLogInfo(msg, ts)
+
+	tableNames := s.getAllTableNames(ts)
+	// NOTICE: When there are no tables to replicate,
+	// we need to send checkpoint ts to the default topic.
+	// This will be compatible with the old behavior.
+	topics := []string{s.comp.eventRouter.GetDefaultTopic()}
+	if len(tableNames) != 0 {
+		topics = s.comp.eventRouter.GetActiveTopics(tableNames)
+	}
+	for _, topic := range topics {
+		_, err = s.comp.topicManager.GetPartitionNum(ctx, topic)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		err = s.ddlProducer.syncBroadcastMessage(ctx, topic, msg, common.MessageTypeResolved)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
 func (s *sink) close() {
 	s.eventChan.Close()
 	s.rowChan.Close()
@@ -0,0 +1,345 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/retry"
+	"go.uber.org/zap"
+)
+
+const (
+	// the commands sent by the sink are idempotent or tolerate duplication,
+	// so the pipeline is resent on the network errors.
+	pipelineMaxTries      = 3
+	pipelineBackoffBaseMs = 200
+	pipelineBackoffMaxMs  = 2000
+
+	scanCount = 1000
+)
+
+// command is a redis command with its arguments.
+type command []string
+
+// redisError is the error reply of redis.
+type redisError string
+
+func (e redisError) Error() string {
+	return string(e)
+}
+
+// client is a minimal client of the RESP2 protocol, which sends the commands
+// in pipelines through a single connection.
+type client struct {
+	options   *options
+	tlsConfig *tls.Config
+
+	// mu protects the connection, the DDLs and the DMLs may be written concurrently.
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+	writer *bufio.Writer
+}
+
+func newClient(opts *options) (*client, error) {
+	c := &client{options: opts}
+	if opts.enableTLS {
+		tlsConfig, err := opts.credential.ToTLSConfig()
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrRedisInvalidConfig, err)
+		}
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		if host, _, err := net.SplitHostPort(opts.address); err == nil && tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = host
+		}
+		c.tlsConfig = tlsConfig
+	}
+	return c, nil
+}
+
+// connect dials the server, and authenticates and selects the database if needed.
+// It must be called with the mutex held.
+func (c *client) connect(ctx context.Context) error {
+	dialer := &net.Dialer{Timeout: c.options.dialTimeout}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if c.tlsConfig != nil {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: c.tlsConfig}).DialContext(ctx, "tcp", c.options.address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", c.options.address)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	c.conn = conn
+	c.reader = bufio.NewReader(conn)
+	c.writer = bufio.NewWriter(conn)
+
+	var cmds []command
+	if c.options.password != "" {
+		if c.options.username != "" {
+			cmds = append(cmds, command{"AUTH", c.options.username, c.options.password})
+		} else {
+			cmds = append(cmds, command{"AUTH", c.options.password})
+		}
+	}
+	if c.options.db != 0 {
+		cmds = append(cmds, command{"SELECT", strconv.Itoa(c.options.db)})
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	replies, err := c.roundTrip(ctx, cmds)
+	if err == nil {
+		err = firstError(replies)
+	}
+	if err != nil {
+		c.disconnect()
+		return errors.WrapError(errors.ErrRedisInvalidConfig, err)
+	}
+	return nil
+}
+
+func (c *client) disconnect() {
+	if c.conn != nil {
+		_ = c.conn.Close()
+	}
+	c.conn, c.reader, c.writer = nil, nil, nil
+}
+
+// roundTrip writes the commands and reads their replies.
+// It must be called with the mutex held.
+func (c *client) roundTrip(ctx context.Context, cmds []command) ([]any, error) {
+	// interrupt the blocking I/O once the context is canceled.
+	stop := context.AfterFunc(ctx, func() {
+		_ = c.conn.SetDeadline(time.Unix(1, 0))
+	})
+	defer stop()
+
+	if err := c.conn.SetWriteDeadline(time.Now().Add(c.options.writeTimeout)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, cmd := range cmds {
+		writeCommand(c.writer, cmd)
+	}
+	if err := c.writer.Flush(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := c.conn.SetReadDeadline(time.Now().Add(c.options.readTimeout)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	replies := make([]any, 0, len(cmds))
+	for range cmds {
+		reply, err := readReply(c.reader)
+		if err != nil {
+			return nil, err
+		}
+		replies = append(replies, reply)
+	}
+	return replies, nil
+}
+
+// pipeline sends the commands in one round trip. The error replies are returned
+// as ErrRedisRequestFailed, and the network errors are retried with a new connection.
+func (c *client) pipeline(ctx context.Context, cmds []command) ([]any, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var replies []any
+	err := retry.Do(ctx, func() error {
+		if c.conn == nil {
+			if err := c.connect(ctx); err != nil {
+				return err
+			}
+		}
+		var err error
+		replies, err = c.roundTrip(ctx, cmds)
+		if err != nil {
+			log.Warn("redis pipeline failed, reconnect", zap.String("address", c.options.address), zap.Error(err))
+			c.disconnect()
+		}
+		return err
+	},
+		retry.WithBackoffBaseDelay(pipelineBackoffBaseMs),
+		retry.WithBackoffMaxDelay(pipelineBackoffMaxMs),
+		retry.WithMaxTries(pipelineMaxTries),
+		retry.WithIsRetryableErr(func(err error) bool {
+			return !errors.ErrRedisInvalidConfig.Equal(err) && errors.IsRetryableError(err)
+		}),
+	)
+	if err != nil {
+		if errors.ErrRedisInvalidConfig.Equal(err) {
+			return nil, err
+		}
+		return nil, errors.WrapError(errors.ErrRedisRequestFailed, err)
+	}
+	if err = firstError(replies); err != nil {
+		return nil, errors.WrapError(errors.ErrRedisRequestFailed, err)
+	}
+	return replies, nil
+}
+
+func (c *client) ping(ctx context.Context) error {
+	_, err := c.pipeline(ctx, []command{{"PING"}})
+	return err
+}
+
+// deleteByPattern deletes the keys matching the glob style pattern,
+// it returns the number of the deleted keys.
+func (c *client) deleteByPattern(ctx context.Context, pattern string) (int64, error) {
+	var (
+		cursor  = "0"
+		deleted int64
+	)
+	for {
+		replies, err := c.pipeline(ctx, []command{
+			{"SCAN", cursor, "MATCH", pattern, "COUNT", strconv.Itoa(scanCount)},
+		})
+		if err != nil {
+			return deleted, err
+		}
+		result, ok := replies[0].([]any)
+		if !ok || len(result) != 2 {
+			return deleted, errors.ErrRedisRequestFailed.GenWithStack("unexpected reply of SCAN: %v", replies[0])
+		}
+		next, _ := result[0].([]byte)
+		keys, _ := result[1].([]any)
+		if len(keys) > 0 {
+			del := make(command, 0, len(keys)+1)
+			del = append(del, "DEL")
+			for _, key := range keys {
+				if k, ok := key.([]byte); ok {
+					del = append(del, string(k))
+				}
+			}
+			replies, err = c.pipeline(ctx, []command{del})
+			if err != nil {
+				return deleted, err
+			}
+			if n, ok := replies[0].(int64); ok {
+				deleted += n
+			}
+		}
+		cursor = string(next)
+		if cursor == "0" || cursor == "" {
+			return deleted, nil
+		}
+	}
+}
+
+func (c *client) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.disconnect()
+}
+
+// writeCommand encodes the command as an array of bulk strings.
+func writeCommand(w *bufio.Writer, cmd command) {
+	w.WriteByte('*')
+	w.WriteString(strconv.Itoa(len(cmd)))
+	w.WriteString("\r\n")
+	for _, arg := range cmd {
+		w.WriteByte('$')
+		w.WriteString(strconv.Itoa(len(arg)))
+		w.WriteString("\r\n")
+		w.WriteString(arg)
+		w.WriteString("\r\n")
+	}
+}
+
+// readReply reads a reply, which is one of string, redisError, int64, []byte,
+// []any and nil.
+func readReply(r *bufio.Reader) (any, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, errors.ErrRedisRequestFailed.GenWithStack("invalid reply line %q", line)
+	}
+	payload := line[1 : len(line)-2]
+	switch line[0] {
+	case '+':
+		return payload, nil
+	case '-':
+		return redisError(payload), nil
+	case ':':
+		n, err := strconv.ParseInt(payload, 10, 64)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return n, nil
+	case '$':
+		n, err := strconv.Atoi(payload)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, n+2)
+		if _, err = io.ReadFull(r, buf); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return buf[:n], nil
+	case '*':
+		n, err := strconv.Atoi(payload)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		items := make([]any, 0, n)
+		for i := 0; i < n; i++ {
+			item, err := readReply(r)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	default:
+		return nil, errors.ErrRedisRequestFailed.GenWithStack("unknown reply type %q", line[0])
+	}
+}
+
+// firstError returns the first error reply, including the error in the reply of EXEC.
+func firstError(replies []any) error {
+	for _, reply := range replies {
+		switch v := reply.(type) {
+		case redisError:
+			return v
+		case []any:
+			if err := firstError(v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+// keySeparator joins the parts of the keys, it's the convention of redis.
+const keySeparator = ":"
+
+const (
+	messageTypeInsert   = "insert"
+	messageTypeUpdate   = "update"
+	messageTypeDelete   = "delete"
+	messageTypeTruncate = "truncate"
+	messageTypeDrop     = "drop"
+)
+
+// invalidation is the message published for a row change or a destructive DDL.
+// Key is the key of the row in the hash mode, or the pattern of the keys of
+// the table for the DDLs, so the consumers can invalidate the caches by it.
+type invalidation struct {
+	Type     string            `json:"type"`
+	Schema   string            `json:"schema"`
+	Table    string            `json:"table"`
+	Key      string            `json:"key"`
+	Handle   map[string]string `json:"handle,omitempty"`
+	CommitTs uint64            `json:"commitTs"`
+}
+
+// tableKeyPrefix returns the prefix of the keys of the table.
+func tableKeyPrefix(keyPrefix, schema, table string) string {
+	return keyPrefix + schema + keySeparator + table + keySeparator
+}
+
+// tableKeyPattern returns the glob style pattern matching the keys of the table.
+// The table name may contain the special characters of the pattern, which are escaped.
+func tableKeyPattern(keyPrefix, schema, table string) string {
+	return escapePattern(tableKeyPrefix(keyPrefix, schema, table)) + "*"
+}
+
+// schemaKeyPattern returns the glob style pattern matching the keys of the schema.
+func schemaKeyPattern(keyPrefix, schema string) string {
+	return escapePattern(keyPrefix+schema+keySeparator) + "*"
+}
+
+func escapePattern(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\', '^', '-':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// genKey generates the key of the row from its handle key columns, the key is
+// `{key-prefix}{schema}:{table}:{handle values joined by :}`.
+func genKey(keyPrefix string, row *chunk.Row, tableInfo *common.TableInfo) (string, map[string]string, error) {
+	colIDs := tableInfo.GetOrderedHandleKeyColumnIDs()
+	if len(colIDs) == 0 {
+		return "", nil, errors.ErrRedisInvalidConfig.GenWithStack(
+			"table %s.%s has neither primary key nor not null unique key, "+
+				"it can't be replicated to redis",
+			tableInfo.GetSchemaName(), tableInfo.GetTableName())
+	}
+	var (
+		b      strings.Builder
+		handle = make(map[string]string, len(colIDs))
+	)
+	b.WriteString(tableKeyPrefix(keyPrefix, tableInfo.GetTargetSchemaName(), tableInfo.GetTargetTableName()))
+	for i, colID := range colIDs {
+		// chunk.Row is laid out in schema column order, see genKeyList in the mysql sink.
+		offset := tableInfo.MustGetColumnOffsetByID(colID)
+		col := tableInfo.GetColumns()[offset]
+		value := common.ColumnValueString(common.ExtractColVal(row, col, offset))
+		if i > 0 {
+			b.WriteString(keySeparator)
+		}
+		b.WriteString(value)
+		handle[col.Name.O] = value
+	}
+	return b.String(), handle, nil
+}
+
+// genHashFields returns the field and value pairs of the selected columns.
+// The NULL values and the virtual generated columns are omitted.
+func genHashFields(row *chunk.Row, tableInfo *common.TableInfo, selector commonEvent.Selector) []string {
+	columns := tableInfo.GetColumns()
+	fields := make([]string, 0, 2*len(columns))
+	for offset, col := range columns {
+		if col == nil || col.IsVirtualGenerated() || !selector.Select(col) {
+			continue
+		}
+		value := common.ExtractColVal(row, col, offset)
+		if value == nil {
+			continue
+		}
+		fields = append(fields, col.Name.O, common.ColumnValueString(value))
+	}
+	return fields
+}
+
+// genRowCommands generates the commands to apply the row change in the hash mode.
+// The hash is recreated for the insert and the update in a transaction, so the
+// fields which become NULL or are no longer selected are removed.
+func genRowCommands(keyPrefix string, row *commonEvent.RowEvent) ([]command, error) {
+	tableInfo := row.TableInfo
+	if row.IsDelete() {
+		key, _, err := genKey(keyPrefix, row.GetPreRows(), tableInfo)
+		if err != nil {
+			return nil, err
+		}
+		return []command{{"DEL", key}}, nil
+	}
+
+	key, _, err := genKey(keyPrefix, row.GetRows(), tableInfo)
+	if err != nil {
+		return nil, err
+	}
+	cmds := []command{{"MULTI"}}
+	if row.IsUpdate() {
+		preKey, _, err := genKey(keyPrefix, row.GetPreRows(), tableInfo)
+		if err != nil {
+			return nil, err
+		}
+		if preKey != key {
+			cmds = append(cmds, command{"DEL", preKey})
+		}
+	}
+	cmds = append(cmds, command{"DEL", key})
+	// redis doesn't store an empty hash, so the key is left deleted if all
+	// the columns are NULL or unselected.
+	fields := genHashFields(row.GetRows(), tableInfo, row.ColumnSelector)
+	if len(fields) > 0 {
+		hset := make(command, 0, len(fields)+2)
+		hset = append(hset, "HSET", key)
+		hset = append(hset, fields...)
+		cmds = append(cmds, hset)
+	}
+	cmds = append(cmds, command{"EXEC"})
+	return cmds, nil
+}
+
+// genInvalidations generates the invalidation messages of the row change. The
+// update which changes the handle key invalidates both of the old and new keys.
+func genInvalidations(keyPrefix string, row *commonEvent.RowEvent) ([]*invalidation, error) {
+	tableInfo := row.TableInfo
+	newMessage := func(typ string, r *chunk.Row) (*invalidation, error) {
+		key, handle, err := genKey(keyPrefix, r, tableInfo)
+		if err != nil {
+			return nil, err
+		}
+		return &invalidation{
+			Type:     typ,
+			Schema:   tableInfo.GetTargetSchemaName(),
+			Table:    tableInfo.GetTargetTableName(),
+			Key:      key,
+			Handle:   handle,
+			CommitTs: row.CommitTs,
+		}, nil
+	}
+	switch {
+	case row.IsDelete():
+		message, err := newMessage(messageTypeDelete, row.GetPreRows())
+		if err != nil {
+			return nil, err
+		}
+		return []*invalidation{message}, nil
+	case row.IsInsert():
+		message, err := newMessage(messageTypeInsert, row.GetRows())
+		if err != nil {
+			return nil, err
+		}
+		return []*invalidation{message}, nil
+	default:
+		message, err := newMessage(messageTypeUpdate, row.GetRows())
+		if err != nil {
+			return nil, err
+		}
+		preMessage, err := newMessage(messageTypeUpdate, row.GetPreRows())
+		if err != nil {
+			return nil, err
+		}
+		if preMessage.Key == message.Key {
+			return []*invalidation{message}, nil
+		}
+		preMessage.Type = messageTypeDelete
+		return []*invalidation{preMessage, message}, nil
+	}
+}
+
+// genMessageCommand generates the command to publish the message in the pubsub
+// mode, or to append the message to the stream in the stream mode.
+func genMessageCommand(opts *options, channel string, message *invalidation) (command, error) {
+	if opts.mode == modePubSub {
+		value, err := json.Marshal(message)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrRedisRequestFailed, err)
+		}
+		return command{"PUBLISH", channel, string(value)}, nil
+	}
+	cmd := command{"XADD", channel}
+	if opts.streamMaxLen > 0 {
+		cmd = append(cmd, "MAXLEN", "~", strconv.FormatInt(opts.streamMaxLen, 10))
+	}
+	cmd = append(cmd, "*",
+		"type", message.Type,
+		"schema", message.Schema,
+		"table", message.Table,
+		"key", message.Key,
+		"commitTs", strconv.FormatUint(message.CommitTs, 10))
+	return cmd, nil
+}
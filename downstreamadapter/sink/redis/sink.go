@@ -0,0 +1,364 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/columnselector"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/eventrouter"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/helper"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/metrics"
+	"github.com/pingcap/ticdc/utils/chann"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// sink writes the row changes into redis. In the hash mode, each row is written
+// as a hash keyed by the schema, the table and the handle key of the row, so the
+// redis keeps a materialized view of the tables. In the pubsub and stream modes,
+// an invalidation message is published for each row change, and the channel or
+// stream of the message is decided by the topic rule of the dispatchers.
+type sink struct {
+	changefeedID common.ChangeFeedID
+	ctx          context.Context
+
+	options        *options
+	client         *client
+	eventRouter    *eventrouter.EventRouter
+	columnSelector *columnselector.ColumnSelectors
+	statistics     *metrics.Statistics
+	eventCh        *chann.UnlimitedChannel[*commonEvent.DMLEvent, any]
+
+	// isNormal indicate whether the sink is in the normal state.
+	isNormal *atomic.Bool
+}
+
+type components struct {
+	options        *options
+	eventRouter    *eventrouter.EventRouter
+	columnSelector *columnselector.ColumnSelectors
+	client         *client
+}
+
+func newComponents(sinkURI *url.URL, sinkConfig *config.SinkConfig) (*components, error) {
+	opts := newOptions()
+	if err := opts.apply(sinkURI); err != nil {
+		return nil, err
+	}
+	eventRouter, err := eventrouter.NewEventRouter(sinkConfig, opts.channel, false, false)
+	if err != nil {
+		return nil, err
+	}
+	columnSelector, err := columnselector.New(sinkConfig)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &components{
+		options:        opts,
+		eventRouter:    eventRouter,
+		columnSelector: columnSelector,
+		client:         client,
+	}, nil
+}
+
+// Verify checks the sink URI and whether the redis is reachable.
+func Verify(ctx context.Context, sinkURI *url.URL, sinkConfig *config.SinkConfig) error {
+	comp, err := newComponents(sinkURI, sinkConfig)
+	if err != nil {
+		return err
+	}
+	defer comp.client.close()
+	return comp.client.ping(ctx)
+}
+
+// New creates a redis sink.
+func New(
+	ctx context.Context,
+	changefeedID common.ChangeFeedID,
+	sinkURI *url.URL,
+	sinkConfig *config.SinkConfig,
+	keyspaceID uint32,
+) (*sink, error) {
+	comp, err := newComponents(sinkURI, sinkConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err = comp.client.ping(ctx); err != nil {
+		comp.client.close()
+		return nil, err
+	}
+	return &sink{
+		changefeedID:   changefeedID,
+		ctx:            ctx,
+		options:        comp.options,
+		client:         comp.client,
+		eventRouter:    comp.eventRouter,
+		columnSelector: comp.columnSelector,
+		statistics:     metrics.NewStatistics(changefeedID, keyspaceID, "sink"),
+		eventCh:        chann.NewUnlimitedChannelDefault[*commonEvent.DMLEvent](),
+		isNormal:       atomic.NewBool(true),
+	}, nil
+}
+
+func (s *sink) SinkType() common.SinkType {
+	return common.RedisSinkType
+}
+
+func (s *sink) IsNormal() bool {
+	return s.isNormal.Load()
+}
+
+func (s *sink) SetTableSchemaStore(_ *commonEvent.TableSchemaStore) {
+}
+
+func (s *sink) AddDMLEvent(event *commonEvent.DMLEvent) {
+	s.eventCh.Push(event)
+}
+
+func (s *sink) FlushDMLBeforeBlock(_ commonEvent.BlockEvent) error {
+	return nil
+}
+
+func (s *sink) WriteBlockEvent(event commonEvent.BlockEvent) error {
+	var err error
+	switch v := event.(type) {
+	case *commonEvent.DDLEvent:
+		err = s.writeDDLEvent(s.ctx, v)
+	default:
+		log.Error("unsupported redis sink block event type",
+			zap.String("keyspace", s.changefeedID.Keyspace()),
+			zap.String("changefeed", s.changefeedID.Name()),
+			zap.String("eventType", commonEvent.TypeToString(event.GetType())))
+		return errors.ErrInvalidEventType.GenWithStackByArgs(commonEvent.TypeToString(event.GetType()))
+	}
+	if err != nil {
+		s.isNormal.Store(false)
+		return err
+	}
+	event.PostFlush()
+	return nil
+}
+
+func (s *sink) AddCheckpointTs(_ uint64) {
+}
+
+func (s *sink) Run(ctx context.Context) error {
+	buffer := make([]*commonEvent.DMLEvent, 0, s.BatchCount())
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		default:
+		}
+		// GetMultipleNoGroup blocks until there are events or the channel is closed.
+		events, ok := s.eventCh.GetMultipleNoGroup(buffer)
+		if !ok {
+			return nil
+		}
+		if err := s.writeDMLEvents(ctx, events); err != nil {
+			s.isNormal.Store(false)
+			log.Error("redis sink write events failed",
+				zap.String("keyspace", s.changefeedID.Keyspace()),
+				zap.String("changefeed", s.changefeedID.Name()),
+				zap.Error(err))
+			return err
+		}
+		buffer = buffer[:0]
+	}
+}
+
+// commandBatch collects the commands of the rows, which are sent in a pipeline.
+type commandBatch struct {
+	commands  []command
+	callbacks []func()
+	bytes     int64
+}
+
+func (b *commandBatch) add(cmds []command, callback func()) {
+	for _, cmd := range cmds {
+		for _, arg := range cmd {
+			b.bytes += int64(len(arg))
+		}
+	}
+	b.commands = append(b.commands, cmds...)
+	b.callbacks = append(b.callbacks, callback)
+}
+
+func (b *commandBatch) reset() {
+	b.commands = b.commands[:0]
+	b.callbacks = b.callbacks[:0]
+	b.bytes = 0
+}
+
+// writeDMLEvents writes the rows of the events in pipelines. The callback of
+// a row is called after the pipeline containing the row is replied.
+func (s *sink) writeDMLEvents(ctx context.Context, events []*commonEvent.DMLEvent) error {
+	var batch commandBatch
+	for _, event := range events {
+		if event.Len() == 0 {
+			event.PostFlush()
+			continue
+		}
+		callback := helper.NewPostFlushRowCallback(event, uint64(event.Len()))
+		selector := s.columnSelector.GetForTableInfo(event.TableInfo)
+		for _, row := range helper.NewRowEvents(event, selector, callback) {
+			cmds, err := s.genCommands(row)
+			if err != nil {
+				return err
+			}
+			batch.add(cmds, row.Callback)
+			if len(batch.commands) >= s.options.batchSize {
+				if err = s.flush(ctx, &batch); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return s.flush(ctx, &batch)
+}
+
+func (s *sink) genCommands(row *commonEvent.RowEvent) ([]command, error) {
+	if s.options.mode == modeHash {
+		return genRowCommands(s.options.keyPrefix, row)
+	}
+	messages, err := genInvalidations(s.options.keyPrefix, row)
+	if err != nil {
+		return nil, err
+	}
+	channel := s.eventRouter.GetTopicForRowChange(row.TableInfo.GetTargetSchemaName(), row.TableInfo.GetTargetTableName())
+	cmds := make([]command, 0, len(messages))
+	for _, message := range messages {
+		cmd, err := genMessageCommand(s.options, channel, message)
+		if err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, cmd)
+	}
+	return cmds, nil
+}
+
+func (s *sink) flush(ctx context.Context, batch *commandBatch) error {
+	if len(batch.callbacks) == 0 {
+		return nil
+	}
+	err := s.statistics.RecordBatchExecution(func() (int, int64, error) {
+		_, err := s.client.pipeline(ctx, batch.commands)
+		return len(batch.callbacks), batch.bytes, err
+	})
+	if err != nil {
+		return err
+	}
+	for _, callback := range batch.callbacks {
+		callback()
+	}
+	batch.reset()
+	return nil
+}
+
+func (s *sink) writeDDLEvent(ctx context.Context, event *commonEvent.DDLEvent) error {
+	for _, e := range event.GetEvents() {
+		err := s.statistics.RecordDDLExecution(func() (string, error) {
+			return e.GetDDLType().String(), s.execDDL(ctx, e)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execDDL clears the keyspace of the table for the destructive DDLs. The rows
+// of a partition can't be told apart by the keys, so the whole table is cleared
+// for the partition level DDLs. The other DDLs are skipped.
+func (s *sink) execDDL(ctx context.Context, event *commonEvent.DDLEvent) error {
+	var (
+		schema  = event.GetTargetSchemaName()
+		table   = event.GetTargetTableName()
+		typ     string
+		pattern string
+	)
+	switch event.GetDDLType() {
+	case model.ActionTruncateTable, model.ActionTruncateTablePartition:
+		typ, pattern = messageTypeTruncate, tableKeyPattern(s.options.keyPrefix, schema, table)
+	case model.ActionDropTable, model.ActionDropTablePartition:
+		typ, pattern = messageTypeDrop, tableKeyPattern(s.options.keyPrefix, schema, table)
+	case model.ActionDropSchema:
+		typ, pattern = messageTypeDrop, schemaKeyPattern(s.options.keyPrefix, schema)
+	default:
+		log.Info("redis sink skip the DDL",
+			zap.String("keyspace", s.changefeedID.Keyspace()),
+			zap.String("changefeed", s.changefeedID.Name()),
+			zap.String("query", event.GetDDLQuery()))
+		return nil
+	}
+
+	if s.options.mode == modeHash {
+		deleted, err := s.client.deleteByPattern(ctx, pattern)
+		if err != nil {
+			return err
+		}
+		log.Info("redis sink clear the keys",
+			zap.String("keyspace", s.changefeedID.Keyspace()),
+			zap.String("changefeed", s.changefeedID.Name()),
+			zap.String("pattern", pattern),
+			zap.Int64("deleted", deleted),
+			zap.String("query", event.GetDDLQuery()))
+		return nil
+	}
+
+	var channel string
+	if table == "" {
+		channel = s.options.channel
+	} else {
+		channel = s.eventRouter.GetTopicForRowChange(schema, table)
+	}
+	cmd, err := genMessageCommand(s.options, channel, &invalidation{
+		Type:     typ,
+		Schema:   schema,
+		Table:    table,
+		Key:      pattern,
+		CommitTs: event.GetCommitTs(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.client.pipeline(ctx, []command{cmd})
+	return err
+}
+
+func (s *sink) Close() {
+	s.eventCh.Close()
+	s.client.close()
+	s.statistics.Close()
+}
+
+func (s *sink) BatchCount() int {
+	return 4096
+}
+
+func (s *sink) BatchBytes() int {
+	return 0
+}
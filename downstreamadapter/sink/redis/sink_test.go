@@ -0,0 +1,488 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+// fakeServer is an in-process stand-in of redis, which supports the commands
+// used by the sink.
+type fakeServer struct {
+	listener net.Listener
+	password string
+
+	mu       sync.Mutex
+	hashes   map[string]map[string]string
+	messages map[string][]string
+	streams  map[string][]map[string]string
+	commands []string
+}
+
+func newFakeServer(t *testing.T, password string) *fakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &fakeServer{
+		listener: listener,
+		password: password,
+		hashes:   make(map[string]map[string]string),
+		messages: make(map[string][]string),
+		streams:  make(map[string][]map[string]string),
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { _ = listener.Close() })
+	return s
+}
+
+func (s *fakeServer) addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeServer) serve(conn net.Conn) {
+	defer conn.Close()
+	var (
+		reader = bufio.NewReader(conn)
+		writer = bufio.NewWriter(conn)
+		authed = s.password == ""
+		queue  [][]string
+		multi  bool
+	)
+	for {
+		cmd, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+		name := strings.ToUpper(cmd[0])
+		switch {
+		case name == "AUTH":
+			authed = cmd[len(cmd)-1] == s.password
+			if authed {
+				writer.WriteString("+OK\r\n")
+			} else {
+				writer.WriteString("-WRONGPASS invalid password\r\n")
+			}
+		case !authed:
+			writer.WriteString("-NOAUTH Authentication required\r\n")
+		case name == "MULTI":
+			multi = true
+			writer.WriteString("+OK\r\n")
+		case name == "EXEC":
+			writer.WriteString("*" + strconv.Itoa(len(queue)) + "\r\n")
+			for _, queued := range queue {
+				writer.WriteString(s.exec(queued))
+			}
+			multi, queue = false, nil
+		case multi:
+			queue = append(queue, cmd)
+			writer.WriteString("+QUEUED\r\n")
+		default:
+			writer.WriteString(s.exec(cmd))
+		}
+		if reader.Buffered() == 0 {
+			if err = writer.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *fakeServer) exec(cmd []string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.commands = append(s.commands, strings.Join(cmd, " "))
+	switch strings.ToUpper(cmd[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "SELECT":
+		return "+OK\r\n"
+	case "DEL":
+		deleted := 0
+		for _, key := range cmd[1:] {
+			if _, ok := s.hashes[key]; ok {
+				delete(s.hashes, key)
+				deleted++
+			}
+		}
+		return ":" + strconv.Itoa(deleted) + "\r\n"
+	case "HSET":
+		hash, ok := s.hashes[cmd[1]]
+		if !ok {
+			hash = make(map[string]string)
+			s.hashes[cmd[1]] = hash
+		}
+		for i := 2; i+1 < len(cmd); i += 2 {
+			hash[cmd[i]] = cmd[i+1]
+		}
+		return ":" + strconv.Itoa((len(cmd)-2)/2) + "\r\n"
+	case "SCAN":
+		// all the matched keys are returned in one round.
+		var keys []string
+		for key := range s.hashes {
+			if ok, _ := path.Match(cmd[3], key); ok {
+				keys = append(keys, key)
+			}
+		}
+		reply := "*2\r\n$1\r\n0\r\n*" + strconv.Itoa(len(keys)) + "\r\n"
+		for _, key := range keys {
+			reply += "$" + strconv.Itoa(len(key)) + "\r\n" + key + "\r\n"
+		}
+		return reply
+	case "PUBLISH":
+		s.messages[cmd[1]] = append(s.messages[cmd[1]], cmd[2])
+		return ":0\r\n"
+	case "XADD":
+		args := cmd[2:]
+		if strings.ToUpper(args[0]) == "MAXLEN" {
+			args = args[3:]
+		}
+		entry := make(map[string]string)
+		for i := 1; i+1 < len(args); i += 2 {
+			entry[args[i]] = args[i+1]
+		}
+		s.streams[cmd[1]] = append(s.streams[cmd[1]], entry)
+		return "$3\r\n1-0\r\n"
+	default:
+		return "-ERR unknown command '" + cmd[0] + "'\r\n"
+	}
+}
+
+func (s *fakeServer) getHash(key string) (map[string]string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hash, ok := s.hashes[key]
+	return hash, ok
+}
+
+func (s *fakeServer) getKeys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.hashes))
+	for key := range s.hashes {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (s *fakeServer) getMessages(channel string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.messages[channel]...)
+}
+
+func (s *fakeServer) getStream(stream string) []map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]map[string]string(nil), s.streams[stream]...)
+}
+
+func (s *fakeServer) getCommands() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.commands...)
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	cmd := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		line, err = r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err = io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		cmd = append(cmd, string(buf[:size]))
+	}
+	return cmd, nil
+}
+
+func newSinkForTest(
+	ctx context.Context, t *testing.T, server *fakeServer, userInfo, query string, sinkConfig *config.SinkConfig,
+) *sink {
+	sinkURI, err := url.Parse("redis://" + userInfo + server.addr() + "/1" + query)
+	require.NoError(t, err)
+	if sinkConfig == nil {
+		sinkConfig = &config.SinkConfig{}
+	}
+	s, err := New(ctx, common.NewChangefeedID4Test("test", t.Name()), sinkURI, sinkConfig, common.DefaultKeyspaceID)
+	require.NoError(t, err)
+	return s
+}
+
+func TestRedisSinkHashMode(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t, "pass")
+	sinkConfig := &config.SinkConfig{
+		ColumnSelectors: []*config.ColumnSelector{
+			{Matcher: []string{"test.t"}, Columns: []string{"*", "!secret"}},
+		},
+	}
+	s := newSinkForTest(ctx, t, server, ":pass@", "?key-prefix=cache:&batch-size=4", sinkConfig)
+	defer s.Close()
+	go s.Run(ctx)
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+	helper.DDL2Event("create table t (id int primary key, name varchar(32), secret varchar(32), note varchar(32))")
+
+	var flushed atomic.Int32
+	insertEvent := helper.DML2Event("test", "t",
+		"insert into t values (1, 'a', 's1', 'n1')",
+		"insert into t values (2, 'b', 's2', null)")
+	insertEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	updateEvent, _ := helper.DML2UpdateEvent("test", "t",
+		"insert into t values (3, 'c', 's3', 'n3')",
+		"update t set name = 'd', note = null where id = 3")
+	updateEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	deleteEvent := helper.DML2DeleteEvent("test", "t",
+		"insert into t values (4, 'e', 's4', 'n4')",
+		"delete from t where id = 4")
+	deleteEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+
+	s.AddDMLEvent(insertEvent)
+	s.AddDMLEvent(updateEvent)
+	s.AddDMLEvent(deleteEvent)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	hash, ok := server.getHash("cache:test:t:1")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"id": "1", "name": "a", "note": "n1"}, hash)
+	hash, ok = server.getHash("cache:test:t:2")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"id": "2", "name": "b"}, hash)
+	// the hash is recreated, so the field which becomes NULL is removed.
+	hash, ok = server.getHash("cache:test:t:3")
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"id": "3", "name": "d"}, hash)
+	_, ok = server.getHash("cache:test:t:4")
+	require.False(t, ok)
+	require.Contains(t, server.getCommands(), "SELECT 1")
+	require.True(t, s.IsNormal())
+
+	// the keyspace of the table is cleared by the truncate table.
+	helper.DDL2Event("create table t2 (id int primary key)")
+	t2Event := helper.DML2Event("test", "t2", "insert into t2 values (1)")
+	t2Event.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	s.AddDMLEvent(t2Event)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 4
+	}, 5*time.Second, 10*time.Millisecond)
+
+	ddlEvent := helper.DDL2Event("truncate table t")
+	ddlEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	require.NoError(t, s.WriteBlockEvent(ddlEvent))
+	require.EqualValues(t, 5, flushed.Load())
+	require.ElementsMatch(t, []string{"cache:test:t2:1"}, server.getKeys())
+
+	require.NoError(t, s.WriteBlockEvent(helper.DDL2Event("drop table t2")))
+	require.Empty(t, server.getKeys())
+}
+
+func TestRedisSinkPubSubMode(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t, "")
+	sinkConfig := &config.SinkConfig{
+		DispatchRules: []*config.DispatchRule{
+			{Matcher: []string{"test.t"}, TopicRule: "invalidate_{table}"},
+		},
+	}
+	s := newSinkForTest(ctx, t, server, "", "?mode=pubsub", sinkConfig)
+	defer s.Close()
+	go s.Run(ctx)
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+	helper.DDL2Event("create table t (id int primary key, name varchar(32))")
+
+	var flushed atomic.Int32
+	updateEvent, _ := helper.DML2UpdateEvent("test", "t",
+		"insert into t values (1, 'a')",
+		"update t set name = 'b' where id = 1")
+	updateEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	s.AddDMLEvent(updateEvent)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, s.WriteBlockEvent(helper.DDL2Event("truncate table t")))
+
+	messages := server.getMessages("invalidate_t")
+	require.Len(t, messages, 2)
+	var message invalidation
+	require.NoError(t, json.Unmarshal([]byte(messages[0]), &message))
+	require.Equal(t, messageTypeUpdate, message.Type)
+	require.Equal(t, "test:t:1", message.Key)
+	require.Equal(t, map[string]string{"id": "1"}, message.Handle)
+	require.Equal(t, updateEvent.CommitTs, message.CommitTs)
+	require.NoError(t, json.Unmarshal([]byte(messages[1]), &message))
+	require.Equal(t, messageTypeTruncate, message.Type)
+	require.Equal(t, "test:t:*", message.Key)
+	// the hashes are not written in the pubsub mode.
+	require.Empty(t, server.getKeys())
+}
+
+func TestRedisSinkPubSubModeWithRouting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t, "")
+	sinkConfig := &config.SinkConfig{
+		DispatchRules: []*config.DispatchRule{
+			{Matcher: []string{"target_db.*"}, TopicRule: "invalidate_{schema}_{table}"},
+		},
+	}
+	s := newSinkForTest(ctx, t, server, "", "?mode=pubsub", sinkConfig)
+	defer s.Close()
+	go s.Run(ctx)
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+	helper.DDL2Event("create table t (id int primary key, name varchar(32))")
+
+	// the channel is routed by the target names of the table.
+	var flushed atomic.Int32
+	insertEvent := helper.DML2Event("test", "t", "insert into t values (1, 'a')")
+	insertEvent.TableInfo = insertEvent.TableInfo.CloneWithRouting("target_db", "target_t")
+	insertEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	s.AddDMLEvent(insertEvent)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	ddlEvent := helper.DDL2Event("truncate table t")
+	routedDDLEvent := commonEvent.NewRoutedDDLEvent(ddlEvent, ddlEvent.Query,
+		"target_db", "target_t", "", "", ddlEvent.TableInfo, nil, nil)
+	require.NoError(t, s.WriteBlockEvent(routedDDLEvent))
+
+	messages := server.getMessages("invalidate_target_db_target_t")
+	require.Len(t, messages, 2)
+	var message invalidation
+	require.NoError(t, json.Unmarshal([]byte(messages[0]), &message))
+	require.Equal(t, messageTypeInsert, message.Type)
+	require.Equal(t, "target_db:target_t:1", message.Key)
+	require.NoError(t, json.Unmarshal([]byte(messages[1]), &message))
+	require.Equal(t, messageTypeTruncate, message.Type)
+	require.Equal(t, "target_db:target_t:*", message.Key)
+	require.Empty(t, server.getMessages("invalidate_test_t"))
+}
+
+func TestRedisSinkStreamMode(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t, "")
+	s := newSinkForTest(ctx, t, server, "", "?mode=stream&channel=changes&stream-max-len=100", nil)
+	defer s.Close()
+	go s.Run(ctx)
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+	helper.DDL2Event("create table t (a int, b int, primary key (a, b))")
+
+	var flushed atomic.Int32
+	deleteEvent := helper.DML2DeleteEvent("test", "t",
+		"insert into t values (1, 2)",
+		"delete from t where a = 1")
+	deleteEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	s.AddDMLEvent(deleteEvent)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	entries := server.getStream("changes")
+	require.Len(t, entries, 1)
+	require.Equal(t, messageTypeDelete, entries[0]["type"])
+	require.Equal(t, "test:t:1:2", entries[0]["key"])
+	require.Contains(t, server.getCommands()[len(server.getCommands())-1], "XADD changes MAXLEN ~ 100 *")
+}
+
+func TestRedisSinkWithoutHandleKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t, "")
+	s := newSinkForTest(ctx, t, server, "", "", nil)
+	defer s.Close()
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+	helper.DDL2Event("create table t (id int, name varchar(32))")
+
+	s.AddDMLEvent(helper.DML2Event("test", "t", "insert into t values (1, 'a')"))
+	err := s.Run(ctx)
+	require.True(t, errors.ErrRedisInvalidConfig.Equal(err))
+	require.False(t, s.IsNormal())
+}
+
+func TestRedisVerify(t *testing.T) {
+	server := newFakeServer(t, "pass")
+	sinkURI, err := url.Parse("redis://:pass@" + server.addr() + "/0")
+	require.NoError(t, err)
+	require.NoError(t, Verify(context.Background(), sinkURI, &config.SinkConfig{}))
+
+	sinkURI, err = url.Parse("redis://:wrong@" + server.addr() + "/0")
+	require.NoError(t, err)
+	err = Verify(context.Background(), sinkURI, &config.SinkConfig{})
+	require.True(t, errors.ErrRedisInvalidConfig.Equal(err))
+}
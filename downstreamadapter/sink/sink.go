@@ -19,9 +19,13 @@ import (
 
 	"github.com/pingcap/ticdc/downstreamadapter/sink/blackhole"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/cloudstorage"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/deadletter"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/elasticsearch"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/kafka"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/mysql"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/pulsar"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/redis"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/webhook"
 	"github.com/pingcap/ticdc/pkg/common"
 	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
 	"github.com/pingcap/ticdc/pkg/config"
@@ -50,6 +54,20 @@ type Sink interface {
 	BatchBytes() int
 }
 
+// DeadLetterSink is implemented by the sinks which write the events rejected
+// by the downstream to a dead letter queue, instead of failing on them.
+type DeadLetterSink interface {
+	// DeadLetterQueue returns nil if the dead letter queue is not configured.
+	DeadLetterQueue() *deadletter.Queue
+}
+
+// CheckpointFlusher is implemented by the sinks which emit the checkpoint ts
+// to the downstream asynchronously in AddCheckpointTs, it emits the checkpoint
+// ts synchronously, such as the final checkpoint ts before the sink is closed.
+type CheckpointFlusher interface {
+	FlushCheckpointTs(ctx context.Context, ts uint64) error
+}
+
 func New(ctx context.Context, cfg *config.ChangefeedConfig, changefeedID common.ChangeFeedID, keyspaceID uint32) (Sink, error) {
 	sinkURI, err := url.Parse(cfg.SinkURI)
 	if err != nil {
@@ -68,6 +86,12 @@ func New(ctx context.Context, cfg *config.ChangefeedConfig, changefeedID common.
 		return pulsar.New(ctx, changefeedID, sinkURI, cfg.SinkConfig, keyspaceID)
 	case config.S3Scheme, config.FileScheme, config.GCSScheme, config.GSScheme, config.AzblobScheme, config.AzureScheme, config.CloudStorageNoopScheme:
 		return cloudstorage.New(ctx, changefeedID, sinkURI, cfg.SinkConfig, cfg.EnableTableAcrossNodes, nil, keyspaceID)
+	case config.ElasticsearchScheme, config.ElasticsearchSSLScheme, config.OpenSearchScheme, config.OpenSearchSSLScheme:
+		return elasticsearch.New(ctx, changefeedID, sinkURI, cfg.SinkConfig, keyspaceID)
+	case config.WebhookScheme, config.WebhookSSLScheme:
+		return webhook.New(ctx, changefeedID, sinkURI, cfg.SinkConfig, keyspaceID)
+	case config.RedisScheme, config.RedisSSLScheme:
+		return redis.New(ctx, changefeedID, sinkURI, cfg.SinkConfig, keyspaceID)
 	case config.BlackHoleScheme:
 		return blackhole.New(changefeedID, keyspaceID)
 	}
@@ -93,6 +117,12 @@ func Verify(ctx context.Context, cfg *config.ChangefeedConfig, changefeedID comm
 		return pulsar.Verify(ctx, changefeedID, sinkURI, cfg.SinkConfig)
 	case config.S3Scheme, config.FileScheme, config.GCSScheme, config.GSScheme, config.AzblobScheme, config.AzureScheme, config.CloudStorageNoopScheme:
 		return cloudstorage.Verify(ctx, changefeedID, sinkURI, cfg.SinkConfig, cfg.EnableTableAcrossNodes)
+	case config.ElasticsearchScheme, config.ElasticsearchSSLScheme, config.OpenSearchScheme, config.OpenSearchSSLScheme:
+		return elasticsearch.Verify(ctx, sinkURI, cfg.SinkConfig)
+	case config.WebhookScheme, config.WebhookSSLScheme:
+		return webhook.Verify(ctx, changefeedID, sinkURI, cfg.SinkConfig)
+	case config.RedisScheme, config.RedisSSLScheme:
+		return redis.Verify(ctx, sinkURI, cfg.SinkConfig)
 	case config.BlackHoleScheme:
 		return nil
 	}
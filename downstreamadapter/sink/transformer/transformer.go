@@ -0,0 +1,391 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transformer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"go.uber.org/zap"
+)
+
+// Transformer transforms the column values of the tables matched by a rule.
+type Transformer struct {
+	tableF filter.Filter
+	rule   *config.TransformRule
+
+	// mu protects the fields below, the session context used to evaluate
+	// the expressions is not thread safe.
+	mu      sync.Mutex
+	sessCtx sessionctx.Context
+	// tables caches the column transforms of the tables,
+	// table id -> column transforms
+	tables map[int64]*tableTransforms
+}
+
+// tableTransforms is the column transforms of a table built from a table info.
+type tableTransforms struct {
+	updateTS uint64
+	columns  []*columnTransform
+}
+
+type columnTransform struct {
+	offset int
+	// transform returns the new value of the column, the row is the original
+	// row and the datum is the original value of the column.
+	transform func(row chunk.Row, d types.Datum) (types.Datum, error)
+}
+
+func newTransformer(
+	rule *config.TransformRule, caseSensitive bool, sessCtx sessionctx.Context,
+) (*Transformer, error) {
+	tableF, err := filter.Parse(rule.Matcher)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrFilterRuleInvalid, err, rule.Matcher)
+	}
+	if !caseSensitive {
+		tableF = filter.CaseInsensitive(tableF)
+	}
+	return &Transformer{
+		tableF:  tableF,
+		rule:    rule,
+		sessCtx: sessCtx,
+		tables:  make(map[int64]*tableTransforms),
+	}, nil
+}
+
+func (t *Transformer) match(schema, table string) bool {
+	return t.tableF.MatchTable(schema, table)
+}
+
+// getTableTransforms returns the column transforms of the table, they are
+// rebuilt if the table info is changed.
+// The caller must hold t.mu.
+func (t *Transformer) getTableTransforms(tableInfo *common.TableInfo) (*tableTransforms, error) {
+	tableID := tableInfo.TableName.TableID
+	if transforms, ok := t.tables[tableID]; ok && transforms.updateTS == tableInfo.GetUpdateTS() {
+		return transforms, nil
+	}
+	transforms, err := t.buildTableTransforms(tableInfo)
+	if err != nil {
+		return nil, err
+	}
+	t.tables[tableID] = transforms
+	return transforms, nil
+}
+
+func (t *Transformer) buildTableTransforms(tableInfo *common.TableInfo) (*tableTransforms, error) {
+	handleKeyColumns := getHandleKeyColumns(tableInfo)
+	columns := tableInfo.GetColumns()
+	transforms := &tableTransforms{
+		updateTS: tableInfo.GetUpdateTS(),
+		columns:  make([]*columnTransform, 0, len(t.rule.Columns)),
+	}
+	for _, cfg := range t.rule.Columns {
+		offset := -1
+		for i, col := range columns {
+			if col.Name.L == strings.ToLower(cfg.Column) {
+				offset = i
+				break
+			}
+		}
+		if offset < 0 {
+			return nil, errors.ErrTransformFailed.GenWithStack(
+				"the transformed column %s is not found, table: %v", cfg.Column, tableInfo.TableName)
+		}
+		col := columns[offset]
+		if _, ok := handleKeyColumns[col.Name.L]; ok {
+			return nil, errors.ErrTransformFailed.GenWithStack(
+				"the transformed column %s is a part of the primary key or a unique key, table: %v",
+				cfg.Column, tableInfo.TableName)
+		}
+		transform, err := t.newColumnTransform(cfg, col, tableInfo)
+		if err != nil {
+			return nil, err
+		}
+		transforms.columns = append(transforms.columns, &columnTransform{
+			offset:    offset,
+			transform: transform,
+		})
+	}
+	return transforms, nil
+}
+
+func (t *Transformer) newColumnTransform(
+	cfg *config.ColumnTransform, col *model.ColumnInfo, tableInfo *common.TableInfo,
+) (func(chunk.Row, types.Datum) (types.Datum, error), error) {
+	switch cfg.Type {
+	case config.TransformTypeHash, config.TransformTypeMask, config.TransformTypeTruncate:
+		if !types.IsString(col.GetType()) {
+			return nil, errors.ErrTransformFailed.GenWithStack(
+				"the %s transform only supports string columns, column: %s, table: %v",
+				cfg.Type, cfg.Column, tableInfo.TableName)
+		}
+	}
+	// the hashed value can't be truncated by the downstream, otherwise the
+	// values are not comparable anymore.
+	if cfg.Type == config.TransformTypeHash &&
+		col.GetFlen() != types.UnspecifiedLength && col.GetFlen() < hashValueLength {
+		return nil, errors.ErrTransformFailed.GenWithStack(
+			"the hash transform requires the column to hold at least %d characters, column: %s(%d), table: %v",
+			hashValueLength, cfg.Column, col.GetFlen(), tableInfo.TableName)
+	}
+
+	switch cfg.Type {
+	case config.TransformTypeHash:
+		return func(_ chunk.Row, d types.Datum) (types.Datum, error) {
+			if d.IsNull() {
+				return d, nil
+			}
+			return types.NewStringDatum(hashValue(d.GetBytes())), nil
+		}, nil
+	case config.TransformTypeMask:
+		maskChar := '*'
+		if cfg.MaskChar != "" {
+			maskChar = []rune(cfg.MaskChar)[0]
+		}
+		return func(_ chunk.Row, d types.Datum) (types.Datum, error) {
+			if d.IsNull() {
+				return d, nil
+			}
+			return types.NewStringDatum(maskValue(d.GetString(), cfg.KeepPrefix, cfg.KeepSuffix, maskChar)), nil
+		}, nil
+	case config.TransformTypeTruncate:
+		binary := types.IsBinaryStr(&col.FieldType)
+		return func(_ chunk.Row, d types.Datum) (types.Datum, error) {
+			if d.IsNull() {
+				return d, nil
+			}
+			if binary {
+				value := d.GetBytes()
+				if len(value) > cfg.Length {
+					value = value[:cfg.Length]
+				}
+				return types.NewBytesDatum(value), nil
+			}
+			value := []rune(d.GetString())
+			if len(value) > cfg.Length {
+				value = value[:cfg.Length]
+			}
+			return types.NewStringDatum(string(value)), nil
+		}, nil
+	case config.TransformTypeConstant:
+		var constant types.Datum
+		if cfg.Value != nil {
+			var err error
+			d := types.NewStringDatum(*cfg.Value)
+			constant, err = d.ConvertTo(t.sessCtx.GetExprCtx().GetEvalCtx().TypeCtx(), &col.FieldType)
+			if err != nil {
+				return nil, errors.ErrTransformFailed.Wrap(err).GenWithStack(
+					"the constant %s can not be converted to the type of column %s, table: %v",
+					*cfg.Value, cfg.Column, tableInfo.TableName)
+			}
+		}
+		return func(_ chunk.Row, _ types.Datum) (types.Datum, error) {
+			return constant, nil
+		}, nil
+	case config.TransformTypeExpression:
+		expr, err := expression.ParseSimpleExprWithTableInfo(
+			t.sessCtx.GetExprCtx(), cfg.Expression, tableInfo.ToTiDBTableInfo())
+		if err != nil {
+			log.Error("failed to parse transform expression",
+				zap.String("expression", cfg.Expression), zap.Error(err))
+			return nil, errors.ErrExpressionParseFailed.FastGenByArgs(err, cfg.Expression)
+		}
+		evalCtx := t.sessCtx.GetExprCtx().GetEvalCtx()
+		return func(row chunk.Row, _ types.Datum) (types.Datum, error) {
+			d, err := expr.Eval(evalCtx, row)
+			if err != nil {
+				return types.Datum{}, errors.WrapError(errors.ErrTransformFailed, err)
+			}
+			if d.IsNull() {
+				return d, nil
+			}
+			d, err = d.ConvertTo(evalCtx.TypeCtx(), &col.FieldType)
+			if err != nil {
+				return types.Datum{}, errors.WrapError(errors.ErrTransformFailed, err)
+			}
+			return d, nil
+		}, nil
+	default:
+		return nil, errors.ErrTransformFailed.GenWithStack(
+			"the transform type %s is not supported, column: %s", cfg.Type, cfg.Column)
+	}
+}
+
+// apply transforms the rows of the event in place. The rows of the event may
+// be shared with other events, so a new chunk is built for the event.
+func (t *Transformer) apply(event *commonEvent.DMLEvent) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	transforms, err := t.getTableTransforms(event.TableInfo)
+	if err != nil {
+		return err
+	}
+	if len(transforms.columns) == 0 {
+		return nil
+	}
+
+	fieldTypes := event.TableInfo.GetFieldSlice()
+	rowCount := len(event.RowTypes)
+	rows := chunk.NewChunkWithCapacity(fieldTypes, rowCount)
+	for i := 0; i < rowCount; i++ {
+		row := event.Rows.GetRow(event.PreviousTotalOffset + i)
+		datums := row.GetDatumRow(fieldTypes)
+		for _, column := range transforms.columns {
+			datums[column.offset], err = column.transform(row, datums[column.offset])
+			if err != nil {
+				return err
+			}
+		}
+		for j := range datums {
+			rows.AppendDatum(j, &datums[j])
+		}
+	}
+	event.SetRows(rows)
+	event.PreviousTotalOffset = 0
+	event.Rewind()
+	return nil
+}
+
+// Transformers manages an array of transformers, the first transformer
+// matching the given event is used to transform the columns.
+type Transformers struct {
+	transformers []*Transformer
+}
+
+// New returns the transformers of the sink config.
+func New(sinkConfig *config.SinkConfig, timezone string) (*Transformers, error) {
+	transformers := make([]*Transformer, 0, len(sinkConfig.Transforms))
+	if len(sinkConfig.Transforms) == 0 {
+		return &Transformers{transformers: transformers}, nil
+	}
+	for _, rule := range sinkConfig.Transforms {
+		// each transformer has its own session context, since they are
+		// protected by their own mutex.
+		sessCtx := util.NewSessionCtx(map[string]string{
+			"time_zone": timezone,
+		})
+		transformer, err := newTransformer(rule, util.GetOrZero(sinkConfig.CaseSensitive), sessCtx)
+		if err != nil {
+			return nil, err
+		}
+		transformers = append(transformers, transformer)
+	}
+	return &Transformers{
+		transformers: transformers,
+	}, nil
+}
+
+func (t *Transformers) get(schema, table string) *Transformer {
+	for _, transformer := range t.transformers {
+		if transformer.match(schema, table) {
+			return transformer
+		}
+	}
+	return nil
+}
+
+// Apply transforms the column values of the event in place. It does nothing
+// if no transformer matches the table of the event.
+func (t *Transformers) Apply(event *commonEvent.DMLEvent) error {
+	if t == nil || len(t.transformers) == 0 {
+		return nil
+	}
+	if event == nil || event.TableInfo == nil || event.Rows == nil {
+		return nil
+	}
+	transformer := t.get(event.TableInfo.GetSchemaName(), event.TableInfo.GetTableName())
+	if transformer == nil {
+		return nil
+	}
+	return transformer.apply(event)
+}
+
+// VerifyTables return the error if any given table cannot satisfy the transform constraints.
+// 1. the transformed column must exist and must not be a part of the primary key or a unique key.
+// 2. the hash, mask and truncate transforms only support string columns.
+// 3. the constant must be convertible to the column type, and the expression must be valid.
+// 4. the hashed column must be able to hold the 64 characters of the hash.
+func (t *Transformers) VerifyTables(infos []*common.TableInfo) error {
+	for _, table := range infos {
+		transformer := t.get(table.TableName.Schema, table.TableName.Table)
+		if transformer == nil {
+			continue
+		}
+		transformer.mu.Lock()
+		_, err := transformer.buildTableTransforms(table)
+		transformer.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getHandleKeyColumns returns the lower case names of the columns in the
+// primary key and the unique keys, the downstream identifies the rows by them.
+func getHandleKeyColumns(table *common.TableInfo) map[string]struct{} {
+	columns := make(map[string]struct{})
+	for _, name := range table.GetPrimaryKeyColumnNames() {
+		columns[strings.ToLower(name)] = struct{}{}
+	}
+	for _, index := range table.GetIndices() {
+		if !index.Primary && !index.Unique {
+			continue
+		}
+		for _, col := range index.Columns {
+			columns[col.Name.L] = struct{}{}
+		}
+	}
+	return columns
+}
+
+// hashValueLength is the length of the hex encoded SHA-256 hash.
+const hashValueLength = sha256.Size * 2
+
+func hashValue(value []byte) string {
+	sum := sha256.Sum256(value)
+	return hex.EncodeToString(sum[:])
+}
+
+// maskValue replaces the characters of the value with the mask character,
+// except keepPrefix characters at the beginning and keepSuffix characters at
+// the end. The whole value is masked if it is not longer than the kept
+// characters, to avoid revealing it.
+func maskValue(value string, keepPrefix, keepSuffix int, maskChar rune) string {
+	runes := []rune(value)
+	if keepPrefix+keepSuffix >= len(runes) {
+		keepPrefix, keepSuffix = 0, 0
+	}
+	for i := keepPrefix; i < len(runes)-keepSuffix; i++ {
+		runes[i] = maskChar
+	}
+	return string(runes)
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transformer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskValue(t *testing.T) {
+	require.Equal(t, "138****5678", maskValue("13812345678", 3, 4, '*'))
+	require.Equal(t, "a####", maskValue("alice", 1, 0, '#'))
+	require.Equal(t, "张*", maskValue("张三", 1, 0, '*'))
+	// the value is not longer than the kept characters
+	require.Equal(t, "***", maskValue("abc", 2, 1, '*'))
+	require.Equal(t, "", maskValue("", 1, 1, '*'))
+}
+
+func TestTransformersApply(t *testing.T) {
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	helper.DDL2Job("create table t (id int primary key, email varchar(128), phone varchar(32), " +
+		"name varchar(32), note varchar(32), score int, level varchar(32))")
+
+	sinkConfig := config.GetDefaultReplicaConfig().Sink
+	sinkConfig.Transforms = []*config.TransformRule{{
+		Matcher: []string{"test.t"},
+		Columns: []*config.ColumnTransform{
+			{Column: "email", Type: config.TransformTypeHash},
+			{Column: "phone", Type: config.TransformTypeMask, KeepPrefix: 3, KeepSuffix: 4, MaskChar: "*"},
+			{Column: "name", Type: config.TransformTypeTruncate, Length: 2},
+			{Column: "note", Type: config.TransformTypeConstant, Value: util.AddressOf("redacted")},
+			{Column: "level", Type: config.TransformTypeExpression, Expression: "if(score > 60, 'high', 'low')"},
+		},
+	}}
+	transformers, err := New(sinkConfig, "UTC")
+	require.NoError(t, err)
+
+	event := helper.DML2Event("test", "t",
+		"insert into t values (1, 'alice@example.com', '13812345678', 'alice', 'secret', 90, null)",
+		"insert into t values (2, null, null, null, null, 10, null)")
+	require.NoError(t, transformers.Apply(event))
+
+	columns := event.TableInfo.GetColumns()
+	values := func(row commonEvent.RowChange) []string {
+		result := make([]string, 0, len(columns))
+		for i := range columns {
+			if row.Row.IsNull(i) {
+				result = append(result, "NULL")
+				continue
+			}
+			d := row.Row.GetDatum(i, &columns[i].FieldType)
+			result = append(result, fmt.Sprint(d.GetValue()))
+		}
+		return result
+	}
+	row, ok := event.GetNextRow()
+	require.True(t, ok)
+	require.Equal(t, []string{"1", hashValue([]byte("alice@example.com")), "138****5678",
+		"al", "redacted", "90", "high"}, values(row))
+	row, ok = event.GetNextRow()
+	require.True(t, ok)
+	require.Equal(t, []string{"2", "NULL", "NULL", "NULL", "redacted", "10", "low"}, values(row))
+	_, ok = event.GetNextRow()
+	require.False(t, ok)
+
+	// the tables not matched are not changed
+	helper.DDL2Job("create table t1 (id int primary key, email varchar(128))")
+	event = helper.DML2Event("test", "t1", "insert into t1 values (1, 'alice@example.com')")
+	require.NoError(t, transformers.Apply(event))
+	row, ok = event.GetNextRow()
+	require.True(t, ok)
+	require.Equal(t, "alice@example.com", row.Row.GetString(1))
+}
+
+func TestTransformersVerifyTables(t *testing.T) {
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	job := helper.DDL2Job("create table t (id int primary key, email varchar(128), " +
+		"code varchar(32) unique key, score int, name char(32))")
+	tableInfo := helper.GetTableInfo(job)
+
+	cases := []struct {
+		column *config.ColumnTransform
+		errMsg string
+	}{
+		{column: &config.ColumnTransform{Column: "email", Type: config.TransformTypeHash}},
+		{column: &config.ColumnTransform{Column: "score", Type: config.TransformTypeConstant, Value: util.AddressOf("0")}},
+		{column: &config.ColumnTransform{Column: "EMAIL", Type: config.TransformTypeExpression, Expression: "lower(email)"}},
+		{
+			column: &config.ColumnTransform{Column: "id", Type: config.TransformTypeConstant},
+			errMsg: "a part of the primary key or a unique key",
+		},
+		{
+			column: &config.ColumnTransform{Column: "code", Type: config.TransformTypeHash},
+			errMsg: "a part of the primary key or a unique key",
+		},
+		{
+			column: &config.ColumnTransform{Column: "score", Type: config.TransformTypeMask, MaskChar: "*"},
+			errMsg: "only supports string columns",
+		},
+		{
+			column: &config.ColumnTransform{Column: "phone", Type: config.TransformTypeHash},
+			errMsg: "not found",
+		},
+		{
+			column: &config.ColumnTransform{Column: "name", Type: config.TransformTypeHash},
+			errMsg: "at least 64 characters",
+		},
+		{
+			column: &config.ColumnTransform{Column: "email", Type: config.TransformTypeExpression, Expression: "lower(phone)"},
+			errMsg: "phone",
+		},
+	}
+	for _, c := range cases {
+		sinkConfig := config.GetDefaultReplicaConfig().Sink
+		sinkConfig.Transforms = []*config.TransformRule{{
+			Matcher: []string{"test.*"},
+			Columns: []*config.ColumnTransform{c.column},
+		}}
+		transformers, err := New(sinkConfig, "UTC")
+		require.NoError(t, err)
+		err = transformers.VerifyTables([]*common.TableInfo{tableInfo})
+		if c.errMsg == "" {
+			require.NoError(t, err)
+			continue
+		}
+		require.ErrorContains(t, err, c.errMsg)
+		if c.column.Type != config.TransformTypeExpression {
+			require.True(t, errors.ErrTransformFailed.Equal(err))
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/httputil"
+	"github.com/pingcap/ticdc/pkg/retry"
+	codecCommon "github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"go.uber.org/zap"
+)
+
+const (
+	contentTypeNDJSON = "application/x-ndjson"
+
+	// headerTopic carries the topic of the events in the request.
+	headerTopic = "X-TiCDC-Topic"
+	// headerChangefeed carries the changefeed ID of the events in the request.
+	headerChangefeed = "X-TiCDC-Changefeed"
+	// headerTimestamp carries the unix time in seconds when the request is signed.
+	headerTimestamp = "X-TiCDC-Timestamp"
+	// headerSignature carries the HMAC-SHA256 signature of the request, which is
+	// calculated by `hex(hmac_sha256(secret, timestamp + "." + body))`.
+	headerSignature = "X-TiCDC-Signature"
+)
+
+// statusError is returned if the webhook responds a status code other than 2xx.
+type statusError struct {
+	statusCode int
+	body       []byte
+}
+
+func (e *statusError) Error() string {
+	return "[" + strconv.Itoa(e.statusCode) + "] " + string(e.body)
+}
+
+// client sends the encoded messages to the webhook.
+type client struct {
+	changefeedID common.ChangeFeedID
+	httpClient   *httputil.Client
+	options      *options
+}
+
+func newClient(changefeedID common.ChangeFeedID, opts *options) (*client, error) {
+	httpClient, err := httputil.NewClient(opts.credential)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrWebhookInvalidConfig, err)
+	}
+	httpClient.SetTimeout(opts.requestTimeout)
+	return &client{
+		changefeedID: changefeedID,
+		httpClient:   httpClient,
+		options:      opts,
+	}, nil
+}
+
+// send posts the messages in batches, the body of a request is the values of
+// the messages delimited by newlines. The callbacks of the messages are called
+// after the batch is acknowledged by the webhook.
+func (c *client) send(ctx context.Context, topic string, messages []*codecCommon.Message) error {
+	var (
+		body  bytes.Buffer
+		batch = make([]*codecCommon.Message, 0, c.options.batchSize)
+	)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := c.post(ctx, topic, body.Bytes()); err != nil {
+			return err
+		}
+		for _, message := range batch {
+			if message.Callback != nil {
+				message.Callback()
+			}
+		}
+		body.Reset()
+		batch = batch[:0]
+		return nil
+	}
+	for _, message := range messages {
+		if len(batch) >= c.options.batchSize ||
+			(len(batch) > 0 && body.Len()+len(message.Value)+1 > c.options.maxRequestBytes) {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		body.Write(message.Value)
+		body.WriteByte('\n')
+		batch = append(batch, message)
+	}
+	return flush()
+}
+
+// post sends the body to the webhook, the request is retried with backoff
+// if the webhook is unavailable or responds a server error.
+func (c *client) post(ctx context.Context, topic string, body []byte) error {
+	url := c.options.getURL(topic)
+	err := retry.Do(ctx, func() error {
+		err := c.doPost(ctx, url, topic, body)
+		if err != nil {
+			log.Warn("webhook request failed",
+				zap.String("keyspace", c.changefeedID.Keyspace()),
+				zap.String("changefeed", c.changefeedID.Name()),
+				zap.String("topic", topic),
+				zap.Error(err))
+		}
+		return err
+	},
+		retry.WithBackoffBaseDelay(defaultBackoffBaseInMs),
+		retry.WithBackoffMaxDelay(defaultBackoffMaxInMs),
+		retry.WithMaxTries(c.options.maxRetries+1),
+		retry.WithIsRetryableErr(isRetryableError),
+	)
+	if err != nil {
+		return errors.WrapError(errors.ErrWebhookRequestFailed, err)
+	}
+	return nil
+}
+
+func (c *client) doPost(ctx context.Context, url, topic string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", contentTypeNDJSON)
+	req.Header.Set(headerTopic, topic)
+	req.Header.Set(headerChangefeed, c.changefeedID.String())
+	if c.options.username != "" {
+		req.SetBasicAuth(c.options.username, c.options.password)
+	}
+	if c.options.hmacSecret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(headerTimestamp, timestamp)
+		req.Header.Set(headerSignature, sign(c.options.hmacSecret, timestamp, body))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if resp.StatusCode/100 != 2 {
+		return &statusError{statusCode: resp.StatusCode, body: content}
+	}
+	return nil
+}
+
+// sign returns the HMAC-SHA256 signature of the request in hex.
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// isRetryableError returns false if the request is rejected by the webhook,
+// except for the rate limiting and request timeout. The requests which time
+// out before the response is received are retried as well.
+func isRetryableError(err error) bool {
+	var statusErr *statusError
+	if errors.As(err, &statusErr) {
+		switch statusErr.statusCode {
+		case http.StatusTooManyRequests, http.StatusRequestTimeout:
+			return true
+		}
+		return statusErr.statusCode/100 == 5
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	return errors.IsRetryableError(err)
+}
+
+func (c *client) close() {
+	c.httpClient.CloseIdleConnections()
+}
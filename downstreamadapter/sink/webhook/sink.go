@@ -0,0 +1,344 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/columnselector"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/eventrouter"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/helper"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/metrics"
+	"github.com/pingcap/ticdc/pkg/sink/codec"
+	codecCommon "github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/pingcap/ticdc/utils/chann"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// sink posts the change events to a webhook. The events are encoded by the
+// protocols of the MQ sinks, and the events of the same topic, which is decided
+// by the topic rule of the dispatchers, are sent in one request.
+type sink struct {
+	changefeedID common.ChangeFeedID
+	ctx          context.Context
+
+	options *options
+	client  *client
+	// encoder is only used by the Run goroutine to encode the DML events, and
+	// ddlEncoder is used by the dispatchers to encode the DDL events, since
+	// the encoders are not thread safe.
+	encoder        codecCommon.EventEncoder
+	ddlEncoder     codecCommon.EventEncoder
+	eventRouter    *eventrouter.EventRouter
+	columnSelector *columnselector.ColumnSelectors
+	statistics     *metrics.Statistics
+	eventCh        *chann.UnlimitedChannel[*commonEvent.DMLEvent, any]
+
+	// isNormal indicate whether the sink is in the normal state.
+	isNormal *atomic.Bool
+}
+
+type components struct {
+	options        *options
+	encoder        codecCommon.EventEncoder
+	ddlEncoder     codecCommon.EventEncoder
+	eventRouter    *eventrouter.EventRouter
+	columnSelector *columnselector.ColumnSelectors
+}
+
+func newComponents(
+	ctx context.Context,
+	changefeedID common.ChangeFeedID,
+	sinkURI *url.URL,
+	sinkConfig *config.SinkConfig,
+) (*components, error) {
+	opts := newOptions()
+	if err := opts.apply(sinkURI); err != nil {
+		return nil, err
+	}
+	protocol, err := getProtocol(util.GetOrZero(sinkConfig.Protocol))
+	if err != nil {
+		return nil, err
+	}
+	encoderConfig, err := helper.GetEncoderConfig(
+		changefeedID, sinkURI, protocol, sinkConfig, opts.maxRequestBytes, opts.maxRequestBytes)
+	if err != nil {
+		return nil, err
+	}
+	if protocol == config.ProtocolSimple && encoderConfig.EncodingFormat != codecCommon.EncodingFormatJSON {
+		return nil, errors.ErrWebhookInvalidConfig.GenWithStack(
+			"webhook sink only supports the json encoding format of the simple protocol")
+	}
+	encoder, err := codec.NewEventEncoder(ctx, encoderConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	ddlEncoder, err := codec.NewEventEncoder(ctx, encoderConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+	eventRouter, err := eventrouter.NewEventRouter(sinkConfig, opts.defaultTopic, false, false)
+	if err != nil {
+		return nil, err
+	}
+	columnSelector, err := columnselector.New(sinkConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &components{
+		options:        opts,
+		encoder:        encoder,
+		ddlEncoder:     ddlEncoder,
+		eventRouter:    eventRouter,
+		columnSelector: columnSelector,
+	}, nil
+}
+
+// getProtocol returns the protocol of the webhook sink, only the protocols
+// whose messages are self-contained text are supported, so that several
+// messages can be sent as newline delimited JSON in one request.
+func getProtocol(protocolStr string) (config.Protocol, error) {
+	protocol, err := config.ParseSinkProtocolFromString(protocolStr)
+	if err != nil {
+		return protocol, errors.WrapError(errors.ErrWebhookInvalidConfig, err)
+	}
+	switch protocol {
+	case config.ProtocolCanalJSON, config.ProtocolSimple, config.ProtocolDebezium, config.ProtocolMaxwell:
+		return protocol, nil
+	default:
+		return protocol, errors.ErrWebhookInvalidConfig.GenWithStack(
+			"protocol %s is not supported by the webhook sink", protocol)
+	}
+}
+
+// Verify checks the configuration of the webhook sink.
+func Verify(
+	ctx context.Context, changefeedID common.ChangeFeedID, sinkURI *url.URL, sinkConfig *config.SinkConfig,
+) error {
+	comp, err := newComponents(ctx, changefeedID, sinkURI, sinkConfig)
+	if err != nil {
+		return err
+	}
+	_, err = newClient(changefeedID, comp.options)
+	return err
+}
+
+// New creates a webhook sink.
+func New(
+	ctx context.Context,
+	changefeedID common.ChangeFeedID,
+	sinkURI *url.URL,
+	sinkConfig *config.SinkConfig,
+	keyspaceID uint32,
+) (*sink, error) {
+	comp, err := newComponents(ctx, changefeedID, sinkURI, sinkConfig)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newClient(changefeedID, comp.options)
+	if err != nil {
+		return nil, err
+	}
+	return &sink{
+		changefeedID:   changefeedID,
+		ctx:            ctx,
+		options:        comp.options,
+		client:         client,
+		encoder:        comp.encoder,
+		ddlEncoder:     comp.ddlEncoder,
+		eventRouter:    comp.eventRouter,
+		columnSelector: comp.columnSelector,
+		statistics:     metrics.NewStatistics(changefeedID, keyspaceID, "sink"),
+		eventCh:        chann.NewUnlimitedChannelDefault[*commonEvent.DMLEvent](),
+		isNormal:       atomic.NewBool(true),
+	}, nil
+}
+
+func (s *sink) SinkType() common.SinkType {
+	return common.WebhookSinkType
+}
+
+func (s *sink) IsNormal() bool {
+	return s.isNormal.Load()
+}
+
+func (s *sink) SetTableSchemaStore(_ *commonEvent.TableSchemaStore) {
+}
+
+func (s *sink) AddDMLEvent(event *commonEvent.DMLEvent) {
+	s.eventCh.Push(event)
+}
+
+func (s *sink) FlushDMLBeforeBlock(_ commonEvent.BlockEvent) error {
+	return nil
+}
+
+func (s *sink) WriteBlockEvent(event commonEvent.BlockEvent) error {
+	var err error
+	switch v := event.(type) {
+	case *commonEvent.DDLEvent:
+		err = s.sendDDLEvent(s.ctx, v)
+	default:
+		log.Error("unsupported webhook sink block event type",
+			zap.String("keyspace", s.changefeedID.Keyspace()),
+			zap.String("changefeed", s.changefeedID.Name()),
+			zap.String("eventType", commonEvent.TypeToString(event.GetType())))
+		return errors.ErrInvalidEventType.GenWithStackByArgs(commonEvent.TypeToString(event.GetType()))
+	}
+	if err != nil {
+		s.isNormal.Store(false)
+		return err
+	}
+	event.PostFlush()
+	return nil
+}
+
+func (s *sink) sendDDLEvent(ctx context.Context, event *commonEvent.DDLEvent) error {
+	for _, e := range event.GetEvents() {
+		message, err := s.ddlEncoder.EncodeDDLEvent(e)
+		if err != nil {
+			return err
+		}
+		if message == nil {
+			log.Info("webhook ddl event skipped",
+				zap.String("keyspace", s.changefeedID.Keyspace()), zap.String("changefeed", s.changefeedID.Name()),
+				zap.Uint64("startTs", e.GetStartTs()), zap.Uint64("commitTs", e.GetCommitTs()),
+				zap.String("query", e.Query))
+			continue
+		}
+		topic := s.getTopicForDDL(e)
+		err = s.statistics.RecordDDLExecution(func() (string, error) {
+			return e.GetDDLType().String(), s.client.send(ctx, topic, []*codecCommon.Message{message})
+		})
+		if err != nil {
+			return err
+		}
+		log.Info("webhook ddl event sent",
+			zap.String("keyspace", s.changefeedID.Keyspace()), zap.String("changefeed", s.changefeedID.Name()),
+			zap.Uint64("startTs", e.GetStartTs()), zap.Uint64("commitTs", e.GetCommitTs()),
+			zap.String("query", e.GetDDLQuery()))
+	}
+	return nil
+}
+
+// getTopicForDDL routes the DDL event by the target names, so that the DDL
+// is sent to the same topic as the DML events of the table.
+func (s *sink) getTopicForDDL(event *commonEvent.DDLEvent) string {
+	schema, table := event.GetTargetSchemaName(), event.GetTargetTableName()
+	if event.GetExtraSchemaName() != "" {
+		schema, table = event.GetTargetExtraSchemaName(), event.GetTargetExtraTableName()
+	}
+	if table == "" {
+		return s.options.defaultTopic
+	}
+	return s.eventRouter.GetTopicForRowChange(schema, table)
+}
+
+// AddCheckpointTs is a no-op, the webhook sink doesn't send checkpoint events.
+func (s *sink) AddCheckpointTs(_ uint64) {
+}
+
+func (s *sink) Run(ctx context.Context) error {
+	buffer := make([]*commonEvent.DMLEvent, 0, s.BatchCount())
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		default:
+		}
+		// GetMultipleNoGroup blocks until there are events or the channel is closed.
+		events, ok := s.eventCh.GetMultipleNoGroup(buffer)
+		if !ok {
+			return nil
+		}
+		if err := s.sendDMLEvents(ctx, events); err != nil {
+			s.isNormal.Store(false)
+			log.Error("webhook sink send events failed",
+				zap.String("keyspace", s.changefeedID.Keyspace()),
+				zap.String("changefeed", s.changefeedID.Name()),
+				zap.Error(err))
+			return err
+		}
+		buffer = buffer[:0]
+	}
+}
+
+// sendDMLEvents encodes the events topic by topic, so the events of the same
+// table are sent in order. The callbacks of the rows are called by the client
+// after the requests are acknowledged.
+func (s *sink) sendDMLEvents(ctx context.Context, events []*commonEvent.DMLEvent) error {
+	topics := make([]string, 0)
+	grouped := make(map[string][]*commonEvent.DMLEvent)
+	for _, event := range events {
+		topic := s.eventRouter.GetTopicForRowChange(event.TableInfo.GetTargetSchemaName(), event.TableInfo.GetTargetTableName())
+		if _, ok := grouped[topic]; !ok {
+			topics = append(topics, topic)
+		}
+		grouped[topic] = append(grouped[topic], event)
+	}
+
+	for _, topic := range topics {
+		for _, event := range grouped[topic] {
+			if event.Len() == 0 {
+				event.PostFlush()
+				continue
+			}
+			callback := helper.NewPostFlushRowCallback(event, uint64(event.Len()))
+			selector := s.columnSelector.GetForTableInfo(event.TableInfo)
+			for _, row := range helper.NewRowEvents(event, selector, callback) {
+				if err := s.encoder.AppendRowChangedEvent(ctx, topic, row); err != nil {
+					return err
+				}
+			}
+		}
+		messages := s.encoder.Build()
+		err := s.statistics.RecordBatchExecution(func() (int, int64, error) {
+			var (
+				rows  int
+				bytes int64
+			)
+			for _, message := range messages {
+				rows += message.GetRowsCount()
+				bytes += int64(len(message.Value))
+			}
+			return rows, bytes, s.client.send(ctx, topic, messages)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sink) Close() {
+	s.eventCh.Close()
+	s.client.close()
+	s.statistics.Close()
+}
+
+func (s *sink) BatchCount() int {
+	return 4096
+}
+
+func (s *sink) BatchBytes() int {
+	return 0
+}
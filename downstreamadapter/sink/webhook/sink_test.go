@@ -0,0 +1,254 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+type request struct {
+	path   string
+	header http.Header
+	body   string
+}
+
+// fakeServer records the requests, and responds the status codes in order.
+type fakeServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []request
+	statuses []int
+}
+
+func newFakeServer(t *testing.T, statuses ...int) *fakeServer {
+	s := &fakeServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		status := http.StatusOK
+		if len(s.statuses) > 0 {
+			status = s.statuses[0]
+			s.statuses = s.statuses[1:]
+		}
+		if status/100 == 2 {
+			s.requests = append(s.requests, request{path: r.URL.Path, header: r.Header.Clone(), body: string(body)})
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeServer) getRequests() []request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]request(nil), s.requests...)
+}
+
+func newSinkForTest(
+	ctx context.Context, t *testing.T, server *fakeServer, query string, sinkConfig *config.SinkConfig,
+) *sink {
+	sinkURI, err := url.Parse(server.URL + "/cdc/{topic}" + query)
+	require.NoError(t, err)
+	if sinkConfig == nil {
+		sinkConfig = &config.SinkConfig{}
+	}
+	if sinkConfig.Protocol == nil {
+		sinkConfig.Protocol = util.AddressOf(config.ProtocolCanalJSON.String())
+	}
+	s, err := New(ctx, common.NewChangefeedID4Test("test", t.Name()), sinkURI, sinkConfig, common.DefaultKeyspaceID)
+	require.NoError(t, err)
+	return s
+}
+
+func splitLines(body string) []map[string]any {
+	var result []map[string]any
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\n"), "\n") {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(line), &m); err == nil {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+func TestWebhookSinkWriteEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t)
+	sinkConfig := &config.SinkConfig{
+		DispatchRules: []*config.DispatchRule{
+			{Matcher: []string{"test.*"}, TopicRule: "{schema}_{table}"},
+		},
+	}
+	s := newSinkForTest(ctx, t, server, "?batch-size=2&hmac-secret=s3cret", sinkConfig)
+	defer s.Close()
+	go s.Run(ctx)
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+
+	var flushed atomic.Int32
+	ddlEvent := helper.DDL2Event("create table t (id int primary key, name varchar(32))")
+	ddlEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	require.NoError(t, s.WriteBlockEvent(ddlEvent))
+	require.EqualValues(t, 1, flushed.Load())
+
+	dmlEvent := helper.DML2Event("test", "t",
+		"insert into t values (1, 'a')",
+		"insert into t values (2, 'b')",
+		"insert into t values (3, 'c')")
+	dmlEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	s.AddDMLEvent(dmlEvent)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the DDL is sent alone, and the rows are split into requests by batch-size.
+	requests := server.getRequests()
+	require.Len(t, requests, 3)
+	rows := 0
+	for i, req := range requests {
+		require.Equal(t, "/cdc/test_t", req.path)
+		require.Equal(t, contentTypeNDJSON, req.header.Get("Content-Type"))
+		require.Equal(t, "test_t", req.header.Get(headerTopic))
+		timestamp := req.header.Get(headerTimestamp)
+		require.NotEmpty(t, timestamp)
+		require.Equal(t, sign("s3cret", timestamp, []byte(req.body)), req.header.Get(headerSignature))
+
+		messages := splitLines(req.body)
+		if i == 0 {
+			require.Len(t, messages, 1)
+			require.Equal(t, true, messages[0]["isDdl"])
+			continue
+		}
+		rows += len(messages)
+		for _, message := range messages {
+			require.Equal(t, "INSERT", message["type"])
+		}
+	}
+	require.Equal(t, 3, rows)
+	require.True(t, s.IsNormal())
+}
+
+func TestWebhookSinkRetry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	s := newSinkForTest(ctx, t, server, "", nil)
+	defer s.Close()
+	go s.Run(ctx)
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+	helper.DDL2Event("create table t (id int primary key)")
+
+	var flushed atomic.Int32
+	dmlEvent := helper.DML2Event("test", "t", "insert into t values (1)")
+	dmlEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	s.AddDMLEvent(dmlEvent)
+	require.Eventually(t, func() bool {
+		return flushed.Load() == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the callbacks are only called after the request is acknowledged.
+	requests := server.getRequests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "/cdc/"+defaultTopic, requests[0].path)
+	require.True(t, s.IsNormal())
+}
+
+func TestWebhookSinkRequestRejected(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newFakeServer(t, http.StatusBadRequest)
+	s := newSinkForTest(ctx, t, server, "", nil)
+	defer s.Close()
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+	helper.Tk().MustExec("use test")
+	helper.DDL2Event("create table t (id int primary key)")
+
+	var flushed atomic.Int32
+	dmlEvent := helper.DML2Event("test", "t", "insert into t values (1)")
+	dmlEvent.PostTxnFlushed = []func(){func() { flushed.Inc() }}
+	s.AddDMLEvent(dmlEvent)
+	err := s.Run(ctx)
+	require.True(t, errors.ErrWebhookRequestFailed.Equal(err))
+	require.False(t, s.IsNormal())
+	require.EqualValues(t, 0, flushed.Load())
+}
+
+func TestWebhookVerify(t *testing.T) {
+	server := newFakeServer(t)
+	changefeedID := common.NewChangefeedID4Test("test", t.Name())
+	sinkURI, err := url.Parse(server.URL + "/cdc")
+	require.NoError(t, err)
+
+	for _, protocol := range []string{"canal-json", "debezium", "simple"} {
+		sinkConfig := &config.SinkConfig{Protocol: util.AddressOf(protocol)}
+		require.NoError(t, Verify(context.Background(), changefeedID, sinkURI, sinkConfig), protocol)
+	}
+	for _, protocol := range []string{"open-protocol", "avro", "csv"} {
+		sinkConfig := &config.SinkConfig{Protocol: util.AddressOf(protocol)}
+		err = Verify(context.Background(), changefeedID, sinkURI, sinkConfig)
+		require.True(t, errors.ErrWebhookInvalidConfig.Equal(err), protocol)
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		err       error
+		retryable bool
+	}{
+		{err: &statusError{statusCode: http.StatusServiceUnavailable}, retryable: true},
+		{err: &statusError{statusCode: http.StatusTooManyRequests}, retryable: true},
+		{err: &statusError{statusCode: http.StatusBadRequest}, retryable: false},
+		{err: errors.Trace(&url.Error{Op: "Post", URL: "http://127.0.0.1", Err: context.DeadlineExceeded}), retryable: true},
+		{err: errors.Trace(context.DeadlineExceeded), retryable: true},
+		{err: errors.Trace(context.Canceled), retryable: false},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.retryable, isRetryableError(c.err), c.err.Error())
+	}
+}
@@ -655,6 +655,9 @@ type DispatcherRequest struct {
 	Mode                          int64                     `protobuf:"varint,18,opt,name=mode,proto3" json:"mode,omitempty"`
 	TxnAtomicity                  string                    `protobuf:"bytes,19,opt,name=txn_atomicity,json=txnAtomicity,proto3" json:"txn_atomicity,omitempty"`
 	EnableIgnoreUpdateOnlyColumns bool                      `protobuf:"varint,20,opt,name=enable_ignore_update_only_columns,json=enableIgnoreUpdateOnlyColumns,proto3" json:"enable_ignore_update_only_columns,omitempty"`
+	// initial_snapshot_ts is the ts to read the initial snapshot of the table span,
+	// 0 means the dispatcher does not need the initial snapshot.
+	InitialSnapshotTs uint64 `protobuf:"varint,21,opt,name=initial_snapshot_ts,json=initialSnapshotTs,proto3" json:"initial_snapshot_ts,omitempty"`
 }
 
 func (m *DispatcherRequest) Reset()         { *m = DispatcherRequest{} }
@@ -830,6 +833,13 @@ func (m *DispatcherRequest) GetEnableIgnoreUpdateOnlyColumns() bool {
 	return false
 }
 
+func (m *DispatcherRequest) GetInitialSnapshotTs() uint64 {
+	if m != nil {
+		return m.InitialSnapshotTs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("eventpb.OpType", OpType_name, OpType_value)
 	proto.RegisterEnum("eventpb.ActionType", ActionType_name, ActionType_value)
@@ -848,83 +858,83 @@ func init() {
 func init() { proto.RegisterFile("eventpb/event.proto", fileDescriptor_d7fb2554dfcf7f7d) }
 
 var fileDescriptor_d7fb2554dfcf7f7d = []byte{
-	// 1201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x36, 0x2d, 0xdb, 0x12, 0x47, 0x92, 0x2d, 0xad, 0xed, 0x84, 0x71, 0x7e, 0xf1, 0xcf, 0x51,
-	0x8b, 0x40, 0x0d, 0x50, 0x39, 0x75, 0x93, 0x16, 0x48, 0x8b, 0x00, 0xa9, 0xad, 0x34, 0x04, 0x9a,
-	0xd8, 0x58, 0x29, 0x01, 0xda, 0x0b, 0x41, 0x91, 0x63, 0x9b, 0x0d, 0xb5, 0x64, 0x96, 0x4b, 0x59,
-	0xea, 0x53, 0xf4, 0xd4, 0x53, 0x1e, 0xa8, 0xc7, 0x1c, 0x7b, 0x2c, 0x92, 0x43, 0xcf, 0x7d, 0x83,
-	0x62, 0x77, 0x29, 0x52, 0x74, 0xdc, 0x5c, 0x7a, 0xd2, 0xee, 0x7c, 0xdf, 0x70, 0x67, 0xbf, 0xf9,
-	0xb3, 0x82, 0x4d, 0x9c, 0x20, 0x13, 0xf1, 0x68, 0x5f, 0xfd, 0xf6, 0x62, 0x1e, 0x89, 0x88, 0x54,
-	0x33, 0xe3, 0xce, 0xcd, 0x73, 0x74, 0xb9, 0x18, 0xa1, 0x2b, 0x19, 0xf9, 0x5a, 0xb3, 0x3a, 0x6f,
-	0x2a, 0xb0, 0xd1, 0x97, 0xc4, 0x27, 0x41, 0x28, 0x90, 0xd3, 0x34, 0x44, 0x62, 0x41, 0x75, 0xec,
-	0x0a, 0xef, 0x1c, 0xb9, 0x65, 0xec, 0x55, 0xba, 0x26, 0x9d, 0x6f, 0xc9, 0x6d, 0x68, 0x04, 0x67,
-	0x2c, 0xe2, 0xe8, 0xa8, 0x8f, 0x5b, 0xcb, 0x0a, 0xae, 0x6b, 0x9b, 0xfa, 0x0c, 0xb9, 0x05, 0x90,
-	0x51, 0x92, 0xd7, 0xa1, 0x55, 0x51, 0x04, 0x53, 0x5b, 0x06, 0xaf, 0x43, 0xf2, 0x35, 0x58, 0x19,
-	0x1c, 0xb0, 0x04, 0xb9, 0x70, 0x26, 0x6e, 0x98, 0xa2, 0x83, 0xd3, 0x98, 0x5b, 0x2b, 0x7b, 0x46,
-	0xd7, 0xa4, 0xdb, 0x1a, 0xb7, 0x15, 0xfc, 0x52, 0xa2, 0xfd, 0x69, 0xcc, 0xc9, 0x23, 0xf8, 0x5f,
-	0xe6, 0x98, 0xc6, 0xbe, 0x2b, 0xd0, 0x61, 0x78, 0xb1, 0xe8, 0xbc, 0xaa, 0x9c, 0xb3, 0x8f, 0xbf,
-	0x50, 0x94, 0xe7, 0x78, 0xf1, 0x11, 0xff, 0x28, 0xf4, 0x17, 0xfd, 0xd7, 0x3e, 0xf4, 0x3f, 0x0e,
-	0xfd, 0xc2, 0xbf, 0x08, 0xdc, 0xc7, 0x10, 0x05, 0x2e, 0xfa, 0x56, 0x17, 0x03, 0x3f, 0x52, 0x70,
-	0xe1, 0xf8, 0x0d, 0xec, 0x5c, 0x3a, 0x98, 0x85, 0x33, 0xc7, 0x8b, 0xc2, 0x74, 0xcc, 0x12, 0xab,
-	0xa6, 0x04, 0xba, 0x5e, 0x3a, 0x96, 0x85, 0xb3, 0x43, 0x0d, 0x77, 0x7e, 0x33, 0xa0, 0x6d, 0x33,
-	0x86, 0x5c, 0xa7, 0xe7, 0x30, 0x62, 0xa7, 0xc1, 0x19, 0xd9, 0x82, 0x55, 0x9e, 0x86, 0x98, 0x64,
-	0xe9, 0xd1, 0x1b, 0xf2, 0x39, 0x6c, 0x66, 0x07, 0x89, 0x29, 0x73, 0x12, 0xe1, 0x72, 0xe1, 0x88,
-	0x44, 0xe5, 0x68, 0x85, 0xb6, 0x34, 0x34, 0x9c, 0xb2, 0x81, 0x04, 0x86, 0x09, 0xf9, 0x16, 0x1a,
-	0x0b, 0x89, 0x4f, 0x54, 0xaa, 0xea, 0x07, 0x56, 0x2f, 0x2b, 0x9b, 0xde, 0xa5, 0xaa, 0xa0, 0x25,
-	0x76, 0xe7, 0x8d, 0x01, 0x8d, 0x52, 0x4c, 0x9f, 0x42, 0xd3, 0x73, 0x13, 0x1c, 0x20, 0x4b, 0x02,
-	0x11, 0x4c, 0xd0, 0x32, 0xf6, 0x8c, 0x6e, 0x8d, 0x96, 0x8d, 0xe4, 0x0e, 0xac, 0x9f, 0x46, 0xdc,
-	0x43, 0x8a, 0x71, 0x18, 0x78, 0xae, 0x40, 0x6b, 0x59, 0xd1, 0x2e, 0x59, 0xc9, 0x23, 0x68, 0x9c,
-	0x2e, 0x7c, 0xdd, 0xaa, 0xec, 0x19, 0xdd, 0xfa, 0xc1, 0x4e, 0x1e, 0xdc, 0x07, 0x9a, 0xd0, 0x12,
-	0xbf, 0xd3, 0x00, 0xa0, 0x98, 0x44, 0xe1, 0x04, 0xfd, 0x61, 0xd2, 0x49, 0x61, 0x55, 0x17, 0x67,
-	0x0b, 0x2a, 0xaf, 0x70, 0xa6, 0x42, 0x6b, 0x50, 0xb9, 0x94, 0x52, 0xaa, 0x44, 0xaa, 0x38, 0x1a,
-	0x54, 0x6f, 0xc8, 0x0e, 0xd4, 0xe6, 0xc9, 0x57, 0x47, 0x37, 0x68, 0xbe, 0x27, 0x5d, 0xa8, 0x46,
-	0xb1, 0x23, 0x66, 0x31, 0xaa, 0x82, 0x5d, 0x3f, 0xd8, 0xc8, 0xa3, 0x3a, 0x8e, 0x87, 0xb3, 0x18,
-	0xe9, 0x5a, 0xa4, 0x7e, 0x3b, 0x3f, 0x43, 0x6d, 0x38, 0x65, 0xfa, 0xe4, 0x3b, 0xb0, 0xa6, 0x58,
-	0x3a, 0x67, 0xf5, 0x83, 0xf5, 0xb2, 0xce, 0x34, 0x43, 0xc9, 0x4d, 0x30, 0xbd, 0x68, 0x3c, 0x0e,
-	0xb2, 0xd4, 0x19, 0xdd, 0x15, 0x5a, 0xd3, 0x86, 0x61, 0x42, 0x6e, 0x40, 0x2d, 0x4f, 0x6b, 0x45,
-	0x61, 0xd5, 0x44, 0x67, 0xb3, 0x53, 0x07, 0x73, 0xe8, 0x8e, 0x42, 0xb4, 0xd9, 0x69, 0xd4, 0xf9,
-	0xcb, 0x00, 0x53, 0x67, 0x0b, 0xd1, 0x27, 0xf7, 0x00, 0x64, 0x41, 0x94, 0x8e, 0x6f, 0xe7, 0xc7,
-	0xcf, 0x23, 0xa4, 0xa6, 0xc8, 0x56, 0x09, 0xf9, 0x3f, 0xd4, 0x79, 0xa6, 0x5e, 0x11, 0x06, 0xf0,
-	0x5c, 0x50, 0xf2, 0x08, 0x9a, 0x7e, 0x90, 0xc4, 0x7a, 0x2a, 0x38, 0x81, 0x9f, 0xe5, 0xe7, 0x46,
-	0x6f, 0x61, 0xd4, 0xf4, 0x8e, 0x72, 0x86, 0x7d, 0x44, 0x1b, 0x05, 0xdf, 0xf6, 0x55, 0x01, 0xbb,
-	0x22, 0x88, 0x94, 0x82, 0xcb, 0x54, 0x6f, 0xc8, 0x17, 0x00, 0x42, 0xde, 0xc1, 0x09, 0xd8, 0x69,
-	0xa4, 0x1a, 0xba, 0x7e, 0x40, 0x8a, 0x40, 0xe7, 0xd7, 0xa3, 0xa6, 0xc8, 0x6f, 0x3a, 0x83, 0x0d,
-	0x9b, 0x09, 0x3c, 0xe3, 0x81, 0x98, 0x65, 0x85, 0x78, 0x0f, 0x36, 0x0b, 0xd3, 0x39, 0x7a, 0xaf,
-	0x7e, 0xc0, 0x09, 0x86, 0x2a, 0xe7, 0x26, 0xbd, 0x0a, 0x22, 0xf7, 0x61, 0xfb, 0x30, 0xe2, 0x3c,
-	0x8d, 0x45, 0x10, 0xb1, 0xa7, 0x2e, 0xf3, 0x43, 0xd4, 0x3e, 0xcb, 0xba, 0xaf, 0xaf, 0x04, 0x3b,
-	0x7f, 0xaf, 0x41, 0xbb, 0xb8, 0x22, 0xc5, 0xd7, 0x29, 0x26, 0x6a, 0xfc, 0x79, 0x61, 0x9a, 0x08,
-	0x2d, 0x8b, 0xa1, 0x94, 0x33, 0x33, 0x8b, 0xed, 0x4b, 0xe1, 0xbc, 0x73, 0x97, 0x9d, 0xe1, 0x29,
-	0xa2, 0x2f, 0x19, 0xcb, 0x57, 0x08, 0x77, 0x98, 0x33, 0xa4, 0x70, 0x05, 0x5f, 0xfb, 0xff, 0x27,
-	0xe1, 0x1f, 0xcc, 0x25, 0x4e, 0x62, 0x97, 0x29, 0xf5, 0xeb, 0x07, 0xd7, 0x4a, 0xce, 0x4a, 0xe6,
-	0x41, 0xec, 0xb2, 0x4c, 0x66, 0xb9, 0x2c, 0x15, 0xde, 0x6a, 0xa9, 0xf0, 0x64, 0xc1, 0x26, 0xc8,
-	0x27, 0x3a, 0x1a, 0x3d, 0x44, 0x6b, 0xda, 0x60, 0xfb, 0xe4, 0x3e, 0xd4, 0x5d, 0x4f, 0x0a, 0xa7,
-	0xfb, 0xa5, 0xaa, 0xfa, 0x65, 0x33, 0x4f, 0xe9, 0x63, 0x85, 0xa9, 0x9e, 0x01, 0x37, 0x5f, 0x93,
-	0x87, 0xd0, 0xd4, 0xcd, 0xec, 0x78, 0xba, 0xfb, 0x6b, 0x2a, 0xce, 0xed, 0xdc, 0xef, 0xdf, 0x1b,
-	0x9f, 0xdc, 0x85, 0x36, 0x32, 0x7d, 0xc3, 0x19, 0xf3, 0x9c, 0x38, 0x0a, 0x98, 0xb0, 0x4c, 0x35,
-	0x63, 0x36, 0x34, 0x30, 0x98, 0x31, 0xef, 0x44, 0x9a, 0x49, 0x07, 0x9a, 0x05, 0x49, 0x5e, 0x0d,
-	0xd4, 0xd5, 0xea, 0xc9, 0x9c, 0x31, 0x4c, 0x48, 0x0f, 0x36, 0x17, 0x38, 0x01, 0x13, 0xc8, 0x27,
-	0x6e, 0x68, 0xd5, 0x15, 0xb3, 0x9d, 0x33, 0xed, 0x0c, 0x90, 0xf9, 0x57, 0xf3, 0x9d, 0x63, 0x9a,
-	0xa0, 0xd5, 0x50, 0x07, 0x9b, 0xd2, 0x42, 0xa5, 0x41, 0x0a, 0x39, 0xf2, 0xb9, 0x33, 0x8e, 0x7c,
-	0xb4, 0x9a, 0x0a, 0xac, 0x8e, 0x7c, 0xfe, 0x2c, 0xf2, 0x91, 0x7c, 0x05, 0x66, 0x30, 0x2f, 0x4e,
-	0x6b, 0x5d, 0xdd, 0xd8, 0x5a, 0x98, 0x77, 0xa5, 0x22, 0xa7, 0x05, 0x55, 0xce, 0x2a, 0x11, 0x8c,
-	0xf1, 0x97, 0x88, 0xa1, 0xb5, 0xa1, 0xf5, 0x9f, 0xef, 0x65, 0x9f, 0x61, 0x1c, 0x79, 0xe7, 0x56,
-	0x4b, 0xc5, 0xab, 0x37, 0xe4, 0x01, 0x5c, 0x8f, 0x52, 0x11, 0xa7, 0xc2, 0xe1, 0xee, 0x85, 0xa3,
-	0xeb, 0x2b, 0x7b, 0xd0, 0xdb, 0x2a, 0xa6, 0x2d, 0x0d, 0x53, 0xf7, 0x42, 0x97, 0xa2, 0x1e, 0x61,
-	0x04, 0x56, 0x54, 0xdc, 0x64, 0xcf, 0xe8, 0x56, 0xa8, 0x5a, 0x93, 0x4f, 0xa0, 0x29, 0x67, 0x8b,
-	0x2b, 0xa2, 0x71, 0xe0, 0xc9, 0xc0, 0x37, 0x55, 0x04, 0x0d, 0x31, 0x65, 0x8f, 0xe7, 0x36, 0xf2,
-	0x14, 0x6e, 0x67, 0x39, 0xf9, 0xc8, 0x43, 0xb8, 0xa5, 0x4e, 0xbe, 0xa5, 0x89, 0xf6, 0xd5, 0xcf,
-	0xe1, 0xdd, 0xcf, 0x60, 0x4d, 0xcf, 0x58, 0xd2, 0x04, 0x53, 0xaf, 0x4e, 0x52, 0xd1, 0x5a, 0x22,
-	0x2d, 0x68, 0xe8, 0xad, 0x7e, 0x7d, 0x5b, 0xc6, 0x5d, 0x0e, 0x50, 0x94, 0x17, 0xb9, 0x09, 0xd7,
-	0x1f, 0x1f, 0x0e, 0xed, 0xe3, 0xe7, 0xce, 0xf0, 0xc7, 0x93, 0xbe, 0xf3, 0xe2, 0xf9, 0xe0, 0xa4,
-	0x7f, 0x68, 0x3f, 0xb1, 0xfb, 0x47, 0xad, 0x25, 0x62, 0xc1, 0xd6, 0x22, 0x48, 0xfb, 0xdf, 0xdb,
-	0x83, 0x61, 0x9f, 0xb6, 0x0c, 0x72, 0x0d, 0x48, 0x19, 0x79, 0x76, 0xfc, 0xb2, 0xdf, 0x5a, 0x26,
-	0xdb, 0xd0, 0x2e, 0xdb, 0x07, 0xfd, 0x61, 0x6b, 0xf5, 0xbb, 0x87, 0xbf, 0xbf, 0xdb, 0x35, 0xde,
-	0xbe, 0xdb, 0x35, 0xfe, 0x7c, 0xb7, 0x6b, 0xfc, 0xfa, 0x7e, 0x77, 0xe9, 0xed, 0xfb, 0xdd, 0xa5,
-	0x3f, 0xde, 0xef, 0x2e, 0xfd, 0xb4, 0x77, 0x16, 0x88, 0xf3, 0x74, 0xd4, 0xf3, 0xa2, 0xf1, 0x7e,
-	0x1c, 0xb0, 0x33, 0xcf, 0x8d, 0xf7, 0x45, 0xe0, 0xf9, 0xde, 0x7e, 0x96, 0xe1, 0xd1, 0x9a, 0xfa,
-	0x3f, 0xf6, 0xe5, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x09, 0x0b, 0x83, 0x16, 0xcc, 0x09, 0x00,
-	0x00,
+	// 1205 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0x56, 0x41, 0x73, 0xdb, 0x44,
+	0x14, 0xae, 0xe2, 0x24, 0xb6, 0x9e, 0xed, 0xc4, 0x5e, 0x27, 0x8d, 0x9a, 0xd2, 0x92, 0x1a, 0xa6,
+	0x13, 0x32, 0x83, 0x53, 0x42, 0x81, 0x99, 0xc2, 0x30, 0x13, 0x12, 0x97, 0x7a, 0x86, 0x26, 0x99,
+	0xb5, 0xdb, 0x19, 0xb8, 0x68, 0x64, 0x69, 0x6d, 0x8b, 0xca, 0x92, 0x22, 0xad, 0x92, 0x98, 0x5f,
+	0xc1, 0x89, 0x53, 0x2f, 0xfc, 0x1b, 0x8e, 0x3d, 0x72, 0x64, 0xe0, 0xc0, 0xdf, 0xe0, 0xed, 0xae,
+	0x2c, 0x59, 0x69, 0xda, 0x0b, 0x07, 0x8d, 0x77, 0xdf, 0xf7, 0xbd, 0xdd, 0xb7, 0xef, 0x7b, 0xfb,
+	0xd6, 0xd0, 0x62, 0x17, 0xcc, 0xe7, 0xe1, 0x70, 0x5f, 0xfe, 0x76, 0xc2, 0x28, 0xe0, 0x01, 0x29,
+	0xa7, 0xc6, 0xed, 0xbb, 0x13, 0x66, 0x45, 0x7c, 0xc8, 0x2c, 0xc1, 0xc8, 0xc6, 0x8a, 0xd5, 0x7e,
+	0x5d, 0x82, 0xf5, 0xae, 0x20, 0x3e, 0x75, 0x3d, 0xce, 0x22, 0x9a, 0x78, 0x8c, 0x18, 0x50, 0x9e,
+	0x5a, 0xdc, 0x9e, 0xb0, 0xc8, 0xd0, 0x76, 0x4a, 0xbb, 0x3a, 0x9d, 0x4f, 0xc9, 0x03, 0xa8, 0xb9,
+	0x63, 0x3f, 0x88, 0x98, 0x29, 0x17, 0x37, 0x96, 0x24, 0x5c, 0x55, 0x36, 0xb9, 0x0c, 0xb9, 0x07,
+	0x90, 0x52, 0xe2, 0x73, 0xcf, 0x28, 0x49, 0x82, 0xae, 0x2c, 0xfd, 0x73, 0x8f, 0x7c, 0x05, 0x46,
+	0x0a, 0xbb, 0x7e, 0xcc, 0x22, 0x6e, 0x5e, 0x58, 0x5e, 0x82, 0xcb, 0x5d, 0x85, 0x91, 0xb1, 0xbc,
+	0xa3, 0x21, 0x79, 0x53, 0xe1, 0x3d, 0x09, 0xbf, 0x14, 0x68, 0x17, 0x41, 0xf2, 0x2d, 0x7c, 0x90,
+	0x3a, 0x26, 0xa1, 0x63, 0x71, 0x66, 0xfa, 0xec, 0x72, 0xd1, 0x79, 0x45, 0x3a, 0xa7, 0x8b, 0xbf,
+	0x90, 0x94, 0x13, 0x76, 0xf9, 0x1e, 0xff, 0xc0, 0x73, 0x16, 0xfd, 0x57, 0xdf, 0xf6, 0x3f, 0xf5,
+	0x9c, 0xdc, 0x3f, 0x0f, 0xdc, 0x61, 0x1e, 0x43, 0xff, 0x05, 0xdf, 0xf2, 0x62, 0xe0, 0xc7, 0x12,
+	0xce, 0x1d, 0xbf, 0x86, 0xed, 0x6b, 0x1b, 0xfb, 0xde, 0xcc, 0xb4, 0x03, 0x2f, 0x99, 0xfa, 0xb1,
+	0x51, 0x91, 0x09, 0xda, 0x2a, 0x6c, 0x8b, 0xf8, 0x91, 0x82, 0xdb, 0xbf, 0x69, 0xd0, 0xec, 0xf9,
+	0x3e, 0x8b, 0x94, 0x3c, 0x47, 0x81, 0x3f, 0x72, 0xc7, 0x64, 0x03, 0x56, 0x22, 0x14, 0x2a, 0x4e,
+	0xe5, 0x51, 0x13, 0xf2, 0x29, 0xb4, 0xd2, 0x8d, 0xf8, 0x95, 0x6f, 0xc6, 0x1c, 0x85, 0x36, 0x79,
+	0x2c, 0x35, 0x5a, 0xa6, 0x0d, 0x05, 0x0d, 0xae, 0xfc, 0xbe, 0x00, 0x06, 0x31, 0xf9, 0x06, 0x6a,
+	0x0b, 0xc2, 0xc7, 0x52, 0xaa, 0xea, 0x81, 0xd1, 0x49, 0xcb, 0xa6, 0x73, 0xad, 0x2a, 0x68, 0x81,
+	0xdd, 0x7e, 0xad, 0x41, 0xad, 0x10, 0xd3, 0xc7, 0x50, 0xb7, 0xad, 0x98, 0xf5, 0x99, 0x1f, 0xbb,
+	0xdc, 0xbd, 0x60, 0x18, 0x9b, 0xb6, 0x5b, 0xa1, 0x45, 0x23, 0x79, 0x08, 0x6b, 0xa3, 0x20, 0xb2,
+	0x19, 0x65, 0xa1, 0xe7, 0xda, 0x78, 0x58, 0x0c, 0x4f, 0xd0, 0xae, 0x59, 0x51, 0xad, 0xda, 0x68,
+	0x61, 0x75, 0x0c, 0x4e, 0xc3, 0xe0, 0xb6, 0xb3, 0xe0, 0xde, 0xca, 0x09, 0x2d, 0xf0, 0xdb, 0x35,
+	0x00, 0xca, 0xe2, 0xc0, 0xbb, 0x60, 0xce, 0x20, 0x6e, 0x27, 0xb0, 0xa2, 0x8a, 0xb3, 0x01, 0xa5,
+	0x57, 0x6c, 0x26, 0x43, 0xab, 0x51, 0x31, 0x14, 0xa9, 0x94, 0x42, 0xca, 0x38, 0x6a, 0x54, 0x4d,
+	0xc8, 0x36, 0x54, 0xe6, 0xe2, 0xcb, 0xad, 0x6b, 0x34, 0x9b, 0x93, 0x5d, 0x28, 0x07, 0xa1, 0xc9,
+	0x67, 0x21, 0x93, 0x05, 0xbb, 0x76, 0xb0, 0x9e, 0x45, 0x75, 0x1a, 0x0e, 0xd0, 0x4c, 0x57, 0x03,
+	0xf9, 0xdb, 0xfe, 0x19, 0x2a, 0x98, 0x6f, 0xb5, 0xf3, 0x43, 0x58, 0x95, 0x2c, 0xa5, 0x59, 0xf5,
+	0x60, 0xad, 0x98, 0x67, 0x9a, 0xa2, 0xe4, 0x2e, 0xe8, 0x76, 0x30, 0x9d, 0xba, 0xa9, 0x74, 0x1a,
+	0x4a, 0x57, 0x51, 0x06, 0x94, 0xec, 0x0e, 0x54, 0x32, 0x59, 0x4b, 0x12, 0x2b, 0xc7, 0x4a, 0xcd,
+	0x76, 0x15, 0xf4, 0x81, 0x35, 0xf4, 0xf0, 0xda, 0x8c, 0x82, 0xf6, 0xbf, 0x1a, 0xe8, 0x4a, 0x2d,
+	0xc6, 0x1c, 0xf2, 0x08, 0x40, 0x14, 0x44, 0x61, 0xfb, 0x66, 0xb6, 0xfd, 0x3c, 0x42, 0xaa, 0xf3,
+	0x74, 0x14, 0x93, 0x0f, 0xa1, 0x1a, 0xa5, 0xd9, 0xcb, 0xc3, 0x80, 0x28, 0x4b, 0x28, 0xca, 0x53,
+	0x77, 0xdc, 0x38, 0x54, 0x5d, 0xc1, 0x74, 0x9d, 0x54, 0x9f, 0x3b, 0x9d, 0x85, 0x56, 0xd3, 0x39,
+	0xce, 0x18, 0xbd, 0x63, 0x5a, 0xcb, 0xf9, 0x3d, 0x47, 0x16, 0xb0, 0xc5, 0xdd, 0x40, 0x66, 0x70,
+	0x89, 0xaa, 0x09, 0xf9, 0x0c, 0x03, 0x15, 0x67, 0xc0, 0xd6, 0x30, 0x0a, 0xe4, 0x85, 0xae, 0x1e,
+	0x90, 0x3c, 0xd0, 0xf9, 0xf1, 0x30, 0xd2, 0xec, 0xa4, 0x33, 0x58, 0xef, 0xf9, 0x9c, 0x8d, 0x23,
+	0x97, 0xcf, 0xd2, 0x42, 0x7c, 0x04, 0xad, 0xdc, 0x34, 0x61, 0xf6, 0xab, 0x1f, 0x70, 0x05, 0x4f,
+	0x6a, 0xae, 0xd3, 0x9b, 0x20, 0xf2, 0x18, 0x36, 0x8f, 0x82, 0x28, 0x4a, 0x42, 0x0c, 0xc2, 0x7f,
+	0x66, 0xf9, 0x8e, 0xc7, 0x94, 0xcf, 0x92, 0xba, 0xd7, 0x37, 0x82, 0xed, 0xdf, 0xcb, 0xd0, 0xcc,
+	0x8f, 0x48, 0xd9, 0x79, 0xc2, 0x62, 0xd9, 0xfe, 0x6c, 0x2f, 0x89, 0xb9, 0x4a, 0x8b, 0x26, 0x33,
+	0xa7, 0xa7, 0x16, 0x3c, 0x38, 0x26, 0xce, 0x9e, 0x58, 0xfe, 0x98, 0x8d, 0x50, 0x19, 0xc1, 0x58,
+	0xba, 0x21, 0x71, 0x47, 0x19, 0x43, 0x24, 0x2e, 0xe7, 0x2b, 0xff, 0xff, 0x95, 0xf8, 0x2f, 0xe6,
+	0x29, 0x46, 0x9b, 0x2f, 0xb3, 0x5f, 0x3d, 0xb8, 0x5d, 0x70, 0x96, 0x69, 0xee, 0x23, 0x9a, 0xa6,
+	0x59, 0x0c, 0x0b, 0x85, 0xb7, 0x52, 0x28, 0x3c, 0x51, 0xb0, 0xd8, 0xa8, 0x2f, 0x54, 0x34, 0xaa,
+	0x89, 0x56, 0x94, 0x01, 0xb7, 0x7b, 0x0c, 0x55, 0xcb, 0x16, 0x89, 0x53, 0xf7, 0xa5, 0x2c, 0xef,
+	0x4b, 0x2b, 0x93, 0xf4, 0x50, 0x62, 0xf2, 0xce, 0x80, 0x95, 0x8d, 0xc9, 0x13, 0xa8, 0xab, 0xcb,
+	0x8c, 0x5d, 0x52, 0xde, 0xfe, 0x8a, 0x8c, 0x73, 0x33, 0xf3, 0x7b, 0xf7, 0xc5, 0x27, 0x7b, 0xd0,
+	0x64, 0xbe, 0x3a, 0xe1, 0xcc, 0xb7, 0xcd, 0x30, 0x70, 0xf1, 0x99, 0xd2, 0x65, 0x8f, 0x59, 0x57,
+	0x40, 0x1f, 0xed, 0x67, 0xc2, 0x4c, 0xda, 0x50, 0xcf, 0x49, 0xe2, 0x68, 0x20, 0x8f, 0x56, 0x8d,
+	0xe7, 0x0c, 0x3c, 0x5e, 0x07, 0x5a, 0x0b, 0x1c, 0xfc, 0xf0, 0x68, 0x96, 0x67, 0x54, 0x25, 0xb3,
+	0x99, 0x31, 0x7b, 0x29, 0x20, 0xf4, 0x97, 0xfd, 0x3d, 0x62, 0x49, 0xcc, 0x8c, 0x9a, 0xdc, 0x58,
+	0x17, 0x16, 0x2a, 0x0c, 0x22, 0x91, 0x43, 0x27, 0x32, 0xa7, 0x81, 0xc3, 0x8c, 0xba, 0x04, 0xcb,
+	0x38, 0x7f, 0x8e, 0x53, 0xf2, 0x25, 0xe8, 0xee, 0xbc, 0x38, 0x8d, 0x35, 0x79, 0x62, 0x63, 0xa1,
+	0xdf, 0x15, 0x8a, 0x9c, 0xe6, 0x54, 0xd1, 0xab, 0xb8, 0x3b, 0x65, 0xbf, 0x04, 0x3e, 0x33, 0xd6,
+	0x55, 0xfe, 0xe7, 0x73, 0x71, 0xcf, 0x58, 0x18, 0xd8, 0x13, 0xa3, 0x21, 0xe3, 0x55, 0x13, 0x2c,
+	0x82, 0xad, 0x20, 0xe1, 0x61, 0xc2, 0xcd, 0xc8, 0xba, 0x34, 0x55, 0x7d, 0xa5, 0x0f, 0x7a, 0x53,
+	0xc6, 0xb4, 0xa1, 0x60, 0x6a, 0x5d, 0xaa, 0x52, 0x54, 0x2d, 0x8c, 0xc0, 0xb2, 0x8c, 0x9b, 0x20,
+	0xa7, 0x44, 0xe5, 0x98, 0x7c, 0x04, 0x75, 0xd1, 0x5b, 0x2c, 0x1e, 0x4c, 0x5d, 0x5b, 0x04, 0xde,
+	0x92, 0x11, 0xd4, 0xd0, 0x78, 0x38, 0xb7, 0x91, 0x67, 0xf0, 0x20, 0xd5, 0xe4, 0x3d, 0x0f, 0xe1,
+	0x86, 0xdc, 0xf9, 0x9e, 0x22, 0xf6, 0x6e, 0x7e, 0x0e, 0x85, 0x1a, 0xae, 0x8f, 0x2f, 0x89, 0xe5,
+	0x99, 0xb1, 0x6f, 0x85, 0xf1, 0x24, 0x90, 0xba, 0x6d, 0x2a, 0x35, 0x52, 0xa8, 0x9f, 0x22, 0x83,
+	0x78, 0xef, 0x13, 0x58, 0x55, 0x3d, 0x99, 0xd4, 0x41, 0x57, 0xa3, 0xb3, 0x84, 0x37, 0x6e, 0xe1,
+	0x43, 0x50, 0x53, 0x53, 0xf5, 0x5a, 0x37, 0xb4, 0xbd, 0x08, 0x20, 0x2f, 0x47, 0xac, 0xea, 0xad,
+	0xc3, 0xa3, 0x41, 0xef, 0xf4, 0xc4, 0x1c, 0xfc, 0x78, 0xd6, 0x35, 0x5f, 0x9c, 0xf4, 0xcf, 0xba,
+	0x47, 0xbd, 0xa7, 0xbd, 0xee, 0x31, 0x3a, 0x1b, 0xb0, 0xb1, 0x08, 0xd2, 0xee, 0xf7, 0xbd, 0xfe,
+	0xa0, 0x4b, 0x1b, 0x1a, 0xb9, 0x0d, 0xa4, 0x88, 0x3c, 0x3f, 0x7d, 0xd9, 0x6d, 0x2c, 0x91, 0x4d,
+	0x68, 0x16, 0xed, 0xfd, 0xee, 0xa0, 0xb1, 0xf2, 0xdd, 0x93, 0x3f, 0xfe, 0xbe, 0xaf, 0xbd, 0xc1,
+	0xef, 0x2f, 0xfc, 0x7e, 0xfd, 0xe7, 0xfe, 0xad, 0x37, 0xf8, 0xfd, 0x89, 0xdf, 0x4f, 0x3b, 0x63,
+	0x97, 0x4f, 0x92, 0x61, 0x07, 0x5b, 0xff, 0x7e, 0xe8, 0xfa, 0x63, 0xdb, 0x0a, 0xf7, 0xb9, 0x6b,
+	0x3b, 0xf6, 0x7e, 0x5a, 0x11, 0xc3, 0x55, 0xf9, 0xff, 0xed, 0xf3, 0xff, 0x00, 0x86, 0xff, 0xf8,
+	0x1f, 0xfc, 0x09, 0x00, 0x00,
 }
 
 func (m *EventFilterRule) Marshal() (dAtA []byte, err error) {
@@ -1404,6 +1414,13 @@ func (m *DispatcherRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InitialSnapshotTs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.InitialSnapshotTs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.EnableIgnoreUpdateOnlyColumns {
 		i--
 		if m.EnableIgnoreUpdateOnlyColumns {
@@ -1873,6 +1890,9 @@ func (m *DispatcherRequest) Size() (n int) {
 	if m.EnableIgnoreUpdateOnlyColumns {
 		n += 3
 	}
+	if m.InitialSnapshotTs != 0 {
+		n += 2 + sovEvent(uint64(m.InitialSnapshotTs))
+	}
 	return n
 }
 
@@ -3737,6 +3757,25 @@ func (m *DispatcherRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableIgnoreUpdateOnlyColumns = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSnapshotTs", wireType)
+			}
+			m.InitialSnapshotTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialSnapshotTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
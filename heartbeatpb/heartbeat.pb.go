@@ -3082,6 +3082,13 @@ type State struct {
 	// for this barrier event. Non-empty only when the table trigger dispatcher's
 	// DDL changes the upstream source name lifecycle (e.g. RENAME TABLE, DROP TABLE).
 	RouteTableAdmissions []*RouteTableAdmission `protobuf:"bytes,9,rep,name=RouteTableAdmissions,proto3" json:"RouteTableAdmissions,omitempty"`
+	// NeedApproval is true when the DDL matches a pause ddl policy, the barrier
+	// holds it until an operator approves or skips it. DDLQuery, DDLSchemaName
+	// and DDLTableName describe the DDL to the operator.
+	NeedApproval  bool   `protobuf:"varint,10,opt,name=NeedApproval,proto3" json:"NeedApproval,omitempty"`
+	DDLQuery      string `protobuf:"bytes,11,opt,name=DDLQuery,proto3" json:"DDLQuery,omitempty"`
+	DDLSchemaName string `protobuf:"bytes,12,opt,name=DDLSchemaName,proto3" json:"DDLSchemaName,omitempty"`
+	DDLTableName  string `protobuf:"bytes,13,opt,name=DDLTableName,proto3" json:"DDLTableName,omitempty"`
 }
 
 func (m *State) Reset()         { *m = State{} }
@@ -3180,6 +3187,34 @@ func (m *State) GetRouteTableAdmissions() []*RouteTableAdmission {
 	return nil
 }
 
+func (m *State) GetNeedApproval() bool {
+	if m != nil {
+		return m.NeedApproval
+	}
+	return false
+}
+
+func (m *State) GetDDLQuery() string {
+	if m != nil {
+		return m.DDLQuery
+	}
+	return ""
+}
+
+func (m *State) GetDDLSchemaName() string {
+	if m != nil {
+		return m.DDLSchemaName
+	}
+	return ""
+}
+
+func (m *State) GetDDLTableName() string {
+	if m != nil {
+		return m.DDLTableName
+	}
+	return ""
+}
+
 type TableSpanBlockStatus struct {
 	ID    *DispatcherID `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	State *State        `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
@@ -4006,196 +4041,198 @@ func init() {
 func init() { proto.RegisterFile("heartbeatpb/heartbeat.proto", fileDescriptor_6d584080fdadb670) }
 
 var fileDescriptor_6d584080fdadb670 = []byte{
-	// 3020 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x1a, 0x4d, 0x8f, 0x1c, 0x47,
-	0xd5, 0xdd, 0xf3, 0xfd, 0x76, 0x67, 0xb7, 0x5d, 0xb6, 0xd7, 0x6b, 0x7b, 0xbd, 0xde, 0x74, 0x02,
-	0xda, 0x4c, 0x82, 0x8d, 0x9d, 0x98, 0x8f, 0x10, 0x12, 0xc6, 0x33, 0x9b, 0x78, 0xe4, 0x9d, 0xdd,
-	0x55, 0xcd, 0x26, 0x46, 0xe1, 0x30, 0xf4, 0x76, 0x97, 0x67, 0x3b, 0x3b, 0xd3, 0x35, 0xe9, 0xee,
-	0xf1, 0xda, 0x96, 0x00, 0x45, 0x88, 0x1b, 0x07, 0xb8, 0x71, 0x20, 0x17, 0x4e, 0x9c, 0x10, 0x7f,
-	0x00, 0xc1, 0x81, 0x03, 0x27, 0x14, 0x71, 0x40, 0x39, 0x41, 0x94, 0xdc, 0x11, 0x12, 0x12, 0x5c,
-	0x51, 0x7d, 0x74, 0x77, 0xf5, 0x4c, 0xcf, 0x7e, 0xb0, 0xa3, 0x88, 0xd3, 0xf4, 0x7b, 0xf5, 0xde,
-	0xab, 0x57, 0xaf, 0x5e, 0xbd, 0x7a, 0xef, 0xd5, 0xc0, 0xb5, 0x7d, 0x62, 0xf9, 0xe1, 0x1e, 0xb1,
-	0xc2, 0xe1, 0xde, 0xad, 0xf8, 0xfb, 0xe6, 0xd0, 0xa7, 0x21, 0x45, 0x73, 0xca, 0xa0, 0xf9, 0x14,
-	0x2a, 0xbb, 0xd6, 0x5e, 0x9f, 0x74, 0x86, 0x96, 0x87, 0x96, 0xa1, 0xc4, 0x81, 0x56, 0x73, 0x59,
-	0x5b, 0xd3, 0xd6, 0x73, 0x38, 0x02, 0xd1, 0x55, 0x28, 0x77, 0x42, 0xcb, 0x0f, 0x1f, 0x90, 0xa7,
-	0xcb, 0xfa, 0x9a, 0xb6, 0x3e, 0x8f, 0x63, 0x18, 0x2d, 0x41, 0x71, 0xc3, 0x73, 0xd8, 0x48, 0x8e,
-	0x8f, 0x48, 0x08, 0xad, 0x02, 0x3c, 0x20, 0x4f, 0x83, 0xa1, 0x65, 0x33, 0x81, 0xf9, 0x35, 0x6d,
-	0xbd, 0x8a, 0x15, 0x8c, 0xf9, 0x57, 0x1d, 0x8c, 0xfb, 0x4c, 0x95, 0x7b, 0xc4, 0x0a, 0x31, 0xf9,
-	0x60, 0x44, 0x82, 0x10, 0x7d, 0x1b, 0xe6, 0xed, 0x7d, 0xcb, 0xeb, 0x91, 0x47, 0x84, 0x38, 0x52,
-	0x8f, 0xb9, 0x3b, 0x57, 0x6e, 0x2a, 0x3a, 0xdf, 0x6c, 0x28, 0x04, 0x38, 0x45, 0x8e, 0x5e, 0x85,
-	0xca, 0xa1, 0x15, 0x12, 0x7f, 0x60, 0xf9, 0x07, 0x5c, 0xd1, 0xb9, 0x3b, 0x4b, 0x29, 0xde, 0x87,
-	0xd1, 0x28, 0x4e, 0x08, 0xd1, 0xeb, 0x50, 0xf5, 0x89, 0x43, 0xe3, 0x31, 0xbe, 0x90, 0xe9, 0x9c,
-	0x69, 0x62, 0xf4, 0x0d, 0x28, 0x07, 0xa1, 0x15, 0x8e, 0x02, 0x12, 0x2c, 0xe7, 0xd7, 0x72, 0xeb,
-	0x73, 0x77, 0x56, 0x52, 0x8c, 0xb1, 0x7d, 0x3b, 0x9c, 0x0a, 0xc7, 0xd4, 0x68, 0x1d, 0x16, 0x6d,
-	0x3a, 0x18, 0x92, 0x3e, 0x09, 0x89, 0x18, 0x5c, 0x2e, 0xac, 0x69, 0xeb, 0x65, 0x3c, 0x8e, 0x46,
-	0x2f, 0x41, 0x8e, 0xf8, 0xfe, 0x72, 0x31, 0xc3, 0x1a, 0x78, 0xe4, 0x79, 0xae, 0xd7, 0xdb, 0xf0,
-	0x7d, 0xea, 0x63, 0x46, 0x65, 0xfe, 0x44, 0x83, 0x4a, 0xa2, 0x9e, 0xc9, 0x2c, 0x4a, 0xec, 0x83,
-	0x21, 0x75, 0xbd, 0x70, 0x37, 0xe0, 0x16, 0xcd, 0xe3, 0x14, 0x8e, 0x6d, 0x95, 0x4f, 0x02, 0xda,
-	0x7f, 0x4c, 0x9c, 0xdd, 0x80, 0xdb, 0x2d, 0x8f, 0x15, 0x0c, 0x32, 0x20, 0x17, 0x90, 0x0f, 0xb8,
-	0x59, 0xf2, 0x98, 0x7d, 0x32, 0xa9, 0x7d, 0x2b, 0x08, 0x3b, 0x4f, 0x3d, 0x9b, 0xf3, 0xe4, 0x85,
-	0x54, 0x15, 0x67, 0xfe, 0x00, 0x8c, 0xa6, 0x1b, 0x0c, 0xad, 0xd0, 0xde, 0x27, 0x7e, 0xdd, 0x0e,
-	0x5d, 0xea, 0xa1, 0x97, 0xa0, 0x68, 0xf1, 0x2f, 0xae, 0xc7, 0xc2, 0x9d, 0x0b, 0xa9, 0xb5, 0x08,
-	0x22, 0x2c, 0x49, 0x98, 0xd7, 0x35, 0xe8, 0x60, 0xe0, 0x86, 0xb1, 0x52, 0x31, 0x8c, 0xd6, 0x60,
-	0xae, 0x15, 0xb0, 0xa9, 0x76, 0xd8, 0x1a, 0xb8, 0x6a, 0x65, 0xac, 0xa2, 0xcc, 0x06, 0xe4, 0xea,
-	0x8d, 0x07, 0x29, 0x21, 0xda, 0xd1, 0x42, 0xf4, 0x49, 0x21, 0x18, 0x50, 0xab, 0xe7, 0x51, 0x9f,
-	0x38, 0xf7, 0xfa, 0xd4, 0x3e, 0x90, 0xdb, 0x71, 0x36, 0x99, 0x3f, 0xd6, 0xe1, 0x52, 0xcb, 0x7b,
-	0xd4, 0x1f, 0x11, 0x66, 0xa8, 0xc4, 0x44, 0x01, 0xfa, 0x0e, 0x54, 0xe3, 0x81, 0xdd, 0xa7, 0x43,
-	0x22, 0x8d, 0x74, 0x35, 0x65, 0xa4, 0x14, 0x05, 0x4e, 0x33, 0xa0, 0x37, 0xa1, 0x9a, 0x08, 0x6c,
-	0x35, 0x99, 0xdd, 0x72, 0x13, 0x2e, 0xa3, 0x52, 0xe0, 0x34, 0x3d, 0x3f, 0xe9, 0xf6, 0x3e, 0x19,
-	0x58, 0xad, 0x26, 0x37, 0x6a, 0x0e, 0xc7, 0x30, 0x7a, 0x00, 0x17, 0xc8, 0x13, 0xbb, 0x3f, 0x72,
-	0x88, 0xc2, 0xe3, 0xf0, 0xbd, 0x3f, 0x72, 0x8a, 0x2c, 0x2e, 0xf3, 0x17, 0xba, 0xea, 0x1e, 0xd2,
-	0xb0, 0xdf, 0x85, 0x4b, 0x6e, 0x96, 0x65, 0x64, 0x1c, 0x30, 0xb3, 0x0d, 0xa1, 0x52, 0xe2, 0x6c,
-	0x01, 0xe8, 0x6e, 0xec, 0x78, 0x22, 0x2c, 0x5c, 0x9f, 0xa2, 0xee, 0x98, 0x0b, 0x9a, 0x90, 0xb3,
-	0xec, 0x28, 0x20, 0x18, 0x69, 0x67, 0x6d, 0x3c, 0xc0, 0x6c, 0x10, 0x6d, 0x03, 0x72, 0x27, 0x7c,
-	0x44, 0x5a, 0xe5, 0x46, 0x5a, 0xe3, 0x09, 0x32, 0x9c, 0xc1, 0x6a, 0x7e, 0xaa, 0xc1, 0x79, 0x25,
-	0x32, 0x06, 0x43, 0xea, 0x05, 0xe4, 0xac, 0xa1, 0xb1, 0x0d, 0xc8, 0x19, 0x33, 0x37, 0x89, 0xdc,
-	0x63, 0x9a, 0x31, 0x22, 0x1d, 0x27, 0x19, 0x11, 0x82, 0xfc, 0x80, 0x3a, 0x44, 0xfa, 0x08, 0xff,
-	0x46, 0x2f, 0x82, 0x31, 0xb0, 0x5c, 0x2f, 0xb4, 0x5c, 0x8f, 0xf8, 0x5d, 0x32, 0xa4, 0xf6, 0xbe,
-	0x0c, 0x0c, 0x8b, 0x09, 0x7e, 0x83, 0xa1, 0xcd, 0x27, 0x70, 0xa1, 0xa1, 0x44, 0xa0, 0x36, 0x09,
-	0x02, 0xab, 0x77, 0xe6, 0x35, 0x8e, 0xc7, 0x3a, 0x7d, 0x32, 0xd6, 0x99, 0xbf, 0xd7, 0x60, 0x11,
-	0x13, 0x87, 0xb6, 0x49, 0x68, 0xcd, 0x68, 0xda, 0xe3, 0xc2, 0xe7, 0xb8, 0x5a, 0xb9, 0x8c, 0x10,
-	0x7c, 0x0a, 0xdb, 0xfd, 0x10, 0xae, 0xb3, 0x05, 0xe0, 0x78, 0x82, 0x1d, 0x9f, 0xf6, 0x7c, 0x12,
-	0x04, 0x5f, 0xcc, 0x72, 0xcc, 0x5f, 0x6b, 0xb0, 0x92, 0x56, 0xe0, 0x2d, 0xea, 0x1f, 0x5a, 0xbe,
-	0xf3, 0x05, 0x99, 0x33, 0xcb, 0x54, 0xb9, 0x6c, 0x53, 0xfd, 0x4b, 0x53, 0x83, 0x4c, 0x83, 0x7a,
-	0x8f, 0xdc, 0x1e, 0xaa, 0x41, 0x3e, 0x18, 0x5a, 0x9e, 0x54, 0x6b, 0x29, 0xfb, 0xb2, 0xc6, 0x9c,
-	0x86, 0xa5, 0x44, 0x01, 0x4b, 0x74, 0x62, 0x45, 0x22, 0x90, 0x2d, 0xd2, 0x51, 0x82, 0x9c, 0x0c,
-	0x11, 0x47, 0x44, 0xc1, 0x14, 0x39, 0x8b, 0xb3, 0x41, 0x14, 0x67, 0xf3, 0x22, 0xce, 0x46, 0x70,
-	0x7c, 0xb6, 0x0a, 0xca, 0xd9, 0xaa, 0x81, 0x11, 0x1c, 0xb8, 0xc3, 0x66, 0x7b, 0xb3, 0x1e, 0x74,
-	0xa4, 0x46, 0x45, 0x7e, 0xb7, 0x4c, 0xe0, 0xcd, 0x3f, 0xe8, 0x70, 0x85, 0x05, 0x6d, 0x67, 0xd4,
-	0x57, 0x62, 0xee, 0x8c, 0x52, 0xac, 0xbb, 0x50, 0xb4, 0xb9, 0x1d, 0x8f, 0x09, 0xa4, 0xc2, 0xd8,
-	0x58, 0x12, 0xa3, 0x06, 0x2c, 0x04, 0x52, 0x25, 0x11, 0x62, 0xb9, 0xc1, 0x16, 0xee, 0x5c, 0x4b,
-	0xb1, 0x77, 0x52, 0x24, 0x78, 0x8c, 0x85, 0xa9, 0x4e, 0x87, 0xc4, 0xb7, 0x42, 0xea, 0xf3, 0xeb,
-	0x31, 0xcf, 0x45, 0xa4, 0x55, 0xdf, 0x56, 0x08, 0x70, 0x8a, 0x3c, 0xd3, 0x71, 0x0a, 0xd9, 0x8e,
-	0xf3, 0x2b, 0x1d, 0x96, 0xda, 0xc4, 0xef, 0xcd, 0xde, 0x7e, 0x6f, 0x42, 0xd5, 0x39, 0xe5, 0x0d,
-	0x9d, 0xa2, 0x47, 0x2d, 0x40, 0x03, 0xa6, 0x99, 0xd3, 0x3c, 0x95, 0xfb, 0x65, 0x30, 0xc5, 0x8e,
-	0x96, 0x3f, 0x26, 0x88, 0x4f, 0x31, 0xd2, 0x0e, 0x5c, 0x68, 0xc7, 0xa8, 0xfb, 0xd1, 0xc4, 0xe8,
-	0x9b, 0x4a, 0x42, 0xac, 0x65, 0xdc, 0x2f, 0x09, 0xcf, 0x78, 0x46, 0x6c, 0x7e, 0xa2, 0x41, 0xb5,
-	0xe9, 0x5b, 0xae, 0x17, 0x85, 0x34, 0xf4, 0x02, 0x2c, 0x84, 0x96, 0xdf, 0x23, 0x61, 0xd7, 0xa3,
-	0x0e, 0xe9, 0xba, 0x0e, 0xb7, 0x77, 0x05, 0xcf, 0x0b, 0xec, 0x16, 0x75, 0x48, 0xcb, 0x41, 0xcf,
-	0x81, 0x84, 0xa5, 0xc2, 0xe2, 0xac, 0xce, 0x09, 0x1c, 0x57, 0x16, 0x7d, 0x0d, 0x2e, 0x4b, 0x92,
-	0xc4, 0x9c, 0x5d, 0x9b, 0x8e, 0x64, 0xf2, 0x58, 0xc5, 0x97, 0xc4, 0xb0, 0xea, 0xc1, 0x23, 0x2f,
-	0x44, 0x6f, 0xc1, 0x9a, 0xe4, 0x63, 0x89, 0x85, 0xdb, 0xdb, 0x0f, 0xbb, 0x0e, 0xd3, 0xb0, 0x3b,
-	0xa0, 0x8f, 0x89, 0x14, 0x20, 0x8a, 0x9b, 0x15, 0x41, 0xd7, 0x92, 0x64, 0x7c, 0x1d, 0x6d, 0xfa,
-	0x98, 0x70, 0x39, 0xe6, 0x6f, 0x72, 0x60, 0x8c, 0xaf, 0xfc, 0xac, 0xbe, 0x74, 0x1d, 0x80, 0x7d,
-	0x75, 0x99, 0xfd, 0x08, 0x5f, 0x74, 0x05, 0x57, 0x18, 0x86, 0x89, 0x27, 0xe8, 0x36, 0x14, 0xc4,
-	0x48, 0xd6, 0x51, 0x6b, 0xd0, 0xc1, 0x90, 0x7a, 0xc4, 0x0b, 0x39, 0x2d, 0x16, 0x94, 0xe8, 0x79,
-	0xa8, 0x26, 0xd7, 0x52, 0x37, 0x8c, 0x13, 0xfb, 0xd4, 0x5d, 0x25, 0xab, 0x91, 0x42, 0x86, 0xe3,
-	0x4e, 0x54, 0x23, 0xe8, 0x4b, 0xb0, 0xb0, 0x47, 0x69, 0x18, 0x84, 0xbe, 0x35, 0xec, 0x3a, 0xd4,
-	0x23, 0x32, 0x6c, 0x55, 0x63, 0x6c, 0x93, 0x7a, 0x64, 0xa2, 0xa0, 0x28, 0x4d, 0x16, 0x14, 0xa8,
-	0x0e, 0x0b, 0xc2, 0xf4, 0x43, 0xe9, 0x1d, 0xcb, 0x65, 0x6e, 0xaf, 0x74, 0x7e, 0x9c, 0xf2, 0x1f,
-	0x5c, 0x75, 0x52, 0xee, 0x94, 0xe5, 0xdd, 0x95, 0x6c, 0xef, 0xfe, 0x87, 0x06, 0x55, 0xe6, 0x5e,
-	0x89, 0x63, 0xdf, 0x85, 0x72, 0xdf, 0x7d, 0x4c, 0x3c, 0x36, 0xb3, 0x96, 0x11, 0x7a, 0x18, 0xf5,
-	0xa6, 0x24, 0xc0, 0x31, 0x29, 0xdb, 0x25, 0xee, 0xbb, 0xaa, 0x6b, 0x56, 0x18, 0x46, 0x38, 0x66,
-	0x13, 0x6e, 0x28, 0x1e, 0x29, 0x16, 0x38, 0xe6, 0xf2, 0x39, 0xbe, 0xb3, 0xd7, 0x12, 0x32, 0xbe,
-	0xc6, 0x5d, 0xf5, 0x04, 0xd4, 0xe1, 0xfa, 0x34, 0x29, 0x6a, 0x32, 0x71, 0x35, 0x53, 0x86, 0x58,
-	0xf0, 0xfb, 0xb0, 0xd4, 0x11, 0xf2, 0xe2, 0x45, 0xc8, 0x90, 0x77, 0x1b, 0x8a, 0x42, 0xd6, 0xf1,
-	0xcb, 0x96, 0x84, 0xc7, 0x2c, 0xda, 0x1c, 0xc0, 0xe5, 0x89, 0xb9, 0x64, 0x9e, 0xfb, 0x0a, 0x94,
-	0xac, 0xe1, 0xb0, 0xef, 0x12, 0xe7, 0xf8, 0xd9, 0x22, 0xca, 0xe3, 0xa6, 0x7b, 0x1f, 0x6e, 0x74,
-	0xd4, 0xa3, 0xad, 0xac, 0x3d, 0x5a, 0xe3, 0xac, 0x02, 0x8d, 0xf9, 0x75, 0xb8, 0xd6, 0xa0, 0xd4,
-	0x77, 0x5c, 0x8f, 0x5d, 0x3c, 0xf7, 0x22, 0x2f, 0x8f, 0xe6, 0x59, 0x86, 0xd2, 0x63, 0xe2, 0x07,
-	0x51, 0x09, 0x9c, 0xc3, 0x11, 0xc8, 0x2a, 0xa2, 0x95, 0x6c, 0x4e, 0x69, 0x99, 0xff, 0x3d, 0xb0,
-	0xa2, 0x57, 0x61, 0x29, 0x3e, 0x3a, 0x21, 0xb5, 0x69, 0xbf, 0x1b, 0x29, 0xa1, 0xf3, 0xd8, 0x75,
-	0x31, 0x3a, 0x26, 0x7c, 0xf0, 0x5d, 0x31, 0xf6, 0xff, 0xe3, 0x9a, 0xff, 0xd6, 0xe0, 0x62, 0xdd,
-	0x71, 0x92, 0x05, 0x46, 0xd6, 0x7c, 0x11, 0x74, 0xb9, 0x53, 0x47, 0x86, 0x4d, 0xdd, 0x75, 0xd0,
-	0x52, 0x2a, 0x71, 0x99, 0x8f, 0x33, 0x93, 0x89, 0x90, 0x97, 0x95, 0x9e, 0xd7, 0xe0, 0xbc, 0x1b,
-	0x74, 0x3d, 0x72, 0xd8, 0x4d, 0x02, 0x30, 0xd7, 0xbb, 0x8c, 0x17, 0xdd, 0x60, 0x8b, 0x1c, 0x26,
-	0xd3, 0xa1, 0x1b, 0x30, 0x77, 0x20, 0xdb, 0x5c, 0xcc, 0x42, 0x05, 0xd1, 0xf9, 0x8a, 0x50, 0x2d,
-	0x27, 0x33, 0x08, 0x15, 0xb3, 0x83, 0xd0, 0x1f, 0x35, 0xb8, 0x8c, 0x09, 0xbb, 0x6a, 0xce, 0xb4,
-	0xf6, 0x65, 0x28, 0xd9, 0x56, 0x60, 0x5b, 0x0e, 0x91, 0x0d, 0x89, 0x08, 0x64, 0x23, 0x3e, 0x97,
-	0xef, 0xc8, 0x1e, 0x4a, 0x04, 0x8e, 0x2f, 0x23, 0x7f, 0xa2, 0x65, 0x4c, 0xc9, 0x14, 0xfe, 0x9c,
-	0x83, 0xab, 0xc9, 0x02, 0x26, 0xce, 0xc4, 0x19, 0xaf, 0xc1, 0x69, 0x3b, 0x7b, 0x85, 0x9f, 0x17,
-	0x5f, 0xd9, 0xd4, 0x38, 0x7b, 0xb7, 0xe1, 0xb9, 0x90, 0xa5, 0xfa, 0xdd, 0xd0, 0x77, 0x7b, 0x3d,
-	0xa6, 0xfe, 0x63, 0xe2, 0xa5, 0x52, 0x03, 0xf7, 0x04, 0x8d, 0x8d, 0xeb, 0x5c, 0xc6, 0xae, 0x10,
-	0xb1, 0xc1, 0x24, 0xa8, 0x2d, 0x8e, 0x6c, 0xa7, 0x29, 0x64, 0x3b, 0x8d, 0xc5, 0xd2, 0x0c, 0x55,
-	0x21, 0x9f, 0x38, 0x74, 0x4c, 0x9f, 0xe2, 0x71, 0xfa, 0xac, 0xa8, 0xfa, 0xb0, 0x12, 0x2d, 0xa5,
-	0xce, 0xd8, 0x86, 0x96, 0x4e, 0xb4, 0xa1, 0xe5, 0xec, 0x0d, 0xfd, 0x4b, 0x0e, 0xae, 0x65, 0x6e,
-	0xe8, 0x6c, 0x9a, 0x15, 0x77, 0xa1, 0xc0, 0xca, 0xaf, 0x28, 0x39, 0x4e, 0x77, 0x51, 0xe2, 0xd9,
-	0x92, 0x62, 0x4d, 0x50, 0x47, 0x89, 0x49, 0xee, 0x24, 0x6d, 0xd2, 0x93, 0xa5, 0x3a, 0x2f, 0x03,
-	0xe2, 0x1b, 0x91, 0xa6, 0x14, 0x5e, 0x6e, 0xb0, 0x11, 0xb5, 0x8b, 0x81, 0x9a, 0x50, 0x89, 0x0a,
-	0x0e, 0x56, 0x9d, 0x31, 0xd5, 0xbf, 0x9c, 0x59, 0xdf, 0x4c, 0x54, 0x15, 0x38, 0x61, 0xcc, 0xdc,
-	0x86, 0x52, 0xe6, 0x36, 0xa0, 0x4d, 0x58, 0xe4, 0x69, 0x7d, 0x37, 0x99, 0xb6, 0xcc, 0xa7, 0x7d,
-	0x3e, 0x7d, 0x31, 0x64, 0x56, 0x32, 0x78, 0x81, 0xf3, 0x46, 0x05, 0x53, 0x60, 0xfe, 0x4d, 0x87,
-	0xd5, 0x64, 0x53, 0x77, 0x68, 0x10, 0xce, 0xfa, 0xa4, 0x9e, 0xe8, 0xd8, 0xe9, 0x67, 0x3c, 0x76,
-	0xb7, 0xa1, 0x24, 0x4a, 0x69, 0x76, 0xea, 0x99, 0x31, 0x2e, 0x4f, 0xec, 0xc1, 0xc0, 0x6a, 0x79,
-	0x8f, 0x28, 0x8e, 0xe8, 0xd0, 0x6b, 0x30, 0xcf, 0xb7, 0x39, 0xe2, 0xcb, 0x1f, 0xcd, 0x37, 0xc7,
-	0x88, 0x3b, 0x92, 0xf7, 0x14, 0x61, 0xf0, 0x23, 0x1d, 0x6e, 0x4c, 0x35, 0xf0, 0x6c, 0x4e, 0xce,
-	0x17, 0x62, 0xe1, 0x53, 0x9d, 0xb3, 0x53, 0x75, 0x05, 0x21, 0xb1, 0x72, 0xaa, 0x15, 0xad, 0x8d,
-	0xb5, 0xa2, 0x57, 0x23, 0xca, 0x2d, 0x6b, 0x10, 0x55, 0x3e, 0x0a, 0x06, 0xdd, 0x84, 0x22, 0x8f,
-	0x0e, 0x91, 0x0b, 0x64, 0x74, 0x79, 0xf8, 0x4e, 0x4a, 0x2a, 0xb3, 0x21, 0xdf, 0xc1, 0xf8, 0xc4,
-	0xd3, 0xdf, 0xc1, 0x56, 0x24, 0x99, 0x32, 0x6b, 0x82, 0x30, 0x7f, 0xa7, 0x03, 0x9a, 0x0c, 0x4e,
-	0xec, 0x9e, 0x9e, 0xb2, 0x8f, 0x29, 0x9b, 0xeb, 0xf2, 0x9d, 0x2d, 0x5a, 0xb2, 0x3e, 0xb6, 0xe4,
-	0xa8, 0x6d, 0x95, 0x3b, 0x41, 0xdb, 0xea, 0x2d, 0x30, 0xec, 0xa8, 0xbe, 0xeb, 0x06, 0x49, 0x43,
-	0xfa, 0x98, 0x22, 0x70, 0xd1, 0x56, 0xe1, 0x51, 0x30, 0x19, 0x23, 0x0b, 0x19, 0x31, 0xf2, 0x15,
-	0x98, 0xdb, 0xeb, 0x53, 0xfb, 0x40, 0x96, 0xa1, 0xe2, 0x96, 0x42, 0xe9, 0xb3, 0xc3, 0xc5, 0xc3,
-	0x5e, 0xd4, 0xe4, 0x26, 0x71, 0xeb, 0xa1, 0x94, 0xb4, 0x1e, 0xcc, 0x5f, 0x6a, 0xb0, 0x94, 0x1c,
-	0x8f, 0x46, 0x9f, 0x06, 0x64, 0x46, 0x71, 0x47, 0xc9, 0x72, 0xf4, 0x74, 0x96, 0x73, 0x8a, 0x66,
-	0xe2, 0x47, 0x1a, 0x5c, 0x9e, 0x50, 0x6f, 0x36, 0xa7, 0x76, 0x19, 0x4a, 0xc1, 0xc8, 0xb6, 0x59,
-	0x61, 0x29, 0xf5, 0x93, 0xe0, 0x69, 0xf4, 0xfb, 0xa9, 0x06, 0x46, 0xf2, 0x26, 0x22, 0x1c, 0x7b,
-	0x06, 0x4f, 0x4a, 0x57, 0xa1, 0x2c, 0xdd, 0x5f, 0x5c, 0xc7, 0x39, 0x1c, 0xc3, 0x47, 0xbd, 0x16,
-	0x99, 0xdf, 0x83, 0x02, 0xa7, 0x3b, 0xe6, 0x59, 0x79, 0x9a, 0xbb, 0xaf, 0x40, 0xa5, 0x33, 0xec,
-	0xbb, 0x3c, 0x10, 0xc9, 0xd4, 0x34, 0x41, 0x98, 0x1f, 0xea, 0x70, 0x01, 0xd3, 0x51, 0x48, 0xb8,
-	0xa8, 0xba, 0x33, 0x70, 0x03, 0x5e, 0xb1, 0xd4, 0xc0, 0xe8, 0xd0, 0x91, 0x6f, 0x13, 0x25, 0x3a,
-	0x88, 0x3a, 0x6e, 0x02, 0x8f, 0xd6, 0x61, 0x51, 0xe0, 0xc6, 0x8f, 0xf4, 0x38, 0x9a, 0x49, 0x15,
-	0xd5, 0x88, 0x22, 0x55, 0x14, 0x3e, 0x13, 0x78, 0x26, 0x55, 0xe0, 0x12, 0xa9, 0x79, 0x21, 0x75,
-	0x0c, 0x8d, 0xde, 0x80, 0xa2, 0x6c, 0x85, 0x16, 0xf8, 0x9e, 0xa4, 0x53, 0x85, 0x8c, 0xd5, 0x45,
-	0x6f, 0x53, 0xe2, 0xd7, 0xf4, 0x60, 0x21, 0xb2, 0x96, 0x70, 0xad, 0x23, 0x2c, 0xbd, 0x06, 0x73,
-	0xdb, 0x7d, 0x67, 0xcc, 0xd8, 0x2a, 0x8a, 0x51, 0x6c, 0x91, 0xc3, 0xb1, 0xdd, 0x54, 0x51, 0xe6,
-	0x7f, 0x72, 0x50, 0x10, 0x87, 0x77, 0x05, 0x2a, 0xad, 0x80, 0xbf, 0x58, 0xc9, 0x22, 0xbd, 0x8c,
-	0x13, 0x04, 0xd3, 0x82, 0x7f, 0x26, 0x3d, 0x73, 0x09, 0xa2, 0x37, 0x61, 0x4e, 0x7c, 0x46, 0xa1,
-	0x79, 0xb2, 0x81, 0x3c, 0xee, 0xc0, 0x58, 0xe5, 0x40, 0x0f, 0xe0, 0xfc, 0x16, 0x21, 0x4e, 0xd3,
-	0xa7, 0xc3, 0x61, 0x44, 0x21, 0xd3, 0xf4, 0x63, 0xc4, 0x4c, 0xf2, 0xa1, 0xd7, 0x61, 0x91, 0x21,
-	0xeb, 0x8e, 0x13, 0x8b, 0x12, 0x2d, 0x2d, 0x34, 0x19, 0x5b, 0xf1, 0x38, 0x29, 0x6a, 0xc0, 0xc2,
-	0x3b, 0x43, 0xc7, 0x0a, 0x89, 0x34, 0x61, 0x94, 0xf0, 0x5d, 0xcb, 0x4a, 0x1a, 0xe4, 0x06, 0xe1,
-	0x31, 0x96, 0xf1, 0xc7, 0xe2, 0xd2, 0xc4, 0x63, 0x31, 0xfa, 0x0a, 0xef, 0xe1, 0xf5, 0x08, 0x4f,
-	0xc4, 0x17, 0xc6, 0x52, 0x92, 0xe8, 0xd1, 0xb0, 0x27, 0xfa, 0x77, 0x3d, 0x82, 0x76, 0xe1, 0x62,
-	0x86, 0xe3, 0x04, 0xcb, 0x15, 0xae, 0xdb, 0xda, 0x71, 0x1e, 0x86, 0x33, 0xb9, 0xcd, 0x1f, 0xc1,
-	0xc5, 0xf8, 0x86, 0x51, 0xdf, 0xc1, 0x4f, 0x71, 0xb3, 0xad, 0x47, 0xbd, 0x48, 0x7d, 0xea, 0xf5,
-	0x20, 0x5b, 0x90, 0x19, 0x2f, 0x8b, 0xe6, 0x3f, 0x35, 0x76, 0xaa, 0x52, 0xff, 0xa3, 0x38, 0xcd,
-	0xe4, 0x59, 0xd7, 0xa1, 0x3e, 0x8b, 0xeb, 0x30, 0xab, 0x55, 0x70, 0x1b, 0x2e, 0x89, 0x9c, 0x2b,
-	0x70, 0x9f, 0x91, 0xee, 0x90, 0xf8, 0xdd, 0x80, 0xd8, 0xd4, 0x13, 0xe5, 0xa4, 0x8e, 0x11, 0x1f,
-	0xec, 0xb8, 0xcf, 0xc8, 0x0e, 0xf1, 0x3b, 0x7c, 0x24, 0xeb, 0xc1, 0xc7, 0xfc, 0xad, 0x06, 0x48,
-	0x7d, 0x28, 0x9e, 0xcd, 0x45, 0xf8, 0x36, 0x54, 0xf7, 0x12, 0xa1, 0xf1, 0x03, 0xf0, 0x73, 0xd9,
-	0xd9, 0x84, 0x3a, 0x7f, 0x9a, 0x2f, 0x73, 0x97, 0x1c, 0x98, 0x57, 0xd3, 0x3f, 0x46, 0x13, 0xba,
-	0x71, 0x00, 0xe6, 0xdf, 0x0c, 0xe7, 0x51, 0x27, 0x8a, 0xb4, 0xfc, 0x9b, 0xe1, 0xec, 0x48, 0x56,
-	0x05, 0xf3, 0x6f, 0x16, 0x44, 0x06, 0xe2, 0x39, 0x51, 0x86, 0xcf, 0x08, 0x34, 0x5f, 0x85, 0xf9,
-	0xf1, 0x47, 0x8c, 0x7d, 0xb7, 0xb7, 0x2f, 0xff, 0x88, 0xc1, 0xbf, 0x91, 0x01, 0xb9, 0x3e, 0x3d,
-	0x94, 0xe1, 0x87, 0x7d, 0x32, 0xdd, 0x54, 0xb3, 0x9c, 0x8c, 0x8b, 0x6b, 0x9b, 0x04, 0x7b, 0xfe,
-	0xcd, 0x2e, 0xad, 0xa8, 0x66, 0x96, 0xaa, 0xc5, 0xb0, 0xf9, 0x7d, 0xb8, 0xb1, 0x49, 0x7b, 0x4a,
-	0x13, 0x2f, 0x79, 0x23, 0x9d, 0xcd, 0x06, 0x9a, 0x1f, 0x6a, 0xb0, 0x36, 0x7d, 0x8a, 0xd9, 0x64,
-	0x23, 0xc7, 0x3d, 0x00, 0xf7, 0x99, 0x2d, 0x89, 0x7d, 0x10, 0x8c, 0x06, 0x6d, 0x12, 0x5a, 0xe8,
-	0xab, 0xd1, 0xd9, 0xce, 0xca, 0x2d, 0x22, 0xca, 0xd4, 0x19, 0xaf, 0x81, 0x61, 0xab, 0xf8, 0x0e,
-	0xf9, 0x40, 0xce, 0x33, 0x81, 0x37, 0x7f, 0xae, 0xc1, 0x25, 0xe5, 0x2f, 0x09, 0x24, 0x8c, 0x24,
-	0xa2, 0x8b, 0x50, 0x10, 0xef, 0x2f, 0x62, 0x13, 0x05, 0xc0, 0x3c, 0xe7, 0x09, 0xf5, 0xef, 0xb3,
-	0xcd, 0x95, 0xd7, 0x8f, 0x04, 0xd1, 0x12, 0x14, 0x9f, 0x50, 0x7f, 0x93, 0x1e, 0xca, 0x73, 0x2b,
-	0x21, 0x91, 0x7d, 0x0d, 0x38, 0x47, 0x5e, 0xb6, 0x89, 0x04, 0xc8, 0x38, 0x82, 0xd1, 0x80, 0x71,
-	0x88, 0xc4, 0x57, 0x42, 0x2c, 0x15, 0x5c, 0xcb, 0xd4, 0xa9, 0x6e, 0x1f, 0xcc, 0x6a, 0x17, 0x2e,
-	0x42, 0x41, 0xed, 0x31, 0x0b, 0x20, 0xf3, 0x7f, 0x17, 0xf2, 0xef, 0x59, 0xf9, 0xf8, 0xef, 0x59,
-	0xe6, 0xdf, 0x35, 0x30, 0x33, 0xf5, 0x13, 0xf7, 0xcf, 0x8c, 0x82, 0xc9, 0x19, 0x34, 0x44, 0x6f,
-	0x40, 0x39, 0xda, 0x69, 0x6e, 0xdb, 0xf1, 0x3f, 0xf7, 0x64, 0x6a, 0x8f, 0x63, 0x9e, 0xda, 0xf5,
-	0x28, 0x79, 0x42, 0x15, 0x28, 0x3c, 0xf4, 0xdd, 0x90, 0x18, 0xe7, 0x50, 0x19, 0xf2, 0x3b, 0x56,
-	0x10, 0x18, 0x5a, 0x6d, 0x5d, 0xe4, 0x46, 0xca, 0xdb, 0x31, 0x40, 0xb1, 0xe1, 0x13, 0x8b, 0xd3,
-	0x01, 0x14, 0x45, 0x53, 0xd5, 0xd0, 0x6a, 0x6d, 0x98, 0x57, 0x9f, 0x8c, 0x99, 0xb8, 0xed, 0x6e,
-	0xdd, 0x71, 0x8c, 0x73, 0x68, 0x1e, 0xca, 0xdb, 0xdd, 0x88, 0x90, 0x31, 0x6d, 0x77, 0xdb, 0xec,
-	0x5b, 0x47, 0x73, 0x50, 0xda, 0xee, 0xf2, 0x6c, 0xd4, 0xc8, 0x09, 0x80, 0xb7, 0x58, 0x8c, 0x7c,
-	0xed, 0x2e, 0xcc, 0xab, 0x2f, 0x14, 0x4c, 0x5c, 0x7d, 0xb3, 0xf5, 0xee, 0x86, 0x10, 0xd7, 0xc4,
-	0xf5, 0xd6, 0x56, 0x6b, 0xeb, 0x6d, 0x43, 0x63, 0x50, 0x67, 0x77, 0x7b, 0x67, 0x87, 0x41, 0x7a,
-	0xed, 0x35, 0x80, 0xe4, 0x32, 0x67, 0xeb, 0xd8, 0xda, 0xde, 0x62, 0x3c, 0x73, 0x50, 0x7a, 0x58,
-	0x6f, 0xed, 0x0a, 0x16, 0x06, 0x60, 0x01, 0xe8, 0x8c, 0xa6, 0xc9, 0x68, 0x72, 0xb5, 0x97, 0xc7,
-	0x52, 0x7c, 0x54, 0x82, 0x5c, 0xbd, 0xdf, 0x37, 0xce, 0xa1, 0x22, 0xe8, 0xcd, 0x7b, 0x42, 0xf5,
-	0x2d, 0xea, 0x0f, 0xac, 0xbe, 0xa1, 0xd7, 0xde, 0x86, 0x2b, 0x53, 0x53, 0x4b, 0xae, 0x6d, 0xb3,
-	0xdd, 0xda, 0x15, 0x33, 0xe3, 0x8d, 0xcd, 0x8d, 0x7a, 0x67, 0xc3, 0xd0, 0x10, 0x82, 0x05, 0x09,
-	0x74, 0x3b, 0x8d, 0xfb, 0x1b, 0xed, 0xba, 0xa1, 0xd7, 0x9e, 0xc1, 0x42, 0xfa, 0xbe, 0xe4, 0xfa,
-	0x51, 0xff, 0xc0, 0xf5, 0x7a, 0x82, 0xbf, 0x13, 0xf2, 0x74, 0x4b, 0x68, 0x2e, 0xec, 0xe8, 0x18,
-	0x3a, 0x32, 0x60, 0xbe, 0xe5, 0xb9, 0xa1, 0x6b, 0xf5, 0xdd, 0x67, 0x8c, 0x36, 0x87, 0xaa, 0x50,
-	0xd9, 0xf1, 0xc9, 0xd0, 0xf2, 0x19, 0x98, 0x47, 0x0b, 0x00, 0xdc, 0x9c, 0x98, 0x58, 0xce, 0x53,
-	0xa3, 0xc0, 0x18, 0x1e, 0x5a, 0x6e, 0xe8, 0x7a, 0x3d, 0x61, 0xe5, 0x62, 0xed, 0x5b, 0x50, 0x4d,
-	0xc5, 0x15, 0x74, 0x1e, 0xaa, 0xef, 0x6c, 0xb5, 0xb6, 0x5a, 0xbb, 0xad, 0xfa, 0x66, 0xeb, 0xbd,
-	0x8d, 0xa6, 0x30, 0x77, 0xbb, 0xd5, 0x69, 0xd7, 0x77, 0x1b, 0xf7, 0x0d, 0x8d, 0xad, 0x4c, 0x7c,
-	0xea, 0xf7, 0xde, 0xf8, 0xd3, 0x67, 0xab, 0xda, 0xc7, 0x9f, 0xad, 0x6a, 0x9f, 0x7e, 0xb6, 0xaa,
-	0xfd, 0xec, 0xf3, 0xd5, 0x73, 0x1f, 0x7f, 0xbe, 0x7a, 0xee, 0x93, 0xcf, 0x57, 0xcf, 0xbd, 0xf7,
-	0x42, 0xcf, 0x0d, 0xf7, 0x47, 0x7b, 0x37, 0x6d, 0x3a, 0xb8, 0x35, 0x74, 0xbd, 0x9e, 0x6d, 0x0d,
-	0x6f, 0x85, 0xae, 0xed, 0xd8, 0xb7, 0x14, 0xd7, 0xdc, 0x2b, 0xf2, 0x37, 0x94, 0x57, 0xfe, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0x50, 0xf3, 0xe4, 0x7c, 0x66, 0x2b, 0x00, 0x00,
+	// 3046 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x1a, 0x4d, 0x8f, 0x23, 0x57,
+	0x31, 0x6e, 0x7f, 0xcc, 0x4c, 0xcd, 0x78, 0xc6, 0xfb, 0x76, 0x77, 0x76, 0xf6, 0x7b, 0xd2, 0x09,
+	0x68, 0x33, 0x84, 0x5d, 0x76, 0x93, 0xe5, 0x23, 0x84, 0x04, 0xaf, 0x3d, 0xc9, 0x5a, 0x3b, 0x5f,
+	0xb4, 0x27, 0x59, 0x14, 0x0e, 0xa6, 0xa7, 0xfb, 0xad, 0xa7, 0x33, 0xb6, 0xdb, 0xe9, 0x6e, 0xef,
+	0x66, 0x23, 0x01, 0x8a, 0x10, 0x37, 0x0e, 0x70, 0xe3, 0x90, 0x5c, 0x38, 0x71, 0x42, 0xfc, 0x01,
+	0x04, 0x07, 0x0e, 0x9c, 0x50, 0xc4, 0x01, 0xe5, 0x04, 0x11, 0xdc, 0x11, 0x12, 0x12, 0x67, 0xea,
+	0x7d, 0x75, 0xbf, 0x6e, 0xb7, 0xe7, 0x83, 0xb1, 0x22, 0x0e, 0x96, 0xdf, 0xab, 0x57, 0x55, 0xaf,
+	0x5e, 0xbd, 0x7a, 0xf5, 0xaa, 0xea, 0x35, 0x5c, 0xde, 0xa7, 0x76, 0x10, 0xed, 0x51, 0x3b, 0x1a,
+	0xee, 0xdd, 0x8a, 0xdb, 0x37, 0x87, 0x81, 0x1f, 0xf9, 0x64, 0x5e, 0x1b, 0x34, 0x9f, 0xc2, 0xdc,
+	0xae, 0xbd, 0xd7, 0xa3, 0xed, 0xa1, 0x3d, 0x20, 0x2b, 0x30, 0xc3, 0x3b, 0xad, 0xe6, 0x4a, 0x61,
+	0xb5, 0x70, 0xa3, 0x68, 0xa9, 0x2e, 0xb9, 0x04, 0xb3, 0xed, 0x08, 0xa9, 0x1e, 0xd0, 0xa7, 0x2b,
+	0x06, 0x0e, 0x2d, 0x58, 0x71, 0x9f, 0x2c, 0x43, 0x65, 0x7d, 0xe0, 0xb2, 0x91, 0x22, 0x1f, 0x91,
+	0x3d, 0x72, 0x0d, 0x00, 0xff, 0xc2, 0xa1, 0xed, 0x30, 0x86, 0x25, 0x1c, 0xab, 0x5a, 0x1a, 0xc4,
+	0xfc, 0x8b, 0x01, 0xb5, 0xfb, 0x4c, 0x94, 0x7b, 0x28, 0x8a, 0x45, 0xdf, 0x1b, 0xd1, 0x30, 0x22,
+	0xdf, 0x82, 0x05, 0x67, 0xdf, 0x1e, 0x74, 0xe9, 0x23, 0x4a, 0x5d, 0x29, 0xc7, 0xfc, 0x9d, 0x8b,
+	0x37, 0x35, 0x99, 0x6f, 0x36, 0x34, 0x04, 0x2b, 0x85, 0x4e, 0x5e, 0x86, 0xb9, 0x27, 0x76, 0x44,
+	0x83, 0xbe, 0x1d, 0x1c, 0x70, 0x41, 0xe7, 0xef, 0x2c, 0xa7, 0x68, 0x1f, 0xaa, 0x51, 0x2b, 0x41,
+	0x24, 0xaf, 0x42, 0x35, 0xa0, 0xae, 0x1f, 0x8f, 0xf1, 0x85, 0x4c, 0xa6, 0x4c, 0x23, 0x93, 0xaf,
+	0xc3, 0x6c, 0x18, 0xd9, 0xd1, 0x28, 0xa4, 0x21, 0xae, 0xb2, 0x88, 0x84, 0x57, 0x52, 0x84, 0xb1,
+	0x7e, 0xdb, 0x1c, 0xcb, 0x8a, 0xb1, 0xc9, 0x0d, 0x58, 0x72, 0xfc, 0xfe, 0x90, 0xf6, 0x68, 0x44,
+	0xc5, 0xe0, 0x4a, 0x19, 0x67, 0x9e, 0xb5, 0xb2, 0x60, 0xf2, 0x25, 0x28, 0xd2, 0x20, 0x58, 0xa9,
+	0xe4, 0x68, 0xc3, 0x1a, 0x0d, 0x06, 0xde, 0xa0, 0xbb, 0x1e, 0x04, 0x7e, 0x60, 0x31, 0x2c, 0xf3,
+	0x27, 0x05, 0x98, 0x4b, 0xc4, 0x33, 0x99, 0x46, 0xa9, 0x73, 0x30, 0xf4, 0xbd, 0x41, 0xb4, 0x1b,
+	0x72, 0x8d, 0x96, 0xac, 0x14, 0x8c, 0x6d, 0x55, 0x40, 0x43, 0xbf, 0xf7, 0x98, 0xba, 0x88, 0x61,
+	0x70, 0x0c, 0x0d, 0x42, 0x6a, 0x50, 0x0c, 0xe9, 0x7b, 0x5c, 0x2d, 0x25, 0x8b, 0x35, 0x19, 0xd7,
+	0x9e, 0x1d, 0x46, 0xed, 0xa7, 0x03, 0x87, 0xd3, 0x94, 0x04, 0x57, 0x1d, 0x66, 0xfe, 0x00, 0x6a,
+	0x4d, 0x0f, 0x77, 0x3b, 0xc2, 0xb9, 0x82, 0xba, 0x13, 0x79, 0xfe, 0x00, 0x17, 0x52, 0xb1, 0x79,
+	0x8b, 0xcb, 0xb1, 0x78, 0xe7, 0x6c, 0x6a, 0x2d, 0x02, 0xc9, 0x92, 0x28, 0xcc, 0xea, 0x1a, 0x7e,
+	0xbf, 0xef, 0x45, 0xb1, 0x50, 0x71, 0x9f, 0xac, 0xc2, 0x7c, 0x2b, 0x64, 0x53, 0xed, 0xb0, 0x35,
+	0x70, 0xd1, 0x66, 0x2d, 0x1d, 0x64, 0x36, 0xa0, 0x58, 0x6f, 0x3c, 0x48, 0x31, 0x29, 0x1c, 0xce,
+	0xc4, 0x18, 0x67, 0x62, 0x01, 0x69, 0x75, 0x07, 0x3e, 0xee, 0xf8, 0xbd, 0x9e, 0xef, 0x1c, 0xc8,
+	0xed, 0x38, 0x1d, 0xcf, 0x1f, 0x1b, 0x70, 0xbe, 0x35, 0x78, 0xd4, 0x1b, 0x51, 0xa6, 0xa8, 0x44,
+	0x45, 0x21, 0xf9, 0x36, 0x54, 0xe3, 0x81, 0xdd, 0xa7, 0x43, 0x2a, 0x95, 0x74, 0x29, 0xa5, 0xa4,
+	0x14, 0x86, 0x95, 0x26, 0x20, 0xaf, 0x43, 0x35, 0x61, 0xd8, 0x6a, 0x32, 0xbd, 0x15, 0xc7, 0x4c,
+	0x46, 0xc7, 0xb0, 0xd2, 0xf8, 0xfc, 0xa4, 0x63, 0xbb, 0x6f, 0xe3, 0xe1, 0x2b, 0x72, 0x27, 0x10,
+	0xf7, 0xc9, 0x03, 0x38, 0x4b, 0xdf, 0x77, 0x7a, 0x23, 0x97, 0x6a, 0x34, 0x2e, 0xdf, 0xfb, 0x43,
+	0xa7, 0xc8, 0xa3, 0x32, 0x7f, 0x61, 0xe8, 0xe6, 0x21, 0x15, 0xfb, 0x5d, 0x38, 0xef, 0xe5, 0x69,
+	0x46, 0xfa, 0x01, 0x33, 0x5f, 0x11, 0x3a, 0xa6, 0x95, 0xcf, 0x80, 0xdc, 0x8d, 0x0d, 0x4f, 0xb8,
+	0x85, 0xab, 0x13, 0xc4, 0xcd, 0x98, 0xa0, 0x09, 0x45, 0xdb, 0x51, 0x0e, 0xa1, 0x96, 0x36, 0xd6,
+	0xc6, 0x03, 0x8b, 0x0d, 0x92, 0x6d, 0x20, 0xde, 0x98, 0x8d, 0x48, 0xad, 0x5c, 0x4f, 0x4b, 0x3c,
+	0x86, 0x66, 0xe5, 0x90, 0x9a, 0x9f, 0x15, 0xe0, 0x8c, 0xe6, 0x19, 0xc3, 0xa1, 0x3f, 0x08, 0xe9,
+	0x69, 0x5d, 0xe3, 0x26, 0x10, 0x37, 0xa3, 0x6e, 0xaa, 0xcc, 0x63, 0x92, 0x32, 0x94, 0x8c, 0xe3,
+	0x84, 0x84, 0x40, 0xa9, 0xef, 0xbb, 0x54, 0xda, 0x08, 0x6f, 0x93, 0x17, 0xa0, 0xd6, 0xb7, 0xd1,
+	0xc2, 0xf1, 0x47, 0x83, 0x0e, 0x1d, 0xfa, 0xce, 0xbe, 0x74, 0x0c, 0x4b, 0x09, 0x7c, 0x9d, 0x81,
+	0xcd, 0xf7, 0xe1, 0x6c, 0x43, 0xf3, 0x40, 0x9b, 0x34, 0x0c, 0xed, 0xee, 0xa9, 0xd7, 0x98, 0xf5,
+	0x75, 0xc6, 0xb8, 0xaf, 0x33, 0x7f, 0x57, 0x80, 0x25, 0x0b, 0x1d, 0xf8, 0x26, 0x8d, 0xec, 0x29,
+	0x4d, 0x7b, 0x94, 0xfb, 0xcc, 0x8a, 0x55, 0xcc, 0x71, 0xc1, 0x27, 0xd0, 0xdd, 0x0f, 0xe1, 0x2a,
+	0x5b, 0x80, 0x15, 0x4f, 0xb0, 0x13, 0xf8, 0x5d, 0x9c, 0x2e, 0xfc, 0x7c, 0x96, 0x63, 0xfe, 0xaa,
+	0x00, 0x57, 0xd2, 0x02, 0xbc, 0xe1, 0x07, 0x4f, 0xec, 0xc0, 0xfd, 0x9c, 0xd4, 0x99, 0xa7, 0xaa,
+	0x62, 0xbe, 0xaa, 0xfe, 0x5d, 0xd0, 0x9d, 0x4c, 0xc3, 0x1f, 0x3c, 0xf2, 0xba, 0x64, 0x0d, 0x4a,
+	0x08, 0x19, 0x48, 0xb1, 0x96, 0xf3, 0x2f, 0x6b, 0x8b, 0xe3, 0xb0, 0x90, 0x28, 0x64, 0x81, 0x4e,
+	0x2c, 0x88, 0xea, 0xb2, 0x45, 0xba, 0x9a, 0x93, 0x93, 0x2e, 0xe2, 0x10, 0x2f, 0x98, 0x42, 0x67,
+	0x7e, 0x36, 0x54, 0x7e, 0xb6, 0x24, 0xfc, 0xac, 0xea, 0xc7, 0x67, 0xab, 0xac, 0x9d, 0xad, 0x35,
+	0xa8, 0x85, 0x07, 0xde, 0xb0, 0xb9, 0xb9, 0x51, 0x0f, 0xdb, 0x52, 0xa2, 0x0a, 0xbf, 0x5b, 0xc6,
+	0xe0, 0xe6, 0xef, 0x0d, 0xb8, 0xc8, 0x9c, 0xb6, 0x3b, 0xea, 0x69, 0x3e, 0x77, 0x4a, 0x21, 0x16,
+	0x3a, 0x52, 0x87, 0xeb, 0xf1, 0x08, 0x47, 0x2a, 0x94, 0x6d, 0x49, 0x64, 0xd2, 0x80, 0xc5, 0x50,
+	0x8a, 0x24, 0x5c, 0x2c, 0x57, 0xd8, 0xe2, 0x9d, 0xcb, 0x29, 0xf2, 0x76, 0x0a, 0xc5, 0xca, 0x90,
+	0x30, 0xd1, 0xfd, 0x21, 0x0d, 0xec, 0xc8, 0x0f, 0xf8, 0xf5, 0x58, 0xe2, 0x2c, 0xd2, 0xa2, 0x6f,
+	0x6b, 0x08, 0x56, 0x0a, 0x3d, 0xd7, 0x70, 0xca, 0xf9, 0x86, 0xf3, 0x4b, 0x03, 0x96, 0x37, 0x69,
+	0xd0, 0x9d, 0xbe, 0xfe, 0xf0, 0x86, 0x76, 0x4f, 0x78, 0x43, 0xa7, 0xf0, 0x49, 0x0b, 0x48, 0x9f,
+	0x49, 0xe6, 0x36, 0x4f, 0x64, 0x7e, 0x39, 0x44, 0xb1, 0xa1, 0x95, 0x8e, 0x70, 0xe2, 0x13, 0x94,
+	0xb4, 0x03, 0x67, 0x37, 0x63, 0xd0, 0x7d, 0x35, 0x31, 0xf9, 0x86, 0x16, 0x10, 0x17, 0x72, 0xee,
+	0x97, 0x84, 0x26, 0x1b, 0x11, 0x9b, 0x9f, 0x16, 0x30, 0x7e, 0x09, 0x70, 0x4c, 0xb9, 0x34, 0xf2,
+	0x3c, 0x2c, 0xa2, 0x55, 0x77, 0x69, 0xd4, 0x19, 0xa0, 0x74, 0x1d, 0xcf, 0xe5, 0xfa, 0x9e, 0xb3,
+	0x16, 0x04, 0x74, 0x0b, 0x81, 0x2d, 0x97, 0x3c, 0x0b, 0xb2, 0x2f, 0x05, 0x16, 0x67, 0x75, 0x5e,
+	0xc0, 0xb8, 0xb0, 0xe4, 0xab, 0x70, 0x41, 0xa2, 0x24, 0xea, 0xec, 0x38, 0xfe, 0x48, 0x06, 0x8f,
+	0x55, 0xeb, 0xbc, 0x18, 0xd6, 0x2d, 0x18, 0x07, 0xc9, 0x1b, 0xb0, 0x2a, 0xe9, 0x58, 0x60, 0xe1,
+	0x75, 0xf7, 0x91, 0x01, 0x93, 0xb0, 0xd3, 0xf7, 0x1f, 0x53, 0xc9, 0x40, 0x24, 0x37, 0x57, 0x04,
+	0x5e, 0x4b, 0xa2, 0xf1, 0x75, 0x6c, 0x22, 0x12, 0xe7, 0x63, 0xfe, 0xba, 0x08, 0xb5, 0xec, 0xca,
+	0x4f, 0x6b, 0x4b, 0x57, 0x01, 0x58, 0xab, 0xc3, 0xf4, 0x47, 0xf9, 0xa2, 0xe7, 0xac, 0x39, 0x06,
+	0x61, 0xec, 0x29, 0xb9, 0x0d, 0x65, 0x31, 0x92, 0x77, 0xd4, 0x30, 0x60, 0xc5, 0xb8, 0x82, 0x0e,
+	0x22, 0x8e, 0x6b, 0x09, 0x4c, 0xf2, 0x1c, 0x54, 0x93, 0x6b, 0xa9, 0x13, 0xc5, 0x81, 0x7d, 0xea,
+	0xae, 0x92, 0xd9, 0x48, 0x39, 0xc7, 0x70, 0xc7, 0xb2, 0x11, 0xf2, 0x05, 0x58, 0xdc, 0xf3, 0xfd,
+	0x28, 0x8c, 0x02, 0x7b, 0xd8, 0x71, 0x71, 0x46, 0xe9, 0xb6, 0xaa, 0x31, 0xb4, 0x89, 0xc0, 0xb1,
+	0x84, 0x62, 0x66, 0x3c, 0xa1, 0x20, 0x75, 0x58, 0x14, 0xaa, 0x1f, 0x4a, 0xeb, 0x58, 0x99, 0xe5,
+	0xfa, 0x4a, 0xc7, 0xc7, 0x29, 0xfb, 0xc1, 0xc3, 0x93, 0x32, 0xa7, 0x3c, 0xeb, 0x9e, 0xcb, 0xb7,
+	0xee, 0x7f, 0xa2, 0x2d, 0x32, 0xf3, 0x4a, 0x0c, 0xfb, 0x2e, 0xcc, 0xf6, 0xbc, 0xc7, 0x74, 0xc0,
+	0x66, 0x2e, 0xe4, 0xb8, 0x1e, 0x86, 0xbd, 0x21, 0x11, 0xac, 0x18, 0x95, 0xed, 0x12, 0xb7, 0x5d,
+	0xdd, 0x34, 0xe7, 0x18, 0x44, 0x18, 0x66, 0x13, 0xae, 0x6b, 0x16, 0x29, 0x16, 0x98, 0x31, 0xf9,
+	0x22, 0xdf, 0xd9, 0xcb, 0x09, 0x1a, 0x5f, 0xe3, 0xae, 0x7e, 0x02, 0xea, 0x70, 0x75, 0x12, 0x17,
+	0x3d, 0x98, 0xb8, 0x94, 0xcb, 0x43, 0x2c, 0xf8, 0x5d, 0x58, 0x6e, 0x0b, 0x7e, 0xf1, 0x22, 0xa4,
+	0xcb, 0xbb, 0x0d, 0x15, 0xc1, 0xeb, 0xe8, 0x65, 0x4b, 0xc4, 0x23, 0x16, 0x6d, 0xf6, 0xe1, 0xc2,
+	0xd8, 0x5c, 0x32, 0xce, 0x7d, 0x09, 0x66, 0xec, 0xe1, 0xb0, 0xe7, 0x51, 0xf7, 0xe8, 0xd9, 0x14,
+	0xe6, 0x51, 0xd3, 0xbd, 0x0b, 0xd7, 0xdb, 0xfa, 0xd1, 0xd6, 0xd6, 0xae, 0xd6, 0x38, 0x2d, 0x47,
+	0x63, 0x7e, 0x0d, 0x2e, 0x37, 0x7c, 0x3f, 0x70, 0xbd, 0x01, 0xbb, 0x78, 0xee, 0x29, 0x2b, 0x57,
+	0xf3, 0x60, 0x44, 0xf1, 0x18, 0x13, 0x12, 0x95, 0x02, 0x17, 0x2d, 0xd5, 0x65, 0x19, 0xd1, 0x95,
+	0x7c, 0x4a, 0xa9, 0x99, 0xff, 0xdd, 0xb1, 0x92, 0x97, 0x61, 0x39, 0x3e, 0x3a, 0x91, 0xef, 0xf8,
+	0xbd, 0x8e, 0x12, 0xc2, 0xe0, 0xbe, 0xeb, 0x9c, 0x3a, 0x26, 0x7c, 0xf0, 0x6d, 0x31, 0xf6, 0xff,
+	0x63, 0x9a, 0xff, 0x29, 0xc0, 0xb9, 0xba, 0xeb, 0x26, 0x0b, 0x54, 0xda, 0x7c, 0x01, 0x0c, 0xb9,
+	0x53, 0x87, 0xba, 0x4d, 0x44, 0x62, 0x75, 0x2a, 0x2d, 0x70, 0x59, 0x88, 0x23, 0x93, 0x31, 0x97,
+	0x97, 0x17, 0x9e, 0xaf, 0xc1, 0x19, 0x2f, 0xec, 0x0c, 0xe8, 0x93, 0x4e, 0xe2, 0x80, 0xb9, 0xdc,
+	0xb3, 0xd6, 0x92, 0x17, 0x6e, 0xd1, 0x27, 0xc9, 0x74, 0xe4, 0x3a, 0xcc, 0x1f, 0xc8, 0x32, 0x17,
+	0xd3, 0x50, 0x59, 0x54, 0xbe, 0x14, 0x08, 0x15, 0x92, 0xe7, 0x84, 0x2a, 0xf9, 0x4e, 0xe8, 0x0f,
+	0x05, 0xb8, 0x60, 0x51, 0x76, 0xd5, 0x9c, 0x6a, 0xed, 0x68, 0x74, 0x8e, 0x1d, 0x3a, 0xb6, 0x4b,
+	0x65, 0x41, 0x42, 0x75, 0xd9, 0x48, 0xc0, 0xf9, 0xbb, 0xb2, 0x86, 0xa2, 0xba, 0xd9, 0x65, 0x94,
+	0x8e, 0xb5, 0x8c, 0x09, 0x91, 0xc2, 0x9f, 0x8a, 0x70, 0x29, 0x59, 0xc0, 0xd8, 0x99, 0x38, 0xe5,
+	0x35, 0x38, 0x69, 0x67, 0x2f, 0xf2, 0xf3, 0x12, 0x68, 0x9b, 0x1a, 0x47, 0xef, 0x0e, 0x3c, 0x1b,
+	0xb1, 0x50, 0xbf, 0x13, 0x05, 0x5e, 0xb7, 0xcb, 0xc4, 0x47, 0x8f, 0x92, 0x0a, 0x0d, 0xbc, 0x63,
+	0x14, 0x36, 0xae, 0x72, 0x1e, 0xbb, 0x82, 0xc5, 0x3a, 0xe3, 0xa0, 0x97, 0x38, 0xf2, 0x8d, 0xa6,
+	0x9c, 0x6f, 0x34, 0x36, 0x0b, 0x33, 0x74, 0x81, 0x58, 0x91, 0x31, 0x23, 0x4f, 0xe5, 0x28, 0x79,
+	0xae, 0xe8, 0xf2, 0xb0, 0x14, 0x2d, 0x25, 0x4e, 0x66, 0x43, 0x67, 0x8e, 0xb5, 0xa1, 0xb3, 0xf9,
+	0x1b, 0xfa, 0xe7, 0x22, 0x5c, 0xce, 0xdd, 0xd0, 0xe9, 0x14, 0x2b, 0xee, 0x62, 0xe4, 0x82, 0xe9,
+	0x97, 0x0a, 0x8e, 0xd3, 0x55, 0x94, 0x78, 0xb6, 0x24, 0x59, 0x13, 0xd8, 0x2a, 0x30, 0x29, 0x1e,
+	0xa7, 0x4c, 0x7a, 0xbc, 0x50, 0xe7, 0x45, 0x20, 0x7c, 0x23, 0xd2, 0x98, 0xc2, 0xca, 0x6b, 0x6c,
+	0x44, 0xaf, 0x62, 0xa0, 0xbf, 0x9c, 0x53, 0x09, 0x07, 0xcb, 0xce, 0x98, 0xe8, 0x5f, 0xcc, 0xcd,
+	0x6f, 0xc6, 0xb2, 0x0a, 0x2b, 0x21, 0xcc, 0xdd, 0x86, 0x99, 0xdc, 0x6d, 0x20, 0x1b, 0xb0, 0xc4,
+	0xc3, 0xfa, 0x4e, 0x32, 0xed, 0x2c, 0x9f, 0xf6, 0xb9, 0xf4, 0xc5, 0x90, 0x9b, 0xc9, 0x58, 0x8b,
+	0x9c, 0x56, 0x25, 0x4c, 0xa1, 0xf9, 0x57, 0x03, 0xae, 0x25, 0x9b, 0xba, 0xe3, 0x87, 0xd1, 0xb4,
+	0x4f, 0xea, 0xb1, 0x8e, 0x9d, 0x71, 0xca, 0x63, 0x77, 0x1b, 0x73, 0x76, 0x9e, 0x4a, 0xb3, 0x53,
+	0xcf, 0x94, 0x71, 0x61, 0x6c, 0x0f, 0x30, 0xcd, 0x1e, 0x3c, 0xf2, 0x2d, 0x85, 0x47, 0x5e, 0x81,
+	0x05, 0xbe, 0xcd, 0x8a, 0xae, 0x74, 0x38, 0xdd, 0x3c, 0x43, 0x6e, 0x4b, 0xda, 0x13, 0xb8, 0xc1,
+	0x8f, 0x0d, 0xb8, 0x3e, 0x51, 0xc1, 0xd3, 0x39, 0x39, 0x9f, 0x8b, 0x86, 0x4f, 0x74, 0xce, 0x4e,
+	0x54, 0x15, 0x84, 0x44, 0xcb, 0xa9, 0x52, 0x74, 0x21, 0x53, 0x8a, 0xbe, 0xa6, 0x30, 0xb7, 0xec,
+	0xbe, 0xca, 0x7c, 0x34, 0x08, 0xb9, 0x09, 0x15, 0xee, 0x1d, 0x94, 0x09, 0xe4, 0x54, 0x79, 0xf8,
+	0x4e, 0x4a, 0x2c, 0xb3, 0x21, 0xdf, 0xc1, 0xf8, 0xc4, 0x93, 0xdf, 0xc1, 0xae, 0x48, 0x34, 0x6d,
+	0xd6, 0x04, 0x60, 0xfe, 0xd6, 0x00, 0x32, 0xee, 0x9c, 0xd8, 0x3d, 0x3d, 0x61, 0x1f, 0x53, 0x3a,
+	0x37, 0xe4, 0x3b, 0x9b, 0x5a, 0xb2, 0x91, 0x59, 0xb2, 0x2a, 0x5b, 0x15, 0x8f, 0x51, 0xb6, 0x7a,
+	0x03, 0x6a, 0x8e, 0xca, 0xef, 0x3a, 0x61, 0x52, 0x90, 0x3e, 0x22, 0x09, 0x5c, 0x72, 0xf4, 0x3e,
+	0xe6, 0xa7, 0x63, 0x3e, 0xb2, 0x9c, 0xe3, 0x23, 0x5f, 0x82, 0xf9, 0x3d, 0x56, 0xbd, 0x96, 0x69,
+	0xa8, 0xb8, 0xa5, 0x48, 0xfa, 0xec, 0x70, 0xf6, 0xb0, 0xa7, 0x8a, 0xdc, 0x34, 0x2e, 0x3d, 0xcc,
+	0x24, 0xa5, 0x07, 0xf3, 0xa3, 0x02, 0x2c, 0x27, 0xc7, 0xa3, 0xd1, 0xf3, 0x43, 0x3a, 0x25, 0xbf,
+	0xa3, 0x45, 0x39, 0x46, 0x3a, 0xca, 0x39, 0x41, 0x31, 0xf1, 0x63, 0x8c, 0xc5, 0xc6, 0xc4, 0x9b,
+	0xce, 0xa9, 0x65, 0x65, 0xc6, 0x91, 0xe3, 0xb0, 0xc4, 0x52, 0xca, 0x27, 0xbb, 0x27, 0x91, 0xef,
+	0xa7, 0x05, 0xa8, 0x25, 0x6f, 0x22, 0xc2, 0xb0, 0xa7, 0xf0, 0xa4, 0x84, 0x36, 0x29, 0xcd, 0x5f,
+	0x5c, 0xc7, 0x68, 0x93, 0xaa, 0x7f, 0xd8, 0x6b, 0x91, 0xf9, 0x3d, 0x28, 0x73, 0xbc, 0x23, 0x9e,
+	0x95, 0x27, 0x99, 0x3b, 0x1e, 0xb5, 0x36, 0xe6, 0x76, 0xdc, 0x11, 0xc9, 0xd0, 0x34, 0x01, 0x98,
+	0x1f, 0x1a, 0x70, 0xd6, 0xf2, 0x47, 0x11, 0xe5, 0xac, 0xea, 0x6e, 0xdf, 0x0b, 0x79, 0xc6, 0xb2,
+	0x06, 0xb5, 0xb6, 0x3f, 0x0a, 0x1c, 0xaa, 0x79, 0x07, 0x91, 0xc7, 0x8d, 0xc1, 0xd9, 0xf3, 0xab,
+	0x80, 0x65, 0x8f, 0x74, 0x16, 0xcc, 0xb8, 0x8a, 0x6c, 0x44, 0xe3, 0x2a, 0x12, 0x9f, 0x31, 0x38,
+	0xe3, 0x2a, 0x60, 0x09, 0xd7, 0x92, 0xe0, 0x9a, 0x01, 0x93, 0xd7, 0xa0, 0x22, 0x4b, 0xa1, 0x65,
+	0xbe, 0x27, 0xe9, 0x50, 0x21, 0x67, 0x75, 0xea, 0x6d, 0x4a, 0xfc, 0x9b, 0x03, 0x58, 0x54, 0xda,
+	0x12, 0xa6, 0x75, 0x88, 0xa6, 0x57, 0x61, 0x7e, 0xbb, 0xe7, 0x66, 0x94, 0xad, 0x83, 0x18, 0x06,
+	0x46, 0xa4, 0x99, 0xdd, 0xd4, 0x41, 0xe6, 0x87, 0x65, 0x28, 0x8b, 0xc3, 0x8b, 0x7b, 0xd3, 0x0a,
+	0xf9, 0x8b, 0x95, 0x4c, 0xd2, 0x71, 0x6f, 0x62, 0x00, 0x93, 0x82, 0x37, 0x93, 0x9a, 0xb9, 0xec,
+	0x92, 0xd7, 0x61, 0x5e, 0x34, 0x95, 0x6b, 0x1e, 0x2f, 0x20, 0x67, 0x0d, 0xd8, 0xd2, 0x29, 0xc8,
+	0x03, 0x38, 0xb3, 0x85, 0x27, 0xa6, 0x19, 0xf8, 0xc3, 0xa1, 0xc2, 0x90, 0x61, 0xfa, 0x11, 0x6c,
+	0xc6, 0xe9, 0xc8, 0xab, 0xb0, 0xc4, 0x80, 0x98, 0x57, 0xc6, 0xac, 0x44, 0x49, 0x8b, 0x8c, 0xfb,
+	0x56, 0x2b, 0x8b, 0xca, 0x0a, 0xda, 0x6f, 0x0d, 0x5d, 0xd4, 0x86, 0x54, 0xa1, 0x0a, 0xf8, 0x2e,
+	0xe7, 0x05, 0x0d, 0x72, 0x83, 0xac, 0x0c, 0x49, 0xf6, 0xb1, 0x78, 0x66, 0xec, 0xb1, 0x98, 0x7c,
+	0x99, 0xd7, 0xf0, 0xba, 0x94, 0x07, 0xe2, 0x8b, 0x99, 0x90, 0x44, 0x3d, 0x1a, 0x76, 0x45, 0xfd,
+	0x0e, 0x2d, 0x60, 0x17, 0xce, 0xe5, 0x18, 0x4e, 0xb8, 0x32, 0xc7, 0x65, 0x5b, 0x3d, 0xca, 0xc2,
+	0xac, 0x5c, 0x6a, 0x56, 0x9c, 0xe3, 0xcb, 0x1f, 0x0e, 0x03, 0xff, 0xb1, 0xdd, 0x5b, 0x01, 0x2e,
+	0x67, 0x0a, 0xc6, 0xce, 0x72, 0xb3, 0xb9, 0xf1, 0x9d, 0x11, 0x0d, 0x9e, 0xae, 0xcc, 0x73, 0x83,
+	0x8f, 0xfb, 0xe4, 0x79, 0xa8, 0x62, 0x5b, 0x3b, 0x3c, 0x0b, 0x1c, 0x21, 0x0d, 0x64, 0xb3, 0x20,
+	0x20, 0x39, 0x36, 0x55, 0x51, 0x7f, 0xd1, 0x61, 0xe6, 0x8f, 0xe0, 0x5c, 0x7c, 0xd7, 0xe9, 0x2f,
+	0xf2, 0x27, 0xb8, 0x63, 0x6f, 0xa8, 0xaa, 0xa8, 0x31, 0xf1, 0xa2, 0x92, 0xc5, 0xd0, 0x9c, 0x37,
+	0x4e, 0xf3, 0x5f, 0x05, 0x76, 0xbe, 0x53, 0x5f, 0x74, 0x9c, 0x64, 0xf2, 0xbc, 0x8b, 0xd9, 0x98,
+	0xc6, 0xc5, 0x9c, 0x57, 0xb4, 0xb8, 0x0d, 0xe7, 0x45, 0xf4, 0x17, 0x7a, 0x1f, 0xd0, 0x0e, 0x86,
+	0xf9, 0x9d, 0x90, 0x62, 0x6a, 0x2c, 0x12, 0x5b, 0xc3, 0x22, 0x7c, 0xb0, 0x8d, 0x63, 0x3b, 0x34,
+	0x68, 0xf3, 0x91, 0xbc, 0xa7, 0x27, 0xf3, 0x37, 0x05, 0x0c, 0x6b, 0xb4, 0x27, 0xeb, 0xe9, 0x5c,
+	0xc9, 0x6f, 0x42, 0x75, 0x2f, 0x61, 0x1a, 0x3f, 0x45, 0x3f, 0x9b, 0x1f, 0xd7, 0xe8, 0xf3, 0xa7,
+	0xe9, 0x72, 0x77, 0xc9, 0x85, 0x05, 0x3d, 0x10, 0x65, 0x38, 0x91, 0x17, 0x5f, 0x05, 0xbc, 0xcd,
+	0x60, 0xac, 0x88, 0x25, 0x7d, 0x3e, 0x6f, 0x33, 0x98, 0xa3, 0x78, 0x21, 0x8c, 0xb5, 0x99, 0x3b,
+	0xeb, 0x8b, 0x87, 0x4d, 0xe9, 0xc8, 0x55, 0xd7, 0x7c, 0x19, 0x0d, 0x36, 0xf3, 0x9c, 0xb2, 0xef,
+	0x75, 0xf7, 0xe5, 0x27, 0x21, 0xbc, 0xcd, 0x3e, 0x9d, 0xe9, 0xf9, 0x4f, 0xa4, 0x23, 0x64, 0x4d,
+	0x26, 0x9b, 0xae, 0x96, 0xe3, 0x51, 0x71, 0x69, 0x93, 0x6b, 0x87, 0xb7, 0xd9, 0x91, 0x53, 0xd9,
+	0xbb, 0x14, 0x2d, 0xee, 0x9b, 0xdf, 0x87, 0xeb, 0x1b, 0x7e, 0x57, 0x2b, 0x27, 0x26, 0xaf, 0xb5,
+	0xd3, 0xd9, 0x40, 0xf3, 0xc3, 0x02, 0xac, 0x4e, 0x9e, 0x62, 0x3a, 0x71, 0xd1, 0x51, 0x4f, 0xd1,
+	0x3d, 0xa6, 0x4b, 0xb4, 0xf8, 0x70, 0xd4, 0x67, 0xef, 0xf9, 0xe4, 0x2b, 0xea, 0x6c, 0xe7, 0x45,
+	0x39, 0x0a, 0x33, 0x75, 0xc6, 0xf1, 0x6a, 0x77, 0x74, 0x78, 0x9b, 0xbe, 0x27, 0xe7, 0x19, 0x83,
+	0x9b, 0x3f, 0x2f, 0xc0, 0x79, 0xed, 0xe3, 0x08, 0x1a, 0x29, 0x8e, 0xe4, 0x1c, 0x94, 0xc5, 0x4b,
+	0x90, 0xd8, 0x44, 0xd1, 0x61, 0x96, 0xf3, 0xbe, 0x1f, 0xdc, 0x67, 0x9b, 0x2b, 0x2f, 0x42, 0xd9,
+	0x65, 0x15, 0x2b, 0x6c, 0x6e, 0xe0, 0x16, 0x8b, 0x73, 0x2b, 0x7b, 0x22, 0x0e, 0xec, 0x73, 0x8a,
+	0x92, 0x2c, 0x58, 0x89, 0x2e, 0xa3, 0xc0, 0x26, 0xa3, 0x10, 0x21, 0xb8, 0xec, 0xb1, 0xa0, 0x74,
+	0x35, 0x57, 0xa6, 0xba, 0x73, 0x30, 0xad, 0x5d, 0xc0, 0xd5, 0xe9, 0xd5, 0x6e, 0xd1, 0xc9, 0xfd,
+	0x02, 0x44, 0x7e, 0x28, 0x56, 0x8a, 0x3f, 0x14, 0x33, 0xff, 0x56, 0x00, 0x33, 0x57, 0x3e, 0x71,
+	0x13, 0x4e, 0xc9, 0x99, 0x9c, 0x42, 0x42, 0x0c, 0xc3, 0x66, 0xd5, 0x4e, 0x73, 0xdd, 0x66, 0x3f,
+	0x33, 0xca, 0x95, 0xde, 0x8a, 0x69, 0xd6, 0xae, 0xaa, 0x30, 0x8e, 0xcc, 0x41, 0xf9, 0x61, 0xe0,
+	0x45, 0xb4, 0xf6, 0x0c, 0x99, 0x85, 0xd2, 0x8e, 0x1d, 0x86, 0xb5, 0xc2, 0xda, 0x0d, 0x11, 0xa5,
+	0x69, 0xaf, 0xd8, 0x00, 0x95, 0x46, 0x80, 0xbc, 0x19, 0x1e, 0xb6, 0x45, 0x79, 0x17, 0x31, 0x37,
+	0x61, 0x41, 0x7f, 0xbc, 0x66, 0xec, 0xb6, 0x3b, 0x18, 0x72, 0x20, 0xda, 0x02, 0xcc, 0x6e, 0x77,
+	0x14, 0x22, 0x23, 0xda, 0xee, 0xb0, 0x97, 0xc5, 0x9a, 0x41, 0xe6, 0x61, 0x66, 0xbb, 0xc3, 0xe3,
+	0xe2, 0x5a, 0x51, 0x74, 0x78, 0xb1, 0xa7, 0x56, 0x5a, 0xbb, 0x8b, 0x97, 0xb6, 0xf6, 0x56, 0xc2,
+	0xd8, 0xd5, 0x37, 0x5a, 0x6f, 0xaf, 0x0b, 0x76, 0x4d, 0xab, 0xde, 0xda, 0x6a, 0x6d, 0xbd, 0x89,
+	0xec, 0xb0, 0xd7, 0xde, 0xdd, 0xde, 0xd9, 0x61, 0x3d, 0x63, 0xed, 0x15, 0x80, 0x24, 0xac, 0x60,
+	0xeb, 0xd8, 0xda, 0xde, 0x62, 0x34, 0xc8, 0xfb, 0x61, 0xbd, 0xb5, 0x2b, 0x48, 0x58, 0xc7, 0x12,
+	0x1d, 0x83, 0xe1, 0x34, 0x19, 0x4e, 0x71, 0xed, 0xc5, 0x4c, 0xb2, 0x41, 0x66, 0xa0, 0x58, 0xef,
+	0xf5, 0x90, 0xba, 0x02, 0x46, 0xf3, 0x9e, 0x10, 0x7d, 0xcb, 0x0f, 0xfa, 0x76, 0x0f, 0x67, 0x7a,
+	0x13, 0x2e, 0x4e, 0x0c, 0x72, 0xb9, 0xb4, 0xcd, 0xcd, 0xd6, 0xae, 0x98, 0xd9, 0x5a, 0xdf, 0x58,
+	0xaf, 0xb7, 0xd7, 0x91, 0x01, 0x81, 0x45, 0xd9, 0xe9, 0xb4, 0x1b, 0xf7, 0xd7, 0x37, 0xeb, 0xc8,
+	0xe8, 0x03, 0x58, 0x4c, 0xdf, 0x97, 0x5c, 0x3e, 0x3f, 0x38, 0x40, 0xff, 0x2f, 0xe8, 0xdb, 0x11,
+	0x0f, 0xfc, 0x84, 0xe4, 0x42, 0x8f, 0x2e, 0x4a, 0x5e, 0x83, 0x85, 0xd6, 0xc0, 0x8b, 0x3c, 0xbb,
+	0xe7, 0x7d, 0xc0, 0x70, 0x8b, 0xa4, 0x0a, 0x73, 0x3b, 0x01, 0x1d, 0xda, 0x01, 0xeb, 0x96, 0xc8,
+	0x22, 0x00, 0x57, 0xa7, 0x45, 0x6d, 0xf7, 0x69, 0xad, 0xcc, 0x08, 0x1e, 0xda, 0x48, 0x30, 0xe8,
+	0x0a, 0x2d, 0x57, 0xd6, 0xbe, 0x09, 0xd5, 0x94, 0x5f, 0x21, 0x67, 0xa0, 0xfa, 0x16, 0x2a, 0x16,
+	0x95, 0x83, 0xda, 0x7e, 0x67, 0xbd, 0x29, 0xd4, 0xbd, 0xd9, 0x6a, 0x6f, 0xd6, 0x77, 0x1b, 0xf7,
+	0x51, 0x02, 0x5c, 0x99, 0x68, 0x1a, 0xf7, 0x5e, 0xfb, 0xe3, 0xdf, 0xaf, 0x15, 0x3e, 0xc1, 0xdf,
+	0x67, 0xf8, 0xfb, 0xd9, 0x3f, 0xae, 0x3d, 0xf3, 0x09, 0xfe, 0x3e, 0xc5, 0xdf, 0x3b, 0xcf, 0x77,
+	0xbd, 0x68, 0x7f, 0xb4, 0x77, 0x13, 0xef, 0xfd, 0x5b, 0x43, 0x9c, 0xce, 0xb1, 0x87, 0xb7, 0x22,
+	0xcf, 0x71, 0x9d, 0x5b, 0x9a, 0x69, 0xee, 0x55, 0xf8, 0x6b, 0xce, 0x4b, 0xff, 0x05, 0xd1, 0x70,
+	0xfd, 0x9f, 0xf0, 0x2b, 0x00, 0x00,
 }
 
 func (m *TableSpan) Marshal() (dAtA []byte, err error) {
@@ -6422,6 +6459,37 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DDLTableName) > 0 {
+		i -= len(m.DDLTableName)
+		copy(dAtA[i:], m.DDLTableName)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.DDLTableName)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DDLSchemaName) > 0 {
+		i -= len(m.DDLSchemaName)
+		copy(dAtA[i:], m.DDLSchemaName)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.DDLSchemaName)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DDLQuery) > 0 {
+		i -= len(m.DDLQuery)
+		copy(dAtA[i:], m.DDLQuery)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.DDLQuery)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.NeedApproval {
+		i--
+		if m.NeedApproval {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.RouteTableAdmissions) > 0 {
 		for iNdEx := len(m.RouteTableAdmissions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8065,6 +8133,21 @@ func (m *State) Size() (n int) {
 			n += 1 + l + sovHeartbeat(uint64(l))
 		}
 	}
+	if m.NeedApproval {
+		n += 2
+	}
+	l = len(m.DDLQuery)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.DDLSchemaName)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	l = len(m.DDLTableName)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
 	return n
 }
 
@@ -14715,6 +14798,122 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NeedApproval", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NeedApproval = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DDLQuery", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DDLQuery = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DDLSchemaName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DDLSchemaName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DDLTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DDLTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHeartbeat(dAtA[iNdEx:])
@@ -52,6 +52,13 @@ type Barrier struct {
 	// routeAdmin gates route-affecting DDLs before Barrier emits WRITE/PASS
 	// actions. A nil value keeps Barrier on the normal non-route path.
 	routeAdmin *routing.Admin
+
+	// ddlApprovals holds the DDLs matched by a pause ddl policy until an
+	// operator approves or skips them. A nil value never holds the DDLs.
+	ddlApprovals *ddlApprovals
+	// syncPointNotifier is called after a syncpoint is written by the
+	// dispatchers. A nil value ignores the syncpoints.
+	syncPointNotifier func()
 }
 
 // NewBarrier create a new barrier for the changefeed
@@ -428,6 +435,9 @@ func (b *Barrier) handleBlockState(changefeedID common.ChangeFeedID,
 		key := getEventKey(blockState.BlockTs, blockState.IsSyncPoint)
 		// insert an event, or get the old one event check if the event is already tracked
 		event := b.getOrInsertNewEvent(changefeedID, dispatcherID, key, blockState)
+		if event.pendingDDL == nil && blockState.NeedApproval {
+			event.pendingDDL = newPendingDDL(blockState)
+		}
 		if dispatcherID == b.spanController.GetDDLDispatcherID() {
 			// A table dispatcher may create the BarrierEvent before the table
 			// trigger dispatcher reports the same DDL. Route admissions are only
@@ -500,6 +510,11 @@ func (b *Barrier) handleBlockState(changefeedID common.ChangeFeedID,
 			if !b.precheckRouteEvent(event) {
 				return event, nil, "", false
 			}
+			// Hold the DDL until it's approved or skipped, the dispatchers not
+			// acked keep resending the block status.
+			if !b.approveDDLEvent(event) {
+				return event, nil, "", false
+			}
 		}
 		status, targetID := event.checkEventAction(dispatcherID)
 		if status != nil && event.needSchedule {
@@ -573,6 +588,12 @@ func (b *Barrier) checkEventFinish(be *BarrierEvent) {
 			zap.Int64("mode", b.mode))
 		// already selected a dispatcher to write, now all dispatchers reported the block event
 		b.blockedEvents.Delete(getEventKey(be.commitTs, be.isSyncPoint))
+		if b.ddlApprovals != nil && be.pendingDDL != nil {
+			b.ddlApprovals.remove(be.pendingDDL)
+		}
+		if b.syncPointNotifier != nil && be.isSyncPoint {
+			b.syncPointNotifier()
+		}
 	}
 }
 
@@ -613,6 +634,40 @@ func (b *Barrier) tryScheduleEvent(event *BarrierEvent) bool {
 	return true
 }
 
+// approveDDLEvent returns false if the DDL is waiting for an operator to
+// approve or skip it. The DDL skipped by the operator is passed by the writer
+// dispatcher instead of written to the downstream.
+func (b *Barrier) approveDDLEvent(event *BarrierEvent) bool {
+	if b.ddlApprovals == nil || event.pendingDDL == nil {
+		return true
+	}
+	switch b.ddlApprovals.wait(event.pendingDDL) {
+	case ddlDecisionApprove:
+		log.Info("ddl is approved",
+			zap.String("changefeed", event.cfID.Name()),
+			zap.Uint64("commitTs", event.commitTs),
+			zap.String("query", event.pendingDDL.Query))
+	case ddlDecisionSkip:
+		log.Info("ddl is skipped",
+			zap.String("changefeed", event.cfID.Name()),
+			zap.Uint64("commitTs", event.commitTs),
+			zap.String("query", event.pendingDDL.Query))
+		event.skipWrite = true
+	default:
+		if time.Since(event.lastWarningLogTime) > time.Second*10 {
+			log.Info("ddl is waiting for approval",
+				zap.String("changefeed", event.cfID.Name()),
+				zap.Uint64("commitTs", event.commitTs),
+				zap.String("schema", event.pendingDDL.SchemaName),
+				zap.String("table", event.pendingDDL.TableName),
+				zap.String("query", event.pendingDDL.Query))
+			event.lastWarningLogTime = time.Now()
+		}
+		return false
+	}
+	return true
+}
+
 func (b *Barrier) precheckRouteEvent(event *BarrierEvent) bool {
 	if b.routeAdmin == nil || event.isSyncPoint {
 		return true
@@ -61,6 +61,12 @@ type BarrierEvent struct {
 	schemaIDChange     []*heartbeatpb.SchemaIDChange
 	isSyncPoint        bool
 	needSchedule       bool
+	// pendingDDL is not nil if the DDL needs the approval of an operator
+	// before it's written.
+	pendingDDL *PendingDDL
+	// skipWrite is true if the DDL is skipped by the operator, the writer
+	// dispatcher passes it instead of writing it.
+	skipWrite bool
 	// mode is inherited from the owning Barrier and keeps this event's
 	// scheduling, resend messages, and logs within the same replication pipeline
 	// (common.DefaultMode or common.RedoMode).
@@ -116,6 +122,7 @@ func NewBlockEvent(cfID common.ChangeFeedID,
 		schemaIDChange:     status.UpdatedSchemas,
 		isSyncPoint:        status.IsSyncPoint,
 		needSchedule:       needSchedule(status),
+		pendingDDL:         newPendingDDL(status),
 		mode:               mode,
 		// if the split table is enable for this changefeed, if not we can use tableID to check coverage
 		dynamicSplitEnabled: dynamicSplitEnabled,
@@ -303,7 +310,7 @@ func (be *BarrierEvent) onAllDispatcherReportedBlockEvent(dispatcherID common.Di
 			InfluenceType: heartbeatpb.InfluenceType_Normal,
 			DispatcherIDs: []*heartbeatpb.DispatcherID{be.writerDispatcher.ToPB()},
 		},
-		Action: be.action(heartbeatpb.Action_Write),
+		Action: be.writerAction(),
 	}, stm.GetNodeID()
 }
 
@@ -793,7 +800,7 @@ func (be *BarrierEvent) newWriterActionMessage(capture node.ID, mode int64) *mes
 			ChangefeedID: be.cfID.ToPB(),
 			DispatcherStatuses: []*heartbeatpb.DispatcherStatus{
 				{
-					Action: be.action(heartbeatpb.Action_Write),
+					Action: be.writerAction(),
 					InfluencedDispatchers: &heartbeatpb.InfluencedDispatchers{
 						InfluenceType: heartbeatpb.InfluenceType_Normal,
 						DispatcherIDs: []*heartbeatpb.DispatcherID{
@@ -833,6 +840,15 @@ func (be *BarrierEvent) newPassActionMessage(capture node.ID, mode int64) *messa
 		})
 }
 
+// writerAction returns the action of the writer dispatcher, the DDL skipped by
+// the operator is passed instead of written.
+func (be *BarrierEvent) writerAction() *heartbeatpb.DispatcherAction {
+	if be.skipWrite {
+		return be.action(heartbeatpb.Action_Pass)
+	}
+	return be.action(heartbeatpb.Action_Write)
+}
+
 func (be *BarrierEvent) action(action heartbeatpb.Action) *heartbeatpb.DispatcherAction {
 	return &heartbeatpb.DispatcherAction{
 		Action:      action,
@@ -1168,6 +1168,8 @@ func TestSyncPointBlock(t *testing.T) {
 	spanController.MarkSpanReplicating(selectedRep)
 
 	barrier := NewBarrier(spanController, operatorController, true, nil, common.DefaultMode, nil)
+	notified := 0
+	barrier.syncPointNotifier = func() { notified++ }
 	// first dispatcher  block request
 	msgs := barrier.HandleStatus("node1", &heartbeatpb.BlockStatusRequest{
 		ChangefeedID: cfID.ToPB(),
@@ -1271,6 +1273,7 @@ func TestSyncPointBlock(t *testing.T) {
 	// 2 pass action messages to one node
 	require.Len(t, resendMsgs, 2)
 	require.Len(t, barrier.blockedEvents.m, 1)
+	require.Equal(t, 0, notified)
 	// other dispatcher advanced checkpoint ts
 	_ = barrier.HandleStatus("node1", &heartbeatpb.BlockStatusRequest{
 		ChangefeedID: cfID.ToPB(),
@@ -1305,6 +1308,8 @@ func TestSyncPointBlock(t *testing.T) {
 		},
 	})
 	require.Len(t, barrier.blockedEvents.m, 0)
+	// the syncpoint is written by all dispatchers
+	require.Equal(t, 1, notified)
 }
 
 func TestNonBlocked(t *testing.T) {
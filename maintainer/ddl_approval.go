@@ -0,0 +1,150 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintainer
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pingcap/ticdc/heartbeatpb"
+	"github.com/pingcap/ticdc/pkg/errors"
+)
+
+// PendingDDL is a DDL matched by a pause ddl policy, which is held by the
+// barrier until an operator approves or skips it.
+type PendingDDL struct {
+	CommitTs   uint64
+	Query      string
+	SchemaName string
+	TableName  string
+	// CreateTime is the time when all the dispatchers reported the DDL.
+	CreateTime time.Time
+}
+
+// pendingDDLKey identifies a pending DDL. The DDLs split from a batch DDL
+// share the commit ts, so the query is a part of the key.
+type pendingDDLKey struct {
+	commitTs uint64
+	query    string
+}
+
+func (d *PendingDDL) key() pendingDDLKey {
+	return pendingDDLKey{commitTs: d.CommitTs, query: d.Query}
+}
+
+func newPendingDDL(state *heartbeatpb.State) *PendingDDL {
+	if !state.NeedApproval {
+		return nil
+	}
+	return &PendingDDL{
+		CommitTs:   state.BlockTs,
+		Query:      state.DDLQuery,
+		SchemaName: state.DDLSchemaName,
+		TableName:  state.DDLTableName,
+	}
+}
+
+type ddlDecision int
+
+const (
+	ddlDecisionNone ddlDecision = iota
+	ddlDecisionApprove
+	ddlDecisionSkip
+)
+
+// ddlApprovals tracks the DDLs waiting for the approval and the decisions
+// made by the operator. It's shared by the barrier and the http api, so it
+// must be thread safe.
+//
+// The decisions are kept in memory. If the maintainer is moved before a DDL
+// is approved or skipped, the dispatchers report it to the new maintainer
+// and it's waiting for the approval again.
+type ddlApprovals struct {
+	mu        sync.Mutex
+	pending   map[pendingDDLKey]*PendingDDL
+	decisions map[pendingDDLKey]ddlDecision
+}
+
+func newDDLApprovals() *ddlApprovals {
+	return &ddlApprovals{
+		pending:   make(map[pendingDDLKey]*PendingDDL),
+		decisions: make(map[pendingDDLKey]ddlDecision),
+	}
+}
+
+// wait returns the decision of the DDL. If there is no decision yet, the DDL
+// is added to the pending DDLs.
+func (a *ddlApprovals) wait(ddl *PendingDDL) ddlDecision {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	key := ddl.key()
+	if decision, ok := a.decisions[key]; ok {
+		return decision
+	}
+	if _, ok := a.pending[key]; !ok {
+		pending := *ddl
+		pending.CreateTime = time.Now()
+		a.pending[key] = &pending
+	}
+	return ddlDecisionNone
+}
+
+// decide approves or skips the pending DDL at the commitTs. The query is
+// required only if there are several pending DDLs at the commitTs.
+func (a *ddlApprovals) decide(commitTs uint64, query string, decision ddlDecision) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	var matched []pendingDDLKey
+	for key := range a.pending {
+		if key.commitTs == commitTs && (query == "" || key.query == query) {
+			matched = append(matched, key)
+		}
+	}
+	switch len(matched) {
+	case 0:
+		return errors.ErrPendingDDLNotFound.GenWithStackByArgs(commitTs)
+	case 1:
+	default:
+		return errors.ErrPendingDDLAmbiguous.GenWithStackByArgs(len(matched), commitTs)
+	}
+	delete(a.pending, matched[0])
+	a.decisions[matched[0]] = decision
+	return nil
+}
+
+// remove removes the DDL after the barrier handles it.
+func (a *ddlApprovals) remove(ddl *PendingDDL) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	key := ddl.key()
+	delete(a.pending, key)
+	delete(a.decisions, key)
+}
+
+// list returns the pending DDLs sorted by the commit ts.
+func (a *ddlApprovals) list() []*PendingDDL {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	res := make([]*PendingDDL, 0, len(a.pending))
+	for _, ddl := range a.pending {
+		pending := *ddl
+		res = append(res, &pending)
+	}
+	slices.SortFunc(res, func(x, y *PendingDDL) int {
+		return cmp.Or(cmp.Compare(x.CommitTs, y.CommitTs), cmp.Compare(x.Query, y.Query))
+	})
+	return res
+}
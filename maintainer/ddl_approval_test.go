@@ -0,0 +1,157 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintainer
+
+import (
+	"testing"
+
+	"github.com/pingcap/ticdc/heartbeatpb"
+	"github.com/pingcap/ticdc/maintainer/operator"
+	"github.com/pingcap/ticdc/maintainer/replica"
+	"github.com/pingcap/ticdc/maintainer/span"
+	"github.com/pingcap/ticdc/maintainer/testutil"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDDLApprovals(t *testing.T) {
+	t.Parallel()
+
+	approvals := newDDLApprovals()
+	require.True(t, errors.ErrPendingDDLNotFound.Equal(approvals.decide(10, "", ddlDecisionApprove)))
+
+	drop1 := &PendingDDL{CommitTs: 10, Query: "DROP TABLE t1"}
+	drop2 := &PendingDDL{CommitTs: 20, Query: "DROP TABLE t2"}
+	require.Equal(t, ddlDecisionNone, approvals.wait(drop2))
+	require.Equal(t, ddlDecisionNone, approvals.wait(drop1))
+	// the pending ddl is not added again
+	require.Equal(t, ddlDecisionNone, approvals.wait(drop1))
+	pending := approvals.list()
+	require.Len(t, pending, 2)
+	require.Equal(t, uint64(10), pending[0].CommitTs)
+	require.Equal(t, uint64(20), pending[1].CommitTs)
+	require.False(t, pending[0].CreateTime.IsZero())
+
+	require.NoError(t, approvals.decide(10, "", ddlDecisionApprove))
+	require.NoError(t, approvals.decide(20, "DROP TABLE t2", ddlDecisionSkip))
+	// the decided ddl is not pending anymore
+	require.Empty(t, approvals.list())
+	require.True(t, errors.ErrPendingDDLNotFound.Equal(approvals.decide(10, "", ddlDecisionSkip)))
+	require.Equal(t, ddlDecisionApprove, approvals.wait(drop1))
+	require.Equal(t, ddlDecisionSkip, approvals.wait(drop2))
+
+	// the decision does not apply to another ddl at the same commit ts
+	drop3 := &PendingDDL{CommitTs: 10, Query: "DROP TABLE t3"}
+	require.Equal(t, ddlDecisionNone, approvals.wait(drop3))
+	approvals.remove(drop1)
+	require.Equal(t, ddlDecisionNone, approvals.wait(drop1))
+
+	// the query is required if several ddls are pending at the commit ts
+	require.True(t, errors.ErrPendingDDLAmbiguous.Equal(approvals.decide(10, "", ddlDecisionApprove)))
+	require.True(t, errors.ErrPendingDDLNotFound.Equal(approvals.decide(10, "DROP TABLE t4", ddlDecisionApprove)))
+	require.NoError(t, approvals.decide(10, "DROP TABLE t3", ddlDecisionSkip))
+	require.Equal(t, ddlDecisionSkip, approvals.wait(drop3))
+	require.Equal(t, ddlDecisionNone, approvals.wait(drop1))
+}
+
+func TestBarrierHoldsDDLForApproval(t *testing.T) {
+	testutil.SetUpTestServices(t)
+	tableTriggerEventDispatcherID := common.NewDispatcherID()
+	cfID := common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName)
+	ddlSpan := replica.NewWorkingSpanReplication(cfID, tableTriggerEventDispatcherID,
+		common.DDLSpanSchemaID,
+		common.KeyspaceDDLSpan(common.DefaultKeyspaceID), &heartbeatpb.TableSpanStatus{
+			ID:              tableTriggerEventDispatcherID.ToPB(),
+			ComponentStatus: heartbeatpb.ComponentState_Working,
+			CheckpointTs:    1,
+		}, "node1", false)
+	spanController := span.NewController(cfID, ddlSpan, nil, nil, nil, common.DefaultKeyspaceID, common.DefaultMode)
+	operatorController := operator.NewOperatorController(cfID, spanController, 1000, common.DefaultMode)
+	spanController.AddNewTable(commonEvent.Table{SchemaID: 1, TableID: 1}, 1)
+	stm := spanController.GetTasksByTableID(1)[0]
+	spanController.BindSpanToNode("", "node1", stm)
+	spanController.MarkSpanReplicating(stm)
+
+	barrier := NewBarrier(spanController, operatorController, false, nil, common.DefaultMode, nil)
+	barrier.ddlApprovals = newDDLApprovals()
+
+	blockStatus := func(commitTs uint64, stage heartbeatpb.BlockStage) *heartbeatpb.BlockStatusRequest {
+		return &heartbeatpb.BlockStatusRequest{
+			ChangefeedID: cfID.ToPB(),
+			BlockStatuses: []*heartbeatpb.TableSpanBlockStatus{
+				{
+					ID: stm.ID.ToPB(),
+					State: &heartbeatpb.State{
+						IsBlocked: true,
+						BlockTs:   commitTs,
+						BlockTables: &heartbeatpb.InfluencedTables{
+							InfluenceType: heartbeatpb.InfluenceType_Normal,
+							TableIDs:      []int64{1},
+						},
+						Stage:         stage,
+						NeedApproval:  true,
+						DDLQuery:      "ALTER TABLE t1 ADD COLUMN c INT",
+						DDLSchemaName: "test",
+						DDLTableName:  "t1",
+					},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		commitTs   uint64
+		approve    bool
+		wantAction heartbeatpb.Action
+	}{
+		{commitTs: 10, approve: true, wantAction: heartbeatpb.Action_Write},
+		{commitTs: 20, approve: false, wantAction: heartbeatpb.Action_Pass},
+	} {
+		// the ddl is held without ack until it's approved or skipped
+		msgs := barrier.HandleStatus("node1", blockStatus(tc.commitTs, heartbeatpb.BlockStage_WAITING))
+		require.Len(t, msgs, 1)
+		require.Empty(t, msgs[0].Message[0].(*heartbeatpb.HeartBeatResponse).DispatcherStatuses)
+		pending := barrier.ddlApprovals.list()
+		require.Len(t, pending, 1)
+		require.Equal(t, tc.commitTs, pending[0].CommitTs)
+		require.Equal(t, "ALTER TABLE t1 ADD COLUMN c INT", pending[0].Query)
+		require.Equal(t, "test", pending[0].SchemaName)
+		require.Equal(t, "t1", pending[0].TableName)
+
+		// resend before the decision
+		msgs = barrier.HandleStatus("node1", blockStatus(tc.commitTs, heartbeatpb.BlockStage_WAITING))
+		require.Empty(t, msgs[0].Message[0].(*heartbeatpb.HeartBeatResponse).DispatcherStatuses)
+
+		decision := ddlDecisionSkip
+		if tc.approve {
+			decision = ddlDecisionApprove
+		}
+		require.NoError(t, barrier.ddlApprovals.decide(tc.commitTs, "", decision))
+		msgs = barrier.HandleStatus("node1", blockStatus(tc.commitTs, heartbeatpb.BlockStage_WAITING))
+		resp := msgs[0].Message[0].(*heartbeatpb.HeartBeatResponse)
+		require.Len(t, resp.DispatcherStatuses, 2)
+		require.Equal(t, tc.commitTs, resp.DispatcherStatuses[0].Ack.CommitTs)
+		require.Equal(t, tc.commitTs, resp.DispatcherStatuses[1].Action.CommitTs)
+		require.Equal(t, tc.wantAction, resp.DispatcherStatuses[1].Action.Action)
+
+		// the decision is removed after the ddl is done
+		barrier.HandleStatus("node1", blockStatus(tc.commitTs, heartbeatpb.BlockStage_DONE))
+		require.Len(t, barrier.blockedEvents.m, 0)
+		ddl := &PendingDDL{CommitTs: tc.commitTs, Query: "ALTER TABLE t1 ADD COLUMN c INT"}
+		require.Equal(t, ddlDecisionNone, barrier.ddlApprovals.wait(ddl))
+		barrier.ddlApprovals.remove(ddl)
+	}
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/pingcap/ticdc/pkg/redo"
 	pkgReplica "github.com/pingcap/ticdc/pkg/scheduler/replica"
 	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/pingcap/ticdc/pkg/verifier"
 	"github.com/pingcap/ticdc/server/watcher"
 	"github.com/pingcap/ticdc/utils/chann"
 	"github.com/pingcap/ticdc/utils/threadpool"
@@ -260,6 +261,19 @@ func NewMaintainer(cfID common.ChangeFeedID,
 	ctx, cancel := context.WithCancel(context.Background())
 	m.cancel = cancel
 
+	// The validator is notified by the barrier created during the bootstrap,
+	// so it is set before the events are handled.
+	if info.Config.Validation.IsEnabled() {
+		validator, err := verifier.NewValidator(info)
+		if err != nil {
+			log.Warn("create validator failed, the data will not be validated",
+				zap.Stringer("changefeedID", cfID), zap.Error(err))
+		} else {
+			m.controller.syncPointNotifier = validator.NotifySyncPoint
+			go validator.Run(ctx)
+		}
+	}
+
 	go m.runHandleEvents(ctx)
 	go m.calCheckpointTs(ctx)
 	if enableRedo {
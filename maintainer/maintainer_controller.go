@@ -85,6 +85,13 @@ type Controller struct {
 	// route admission checks during DDL coordination.
 	routeAdmin  *routing.Admin
 	reportError func(error)
+
+	// ddlApprovals is shared with the default mode Barrier, it holds the DDLs
+	// matched by a pause ddl policy until they are approved or skipped.
+	ddlApprovals *ddlApprovals
+	// syncPointNotifier is called by the default mode Barrier after a
+	// syncpoint is written, it is nil if the validation is disabled.
+	syncPointNotifier func()
 }
 
 func NewController(changefeedID common.ChangeFeedID,
@@ -148,6 +155,7 @@ func NewController(changefeedID common.ChangeFeedID,
 		keyspaceMeta:           keyspaceMeta,
 		enableRedo:             enableRedo,
 		drainState:             mscheduler.NewDrainState(),
+		ddlApprovals:           newDDLApprovals(),
 	}
 	// Scheduler instances share a dedicated drain state object so each tick can
 	// read a consistent snapshot without depending on the whole controller.
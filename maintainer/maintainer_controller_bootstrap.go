@@ -395,6 +395,10 @@ func (c *Controller) initializeComponents(
 		c.redoBarrier = NewBarrier(c.redoSpanController, c.redoOperatorController, util.GetOrZero(c.replicaConfig.Scheduler.EnableTableAcrossNodes), allNodesResp, common.RedoMode, nil)
 	}
 	c.barrier = NewBarrier(c.spanController, c.operatorController, util.GetOrZero(c.replicaConfig.Scheduler.EnableTableAcrossNodes), allNodesResp, common.DefaultMode, c.routeAdmin)
+	// Only the default mode barrier waits for the approval, the redo
+	// dispatchers do not block on the DDLs matched by a pause ddl policy.
+	c.barrier.ddlApprovals = c.ddlApprovals
+	c.barrier.syncPointNotifier = c.syncPointNotifier
 
 	// Start scheduler
 	c.taskHandlesMu.Lock()
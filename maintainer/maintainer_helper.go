@@ -72,6 +72,25 @@ func (m *Maintainer) MoveSplitTable(tableId int64, targetNode node.ID, mode int6
 	return m.controller.moveSplitTable(tableId, targetNode, mode)
 }
 
+// ApproveDDL approves the DDL waiting for the approval at the commitTs, it's
+// written to the downstream. The query is required only if there are several
+// DDLs waiting at the commitTs.
+func (m *Maintainer) ApproveDDL(commitTs uint64, query string) error {
+	return m.controller.ddlApprovals.decide(commitTs, query, ddlDecisionApprove)
+}
+
+// SkipDDL skips the DDL waiting for the approval at the commitTs, it's not
+// written to the downstream. The query is required only if there are several
+// DDLs waiting at the commitTs.
+func (m *Maintainer) SkipDDL(commitTs uint64, query string) error {
+	return m.controller.ddlApprovals.decide(commitTs, query, ddlDecisionSkip)
+}
+
+// GetPendingDDLs returns the DDLs waiting for the approval.
+func (m *Maintainer) GetPendingDDLs() []*PendingDDL {
+	return m.controller.ddlApprovals.list()
+}
+
 // GetTables returns all tables.
 func (m *Maintainer) GetTables(mode int64) []*replica.SpanReplication {
 	if common.IsRedoMode(mode) {
@@ -90,7 +90,8 @@ var httpBadRequestError = []*errors.Error{
 	cerror.ErrTargetTsBeforeStartTs, cerror.ErrTableIneligible,
 	cerror.ErrFilterRuleInvalid, cerror.ErrChangefeedUpdateRefused, cerror.ErrMySQLConnectionError,
 	cerror.ErrMySQLInvalidConfig, cerror.ErrCaptureNotExist, cerror.ErrSchedulerRequestFailed,
-	cerror.ErrActiveActiveTSOIndexIncompatible,
+	cerror.ErrActiveActiveTSOIndexIncompatible, cerror.ErrPendingDDLNotFound,
+	cerror.ErrPendingDDLAmbiguous,
 }
 
 const (
@@ -109,8 +110,16 @@ const (
 	APIOpVarChangefeedID = "changefeed_id"
 	// APIOpVarCaptureID is the key of capture ID in HTTP API.
 	APIOpVarCaptureID = "capture_id"
+	// APIOpVarTemplateName is the key of changefeed template name in HTTP API.
+	APIOpVarTemplateName = "template_name"
+	// APIOpVarConfigRevision is the key of changefeed config revision in HTTP API.
+	APIOpVarConfigRevision = "revision"
 	// APIOpVarKeyspace is the key of changefeed keyspace in HTTP API
 	APIOpVarKeyspace = "keyspace"
+	// APIOpVarCommitTs is the key of the commit ts of a ddl in HTTP API.
+	APIOpVarCommitTs = "commit_ts"
+	// APIOpVarDDLQuery is the key of the query of a ddl in HTTP API.
+	APIOpVarDDLQuery = "query"
 	// APIOpVarTiCDCUser is the key of ticdc user in HTTP API.
 	APIOpVarTiCDCUser = "user"
 	// APIOpVarTiCDCPassword is the key of ticdc password in HTTP API.
@@ -26,6 +26,7 @@ import (
 type APIV2Interface interface {
 	RESTClient() rest.CDCRESTInterface
 	ChangefeedsGetter
+	ChangefeedTemplatesGetter
 	TsoGetter
 	UnsafeGetter
 	CapturesGetter
@@ -70,6 +71,14 @@ func (c *APIV2Client) Changefeeds() ChangefeedInterface {
 	return newChangefeeds(c)
 }
 
+// ChangefeedTemplates returns a ChangefeedTemplateInterface with cdc api
+func (c *APIV2Client) ChangefeedTemplates() ChangefeedTemplateInterface {
+	if c == nil {
+		return nil
+	}
+	return newChangefeedTemplates(c)
+}
+
 // Captures returns a CaptureInterface which abstracts capture operations.
 func (c *APIV2Client) Captures() CaptureInterface {
 	return newCaptures(c)
@@ -16,6 +16,7 @@ package v2
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 
 	v2 "github.com/pingcap/ticdc/api/v2"
@@ -51,6 +52,32 @@ type ChangefeedInterface interface {
 	Get(ctx context.Context, keyspace string, name string) (*v2.ChangeFeedInfo, error)
 	// List lists all changefeeds
 	List(ctx context.Context, keyspace string, state string) ([]v2.ChangefeedCommonInfo, error)
+	// BulkCreate creates changefeeds from a template atomically
+	BulkCreate(ctx context.Context, cfg *v2.BulkChangefeedConfig, keyspace string) (*v2.BulkChangefeedResponse, error)
+	// BulkUpdate applies a template to changefeeds atomically
+	BulkUpdate(ctx context.Context, cfg *v2.BulkChangefeedConfig, keyspace string) (*v2.BulkChangefeedResponse, error)
+	// BulkPause pauses changefeeds atomically
+	BulkPause(ctx context.Context, cfg *v2.BulkChangefeedConfig, keyspace string) (*v2.BulkChangefeedResponse, error)
+	// BulkResume resumes changefeeds atomically
+	BulkResume(ctx context.Context, cfg *v2.BulkChangefeedConfig, keyspace string) (*v2.BulkChangefeedResponse, error)
+	// History lists the config revisions of a changefeed
+	History(ctx context.Context, keyspace string, name string) ([]v2.ChangefeedConfigRevision, error)
+	// Rollback rolls back the config of a stopped changefeed to the given revision
+	Rollback(ctx context.Context, keyspace string, name string, revision uint64) (*v2.ChangeFeedInfo, error)
+	// FilterPreview previews the table set change of a changefeed by the proposed config
+	FilterPreview(ctx context.Context, cfg *v2.ChangefeedConfig, keyspace string, name string) (*v2.FilterPreview, error)
+	// Verify starts a background job verifying the consistency of a changefeed at a syncpoint
+	Verify(ctx context.Context, cfg *v2.VerifyChangefeedConfig, keyspace string, name string) (*v2.VerifyChangefeedJob, error)
+	// GetVerifyJob gets the last verification job of a changefeed
+	GetVerifyJob(ctx context.Context, keyspace string, name string) (*v2.VerifyChangefeedJob, error)
+	// CancelVerifyJob cancels the running verification job of a changefeed
+	CancelVerifyJob(ctx context.Context, keyspace string, name string) error
+	// ListPendingDDLs lists the DDLs of a changefeed waiting for the approval
+	ListPendingDDLs(ctx context.Context, keyspace string, name string) ([]v2.PendingDDL, error)
+	// ApproveDDL approves a DDL of a changefeed waiting for the approval
+	ApproveDDL(ctx context.Context, keyspace string, name string, commitTs uint64, query string) error
+	// SkipDDL skips a DDL of a changefeed waiting for the approval
+	SkipDDL(ctx context.Context, keyspace string, name string, commitTs uint64, query string) error
 	// Move Table to target node, it just for make test case now. **Not for public use.**
 	MoveTable(ctx context.Context, keyspace string, name string, tableID int64, targetNode string, mode int64, wait bool) error
 	// Move dispatchers in a split Table to target node, it just for make test case now. **Not for public use.**
@@ -189,6 +216,164 @@ func (c *changefeeds) List(ctx context.Context,
 	return result.Items, err
 }
 
+// BulkCreate creates changefeeds from a template atomically
+func (c *changefeeds) BulkCreate(ctx context.Context,
+	cfg *v2.BulkChangefeedConfig, keyspace string,
+) (*v2.BulkChangefeedResponse, error) {
+	return c.bulk(ctx, "create", cfg, keyspace)
+}
+
+// BulkUpdate applies a template to changefeeds atomically
+func (c *changefeeds) BulkUpdate(ctx context.Context,
+	cfg *v2.BulkChangefeedConfig, keyspace string,
+) (*v2.BulkChangefeedResponse, error) {
+	return c.bulk(ctx, "update", cfg, keyspace)
+}
+
+// BulkPause pauses changefeeds atomically
+func (c *changefeeds) BulkPause(ctx context.Context,
+	cfg *v2.BulkChangefeedConfig, keyspace string,
+) (*v2.BulkChangefeedResponse, error) {
+	return c.bulk(ctx, "pause", cfg, keyspace)
+}
+
+// BulkResume resumes changefeeds atomically
+func (c *changefeeds) BulkResume(ctx context.Context,
+	cfg *v2.BulkChangefeedConfig, keyspace string,
+) (*v2.BulkChangefeedResponse, error) {
+	return c.bulk(ctx, "resume", cfg, keyspace)
+}
+
+func (c *changefeeds) bulk(ctx context.Context,
+	op string, cfg *v2.BulkChangefeedConfig, keyspace string,
+) (*v2.BulkChangefeedResponse, error) {
+	result := &v2.BulkChangefeedResponse{}
+	u := fmt.Sprintf("changefeed_bulk/%s?%s=%s", op, api.APIOpVarKeyspace, keyspace)
+	err := c.client.Post().
+		WithURI(u).
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// History lists the config revisions of a changefeed
+func (c *changefeeds) History(ctx context.Context,
+	keyspace string, name string,
+) ([]v2.ChangefeedConfigRevision, error) {
+	result := &v2.ListResponse[v2.ChangefeedConfigRevision]{}
+	u := fmt.Sprintf("changefeeds/%s/history?%s=%s", name, api.APIOpVarKeyspace, keyspace)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result.Items, err
+}
+
+// Rollback rolls back the config of a stopped changefeed to the given revision
+func (c *changefeeds) Rollback(ctx context.Context,
+	keyspace string, name string, revision uint64,
+) (*v2.ChangeFeedInfo, error) {
+	result := &v2.ChangeFeedInfo{}
+	u := fmt.Sprintf("changefeeds/%s/rollback?%s=%s", name, api.APIOpVarKeyspace, keyspace)
+	err := c.client.Post().
+		WithURI(u).
+		WithParam(api.APIOpVarConfigRevision, strconv.FormatUint(revision, 10)).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// FilterPreview previews the table set change of a changefeed by the proposed config
+func (c *changefeeds) FilterPreview(ctx context.Context,
+	cfg *v2.ChangefeedConfig, keyspace string, name string,
+) (*v2.FilterPreview, error) {
+	result := &v2.FilterPreview{}
+	u := fmt.Sprintf("changefeeds/%s/filter_preview?%s=%s", name, api.APIOpVarKeyspace, keyspace)
+	err := c.client.Post().
+		WithURI(u).
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Verify starts a background job verifying the consistency of a changefeed at a syncpoint
+func (c *changefeeds) Verify(ctx context.Context,
+	cfg *v2.VerifyChangefeedConfig, keyspace string, name string,
+) (*v2.VerifyChangefeedJob, error) {
+	result := &v2.VerifyChangefeedJob{}
+	u := fmt.Sprintf("changefeeds/%s/verify?%s=%s", name, api.APIOpVarKeyspace, keyspace)
+	err := c.client.Post().
+		WithURI(u).
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// GetVerifyJob gets the last verification job of a changefeed
+func (c *changefeeds) GetVerifyJob(ctx context.Context,
+	keyspace string, name string,
+) (*v2.VerifyChangefeedJob, error) {
+	result := &v2.VerifyChangefeedJob{}
+	u := fmt.Sprintf("changefeeds/%s/verify?%s=%s", name, api.APIOpVarKeyspace, keyspace)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// CancelVerifyJob cancels the running verification job of a changefeed
+func (c *changefeeds) CancelVerifyJob(ctx context.Context,
+	keyspace string, name string,
+) error {
+	u := fmt.Sprintf("changefeeds/%s/verify?%s=%s", name, api.APIOpVarKeyspace, keyspace)
+	return c.client.Delete().
+		WithURI(u).
+		Do(ctx).Error()
+}
+
+// ListPendingDDLs lists the DDLs of a changefeed waiting for the approval
+func (c *changefeeds) ListPendingDDLs(ctx context.Context,
+	keyspace string, name string,
+) ([]v2.PendingDDL, error) {
+	result := &v2.ListResponse[v2.PendingDDL]{}
+	u := fmt.Sprintf("changefeeds/%s/pending_ddls?%s=%s", name, api.APIOpVarKeyspace, keyspace)
+	err := c.client.Get().
+		WithURI(u).
+		Do(ctx).
+		Into(result)
+	return result.Items, err
+}
+
+// ApproveDDL approves a DDL of a changefeed waiting for the approval
+func (c *changefeeds) ApproveDDL(ctx context.Context,
+	keyspace string, name string, commitTs uint64, query string,
+) error {
+	return c.client.Post().
+		WithURI(decideDDLURI(keyspace, name, commitTs, query, "approve")).
+		Do(ctx).Error()
+}
+
+// SkipDDL skips a DDL of a changefeed waiting for the approval
+func (c *changefeeds) SkipDDL(ctx context.Context,
+	keyspace string, name string, commitTs uint64, query string,
+) error {
+	return c.client.Post().
+		WithURI(decideDDLURI(keyspace, name, commitTs, query, "skip")).
+		Do(ctx).Error()
+}
+
+func decideDDLURI(keyspace string, name string, commitTs uint64, query string, decision string) string {
+	u := fmt.Sprintf("changefeeds/%s/ddl/%d/%s?%s=%s", name, commitTs, decision, api.APIOpVarKeyspace, keyspace)
+	if query != "" {
+		u += fmt.Sprintf("&%s=%s", api.APIOpVarDDLQuery, url.QueryEscape(query))
+	}
+	return u
+}
+
 // MoveTable to target node, it just for make test case now. **Not for public use.**
 func (c *changefeeds) MoveTable(ctx context.Context,
 	keyspace string, name string, tableID int64, targetNode string, mode int64, wait bool,
@@ -0,0 +1,116 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	v2 "github.com/pingcap/ticdc/api/v2"
+	"github.com/pingcap/ticdc/pkg/api/internal/rest"
+	"github.com/pingcap/ticdc/pkg/config"
+)
+
+// ChangefeedTemplatesGetter has a method to return a ChangefeedTemplateInterface.
+type ChangefeedTemplatesGetter interface {
+	ChangefeedTemplates() ChangefeedTemplateInterface
+}
+
+// ChangefeedTemplateInterface has methods to work with changefeed templates.
+type ChangefeedTemplateInterface interface {
+	// Create creates a changefeed template
+	Create(ctx context.Context, cfg *v2.ChangefeedTemplate) (*v2.ChangefeedTemplate, error)
+	// Update updates a changefeed template
+	Update(ctx context.Context, cfg *v2.ChangefeedTemplate, name string) (*v2.ChangefeedTemplate, error)
+	// Delete deletes a changefeed template by name
+	Delete(ctx context.Context, name string) error
+	// Get gets a changefeed template by name
+	Get(ctx context.Context, name string) (*v2.ChangefeedTemplate, error)
+	// List lists all changefeed templates
+	List(ctx context.Context) ([]v2.ChangefeedTemplate, error)
+}
+
+// changefeedTemplates implements ChangefeedTemplateInterface
+type changefeedTemplates struct {
+	client rest.CDCRESTInterface
+}
+
+// newChangefeedTemplates returns changefeedTemplates
+func newChangefeedTemplates(c *APIV2Client) *changefeedTemplates {
+	return &changefeedTemplates{
+		client: c.RESTClient(),
+	}
+}
+
+// Create a changefeed template
+func (c *changefeedTemplates) Create(ctx context.Context,
+	cfg *v2.ChangefeedTemplate,
+) (*v2.ChangefeedTemplate, error) {
+	result := &v2.ChangefeedTemplate{}
+	err := c.client.Post().
+		WithURI("changefeed_templates").
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Update a changefeed template
+func (c *changefeedTemplates) Update(ctx context.Context,
+	cfg *v2.ChangefeedTemplate, name string,
+) (*v2.ChangefeedTemplate, error) {
+	if err := config.ValidateChangefeedTemplateName(name); err != nil {
+		return nil, err
+	}
+	result := &v2.ChangefeedTemplate{}
+	err := c.client.Put().
+		WithURI(fmt.Sprintf("changefeed_templates/%s", name)).
+		WithBody(cfg).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// Delete a changefeed template
+func (c *changefeedTemplates) Delete(ctx context.Context, name string) error {
+	if err := config.ValidateChangefeedTemplateName(name); err != nil {
+		return err
+	}
+	return c.client.Delete().
+		WithURI(fmt.Sprintf("changefeed_templates/%s", name)).
+		Do(ctx).Error()
+}
+
+// Get gets a changefeed template
+func (c *changefeedTemplates) Get(ctx context.Context, name string) (*v2.ChangefeedTemplate, error) {
+	if err := config.ValidateChangefeedTemplateName(name); err != nil {
+		return nil, err
+	}
+	result := &v2.ChangefeedTemplate{}
+	err := c.client.Get().
+		WithURI(fmt.Sprintf("changefeed_templates/%s", name)).
+		Do(ctx).
+		Into(result)
+	return result, err
+}
+
+// List lists all changefeed templates
+func (c *changefeedTemplates) List(ctx context.Context) ([]v2.ChangefeedTemplate, error) {
+	result := &v2.ListResponse[v2.ChangefeedTemplate]{}
+	err := c.client.Get().
+		WithURI("changefeed_templates").
+		Do(ctx).
+		Into(result)
+	return result.Items, err
+}
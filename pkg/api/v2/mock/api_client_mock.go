@@ -49,6 +49,20 @@ func (mr *MockAPIV2InterfaceMockRecorder) Captures() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Captures", reflect.TypeOf((*MockAPIV2Interface)(nil).Captures))
 }
 
+// ChangefeedTemplates mocks base method.
+func (m *MockAPIV2Interface) ChangefeedTemplates() v2.ChangefeedTemplateInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangefeedTemplates")
+	ret0, _ := ret[0].(v2.ChangefeedTemplateInterface)
+	return ret0
+}
+
+// ChangefeedTemplates indicates an expected call of ChangefeedTemplates.
+func (mr *MockAPIV2InterfaceMockRecorder) ChangefeedTemplates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangefeedTemplates", reflect.TypeOf((*MockAPIV2Interface)(nil).ChangefeedTemplates))
+}
+
 // Changefeeds mocks base method.
 func (m *MockAPIV2Interface) Changefeeds() v2.ChangefeedInterface {
 	m.ctrl.T.Helper()
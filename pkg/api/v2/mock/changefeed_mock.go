@@ -73,6 +73,94 @@ func (m *MockChangefeedInterface) EXPECT() *MockChangefeedInterfaceMockRecorder
 	return m.recorder
 }
 
+// ApproveDDL mocks base method.
+func (m *MockChangefeedInterface) ApproveDDL(ctx context.Context, keyspace, name string, commitTs uint64, query string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveDDL", ctx, keyspace, name, commitTs, query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveDDL indicates an expected call of ApproveDDL.
+func (mr *MockChangefeedInterfaceMockRecorder) ApproveDDL(ctx, keyspace, name, commitTs, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveDDL", reflect.TypeOf((*MockChangefeedInterface)(nil).ApproveDDL), ctx, keyspace, name, commitTs, query)
+}
+
+// BulkCreate mocks base method.
+func (m *MockChangefeedInterface) BulkCreate(ctx context.Context, cfg *v2.BulkChangefeedConfig, keyspace string) (*v2.BulkChangefeedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreate", ctx, cfg, keyspace)
+	ret0, _ := ret[0].(*v2.BulkChangefeedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkCreate indicates an expected call of BulkCreate.
+func (mr *MockChangefeedInterfaceMockRecorder) BulkCreate(ctx, cfg, keyspace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreate", reflect.TypeOf((*MockChangefeedInterface)(nil).BulkCreate), ctx, cfg, keyspace)
+}
+
+// BulkPause mocks base method.
+func (m *MockChangefeedInterface) BulkPause(ctx context.Context, cfg *v2.BulkChangefeedConfig, keyspace string) (*v2.BulkChangefeedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkPause", ctx, cfg, keyspace)
+	ret0, _ := ret[0].(*v2.BulkChangefeedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkPause indicates an expected call of BulkPause.
+func (mr *MockChangefeedInterfaceMockRecorder) BulkPause(ctx, cfg, keyspace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkPause", reflect.TypeOf((*MockChangefeedInterface)(nil).BulkPause), ctx, cfg, keyspace)
+}
+
+// BulkResume mocks base method.
+func (m *MockChangefeedInterface) BulkResume(ctx context.Context, cfg *v2.BulkChangefeedConfig, keyspace string) (*v2.BulkChangefeedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkResume", ctx, cfg, keyspace)
+	ret0, _ := ret[0].(*v2.BulkChangefeedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkResume indicates an expected call of BulkResume.
+func (mr *MockChangefeedInterfaceMockRecorder) BulkResume(ctx, cfg, keyspace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkResume", reflect.TypeOf((*MockChangefeedInterface)(nil).BulkResume), ctx, cfg, keyspace)
+}
+
+// BulkUpdate mocks base method.
+func (m *MockChangefeedInterface) BulkUpdate(ctx context.Context, cfg *v2.BulkChangefeedConfig, keyspace string) (*v2.BulkChangefeedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdate", ctx, cfg, keyspace)
+	ret0, _ := ret[0].(*v2.BulkChangefeedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdate indicates an expected call of BulkUpdate.
+func (mr *MockChangefeedInterfaceMockRecorder) BulkUpdate(ctx, cfg, keyspace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdate", reflect.TypeOf((*MockChangefeedInterface)(nil).BulkUpdate), ctx, cfg, keyspace)
+}
+
+// CancelVerifyJob mocks base method.
+func (m *MockChangefeedInterface) CancelVerifyJob(ctx context.Context, keyspace, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelVerifyJob", ctx, keyspace, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelVerifyJob indicates an expected call of CancelVerifyJob.
+func (mr *MockChangefeedInterfaceMockRecorder) CancelVerifyJob(ctx, keyspace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelVerifyJob", reflect.TypeOf((*MockChangefeedInterface)(nil).CancelVerifyJob), ctx, keyspace, name)
+}
+
 // Create mocks base method.
 func (m *MockChangefeedInterface) Create(ctx context.Context, cfg *v2.ChangefeedConfig, keyspace string) (*v2.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +190,21 @@ func (mr *MockChangefeedInterfaceMockRecorder) Delete(ctx, keyspace, name interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChangefeedInterface)(nil).Delete), ctx, keyspace, name)
 }
 
+// FilterPreview mocks base method.
+func (m *MockChangefeedInterface) FilterPreview(ctx context.Context, cfg *v2.ChangefeedConfig, keyspace, name string) (*v2.FilterPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterPreview", ctx, cfg, keyspace, name)
+	ret0, _ := ret[0].(*v2.FilterPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterPreview indicates an expected call of FilterPreview.
+func (mr *MockChangefeedInterfaceMockRecorder) FilterPreview(ctx, cfg, keyspace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterPreview", reflect.TypeOf((*MockChangefeedInterface)(nil).FilterPreview), ctx, cfg, keyspace, name)
+}
+
 // Get mocks base method.
 func (m *MockChangefeedInterface) Get(ctx context.Context, keyspace, name string) (*v2.ChangeFeedInfo, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +235,36 @@ func (mr *MockChangefeedInterfaceMockRecorder) GetAllTables(ctx, cfg, keyspace i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTables", reflect.TypeOf((*MockChangefeedInterface)(nil).GetAllTables), ctx, cfg, keyspace)
 }
 
+// GetVerifyJob mocks base method.
+func (m *MockChangefeedInterface) GetVerifyJob(ctx context.Context, keyspace, name string) (*v2.VerifyChangefeedJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerifyJob", ctx, keyspace, name)
+	ret0, _ := ret[0].(*v2.VerifyChangefeedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerifyJob indicates an expected call of GetVerifyJob.
+func (mr *MockChangefeedInterfaceMockRecorder) GetVerifyJob(ctx, keyspace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerifyJob", reflect.TypeOf((*MockChangefeedInterface)(nil).GetVerifyJob), ctx, keyspace, name)
+}
+
+// History mocks base method.
+func (m *MockChangefeedInterface) History(ctx context.Context, keyspace, name string) ([]v2.ChangefeedConfigRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, keyspace, name)
+	ret0, _ := ret[0].([]v2.ChangefeedConfigRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockChangefeedInterfaceMockRecorder) History(ctx, keyspace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockChangefeedInterface)(nil).History), ctx, keyspace, name)
+}
+
 // List mocks base method.
 func (m *MockChangefeedInterface) List(ctx context.Context, keyspace, state string) ([]v2.ChangefeedCommonInfo, error) {
 	m.ctrl.T.Helper()
@@ -147,6 +280,21 @@ func (mr *MockChangefeedInterfaceMockRecorder) List(ctx, keyspace, state interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockChangefeedInterface)(nil).List), ctx, keyspace, state)
 }
 
+// ListPendingDDLs mocks base method.
+func (m *MockChangefeedInterface) ListPendingDDLs(ctx context.Context, keyspace, name string) ([]v2.PendingDDL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingDDLs", ctx, keyspace, name)
+	ret0, _ := ret[0].([]v2.PendingDDL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingDDLs indicates an expected call of ListPendingDDLs.
+func (mr *MockChangefeedInterfaceMockRecorder) ListPendingDDLs(ctx, keyspace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingDDLs", reflect.TypeOf((*MockChangefeedInterface)(nil).ListPendingDDLs), ctx, keyspace, name)
+}
+
 // MergeTable mocks base method.
 func (m *MockChangefeedInterface) MergeTable(ctx context.Context, keyspace, name string, tableID, mode int64) error {
 	m.ctrl.T.Helper()
@@ -217,6 +365,35 @@ func (mr *MockChangefeedInterfaceMockRecorder) Resume(ctx, cfg, keyspace, name i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockChangefeedInterface)(nil).Resume), ctx, cfg, keyspace, name)
 }
 
+// Rollback mocks base method.
+func (m *MockChangefeedInterface) Rollback(ctx context.Context, keyspace, name string, revision uint64) (*v2.ChangeFeedInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", ctx, keyspace, name, revision)
+	ret0, _ := ret[0].(*v2.ChangeFeedInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollback indicates an expected call of Rollback.
+func (mr *MockChangefeedInterfaceMockRecorder) Rollback(ctx, keyspace, name, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockChangefeedInterface)(nil).Rollback), ctx, keyspace, name, revision)
+}
+
+// SkipDDL mocks base method.
+func (m *MockChangefeedInterface) SkipDDL(ctx context.Context, keyspace, name string, commitTs uint64, query string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SkipDDL", ctx, keyspace, name, commitTs, query)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SkipDDL indicates an expected call of SkipDDL.
+func (mr *MockChangefeedInterfaceMockRecorder) SkipDDL(ctx, keyspace, name, commitTs, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkipDDL", reflect.TypeOf((*MockChangefeedInterface)(nil).SkipDDL), ctx, keyspace, name, commitTs, query)
+}
+
 // SplitTableByRegionCount mocks base method.
 func (m *MockChangefeedInterface) SplitTableByRegionCount(ctx context.Context, keyspace, name string, tableID, mode int64) error {
 	m.ctrl.T.Helper()
@@ -246,6 +423,21 @@ func (mr *MockChangefeedInterfaceMockRecorder) Update(ctx, cfg, keyspace, name i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChangefeedInterface)(nil).Update), ctx, cfg, keyspace, name)
 }
 
+// Verify mocks base method.
+func (m *MockChangefeedInterface) Verify(ctx context.Context, cfg *v2.VerifyChangefeedConfig, keyspace, name string) (*v2.VerifyChangefeedJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, cfg, keyspace, name)
+	ret0, _ := ret[0].(*v2.VerifyChangefeedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockChangefeedInterfaceMockRecorder) Verify(ctx, cfg, keyspace, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockChangefeedInterface)(nil).Verify), ctx, cfg, keyspace, name)
+}
+
 // VerifyTable mocks base method.
 func (m *MockChangefeedInterface) VerifyTable(ctx context.Context, cfg *v2.VerifyTableConfig, keyspace string) (*v2.Tables, error) {
 	m.ctrl.T.Helper()
@@ -20,16 +20,19 @@ import (
 	"time"
 
 	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/downstreamadapter/sink"
 	"github.com/pingcap/ticdc/downstreamadapter/sink/mysql"
 	commonType "github.com/pingcap/ticdc/pkg/common"
 	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
 	"github.com/pingcap/ticdc/pkg/config"
 	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
 	"github.com/pingcap/ticdc/pkg/redo"
 	misc "github.com/pingcap/ticdc/pkg/redo/common"
 	"github.com/pingcap/ticdc/pkg/redo/reader"
 	"github.com/pingcap/ticdc/pkg/util"
 	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	tfilter "github.com/pingcap/tidb/pkg/util/table-filter"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -53,6 +56,15 @@ type RedoApplierConfig struct {
 	SinkURI string
 	Storage string
 	Dir     string
+	// SinkConfig is the sink config of the non mysql sinks, such as the protocol
+	// of the kafka sink, the sink uri parameters are applied to it.
+	SinkConfig *config.SinkConfig
+	// TargetTs is the consistent point the redo log is applied to, it must be in
+	// [checkpointTs, resolvedTs] of the redo meta, 0 means the resolvedTs.
+	TargetTs uint64
+	// Tables is the table filter rules of the tables to be applied, such as "db.tbl"
+	// and "db.*", empty means all tables.
+	Tables []string
 }
 
 // RedoApplier implements a redo log applier
@@ -60,7 +72,11 @@ type RedoApplier struct {
 	cfg            *RedoApplierConfig
 	rd             reader.RedoLogReader
 	updateSplitter *updateEventSplitter
+	tableFilter    tfilter.Filter
 
+	// sink is the downstream of the redo log, mysqlSink is set as well if the
+	// downstream is mysql compatible, it's used to query the recovery info.
+	sink            sink.Sink
 	mysqlSink       *mysql.Sink
 	appliedDDLCount uint64
 
@@ -99,6 +115,19 @@ func (rac *RedoApplierConfig) toLogReaderConfig() (string, *reader.LogReaderConf
 	return uri.Scheme, cfg, nil
 }
 
+// newTableFilter creates the table filter by the table rules, a nil filter
+// matches all tables.
+func (rac *RedoApplierConfig) newTableFilter() (tfilter.Filter, error) {
+	if len(rac.Tables) == 0 {
+		return nil, nil
+	}
+	f, err := filter.VerifyTableRules(&config.FilterConfig{Rules: rac.Tables})
+	if err != nil {
+		return nil, err
+	}
+	return tfilter.CaseInsensitive(f), nil
+}
+
 func getRedoApplyTimezone(sinkURI *url.URL) (string, error) {
 	timezone := sinkURI.Query().Get("time-zone")
 	if timezone == "" {
@@ -172,11 +201,37 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if ra.cfg.TargetTs != 0 {
+		if ra.cfg.TargetTs < checkpointTs || ra.cfg.TargetTs > resolvedTs {
+			return errors.ErrRedoApplyInvalidTargetTs.GenWithStackByArgs(
+				ra.cfg.TargetTs, checkpointTs, resolvedTs)
+		}
+		resolvedTs = ra.cfg.TargetTs
+	}
 	log.Info("apply redo log starts",
 		zap.Uint64("checkpointTs", checkpointTs),
 		zap.Uint64("resolvedTs", resolvedTs),
+		zap.Uint64("targetTs", ra.cfg.TargetTs),
+		zap.Strings("tables", ra.cfg.Tables),
 		zap.Int("version", version))
 
+	// The redo log is sorted by commitTs, so the rest of the log is beyond the
+	// target consistent point once a commitTs is greater than the resolvedTs.
+	readNextRow := func() (*commonEvent.RedoDMLEvent, error) {
+		row, err := ra.updateSplitter.readNextRow(ctx)
+		if err != nil || row == nil || row.Row.CommitTs <= resolvedTs {
+			return row, err
+		}
+		return nil, nil
+	}
+	readNextDDL := func() (*commonEvent.RedoDDLEvent, error) {
+		ddl, err := ra.rd.ReadNextDDL(ctx)
+		if err != nil || ddl == nil || ddl.DDL == nil || ddl.DDL.CommitTs <= resolvedTs {
+			return ddl, err
+		}
+		return nil, nil
+	}
+
 	shouldApplyDDL := func(row *commonEvent.RedoDMLEvent, ddl *commonEvent.RedoDDLEvent) bool {
 		if ddl == nil {
 			return false
@@ -189,11 +244,11 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 		return row.Row.CommitTs > ddl.DDL.CommitTs
 	}
 
-	row, err := ra.updateSplitter.readNextRow(ctx)
+	row, err := readNextRow()
 	if err != nil {
 		return err
 	}
-	ddl, err := ra.rd.ReadNextDDL(ctx)
+	ddl, err := readNextDDL()
 	if err != nil {
 		return err
 	}
@@ -202,14 +257,14 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 			if err := ra.applyDDL(ctx, ddl, checkpointTs); err != nil {
 				return err
 			}
-			if ddl, err = ra.rd.ReadNextDDL(ctx); err != nil {
+			if ddl, err = readNextDDL(); err != nil {
 				return err
 			}
 		} else {
 			if err := ra.applyRow(row, checkpointTs); err != nil {
 				return err
 			}
-			if row, err = ra.updateSplitter.readNextRow(ctx); err != nil {
+			if row, err = readNextRow(); err != nil {
 				return err
 			}
 		}
@@ -220,6 +275,12 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 			return err
 		}
 	}
+	// The mq and storage consumers rely on the checkpoint to know the events
+	// before it are complete, so it must reach the downstream before the sink
+	// is closed.
+	if err := ra.flushCheckpointTs(ctx, resolvedTs); err != nil {
+		return err
+	}
 
 	log.Info("apply redo log finishes",
 		zap.Uint64("appliedLogCount", ra.appliedLogCount),
@@ -228,6 +289,17 @@ func (ra *RedoApplier) consumeLogs(ctx context.Context) error {
 	return errApplyFinished
 }
 
+// flushCheckpointTs emits the checkpoint ts to the downstream synchronously.
+// AddCheckpointTs of the sinks implementing sink.CheckpointFlusher may drop the
+// checkpoint ts, while the others write it synchronously or ignore it.
+func (ra *RedoApplier) flushCheckpointTs(ctx context.Context, ts uint64) error {
+	if flusher, ok := ra.sink.(sink.CheckpointFlusher); ok {
+		return flusher.FlushCheckpointTs(ctx, ts)
+	}
+	ra.sink.AddCheckpointTs(ts)
+	return nil
+}
+
 // applyDDL will check the ddl and replicate the previous dmls and ddl to downstream.
 //
 // Before appling DDL, we have to query the start-ts of the table,
@@ -254,6 +326,11 @@ func (ra *RedoApplier) applyDDL(
 			log.Warn("ignore DDL without table info", zap.Any("ddl", ddl))
 			return true
 		}
+		if !ra.matchDDL(ddl) {
+			log.Info("ignore DDL which is filtered out by the table rules",
+				zap.Uint64("commitTs", ddl.DDL.CommitTs), zap.String("ddl", ddl.DDL.Query))
+			return true
+		}
 
 		var tableDDLTs ddlTs
 		if !ra.needRecoveryInfo {
@@ -339,8 +416,12 @@ func (ra *RedoApplier) applyDDL(
 			return err
 		}
 	}
-	ra.mysqlSink.SetTableSchemaStore(ddl.TableSchemaStore)
-	if err := ra.mysqlSink.WriteBlockEvent(ddl.ToDDLEvent()); err != nil {
+	ddlEvent := ddl.ToDDLEvent()
+	ra.sink.SetTableSchemaStore(ddl.TableSchemaStore)
+	if err := ra.sink.FlushDMLBeforeBlock(ddlEvent); err != nil {
+		return err
+	}
+	if err := ra.sink.WriteBlockEvent(ddlEvent); err != nil {
 		return err
 	}
 	ra.appliedDDLCount++
@@ -350,6 +431,9 @@ func (ra *RedoApplier) applyDDL(
 func (ra *RedoApplier) applyRow(
 	row *commonEvent.RedoDMLEvent, checkpointTs uint64,
 ) error {
+	if ra.tableFilter != nil && !ra.tableFilter.MatchTable(row.Row.Table.Schema, row.Row.Table.Table) {
+		return nil
+	}
 	tableID := row.Row.Table.TableID
 	if _, ok := ra.eventsGroup[tableID]; !ok {
 		ra.eventsGroup[tableID] = newEventsGroup(tableID)
@@ -412,7 +496,7 @@ func (ra *RedoApplier) waitTableFlush(
 				close(done)
 			}
 		})
-		ra.mysqlSink.AddDMLEvent(e)
+		ra.sink.AddDMLEvent(e)
 	}
 	// Make sure all events are flushed to downstream.
 	start := time.Now()
@@ -452,50 +536,87 @@ func (ra *RedoApplier) ReadMeta(ctx context.Context) (checkpointTs uint64, resol
 	return rd.ReadMeta(ctx)
 }
 
-// Apply applies redo log to given target
-func (ra *RedoApplier) Apply(egCtx context.Context) (err error) {
-	eg, egCtx := errgroup.WithContext(egCtx)
-	if ra.rd, err = createRedoReader(egCtx, ra.cfg); err != nil {
-		return err
-	}
-
+// initSink creates the sink of the downstream. The mysql sink applies the
+// redo log in the safe mode and queries the ddl ts table for the recovery info,
+// the other sinks replay all events after the checkpointTs of the redo meta.
+func (ra *RedoApplier) initSink(ctx context.Context) error {
 	sinkURI, err := url.Parse(ra.cfg.SinkURI)
 	if err != nil {
 		return errors.WrapError(errors.ErrSinkURIInvalid, err)
 	}
-	query := sinkURI.Query()
-	query.Set("batch-dml-enable", "false")
-	if ra.rd.GetVersion() != misc.Version {
-		ra.needRecoveryInfo = false
-		query.Set("enable-ddl-ts", "false")
-		log.Warn("The redo log version is different the current version, enable-ddl-ts will be set to false", zap.Any("logVersion", ra.rd.GetVersion()), zap.Any("currentVersion", misc.Version))
+	isMySQL := config.IsMySQLCompatibleScheme(config.GetScheme(sinkURI))
+	if isMySQL {
+		query := sinkURI.Query()
+		query.Set("batch-dml-enable", "false")
+		if !ra.needRecoveryInfo {
+			query.Set("enable-ddl-ts", "false")
+		}
+		sinkURI.RawQuery = query.Encode()
 	}
-	sinkURI.RawQuery = query.Encode()
 	timezone, err := getRedoApplyTimezone(sinkURI)
 	if err != nil {
 		return err
 	}
-	replicaConfig := &config.ChangefeedConfig{
+	changefeedConfig := &config.ChangefeedConfig{
 		SinkURI: sinkURI.String(),
 		// Redo apply runs without a CDC server instance, so resolve the default
 		// server timezone for the same sink URI validation as normal changefeeds.
 		TimeZone:   timezone,
 		SinkConfig: &config.SinkConfig{},
 	}
-	if ra.mysqlSink == nil {
+	if isMySQL {
 		ra.mysqlSink, err = mysql.New(
-			egCtx,
+			ctx,
 			ra.rd.GetChangefeedID(),
-			replicaConfig,
+			changefeedConfig,
 			sinkURI,
 			commonType.DefaultKeyspaceID,
 		)
 		if err != nil {
 			return err
 		}
+		ra.sink = ra.mysqlSink
+		return nil
+	}
+
+	// There is no ddl ts table in the non mysql downstream.
+	ra.needRecoveryInfo = false
+	replicaConfig := config.GetDefaultReplicaConfig()
+	if ra.cfg.SinkConfig != nil {
+		replicaConfig.Sink = ra.cfg.SinkConfig
+	}
+	if err = replicaConfig.ValidateAndAdjust(sinkURI); err != nil {
+		return err
+	}
+	changefeedConfig.SinkConfig = replicaConfig.Sink
+	ra.sink, err = sink.New(ctx, changefeedConfig, ra.rd.GetChangefeedID(), commonType.DefaultKeyspaceID)
+	return err
+}
+
+// Apply applies redo log to given target
+func (ra *RedoApplier) Apply(egCtx context.Context) (err error) {
+	eg, egCtx := errgroup.WithContext(egCtx)
+	if ra.rd, err = createRedoReader(egCtx, ra.cfg); err != nil {
+		return err
+	}
+	if ra.tableFilter, err = ra.cfg.newTableFilter(); err != nil {
+		return err
+	}
+
+	if ra.rd.GetVersion() != misc.Version {
+		ra.needRecoveryInfo = false
+		log.Warn("The redo log version is different the current version, enable-ddl-ts will be set to false", zap.Any("logVersion", ra.rd.GetVersion()), zap.Any("currentVersion", misc.Version))
+	}
+	if ra.sink == nil && ra.mysqlSink != nil {
+		ra.sink = ra.mysqlSink
+	}
+	if ra.sink == nil {
+		if err = ra.initSink(egCtx); err != nil {
+			return err
+		}
 	}
 	eg.Go(func() error {
-		return ra.mysqlSink.Run(egCtx)
+		return ra.sink.Run(egCtx)
 	})
 	eg.Go(func() error {
 		return ra.rd.Run(egCtx)
@@ -503,7 +624,7 @@ func (ra *RedoApplier) Apply(egCtx context.Context) (err error) {
 	ra.updateSplitter = newUpdateEventSplitter(ra.rd, ra.cfg.Dir)
 
 	eg.Go(func() error {
-		defer ra.mysqlSink.Close()
+		defer ra.sink.Close()
 		return ra.consumeLogs(egCtx)
 	})
 
@@ -513,3 +634,8 @@ func (ra *RedoApplier) Apply(egCtx context.Context) (err error) {
 	}
 	return nil
 }
+
+// matchDDL returns whether the DDL changes any table matched by the table rules.
+func (ra *RedoApplier) matchDDL(ddl *commonEvent.RedoDDLEvent) bool {
+	return reader.MatchDDL(ra.tableFilter, ddl)
+}
@@ -16,9 +16,11 @@ package applier
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -591,6 +593,137 @@ func TestApplyBigTxn(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func newBlackholeTestDML(table *common.TableName, commitTs uint64, a int64) *commonEvent.RedoDMLEvent {
+	return &commonEvent.RedoDMLEvent{
+		Row: &commonEvent.DMLEventInRedoLog{
+			StartTs:  commitTs - 10,
+			CommitTs: commitTs,
+			Table:    table,
+			Columns: []*commonEvent.RedoColumn{
+				{Name: "a", Type: pmysql.TypeLong},
+			},
+			IndexColumns: [][]int{{0}},
+		},
+		Columns: []commonEvent.RedoColumnValue{
+			{Value: a, Flag: newFlag(pmysql.PriKeyFlag)},
+		},
+	}
+}
+
+func TestApplyToBlackholeWithTargetTsAndTables(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	checkpointTs := uint64(1000)
+	resolvedTs := uint64(2000)
+	redoLogCh := make(chan *commonEvent.RedoDMLEvent, 1024)
+	ddlEventCh := make(chan *commonEvent.RedoDDLEvent, 1024)
+	createRedoReaderBak := createRedoReader
+	createRedoReader = func(ctx context.Context, cfg *RedoApplierConfig) (reader.RedoLogReader, error) {
+		return NewMockReader(checkpointTs, resolvedTs, redoLogCh, ddlEventCh), nil
+	}
+	defer func() {
+		createRedoReader = createRedoReaderBak
+	}()
+
+	t1 := &common.TableName{Schema: "test", Table: "t1", TableID: 1}
+	t2 := &common.TableName{Schema: "test", Table: "t2", TableID: 2}
+	newDDL := func(commitTs uint64, table string) *commonEvent.RedoDDLEvent {
+		return &commonEvent.RedoDDLEvent{
+			DDL: &commonEvent.DDLEventInRedoLog{
+				CommitTs: commitTs,
+				Query:    fmt.Sprintf("create table %s(a int primary key)", table),
+			},
+			TableName: common.TableName{Schema: "test", Table: table},
+			Type:      byte(timodel.ActionCreateTable),
+		}
+	}
+	ddlEventCh <- newDDL(checkpointTs, "t1")
+	// filtered out by the table rules
+	ddlEventCh <- newDDL(checkpointTs, "t2")
+	// beyond the target ts
+	ddlEventCh <- newDDL(1600, "t3")
+	redoLogCh <- newBlackholeTestDML(t1, 1200, 1)
+	redoLogCh <- newBlackholeTestDML(t2, 1300, 2)
+	redoLogCh <- newBlackholeTestDML(t1, 1400, 3)
+	redoLogCh <- newBlackholeTestDML(t1, 1700, 4)
+	close(redoLogCh)
+	close(ddlEventCh)
+
+	ap := NewRedoApplier(&RedoApplierConfig{
+		SinkURI:  "blackhole://",
+		Dir:      t.TempDir(),
+		TargetTs: 1500,
+		Tables:   []string{"test.t1"},
+	})
+	require.NoError(t, ap.Apply(ctx))
+	require.False(t, ap.needRecoveryInfo)
+	require.Nil(t, ap.mysqlSink)
+	require.Equal(t, uint64(2), ap.appliedLogCount)
+	require.Equal(t, uint64(1), ap.appliedDDLCount)
+}
+
+func TestApplyFlushesCheckpointToStorage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	checkpointTs := uint64(1000)
+	resolvedTs := uint64(2000)
+	redoLogCh := make(chan *commonEvent.RedoDMLEvent, 1024)
+	ddlEventCh := make(chan *commonEvent.RedoDDLEvent, 1024)
+	createRedoReaderBak := createRedoReader
+	createRedoReader = func(ctx context.Context, cfg *RedoApplierConfig) (reader.RedoLogReader, error) {
+		return NewMockReader(checkpointTs, resolvedTs, redoLogCh, ddlEventCh), nil
+	}
+	defer func() {
+		createRedoReader = createRedoReaderBak
+	}()
+	close(redoLogCh)
+	close(ddlEventCh)
+
+	// the checkpoint must be written before the sink is closed, even though
+	// the storage sink writes the checkpoints at most once per 2 seconds.
+	dir := t.TempDir()
+	ap := NewRedoApplier(&RedoApplierConfig{
+		SinkURI: fmt.Sprintf("file:///%s?protocol=csv", dir),
+		Dir:     t.TempDir(),
+	})
+	require.NoError(t, ap.Apply(ctx))
+
+	data, err := os.ReadFile(filepath.Join(dir, "metadata"))
+	require.NoError(t, err)
+	var metadata map[string]uint64
+	require.NoError(t, json.Unmarshal(data, &metadata))
+	require.Equal(t, resolvedTs, metadata["checkpoint-ts"])
+}
+
+func TestApplyInvalidConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	createRedoReaderBak := createRedoReader
+	createRedoReader = func(ctx context.Context, cfg *RedoApplierConfig) (reader.RedoLogReader, error) {
+		return NewMockReader(1000, 2000, nil, nil), nil
+	}
+	defer func() {
+		createRedoReader = createRedoReaderBak
+	}()
+
+	ap := NewRedoApplier(&RedoApplierConfig{
+		SinkURI:  "blackhole://",
+		Dir:      t.TempDir(),
+		TargetTs: 3000,
+	})
+	require.Regexp(t, "CDC:ErrRedoApplyInvalidTargetTs", ap.Apply(ctx))
+
+	ap = NewRedoApplier(&RedoApplierConfig{
+		SinkURI: "blackhole://",
+		Dir:     t.TempDir(),
+		Tables:  []string{"test"},
+	})
+	require.Regexp(t, "CDC:ErrFilterRuleInvalid", ap.Apply(ctx))
+}
+
 func TestApplyMeetSinkError(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
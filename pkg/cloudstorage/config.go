@@ -90,6 +90,7 @@ type Config struct {
 	UseTableIDAsPath         bool
 	SpoolDiskQuota           int64
 	SpoolBaseDir             string
+	TableFormat              string
 }
 
 // NewConfig returns the default cloud storage sink config.
@@ -102,6 +103,7 @@ func NewConfig() *Config {
 		FileCleanupCronSpec:    defaultFileCleanupCronSpec,
 		EnableTableAcrossNodes: defaultEnableTableAcrossNodes,
 		SpoolDiskQuota:         defaultSpoolDiskQuota,
+		TableFormat:            config.TableFormatNone,
 	}
 }
 
@@ -163,6 +165,9 @@ func (c *Config) Apply(
 			c.FileCleanupCronSpec = *sinkConfig.CloudStorageConfig.FileCleanupCronSpec
 		}
 		c.FlushConcurrency = util.GetOrZero(sinkConfig.CloudStorageConfig.FlushConcurrency)
+		if err = getTableFormat(sinkConfig.CloudStorageConfig.TableFormat, &c.TableFormat); err != nil {
+			return err
+		}
 	}
 
 	if c.FileIndexWidth < config.MinFileIndexWidth || c.FileIndexWidth > config.MaxFileIndexWidth {
@@ -312,3 +317,21 @@ func getSpoolBaseDir(values *urlConfig, spoolBaseDir *string) error {
 	*spoolBaseDir = dir
 	return nil
 }
+
+func getTableFormat(value *string, tableFormat *string) error {
+	if value == nil || len(*value) == 0 {
+		return nil
+	}
+
+	format := strings.ToLower(*value)
+	switch format {
+	case config.TableFormatNone, config.TableFormatIceberg:
+	default:
+		return errors.ErrStorageSinkInvalidConfig.GenWithStack(
+			"invalid table-format %q, it must be one of %q and %q",
+			*value, config.TableFormatNone, config.TableFormatIceberg)
+	}
+
+	*tableFormat = format
+	return nil
+}
@@ -248,3 +248,28 @@ func TestInvalidSpoolBaseDirConfig(t *testing.T) {
 	require.Error(t, err)
 	require.True(t, errors.ErrStorageSinkInvalidConfig.Equal(err))
 }
+
+func TestTableFormatConfig(t *testing.T) {
+	sinkURI, err := url.Parse("s3://bucket/prefix?protocol=parquet")
+	require.NoError(t, err)
+
+	cfg := NewConfig()
+	err = cfg.Apply(t.Context(), sinkURI, config.GetDefaultReplicaConfig().Sink, false)
+	require.NoError(t, err)
+	require.Equal(t, config.TableFormatNone, cfg.TableFormat)
+
+	replicaConfig := config.GetDefaultReplicaConfig()
+	replicaConfig.Sink.CloudStorageConfig = &config.CloudStorageConfig{
+		TableFormat: aws.String("Iceberg"),
+	}
+	cfg = NewConfig()
+	err = cfg.Apply(t.Context(), sinkURI, replicaConfig.Sink, false)
+	require.NoError(t, err)
+	require.Equal(t, config.TableFormatIceberg, cfg.TableFormat)
+
+	replicaConfig.Sink.CloudStorageConfig.TableFormat = aws.String("delta")
+	cfg = NewConfig()
+	err = cfg.Apply(t.Context(), sinkURI, replicaConfig.Sink, false)
+	require.Error(t, err)
+	require.True(t, errors.ErrStorageSinkInvalidConfig.Equal(err))
+}
@@ -0,0 +1,506 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/tidb/pkg/objstore/storeapi"
+	"go.uber.org/zap"
+)
+
+// The iceberg table format keeps the table metadata besides the data files:
+//
+//	<schema>/<table>/metadata/version-hint.text
+//	<schema>/<table>/metadata/v<version>.metadata.json
+//	<schema>/<table>/metadata/snap-<snapshot-id>.avro (the manifest list)
+//	<schema>/<table>/metadata/manifest-<snapshot-id>.avro
+//
+// The table metadata is encoded in JSON, the manifests and the manifest lists
+// are avro object container files with the schemas defined by the iceberg spec.
+const (
+	icebergFormatVersion     = 2
+	icebergMetadataDirName   = "metadata"
+	icebergVersionHintFile   = "version-hint.text"
+	icebergMetadataFileFmt   = "v%d.metadata.json"
+	icebergManifestListFmt   = "snap-%d.avro"
+	icebergManifestFileFmt   = "manifest-%d.avro"
+	icebergUnpartitionedID   = 999
+	icebergOperationAppend   = "append"
+	icebergContentData       = 0
+	icebergEntryStatusAdded  = 1
+	icebergSummaryCheckpoint = "ticdc.checkpoint-ts"
+	icebergSummarySchemaFile = "ticdc.schema-file"
+	// icebergNameMappingProperty maps the parquet columns to the field ids,
+	// since the parquet files written by the storage sink carry no field id.
+	icebergNameMappingProperty = "schema.name-mapping.default"
+)
+
+// IcebergField is one field of an iceberg schema.
+type IcebergField struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+	Type     string `json:"type"`
+}
+
+// IcebergSchema is the iceberg schema of the table.
+type IcebergSchema struct {
+	Type               string         `json:"type"`
+	SchemaID           int            `json:"schema-id"`
+	IdentifierFieldIDs []int          `json:"identifier-field-ids,omitempty"`
+	Fields             []IcebergField `json:"fields"`
+}
+
+// IcebergPartitionSpec is the partition spec of the table,
+// the tables written by the storage sink are always unpartitioned.
+type IcebergPartitionSpec struct {
+	SpecID int   `json:"spec-id"`
+	Fields []any `json:"fields"`
+}
+
+// IcebergSortOrder is the sort order of the table,
+// the tables written by the storage sink are always unsorted.
+type IcebergSortOrder struct {
+	OrderID int   `json:"order-id"`
+	Fields  []any `json:"fields"`
+}
+
+// IcebergSnapshot is one snapshot of the table.
+type IcebergSnapshot struct {
+	SnapshotID       int64             `json:"snapshot-id"`
+	ParentSnapshotID *int64            `json:"parent-snapshot-id,omitempty"`
+	SequenceNumber   int64             `json:"sequence-number"`
+	TimestampMs      int64             `json:"timestamp-ms"`
+	ManifestList     string            `json:"manifest-list"`
+	Summary          map[string]string `json:"summary"`
+	SchemaID         int               `json:"schema-id"`
+}
+
+// IcebergSnapshotLogEntry records when a snapshot became the current snapshot.
+type IcebergSnapshotLogEntry struct {
+	SnapshotID  int64 `json:"snapshot-id"`
+	TimestampMs int64 `json:"timestamp-ms"`
+}
+
+// IcebergMetadataLogEntry records a previous metadata file of the table.
+type IcebergMetadataLogEntry struct {
+	MetadataFile string `json:"metadata-file"`
+	TimestampMs  int64  `json:"timestamp-ms"`
+}
+
+// IcebergTableMetadata is the content of a v<version>.metadata.json file.
+type IcebergTableMetadata struct {
+	FormatVersion      int                       `json:"format-version"`
+	TableUUID          string                    `json:"table-uuid"`
+	Location           string                    `json:"location"`
+	LastSequenceNumber int64                     `json:"last-sequence-number"`
+	LastUpdatedMs      int64                     `json:"last-updated-ms"`
+	LastColumnID       int                       `json:"last-column-id"`
+	CurrentSchemaID    int                       `json:"current-schema-id"`
+	Schemas            []IcebergSchema           `json:"schemas"`
+	DefaultSpecID      int                       `json:"default-spec-id"`
+	PartitionSpecs     []IcebergPartitionSpec    `json:"partition-specs"`
+	LastPartitionID    int                       `json:"last-partition-id"`
+	DefaultSortOrderID int                       `json:"default-sort-order-id"`
+	SortOrders         []IcebergSortOrder        `json:"sort-orders"`
+	Properties         map[string]string         `json:"properties,omitempty"`
+	CurrentSnapshotID  *int64                    `json:"current-snapshot-id"`
+	Snapshots          []IcebergSnapshot         `json:"snapshots"`
+	SnapshotLog        []IcebergSnapshotLogEntry `json:"snapshot-log"`
+	MetadataLog        []IcebergMetadataLogEntry `json:"metadata-log"`
+}
+
+// IcebergDataFile is a data file referenced by a manifest.
+type IcebergDataFile struct {
+	Content         int    `json:"content"`
+	FilePath        string `json:"file-path"`
+	FileFormat      string `json:"file-format"`
+	RecordCount     int64  `json:"record-count"`
+	FileSizeInBytes int64  `json:"file-size-in-bytes"`
+}
+
+// IcebergManifestEntry is one entry of a manifest.
+type IcebergManifestEntry struct {
+	Status         int
+	SnapshotID     int64
+	SequenceNumber int64
+	DataFile       IcebergDataFile
+}
+
+// IcebergManifestFile is one entry of a manifest list.
+type IcebergManifestFile struct {
+	ManifestPath    string
+	ManifestLength  int64
+	PartitionSpecID int
+	Content         int
+	SequenceNumber  int64
+	AddedSnapshotID int64
+	AddedFilesCount int
+	AddedRowsCount  int64
+}
+
+// IcebergColumn is one column of the data files committed into the table.
+type IcebergColumn struct {
+	Name string `json:"name"`
+	// Type is the iceberg type which matches the type of the column in the data files.
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+	// Identifier is true if the column is a part of the primary key.
+	Identifier bool `json:"identifier,omitempty"`
+}
+
+// IcebergCommit describes the data files appended to a table by one snapshot.
+type IcebergCommit struct {
+	// Columns are the columns of the data files, in the order of the file schema.
+	Columns []IcebergColumn
+	// SchemaFile is the path of the schema file of the data files.
+	SchemaFile string
+	// DataFiles are the data files appended by the snapshot, the paths are
+	// relative to the root of the storage.
+	DataFiles []IcebergDataFile
+	// CheckpointTs is the checkpoint which all rows of the table are visible before.
+	CheckpointTs uint64
+}
+
+// IcebergMetadataDir returns the directory of the iceberg metadata of a table.
+func IcebergMetadataDir(schema, table string, tableID int64, useTableIDAsPath bool) string {
+	tablePathPart := generateTablePath(table, tableID, useTableIDAsPath)
+	if useTableIDAsPath {
+		return path.Join(tablePathPart, icebergMetadataDirName)
+	}
+	return path.Join(schema, tablePathPart, icebergMetadataDirName)
+}
+
+// LoadIcebergTableMetadata loads the current metadata of the table,
+// it returns nil and version 0 if the table has not been committed yet.
+func LoadIcebergTableMetadata(
+	ctx context.Context, storage storeapi.Storage, metadataDir string,
+) (*IcebergTableMetadata, int, error) {
+	hintPath := path.Join(metadataDir, icebergVersionHintFile)
+	exist, err := storage.FileExists(ctx, hintPath)
+	if err != nil || !exist {
+		return nil, 0, err
+	}
+	data, err := storage.ReadFile(ctx, hintPath)
+	if err != nil {
+		return nil, 0, err
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, 0, errors.WrapError(errors.ErrStorageSinkTableFormatCommitFailed, err)
+	}
+	data, err = storage.ReadFile(ctx, path.Join(metadataDir, fmt.Sprintf(icebergMetadataFileFmt, version)))
+	if err != nil {
+		return nil, 0, err
+	}
+	metadata := &IcebergTableMetadata{}
+	if err = json.Unmarshal(data, metadata); err != nil {
+		return nil, 0, errors.WrapError(errors.ErrUnmarshalFailed, err)
+	}
+	return metadata, version, nil
+}
+
+// LoadIcebergSnapshotDataFiles returns the data files added by the current
+// snapshot of the table, the paths are relative to the root of the storage.
+func LoadIcebergSnapshotDataFiles(
+	ctx context.Context, storage storeapi.Storage, metadataDir string,
+) (map[string]struct{}, error) {
+	metadata, _, err := LoadIcebergTableMetadata(ctx, storage, metadataDir)
+	if err != nil || metadata == nil || metadata.CurrentSnapshotID == nil {
+		return nil, err
+	}
+	root := strings.TrimSuffix(storage.URI(), "/")
+	snapshot := metadata.snapshot(*metadata.CurrentSnapshotID)
+	manifests, err := loadIcebergManifestList(ctx, storage, root, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string]struct{})
+	for _, manifest := range manifests {
+		if manifest.AddedSnapshotID != snapshot.SnapshotID {
+			continue
+		}
+		data, err := storage.ReadFile(ctx, strings.TrimPrefix(manifest.ManifestPath, root+"/"))
+		if err != nil {
+			return nil, err
+		}
+		entries, err := decodeIcebergManifest(data)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			files[strings.TrimPrefix(entry.DataFile.FilePath, root+"/")] = struct{}{}
+		}
+	}
+	return files, nil
+}
+
+// CommitIcebergSnapshot appends the data files to the table as a new snapshot.
+// The manifest and the manifest list are written first, then the new metadata
+// file, and the version hint is updated at last, so a reader never observes
+// a snapshot that references missing files.
+func CommitIcebergSnapshot(
+	ctx context.Context,
+	storage storeapi.Storage,
+	metadataDir string,
+	commit *IcebergCommit,
+) (*IcebergSnapshot, error) {
+	metadata, version, err := LoadIcebergTableMetadata(ctx, storage, metadataDir)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	root := strings.TrimSuffix(storage.URI(), "/")
+	if metadata == nil {
+		metadata = newIcebergTableMetadata(root + "/" + path.Dir(metadataDir))
+	}
+	schemaID := metadata.addSchema(commit.Columns)
+
+	var parent *IcebergSnapshot
+	snapshotID := int64(commit.CheckpointTs)
+	if metadata.CurrentSnapshotID != nil {
+		parent = metadata.snapshot(*metadata.CurrentSnapshotID)
+		if snapshotID <= *metadata.CurrentSnapshotID {
+			snapshotID = *metadata.CurrentSnapshotID + 1
+		}
+	}
+	sequenceNumber := metadata.LastSequenceNumber + 1
+
+	var (
+		entries   []IcebergManifestEntry
+		addedRows int64
+	)
+	for _, dataFile := range commit.DataFiles {
+		dataFile.Content = icebergContentData
+		dataFile.FilePath = root + "/" + dataFile.FilePath
+		entries = append(entries, IcebergManifestEntry{
+			Status:         icebergEntryStatusAdded,
+			SnapshotID:     snapshotID,
+			SequenceNumber: sequenceNumber,
+			DataFile:       dataFile,
+		})
+		addedRows += dataFile.RecordCount
+	}
+	manifestPath := path.Join(metadataDir, fmt.Sprintf(icebergManifestFileFmt, snapshotID))
+	manifestData, err := encodeIcebergManifest(metadata.schema(schemaID), entries)
+	if err != nil {
+		return nil, err
+	}
+	if err = storage.WriteFile(ctx, manifestPath, manifestData); err != nil {
+		return nil, err
+	}
+
+	// the manifest list of the new snapshot includes all manifests of the parent.
+	var manifests []IcebergManifestFile
+	if parent != nil {
+		manifests, err = loadIcebergManifestList(ctx, storage, root, parent)
+		if err != nil {
+			return nil, err
+		}
+	}
+	manifests = append(manifests, IcebergManifestFile{
+		ManifestPath:    root + "/" + manifestPath,
+		ManifestLength:  int64(len(manifestData)),
+		Content:         icebergContentData,
+		SequenceNumber:  sequenceNumber,
+		AddedSnapshotID: snapshotID,
+		AddedFilesCount: len(commit.DataFiles),
+		AddedRowsCount:  addedRows,
+	})
+	manifestListPath := path.Join(metadataDir, fmt.Sprintf(icebergManifestListFmt, snapshotID))
+	manifestListData, err := encodeIcebergManifestList(snapshotID, parent, sequenceNumber, manifests)
+	if err != nil {
+		return nil, err
+	}
+	if err = storage.WriteFile(ctx, manifestListPath, manifestListData); err != nil {
+		return nil, err
+	}
+
+	snapshot := IcebergSnapshot{
+		SnapshotID:     snapshotID,
+		SequenceNumber: sequenceNumber,
+		TimestampMs:    now,
+		ManifestList:   root + "/" + manifestListPath,
+		SchemaID:       schemaID,
+		Summary: map[string]string{
+			"operation":              icebergOperationAppend,
+			"added-data-files":       strconv.Itoa(len(commit.DataFiles)),
+			"added-records":          strconv.FormatInt(addedRows, 10),
+			icebergSummaryCheckpoint: strconv.FormatUint(commit.CheckpointTs, 10),
+			icebergSummarySchemaFile: commit.SchemaFile,
+		},
+	}
+	if parent != nil {
+		snapshot.ParentSnapshotID = &parent.SnapshotID
+	}
+	if version > 0 {
+		metadata.MetadataLog = append(metadata.MetadataLog, IcebergMetadataLogEntry{
+			MetadataFile: root + "/" + path.Join(metadataDir, fmt.Sprintf(icebergMetadataFileFmt, version)),
+			TimestampMs:  metadata.LastUpdatedMs,
+		})
+	}
+	metadata.Snapshots = append(metadata.Snapshots, snapshot)
+	metadata.SnapshotLog = append(metadata.SnapshotLog, IcebergSnapshotLogEntry{
+		SnapshotID:  snapshotID,
+		TimestampMs: now,
+	})
+	metadata.CurrentSnapshotID = &snapshot.SnapshotID
+	metadata.LastSequenceNumber = sequenceNumber
+	metadata.LastUpdatedMs = now
+
+	version++
+	metadataPath := path.Join(metadataDir, fmt.Sprintf(icebergMetadataFileFmt, version))
+	exist, err := storage.FileExists(ctx, metadataPath)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		return nil, errors.ErrStorageSinkTableFormatCommitFailed.GenWithStack(
+			"iceberg metadata file %s is committed concurrently", metadataPath)
+	}
+	if err = storage.WriteFile(ctx, metadataPath, mustMarshalIcebergFile(metadata)); err != nil {
+		return nil, err
+	}
+	if err = storage.WriteFile(ctx, path.Join(metadataDir, icebergVersionHintFile),
+		[]byte(strconv.Itoa(version))); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+func newIcebergTableMetadata(location string) *IcebergTableMetadata {
+	return &IcebergTableMetadata{
+		FormatVersion:   icebergFormatVersion,
+		TableUUID:       uuid.NewString(),
+		Location:        location,
+		CurrentSchemaID: -1,
+		PartitionSpecs:  []IcebergPartitionSpec{{SpecID: 0, Fields: []any{}}},
+		LastPartitionID: icebergUnpartitionedID,
+		SortOrders:      []IcebergSortOrder{{OrderID: 0, Fields: []any{}}},
+		Properties: map[string]string{
+			"write.format.default": "parquet",
+		},
+	}
+}
+
+// addSchema makes the schema built from the columns the current schema and
+// returns its id. The field ids are kept for the columns with the same name
+// and type, so that the data files written before a DDL are still readable.
+func (m *IcebergTableMetadata) addSchema(columns []IcebergColumn) int {
+	current := m.schema(m.CurrentSchemaID)
+	schema := IcebergSchema{Type: "struct"}
+	for _, col := range columns {
+		field := IcebergField{
+			Name:     col.Name,
+			Required: col.Required,
+			Type:     col.Type,
+		}
+		if current != nil {
+			for _, f := range current.Fields {
+				if f.Name == field.Name && f.Type == field.Type {
+					field.ID = f.ID
+					break
+				}
+			}
+		}
+		if field.ID == 0 {
+			m.LastColumnID++
+			field.ID = m.LastColumnID
+		}
+		if col.Identifier && field.Required {
+			schema.IdentifierFieldIDs = append(schema.IdentifierFieldIDs, field.ID)
+		}
+		schema.Fields = append(schema.Fields, field)
+	}
+
+	if current == nil || !sameIcebergFields(current, &schema) {
+		schema.SchemaID = len(m.Schemas)
+		m.Schemas = append(m.Schemas, schema)
+		m.CurrentSchemaID = schema.SchemaID
+		current = &m.Schemas[schema.SchemaID]
+	}
+	if m.Properties == nil {
+		m.Properties = make(map[string]string)
+	}
+	m.Properties[icebergNameMappingProperty] = icebergNameMapping(current)
+	return current.SchemaID
+}
+
+// icebergNameMapping builds the name mapping of the schema, which is used by
+// the readers to resolve the field ids of the parquet columns by their names.
+func icebergNameMapping(schema *IcebergSchema) string {
+	type mappedField struct {
+		FieldID int      `json:"field-id"`
+		Names   []string `json:"names"`
+	}
+	mapping := make([]mappedField, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		mapping = append(mapping, mappedField{FieldID: field.ID, Names: []string{field.Name}})
+	}
+	data, err := json.Marshal(mapping)
+	if err != nil {
+		log.Panic("marshal the iceberg name mapping failed, this should not happen",
+			zap.Any("schema", schema), zap.Error(err))
+	}
+	return string(data)
+}
+
+func (m *IcebergTableMetadata) schema(schemaID int) *IcebergSchema {
+	for idx := range m.Schemas {
+		if m.Schemas[idx].SchemaID == schemaID {
+			return &m.Schemas[idx]
+		}
+	}
+	return nil
+}
+
+func (m *IcebergTableMetadata) snapshot(snapshotID int64) *IcebergSnapshot {
+	for idx := range m.Snapshots {
+		if m.Snapshots[idx].SnapshotID == snapshotID {
+			return &m.Snapshots[idx]
+		}
+	}
+	return nil
+}
+
+func sameIcebergFields(a, b *IcebergSchema) bool {
+	if len(a.Fields) != len(b.Fields) {
+		return false
+	}
+	for idx := range a.Fields {
+		if a.Fields[idx] != b.Fields[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+func mustMarshalIcebergFile(v any) []byte {
+	data, err := json.MarshalIndent(v, marshalPrefix, marshalIndent)
+	if err != nil {
+		log.Panic("marshal the iceberg metadata failed, this should not happen",
+			zap.Any("metadata", v), zap.Error(err))
+	}
+	return data
+}
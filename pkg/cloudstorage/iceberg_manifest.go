@@ -0,0 +1,259 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/tidb/pkg/objstore/storeapi"
+	"go.uber.org/zap"
+)
+
+// icebergManifestEntrySchema is the avro schema of the manifest entries of an
+// unpartitioned table in the format version 2, the field ids follow the spec.
+const icebergManifestEntrySchema = `{
+  "type": "record",
+  "name": "manifest_entry",
+  "fields": [
+    {"name": "status", "type": "int", "field-id": 0},
+    {"name": "snapshot_id", "type": ["null", "long"], "default": null, "field-id": 1},
+    {"name": "sequence_number", "type": ["null", "long"], "default": null, "field-id": 3},
+    {"name": "file_sequence_number", "type": ["null", "long"], "default": null, "field-id": 4},
+    {"name": "data_file", "field-id": 2, "type": {
+      "type": "record",
+      "name": "r2",
+      "fields": [
+        {"name": "content", "type": "int", "field-id": 134},
+        {"name": "file_path", "type": "string", "field-id": 100},
+        {"name": "file_format", "type": "string", "field-id": 101},
+        {"name": "partition", "field-id": 102, "type": {"type": "record", "name": "r102", "fields": []}},
+        {"name": "record_count", "type": "long", "field-id": 103},
+        {"name": "file_size_in_bytes", "type": "long", "field-id": 104}
+      ]
+    }}
+  ]
+}`
+
+// icebergManifestFileSchema is the avro schema of the manifest list entries
+// in the format version 2, the field ids follow the spec.
+const icebergManifestFileSchema = `{
+  "type": "record",
+  "name": "manifest_file",
+  "fields": [
+    {"name": "manifest_path", "type": "string", "field-id": 500},
+    {"name": "manifest_length", "type": "long", "field-id": 501},
+    {"name": "partition_spec_id", "type": "int", "field-id": 502},
+    {"name": "content", "type": "int", "field-id": 517},
+    {"name": "sequence_number", "type": "long", "field-id": 515},
+    {"name": "min_sequence_number", "type": "long", "field-id": 516},
+    {"name": "added_snapshot_id", "type": "long", "field-id": 503},
+    {"name": "added_files_count", "type": "int", "field-id": 504},
+    {"name": "existing_files_count", "type": "int", "field-id": 505},
+    {"name": "deleted_files_count", "type": "int", "field-id": 506},
+    {"name": "added_rows_count", "type": "long", "field-id": 512},
+    {"name": "existing_rows_count", "type": "long", "field-id": 513},
+    {"name": "deleted_rows_count", "type": "long", "field-id": 514}
+  ]
+}`
+
+var (
+	icebergManifestEntryCodec = mustNewIcebergCodec(icebergManifestEntrySchema)
+	icebergManifestFileCodec  = mustNewIcebergCodec(icebergManifestFileSchema)
+)
+
+func mustNewIcebergCodec(schema string) *goavro.Codec {
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		log.Panic("create the iceberg avro codec failed, this should not happen",
+			zap.String("schema", schema), zap.Error(err))
+	}
+	return codec
+}
+
+// encodeIcebergManifest encodes the manifest entries into an avro file,
+// the metadata of the file describes the table schema and the partition spec.
+func encodeIcebergManifest(schema *IcebergSchema, entries []IcebergManifestEntry) ([]byte, error) {
+	schemaData, err := json.Marshal(schema)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrMarshalFailed, err)
+	}
+	records := make([]any, 0, len(entries))
+	for _, entry := range entries {
+		records = append(records, map[string]any{
+			"status":               int32(entry.Status),
+			"snapshot_id":          goavro.Union("long", entry.SnapshotID),
+			"sequence_number":      goavro.Union("long", entry.SequenceNumber),
+			"file_sequence_number": goavro.Union("long", entry.SequenceNumber),
+			"data_file": map[string]any{
+				"content":            int32(entry.DataFile.Content),
+				"file_path":          entry.DataFile.FilePath,
+				"file_format":        entry.DataFile.FileFormat,
+				"partition":          map[string]any{},
+				"record_count":       entry.DataFile.RecordCount,
+				"file_size_in_bytes": entry.DataFile.FileSizeInBytes,
+			},
+		})
+	}
+	return encodeIcebergAvroFile(icebergManifestEntryCodec, map[string][]byte{
+		"schema":            schemaData,
+		"schema-id":         []byte(strconv.Itoa(schema.SchemaID)),
+		"partition-spec":    []byte("[]"),
+		"partition-spec-id": []byte("0"),
+		"format-version":    []byte(strconv.Itoa(icebergFormatVersion)),
+		"content":           []byte("data"),
+	}, records)
+}
+
+// encodeIcebergManifestList encodes the manifest list of a snapshot into an avro file.
+func encodeIcebergManifestList(
+	snapshotID int64, parent *IcebergSnapshot, sequenceNumber int64, manifests []IcebergManifestFile,
+) ([]byte, error) {
+	records := make([]any, 0, len(manifests))
+	for _, manifest := range manifests {
+		records = append(records, map[string]any{
+			"manifest_path":        manifest.ManifestPath,
+			"manifest_length":      manifest.ManifestLength,
+			"partition_spec_id":    int32(manifest.PartitionSpecID),
+			"content":              int32(manifest.Content),
+			"sequence_number":      manifest.SequenceNumber,
+			"min_sequence_number":  manifest.SequenceNumber,
+			"added_snapshot_id":    manifest.AddedSnapshotID,
+			"added_files_count":    int32(manifest.AddedFilesCount),
+			"existing_files_count": int32(0),
+			"deleted_files_count":  int32(0),
+			"added_rows_count":     manifest.AddedRowsCount,
+			"existing_rows_count":  int64(0),
+			"deleted_rows_count":   int64(0),
+		})
+	}
+	parentSnapshotID := "null"
+	if parent != nil {
+		parentSnapshotID = strconv.FormatInt(parent.SnapshotID, 10)
+	}
+	return encodeIcebergAvroFile(icebergManifestFileCodec, map[string][]byte{
+		"snapshot-id":        []byte(strconv.FormatInt(snapshotID, 10)),
+		"parent-snapshot-id": []byte(parentSnapshotID),
+		"sequence-number":    []byte(strconv.FormatInt(sequenceNumber, 10)),
+		"format-version":     []byte(strconv.Itoa(icebergFormatVersion)),
+	}, records)
+}
+
+func encodeIcebergAvroFile(codec *goavro.Codec, metadata map[string][]byte, records []any) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	writer, err := goavro.NewOCFWriter(goavro.OCFConfig{
+		W:               buf,
+		Codec:           codec,
+		CompressionName: goavro.CompressionDeflateLabel,
+		MetaData:        metadata,
+	})
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrStorageSinkTableFormatCommitFailed, err)
+	}
+	if len(records) > 0 {
+		if err = writer.Append(records); err != nil {
+			return nil, errors.WrapError(errors.ErrStorageSinkTableFormatCommitFailed, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// loadIcebergManifestList reads the manifest list of the snapshot.
+func loadIcebergManifestList(
+	ctx context.Context, storage storeapi.Storage, root string, snapshot *IcebergSnapshot,
+) ([]IcebergManifestFile, error) {
+	data, err := storage.ReadFile(ctx, strings.TrimPrefix(snapshot.ManifestList, root+"/"))
+	if err != nil {
+		return nil, err
+	}
+	records, err := decodeIcebergAvroFile(data)
+	if err != nil {
+		return nil, err
+	}
+	manifests := make([]IcebergManifestFile, 0, len(records))
+	for _, record := range records {
+		manifests = append(manifests, IcebergManifestFile{
+			ManifestPath:    record["manifest_path"].(string),
+			ManifestLength:  record["manifest_length"].(int64),
+			PartitionSpecID: int(record["partition_spec_id"].(int32)),
+			Content:         int(record["content"].(int32)),
+			SequenceNumber:  record["sequence_number"].(int64),
+			AddedSnapshotID: record["added_snapshot_id"].(int64),
+			AddedFilesCount: int(record["added_files_count"].(int32)),
+			AddedRowsCount:  record["added_rows_count"].(int64),
+		})
+	}
+	return manifests, nil
+}
+
+// decodeIcebergManifest decodes the entries of a manifest file.
+func decodeIcebergManifest(data []byte) ([]IcebergManifestEntry, error) {
+	records, err := decodeIcebergAvroFile(data)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]IcebergManifestEntry, 0, len(records))
+	for _, record := range records {
+		dataFile := record["data_file"].(map[string]any)
+		entries = append(entries, IcebergManifestEntry{
+			Status:         int(record["status"].(int32)),
+			SnapshotID:     icebergOptionalLong(record["snapshot_id"]),
+			SequenceNumber: icebergOptionalLong(record["sequence_number"]),
+			DataFile: IcebergDataFile{
+				Content:         int(dataFile["content"].(int32)),
+				FilePath:        dataFile["file_path"].(string),
+				FileFormat:      dataFile["file_format"].(string),
+				RecordCount:     dataFile["record_count"].(int64),
+				FileSizeInBytes: dataFile["file_size_in_bytes"].(int64),
+			},
+		})
+	}
+	return entries, nil
+}
+
+func decodeIcebergAvroFile(data []byte) ([]map[string]any, error) {
+	reader, err := goavro.NewOCFReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrUnmarshalFailed, err)
+	}
+	var records []map[string]any
+	for reader.Scan() {
+		record, err := reader.Read()
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrUnmarshalFailed, err)
+		}
+		records = append(records, record.(map[string]any))
+	}
+	if err = reader.Err(); err != nil {
+		return nil, errors.WrapError(errors.ErrUnmarshalFailed, err)
+	}
+	return records, nil
+}
+
+// icebergOptionalLong returns the value of an optional long field, which is
+// decoded as a map from the type name to the value, or nil for null.
+func icebergOptionalLong(v any) int64 {
+	if union, ok := v.(map[string]any); ok {
+		if value, ok := union["long"].(int64); ok {
+			return value
+		}
+	}
+	return 0
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudstorage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIcebergMetadataDir(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "test/t1/metadata", IcebergMetadataDir("test", "t1", 100, false))
+	require.Equal(t, "100/metadata", IcebergMetadataDir("test", "t1", 100, true))
+}
+
+func TestCommitIcebergSnapshot(t *testing.T) {
+	ctx := context.Background()
+	storage, err := util.GetExternalStorageWithDefaultTimeout(ctx, fmt.Sprintf("file:///%s", t.TempDir()))
+	require.NoError(t, err)
+	defer storage.Close()
+
+	metadataDir := IcebergMetadataDir("test", "t1", 100, false)
+	metadata, version, err := LoadIcebergTableMetadata(ctx, storage, metadataDir)
+	require.NoError(t, err)
+	require.Nil(t, metadata)
+	require.Equal(t, 0, version)
+
+	columns := []IcebergColumn{
+		{Name: "id", Type: "int", Required: true, Identifier: true},
+		{Name: "a", Type: "decimal(10, 2)"},
+		{Name: "b", Type: "decimal(20, 0)"},
+	}
+	snapshot, err := CommitIcebergSnapshot(ctx, storage, metadataDir, &IcebergCommit{
+		Columns:    columns,
+		SchemaFile: "test/t1/meta/schema_100_0000000001.json",
+		DataFiles: []IcebergDataFile{
+			{FilePath: "test/t1/100/CDC000001.parquet", FileFormat: "PARQUET", RecordCount: 2, FileSizeInBytes: 10},
+			{FilePath: "test/t1/100/CDC000002.parquet", FileFormat: "PARQUET", RecordCount: 3, FileSizeInBytes: 20},
+		},
+		CheckpointTs: 1000,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, snapshot.SnapshotID)
+	require.Nil(t, snapshot.ParentSnapshotID)
+	require.Equal(t, "5", snapshot.Summary["added-records"])
+	require.Equal(t, "1000", snapshot.Summary[icebergSummaryCheckpoint])
+
+	// add a column and commit again with the same checkpoint.
+	columns = append(columns, IcebergColumn{Name: "c", Type: "string"})
+	snapshot, err = CommitIcebergSnapshot(ctx, storage, metadataDir, &IcebergCommit{
+		Columns:    columns,
+		SchemaFile: "test/t1/meta/schema_200_0000000002.json",
+		DataFiles: []IcebergDataFile{
+			{FilePath: "test/t1/200/CDC000001.parquet", FileFormat: "PARQUET", RecordCount: 1, FileSizeInBytes: 5},
+		},
+		CheckpointTs: 1000,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1001, snapshot.SnapshotID)
+	require.EqualValues(t, 1000, *snapshot.ParentSnapshotID)
+
+	metadata, version, err = LoadIcebergTableMetadata(ctx, storage, metadataDir)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+	require.Equal(t, storage.URI()+"/test/t1", metadata.Location)
+	require.Len(t, metadata.Snapshots, 2)
+	require.Len(t, metadata.MetadataLog, 1)
+	require.EqualValues(t, 1001, *metadata.CurrentSnapshotID)
+	require.Len(t, metadata.Schemas, 2)
+	require.Equal(t, 1, metadata.CurrentSchemaID)
+	require.Equal(t, 4, metadata.LastColumnID)
+	require.Equal(t, []IcebergField{
+		{ID: 1, Name: "id", Required: true, Type: "int"},
+		{ID: 2, Name: "a", Type: "decimal(10, 2)"},
+		{ID: 3, Name: "b", Type: "decimal(20, 0)"},
+		{ID: 4, Name: "c", Type: "string"},
+	}, metadata.Schemas[1].Fields)
+	require.Equal(t, []int{1}, metadata.Schemas[1].IdentifierFieldIDs)
+
+	require.JSONEq(t,
+		`[{"field-id":1,"names":["id"]},{"field-id":2,"names":["a"]},{"field-id":3,"names":["b"]},{"field-id":4,"names":["c"]}]`,
+		metadata.Properties[icebergNameMappingProperty])
+
+	// the manifest list of the current snapshot includes the manifests of all snapshots.
+	manifests, err := loadIcebergManifestList(ctx, storage, storage.URI(), metadata.snapshot(1001))
+	require.NoError(t, err)
+	require.Len(t, manifests, 2)
+	require.EqualValues(t, 5, manifests[0].AddedRowsCount)
+	require.EqualValues(t, 1000, manifests[0].AddedSnapshotID)
+	require.EqualValues(t, 1, manifests[1].AddedRowsCount)
+	require.Equal(t, storage.URI()+"/"+path.Join(metadataDir, "manifest-1000.avro"), manifests[0].ManifestPath)
+
+	data, err := storage.ReadFile(ctx, path.Join(metadataDir, "manifest-1000.avro"))
+	require.NoError(t, err)
+	reader, err := goavro.NewOCFReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, "0", string(reader.MetaData()["schema-id"]))
+	require.Equal(t, "data", string(reader.MetaData()["content"]))
+	entries, err := decodeIcebergManifest(data)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.EqualValues(t, 1000, entries[0].SnapshotID)
+	require.Equal(t, storage.URI()+"/test/t1/100/CDC000001.parquet", entries[0].DataFile.FilePath)
+	require.EqualValues(t, 3, entries[1].DataFile.RecordCount)
+
+	// only the data files added by the current snapshot are returned.
+	files, err := LoadIcebergSnapshotDataFiles(ctx, storage, metadataDir)
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"test/t1/200/CDC000001.parquet": {}}, files)
+
+	// a metadata file committed by others is detected.
+	require.NoError(t, storage.WriteFile(ctx, path.Join(metadataDir, "v3.metadata.json"), []byte("{}")))
+	_, err = CommitIcebergSnapshot(ctx, storage, metadataDir, &IcebergCommit{
+		Columns:      columns,
+		CheckpointTs: 2000,
+	})
+	require.True(t, errors.ErrStorageSinkTableFormatCommitFailed.Equal(err))
+}
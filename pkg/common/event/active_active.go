@@ -395,6 +395,7 @@ func newFilteredDMLEvent(
 	newEvent.Seq = source.Seq
 	newEvent.Epoch = source.Epoch
 	newEvent.ReplicatingTs = source.ReplicatingTs
+	newEvent.IsSnapshot = source.IsSnapshot
 	newEvent.PostTxnEnqueued = source.PostTxnEnqueued
 	newEvent.PostTxnFlushed = source.PostTxnFlushed
 	newEvent.postEnqueueCalled.Store(source.postEnqueueCalled.Load())
@@ -120,6 +120,10 @@ type DDLEvent struct {
 	// UnmarshalJSON compatibility so both `not_sync` and legacy `NotSync`
 	// are interoperable in mixed-version deployment.
 	NotSync bool `json:"not_sync"`
+	// NeedApproval is set by the dispatcher when the DDL matches a pause ddl
+	// policy, the DDL is blocked at the barrier until an operator approves or
+	// skips it.
+	NeedApproval bool `json:"-"`
 
 	// IndexIDs store the add index ids in SQL order for add index and multi schema change DDLs.
 	// MySQL sink uses them to recover anonymous index names.
@@ -430,6 +430,10 @@ type DMLEvent struct {
 	// and TiCDC set the integrity check level to the correctness.
 	Checksum       []*integrity.Checksum `json:"-"`
 	checksumOffset int                   `json:"-"`
+
+	// IsSnapshot indicates the rows are read from the initial snapshot of the table,
+	// instead of the changes committed by the upstream transactions.
+	IsSnapshot bool `json:"is_snapshot"`
 }
 
 // NewDMLEvent creates a new DMLEvent with the given parameters
@@ -893,6 +897,7 @@ func (t *DMLEvent) encodeV1() ([]byte, error) {
 	for i := 0; i < len(t.RowKeys); i++ {
 		size += 4 + len(t.RowKeys[i]) // size + contents of t.RowKeys[i]
 	}
+	size++ // IsSnapshot
 
 	// Allocate a buffer with the calculated size
 	buf := make([]byte, size)
@@ -944,6 +949,11 @@ func (t *DMLEvent) encodeV1() ([]byte, error) {
 		copy(buf[offset:], rowKey)
 		offset += len(rowKey)
 	}
+	// IsSnapshot is appended at the end, so the payload can still be decoded
+	// by the old versions which ignore the trailing bytes.
+	if t.IsSnapshot {
+		buf[offset] = 1
+	}
 	return buf, nil
 }
 
@@ -1000,6 +1010,10 @@ func (t *DMLEvent) decodeV1(data []byte) error {
 		copy(t.RowKeys[i], data[offset:offset+int(len)])
 		offset += int(len)
 	}
+	// The payload encoded by the old versions does not have the IsSnapshot field.
+	if offset < len(data) {
+		t.IsSnapshot = data[offset] == 1
+	}
 	return nil
 }
 
@@ -281,6 +281,23 @@ func TestEncodeAnddecodeV1(t *testing.T) {
 	require.Equal(t, dmlEvent, reverseEvent)
 }
 
+func TestEncodeAndDecodeSnapshotFlag(t *testing.T) {
+	dmlEvent := NewDMLEvent(common.NewDispatcherID(), 1, 100, 100, nil)
+	dmlEvent.IsSnapshot = true
+	data, err := dmlEvent.encodeV1()
+	require.NoError(t, err)
+
+	reverseEvent := &DMLEvent{Version: DMLEventVersion1}
+	require.NoError(t, reverseEvent.decodeV1(data))
+	require.True(t, reverseEvent.IsSnapshot)
+
+	// The payload encoded by the old versions does not have the trailing flag.
+	reverseEvent = &DMLEvent{Version: DMLEventVersion1}
+	require.NoError(t, reverseEvent.decodeV1(data[:len(data)-1]))
+	require.False(t, reverseEvent.IsSnapshot)
+	require.Equal(t, dmlEvent.CommitTs, reverseEvent.CommitTs)
+}
+
 func TestBatchDMLEventAppendWithDifferentTableInfo(t *testing.T) {
 	helper := NewEventTestHelper(t)
 	defer helper.Close()
@@ -98,6 +98,8 @@ type RowEvent struct {
 	Callback        func()
 
 	Checksum *integrity.Checksum
+	// IsSnapshot indicates the row is read from the initial snapshot of the table.
+	IsSnapshot bool
 }
 
 func (e *RowEvent) IsDelete() bool {
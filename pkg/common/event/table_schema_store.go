@@ -91,7 +91,8 @@ func newTableSchemaStoreRequirements(
 			updateTableIDs: false,
 			needTableNames: true,
 		}
-	case commonType.CloudStorageSinkType, commonType.BlackHoleSinkType:
+	case commonType.CloudStorageSinkType, commonType.BlackHoleSinkType, commonType.ElasticsearchSinkType,
+		commonType.WebhookSinkType, commonType.RedisSinkType:
 		return tableSchemaStoreRequirements{
 			needTableIDs:   false,
 			updateTableIDs: false,
@@ -666,6 +666,7 @@ func (ti *TableInfo) ToTiDBTableInfo() *model.TableInfo {
 		Columns:        ti.columnSchema.Columns,
 		Indices:        ti.columnSchema.Indices,
 		PKIsHandle:     ti.columnSchema.PKIsHandle,
+		IsCommonHandle: ti.columnSchema.IsCommonHandle,
 		IsActiveActive: ti.ActiveActiveTable,
 	}
 	if ti.SoftDeleteTable {
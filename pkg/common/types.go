@@ -344,6 +344,9 @@ const (
 	CloudStorageSinkType
 	BlackHoleSinkType
 	RedoSinkType
+	ElasticsearchSinkType
+	WebhookSinkType
+	RedisSinkType
 )
 
 type RowType byte
@@ -253,6 +253,8 @@ type ChangeFeedInfo struct {
 	StartTs uint64 `json:"start-ts"`
 	// The ChangeFeed will exits until sync to timestamp TargetTs
 	TargetTs uint64 `json:"target-ts"`
+	// StopPolicy stops the changefeed automatically when it fires, nil means no policy.
+	StopPolicy *StopPolicy `json:"stop-policy,omitempty"`
 	// used for admin job notification, trigger watch event in capture
 	AdminJobType AdminJobType `json:"admin-job-type"`
 	Engine       SortEngine   `json:"sort-engine"`
@@ -487,13 +489,16 @@ func (info *ChangeFeedInfo) RmUnusedFields() {
 	if IsBlackHoleScheme(uri.Scheme) {
 		return
 	}
-	if !IsMQScheme(uri.Scheme) {
-		info.rmMQOnlyFields()
+	// the webhook sink encodes the events by the MQ protocols, the elasticsearch
+	// sink uses the topic rule to choose the index name, and the redis sink uses
+	// the topic rule to choose the channel of the invalidation messages.
+	if !IsMQScheme(uri.Scheme) && !IsWebhookScheme(uri.Scheme) {
+		info.rmMQOnlyFields(IsElasticsearchScheme(uri.Scheme) || IsRedisScheme(uri.Scheme))
 	} else {
 		// remove schema registry for MQ downstream with
-		// protocol other than avro or debezium-avro
-		protocol := util.GetOrZero(info.Config.Sink.Protocol)
-		if protocol != ProtocolAvro.String() && protocol != ProtocolDebeziumAvro.String() {
+		// protocol not based on the schema registry
+		protocol, err := ParseSinkProtocolFromString(util.GetOrZero(info.Config.Sink.Protocol))
+		if err != nil || !protocol.IsSchemaRegistryBased() {
 			info.Config.Sink.SchemaRegistry = nil
 		}
 	}
@@ -511,7 +516,7 @@ func (info *ChangeFeedInfo) RmUnusedFields() {
 	}
 }
 
-func (info *ChangeFeedInfo) rmMQOnlyFields() {
+func (info *ChangeFeedInfo) rmMQOnlyFields(keepTopicRule bool) {
 	// Don't nil out DispatchRules entirely - it may contain routing rules (TargetSchema/TargetTable)
 	// Remove only MQ-specific fields from each rule.
 	for _, rule := range info.Config.Sink.DispatchRules {
@@ -522,7 +527,9 @@ func (info *ChangeFeedInfo) rmMQOnlyFields() {
 		rule.PartitionRule = ""
 		rule.IndexName = ""
 		rule.Columns = nil
-		rule.TopicRule = ""
+		if !keepTopicRule {
+			rule.TopicRule = ""
+		}
 	}
 	info.Config.Sink.SchemaRegistry = nil
 	info.Config.Sink.EncoderConcurrency = nil
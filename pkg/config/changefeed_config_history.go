@@ -0,0 +1,153 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+// ChangefeedConfigChange is a changed item between two config revisions of a changefeed.
+// Path is the dot separated json path of the item, like "config.filter.rules".
+type ChangefeedConfigChange struct {
+	Path string `json:"path"`
+	From any    `json:"from"`
+	To   any    `json:"to"`
+}
+
+// ChangefeedConfigRevision is a numbered snapshot of the user specified config of a
+// changefeed. A revision is recorded every time the changefeed is updated by the user,
+// so a bad update can be audited and rolled back.
+type ChangefeedConfigRevision struct {
+	Revision uint64    `json:"revision"`
+	Author   string    `json:"author"`
+	Time     time.Time `json:"time"`
+	// RollbackTo is the revision restored by this revision, 0 means it's a normal update.
+	RollbackTo uint64 `json:"rollback-to,omitempty"`
+
+	SinkURI  string         `json:"sink-uri"`
+	TargetTs uint64         `json:"target-ts"`
+	Config   *ReplicaConfig `json:"config"`
+	// Diff is the changes from the previous revision.
+	Diff []ChangefeedConfigChange `json:"diff,omitempty"`
+}
+
+// NewChangefeedConfigRevision returns a revision which snapshots the config of info.
+func NewChangefeedConfigRevision(info *ChangeFeedInfo, author string) *ChangefeedConfigRevision {
+	rev := &ChangefeedConfigRevision{
+		Author:   author,
+		Time:     time.Now(),
+		SinkURI:  info.SinkURI,
+		TargetTs: info.TargetTs,
+	}
+	if info.Config != nil {
+		rev.Config = info.Config.Clone()
+	}
+	return rev
+}
+
+// ApplyTo overwrites the config of info by the snapshot of the revision.
+func (r *ChangefeedConfigRevision) ApplyTo(info *ChangeFeedInfo) {
+	info.SinkURI = r.SinkURI
+	info.TargetTs = r.TargetTs
+	if r.Config != nil {
+		info.Config = r.Config.Clone()
+	}
+}
+
+// ComputeDiff fills the diff of the revision by comparing its snapshot with prev.
+func (r *ChangefeedConfigRevision) ComputeDiff(prev *ChangefeedConfigRevision) error {
+	from, err := prev.flatten()
+	if err != nil {
+		return err
+	}
+	to, err := r.flatten()
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(to))
+	for path := range from {
+		paths = append(paths, path)
+	}
+	for path := range to {
+		if _, ok := from[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	r.Diff = r.Diff[:0]
+	for _, path := range paths {
+		if !reflect.DeepEqual(from[path], to[path]) {
+			r.Diff = append(r.Diff, ChangefeedConfigChange{Path: path, From: from[path], To: to[path]})
+		}
+	}
+	return nil
+}
+
+// flatten returns the snapshot of the revision as a map from the json path to the value.
+func (r *ChangefeedConfigRevision) flatten() (map[string]any, error) {
+	data, err := json.Marshal(struct {
+		SinkURI  string         `json:"sink-uri"`
+		TargetTs uint64         `json:"target-ts"`
+		Config   *ReplicaConfig `json:"config"`
+	}{r.SinkURI, r.TargetTs, r.Config})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMarshalFailed, err)
+	}
+	var snapshot map[string]any
+	if err = json.Unmarshal(data, &snapshot); err != nil {
+		return nil, cerror.WrapError(cerror.ErrUnmarshalFailed, err)
+	}
+	result := make(map[string]any)
+	flattenJSONValue("", snapshot, result)
+	return result, nil
+}
+
+func flattenJSONValue(prefix string, value any, result map[string]any) {
+	m, ok := value.(map[string]any)
+	if !ok || len(m) == 0 {
+		result[prefix] = value
+		return
+	}
+	for k, v := range m {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		flattenJSONValue(path, v, result)
+	}
+}
+
+// Marshal returns the json marshal format of a ChangefeedConfigRevision
+func (r *ChangefeedConfigRevision) Marshal() (string, error) {
+	data, err := json.Marshal(r)
+	return string(data), cerror.WrapError(cerror.ErrMarshalFailed, err)
+}
+
+// Unmarshal unmarshals into *ChangefeedConfigRevision from json marshal byte slice
+func (r *ChangefeedConfigRevision) Unmarshal(data []byte) error {
+	err := json.Unmarshal(data, r)
+	if err != nil {
+		return errors.Annotatef(
+			cerror.WrapError(cerror.ErrUnmarshalFailed, err), "Unmarshal data: %v", data)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangefeedConfigRevisionDiff(t *testing.T) {
+	info := &ChangeFeedInfo{
+		SinkURI: "blackhole://",
+		Config:  GetDefaultReplicaConfig(),
+	}
+	prev := NewChangefeedConfigRevision(info, "")
+	prev.Revision = 1
+
+	info.SinkURI = "kafka://127.0.0.1:9092/test"
+	info.TargetTs = 100
+	info.Config.Filter.Rules = []string{"test.*"}
+	next := NewChangefeedConfigRevision(info, "root")
+	next.Revision = 2
+	require.NoError(t, next.ComputeDiff(prev))
+	require.Equal(t, []ChangefeedConfigChange{
+		{Path: "config.filter.rules", From: []any{"*.*"}, To: []any{"test.*"}},
+		{Path: "sink-uri", From: "blackhole://", To: "kafka://127.0.0.1:9092/test"},
+		{Path: "target-ts", From: float64(0), To: float64(100)},
+	}, next.Diff)
+
+	// the snapshot is not affected by the later changes of info
+	info.Config.Filter.Rules = []string{"other.*"}
+	require.Equal(t, []string{"test.*"}, next.Config.Filter.Rules)
+
+	// no diff between the same snapshots
+	same := NewChangefeedConfigRevision(info, "root")
+	same.ApplyTo(info)
+	require.NoError(t, same.ComputeDiff(NewChangefeedConfigRevision(info, "")))
+	require.Empty(t, same.Diff)
+
+	// rollback to the first revision
+	prev.ApplyTo(info)
+	require.Equal(t, "blackhole://", info.SinkURI)
+	require.Equal(t, uint64(0), info.TargetTs)
+	require.Equal(t, []string{"*.*"}, info.Config.Filter.Rules)
+	info.Config.Filter.Rules = []string{"other.*"}
+	require.Equal(t, []string{"*.*"}, prev.Config.Filter.Rules)
+}
+
+func TestChangefeedConfigRevisionMarshal(t *testing.T) {
+	rev := NewChangefeedConfigRevision(&ChangeFeedInfo{
+		SinkURI: "blackhole://",
+		Config:  GetDefaultReplicaConfig(),
+	}, "root")
+	rev.Revision = 3
+	rev.RollbackTo = 1
+	data, err := rev.Marshal()
+	require.NoError(t, err)
+
+	decoded := &ChangefeedConfigRevision{}
+	require.NoError(t, decoded.Unmarshal([]byte(data)))
+	require.Equal(t, uint64(3), decoded.Revision)
+	require.Equal(t, uint64(1), decoded.RollbackTo)
+	require.Equal(t, "root", decoded.Author)
+	require.Equal(t, rev.SinkURI, decoded.SinkURI)
+	require.NotNil(t, decoded.Config)
+
+	require.Error(t, decoded.Unmarshal([]byte("invalid")))
+}
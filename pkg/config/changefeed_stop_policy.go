@@ -0,0 +1,199 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+	"time"
+
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+// StopPolicyAction is the action taken when the stop policy of a changefeed fires.
+type StopPolicyAction string
+
+const (
+	// StopPolicyActionFinish moves the changefeed to the finished state, like reaching the target ts.
+	StopPolicyActionFinish StopPolicyAction = "finish"
+	// StopPolicyActionPause moves the changefeed to the stopped state, it can be resumed later.
+	StopPolicyActionPause StopPolicyAction = "pause"
+)
+
+// The reasons of a fired stop policy.
+const (
+	StopReasonTargetTs  = "target-ts-reached"
+	StopReasonDeadline  = "deadline-reached"
+	StopReasonDailyTime = "daily-time-reached"
+	StopReasonSynced    = "synced"
+)
+
+const stopPolicyDailyTimeLayout = "15:04"
+
+// StopPolicy describes when the coordinator stops a changefeed automatically.
+// The policy fires once any of the conditions is met.
+type StopPolicy struct {
+	// TargetTs fires the policy when the checkpoint ts reaches it.
+	TargetTs uint64 `json:"target-ts,omitempty"`
+	// Deadline fires the policy once the wall-clock time passes it.
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// DailyTime fires the policy every day at the time, in the format of "HH:MM".
+	DailyTime string `json:"daily-time,omitempty"`
+	// TimeZone is the time zone of DailyTime, the time zone of the cdc server is used if it's empty.
+	TimeZone string `json:"time-zone,omitempty"`
+	// OnceSynced fires the policy once the changefeed reaches the synced status,
+	// see SyncedStatusConfig for the details.
+	OnceSynced bool `json:"once-synced,omitempty"`
+
+	// Action is the action taken when the policy fires, finish by default.
+	Action StopPolicyAction `json:"action,omitempty"`
+	// WebhookURL is notified by a POST request when the policy fires.
+	WebhookURL string `json:"webhook-url,omitempty"`
+
+	// ArmedAt is the time since when the policy takes effect, it's set when the
+	// policy is created, updated or rearmed. It's persisted with the policy, so the
+	// daily time is not rearmed when the changefeed is reloaded by a new coordinator.
+	ArmedAt *time.Time `json:"armed-at,omitempty"`
+}
+
+// StopPolicyStatus is the runtime status of a changefeed used to evaluate its stop policy.
+type StopPolicyStatus struct {
+	Now time.Time
+	// ArmedAt is the time since when the policy takes effect if it's not persisted
+	// with the policy, it's the time the changefeed was loaded by the coordinator.
+	ArmedAt      time.Time
+	CheckpointTs uint64
+	LastSyncedTs uint64
+	// BootstrapDone is true if the maintainer of the changefeed finishes bootstrapping.
+	BootstrapDone bool
+	SyncedStatus  *SyncedStatusConfig
+}
+
+// Validate checks the stop policy of a changefeed which starts at startTs.
+func (p *StopPolicy) Validate(startTs uint64) error {
+	if p.TargetTs == 0 && p.Deadline == nil && p.DailyTime == "" && !p.OnceSynced {
+		return cerror.ErrInvalidChangefeedStopPolicy.GenWithStackByArgs(
+			"one of target-ts, deadline, daily-time and once-synced must be specified")
+	}
+	if p.TargetTs != 0 && p.TargetTs <= startTs {
+		return cerror.ErrInvalidChangefeedStopPolicy.GenWithStackByArgs(
+			"target-ts must be larger than the start-ts of the changefeed")
+	}
+	if p.DailyTime != "" {
+		if _, err := time.Parse(stopPolicyDailyTimeLayout, p.DailyTime); err != nil {
+			return cerror.ErrInvalidChangefeedStopPolicy.GenWithStackByArgs(
+				"daily-time must be in the format of HH:MM")
+		}
+	}
+	if _, err := p.location(); err != nil {
+		return cerror.WrapError(cerror.ErrInvalidChangefeedStopPolicy, err, "invalid time-zone")
+	}
+	switch p.Action {
+	case "", StopPolicyActionFinish, StopPolicyActionPause:
+	default:
+		return cerror.ErrInvalidChangefeedStopPolicy.GenWithStackByArgs(
+			"action must be one of finish and pause")
+	}
+	if p.WebhookURL != "" {
+		u, err := url.Parse(p.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return cerror.ErrInvalidChangefeedStopPolicy.GenWithStackByArgs(
+				"webhook-url must be a http or https url")
+		}
+	}
+	return nil
+}
+
+// TargetState returns the state of the changefeed after the policy fires.
+func (p *StopPolicy) TargetState() FeedState {
+	if p.Action == StopPolicyActionPause {
+		return StateStopped
+	}
+	return StateFinished
+}
+
+// Evaluate returns the reason if the policy fires, otherwise returns an empty string.
+func (p *StopPolicy) Evaluate(status StopPolicyStatus) string {
+	if p.TargetTs != 0 && status.CheckpointTs >= p.TargetTs {
+		return StopReasonTargetTs
+	}
+	if p.Deadline != nil && !status.Now.Before(*p.Deadline) {
+		return StopReasonDeadline
+	}
+	armedAt := status.ArmedAt
+	if p.ArmedAt != nil {
+		armedAt = *p.ArmedAt
+	}
+	if next, ok := p.nextDailyTime(armedAt); ok && !status.Now.Before(next) {
+		return StopReasonDailyTime
+	}
+	if p.OnceSynced && status.BootstrapDone &&
+		status.SyncedStatus.IsSynced(status.Now.UnixMilli(), status.CheckpointTs, status.LastSyncedTs) {
+		return StopReasonSynced
+	}
+	return ""
+}
+
+// Arm makes the policy take effect from now on.
+func (p *StopPolicy) Arm(now time.Time) {
+	p.ArmedAt = &now
+}
+
+// Rearm returns the policy which takes effect from now on after the changefeed
+// is resumed at checkpointTs. The deadline and the target ts are only reached
+// once, so the passed ones are cleared, otherwise they fire again right after
+// the resume. It returns nil if no condition is left.
+func (p *StopPolicy) Rearm(now time.Time, checkpointTs uint64) *StopPolicy {
+	passedTarget := p.TargetTs != 0 && checkpointTs >= p.TargetTs
+	passedDeadline := p.Deadline != nil && !now.Before(*p.Deadline)
+	rearmed := *p
+	rearmed.Arm(now)
+	if passedTarget {
+		rearmed.TargetTs = 0
+	}
+	if passedDeadline {
+		rearmed.Deadline = nil
+	}
+	if rearmed.TargetTs == 0 && rearmed.Deadline == nil && rearmed.DailyTime == "" && !rearmed.OnceSynced {
+		return nil
+	}
+	return &rearmed
+}
+
+// nextDailyTime returns the first daily time after t.
+func (p *StopPolicy) nextDailyTime(t time.Time) (time.Time, bool) {
+	if p.DailyTime == "" {
+		return time.Time{}, false
+	}
+	clock, err := time.Parse(stopPolicyDailyTimeLayout, p.DailyTime)
+	if err != nil {
+		return time.Time{}, false
+	}
+	loc, err := p.location()
+	if err != nil {
+		return time.Time{}, false
+	}
+	t = t.In(loc)
+	next := time.Date(t.Year(), t.Month(), t.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+	if !next.After(t) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next, true
+}
+
+func (p *StopPolicy) location() (*time.Location, error) {
+	if p.TimeZone == "" {
+		return time.Local, nil
+	}
+	return time.LoadLocation(p.TimeZone)
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestStopPolicyValidate(t *testing.T) {
+	require.Error(t, (&StopPolicy{}).Validate(100))
+	require.Error(t, (&StopPolicy{TargetTs: 100}).Validate(100))
+	require.NoError(t, (&StopPolicy{TargetTs: 101}).Validate(100))
+	require.Error(t, (&StopPolicy{DailyTime: "25:00"}).Validate(100))
+	require.NoError(t, (&StopPolicy{DailyTime: "02:00"}).Validate(100))
+	require.Error(t, (&StopPolicy{DailyTime: "02:00", TimeZone: "Mars/Base"}).Validate(100))
+	require.NoError(t, (&StopPolicy{DailyTime: "02:00", TimeZone: "Asia/Shanghai"}).Validate(100))
+	require.Error(t, (&StopPolicy{OnceSynced: true, Action: "remove"}).Validate(100))
+	require.NoError(t, (&StopPolicy{OnceSynced: true, Action: StopPolicyActionPause}).Validate(100))
+	require.Error(t, (&StopPolicy{OnceSynced: true, WebhookURL: "ftp://127.0.0.1/hook"}).Validate(100))
+	require.NoError(t, (&StopPolicy{OnceSynced: true, WebhookURL: "http://127.0.0.1:8080/hook"}).Validate(100))
+
+	require.Equal(t, StateFinished, (&StopPolicy{}).TargetState())
+	require.Equal(t, StateStopped, (&StopPolicy{Action: StopPolicyActionPause}).TargetState())
+}
+
+func TestStopPolicyEvaluate(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	status := StopPolicyStatus{
+		Now:          now,
+		ArmedAt:      now.Add(-time.Hour),
+		CheckpointTs: oracle.GoTimeToTS(now.Add(-time.Minute)),
+		SyncedStatus: GetDefaultReplicaConfig().SyncedStatus,
+	}
+
+	require.Empty(t, (&StopPolicy{TargetTs: status.CheckpointTs + 1}).Evaluate(status))
+	require.Equal(t, StopReasonTargetTs, (&StopPolicy{TargetTs: status.CheckpointTs}).Evaluate(status))
+
+	deadline := now.Add(time.Second)
+	require.Empty(t, (&StopPolicy{Deadline: &deadline}).Evaluate(status))
+	deadline = now
+	require.Equal(t, StopReasonDeadline, (&StopPolicy{Deadline: &deadline}).Evaluate(status))
+
+	// the policy is armed at 09:00, so it fires at 09:30 but not 02:00 in the same day
+	require.Equal(t, StopReasonDailyTime,
+		(&StopPolicy{DailyTime: "09:30", TimeZone: "UTC"}).Evaluate(status))
+	require.Empty(t, (&StopPolicy{DailyTime: "02:00", TimeZone: "UTC"}).Evaluate(status))
+	require.Empty(t, (&StopPolicy{DailyTime: "10:30", TimeZone: "UTC"}).Evaluate(status))
+	status.Now = now.Add(17 * time.Hour)
+	require.Equal(t, StopReasonDailyTime,
+		(&StopPolicy{DailyTime: "02:00", TimeZone: "UTC"}).Evaluate(status))
+	status.Now = now
+
+	// the checkpoint lags behind too much
+	synced := &StopPolicy{OnceSynced: true}
+	status.BootstrapDone = true
+	require.Empty(t, synced.Evaluate(status))
+	// the data is synced recently
+	status.CheckpointTs = oracle.GoTimeToTS(now.Add(-time.Second))
+	status.LastSyncedTs = oracle.GoTimeToTS(now.Add(-time.Minute))
+	require.Empty(t, synced.Evaluate(status))
+	status.LastSyncedTs = oracle.GoTimeToTS(now.Add(-10 * time.Minute))
+	require.Equal(t, StopReasonSynced, synced.Evaluate(status))
+	// the maintainer is not bootstrapped
+	status.BootstrapDone = false
+	require.Empty(t, synced.Evaluate(status))
+}
+
+func TestStopPolicyRearm(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	passed := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+	armedAt := now.Add(-48 * time.Hour)
+
+	// nothing is passed, the conditions are kept and the policy is rearmed
+	policy := &StopPolicy{TargetTs: 200, Deadline: &future, ArmedAt: &armedAt}
+	rearmed := policy.Rearm(now, 100)
+	require.Equal(t, uint64(200), rearmed.TargetTs)
+	require.Equal(t, &future, rearmed.Deadline)
+	require.Equal(t, now, *rearmed.ArmedAt)
+	// the original policy is not changed
+	require.Equal(t, armedAt, *policy.ArmedAt)
+
+	// the passed target ts is cleared, the deadline is kept
+	policy = &StopPolicy{TargetTs: 100, Deadline: &future, Action: StopPolicyActionPause}
+	rearmed = policy.Rearm(now, 100)
+	require.Zero(t, rearmed.TargetTs)
+	require.Equal(t, &future, rearmed.Deadline)
+	require.Equal(t, StopPolicyActionPause, rearmed.Action)
+	// the original policy is not changed
+	require.Equal(t, uint64(100), policy.TargetTs)
+
+	// the passed deadline is cleared, the daily time is kept
+	policy = &StopPolicy{Deadline: &passed, DailyTime: "02:00", ArmedAt: &armedAt}
+	rearmed = policy.Rearm(now, 100)
+	require.Nil(t, rearmed.Deadline)
+	require.Equal(t, "02:00", rearmed.DailyTime)
+	// the persisted armed time takes precedence over the one of the status
+	require.Equal(t, StopReasonDailyTime, policy.Evaluate(StopPolicyStatus{Now: now, ArmedAt: now, CheckpointTs: 100}))
+	require.Empty(t, rearmed.Evaluate(StopPolicyStatus{Now: now, ArmedAt: armedAt, CheckpointTs: 100}))
+
+	// no condition is left
+	policy = &StopPolicy{TargetTs: 100, Deadline: &passed}
+	require.Nil(t, policy.Rearm(now, 200))
+}
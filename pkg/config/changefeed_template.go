@@ -0,0 +1,217 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+const (
+	// TemplateVarChangefeedID is the built-in variable of the sink uri pattern,
+	// it's replaced by the id of the changefeed created from the template.
+	TemplateVarChangefeedID = "changefeed_id"
+	// TemplateVarKeyspace is the built-in variable of the sink uri pattern,
+	// it's replaced by the keyspace of the changefeed created from the template.
+	TemplateVarKeyspace = "keyspace"
+
+	changefeedTemplateNameMaxLen = 128
+)
+
+var (
+	changefeedTemplateNameRe = regexp.MustCompile(`^[a-zA-Z0-9]+(-[a-zA-Z0-9]+)*$`)
+	// templateVarRe matches the placeholders like {changefeed_id} in the sink uri pattern.
+	templateVarRe = regexp.MustCompile(`\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+)
+
+// ChangefeedTemplate is a reusable changefeed configuration stored in the meta store.
+// Changefeeds created from a template share the same replica config, and their sink
+// uris are rendered from the sink uri pattern of the template.
+type ChangefeedTemplate struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// SinkURI is the pattern of the sink uri, the placeholders like {changefeed_id}
+	// are replaced by the built-in or the user defined variables.
+	SinkURI    string         `json:"sink-uri"`
+	Config     *ReplicaConfig `json:"config"`
+	CreateTime time.Time      `json:"create-time"`
+	UpdateTime time.Time      `json:"update-time"`
+}
+
+// ValidateChangefeedTemplateName checks the name of a changefeed template, it uses
+// the same rules as the changefeed id.
+func ValidateChangefeedTemplateName(name string) error {
+	if !changefeedTemplateNameRe.MatchString(name) || len(name) > changefeedTemplateNameMaxLen {
+		return cerror.ErrInvalidChangefeedTemplate.GenWithStackByArgs(
+			`bad template name, please match the pattern "^[a-zA-Z0-9]+(\-[a-zA-Z0-9]+)*$"`)
+	}
+	return nil
+}
+
+// Validate checks the name and the sink uri pattern of the template.
+func (t *ChangefeedTemplate) Validate() error {
+	if err := ValidateChangefeedTemplateName(t.Name); err != nil {
+		return err
+	}
+	if t.SinkURI == "" {
+		return cerror.ErrInvalidChangefeedTemplate.GenWithStackByArgs("sink uri is empty")
+	}
+	if t.Config == nil {
+		return cerror.ErrInvalidChangefeedTemplate.GenWithStackByArgs("replica config is empty")
+	}
+	return nil
+}
+
+// Variables returns the names of the variables referenced by the sink uri pattern.
+func (t *ChangefeedTemplate) Variables() []string {
+	var vars []string
+	seen := make(map[string]struct{})
+	for _, match := range templateVarRe.FindAllStringSubmatch(t.SinkURI, -1) {
+		if _, ok := seen[match[1]]; ok {
+			continue
+		}
+		seen[match[1]] = struct{}{}
+		vars = append(vars, match[1])
+	}
+	return vars
+}
+
+// RenderSinkURI replaces the placeholders in the sink uri pattern with the variables.
+// The built-in variables changefeed_id and keyspace cannot be overridden, and all
+// placeholders must be resolved. The values are escaped for the uri components
+// where their placeholders are, so they can not change the other components.
+func (t *ChangefeedTemplate) RenderSinkURI(changefeedID, keyspace string, vars map[string]string) (string, error) {
+	var (
+		missing  []string
+		builder  strings.Builder
+		lastEnd  int
+		pattern  = t.SinkURI
+		sections = splitURIPattern(pattern)
+	)
+	for _, match := range templateVarRe.FindAllStringSubmatchIndex(pattern, -1) {
+		builder.WriteString(pattern[lastEnd:match[0]])
+		lastEnd = match[1]
+		name := pattern[match[2]:match[3]]
+		var value string
+		switch name {
+		case TemplateVarChangefeedID:
+			value = changefeedID
+		case TemplateVarKeyspace:
+			value = keyspace
+		default:
+			var ok bool
+			if value, ok = vars[name]; !ok {
+				missing = append(missing, name)
+				builder.WriteString(pattern[match[0]:match[1]])
+				continue
+			}
+		}
+		builder.WriteString(sections.escape(match[0], value))
+	}
+	builder.WriteString(pattern[lastEnd:])
+	if len(missing) != 0 {
+		return "", cerror.ErrInvalidChangefeedTemplate.GenWithStackByArgs(
+			"variables " + strings.Join(missing, ", ") + " of template " + t.Name + " are not specified")
+	}
+	return builder.String(), nil
+}
+
+// uriPatternSections are the offsets where the components of a sink uri
+// pattern end, the scheme is treated as a part of the host.
+type uriPatternSections struct {
+	userinfoEnd int
+	hostEnd     int
+	pathEnd     int
+}
+
+// splitURIPattern splits the sink uri pattern into the components, the
+// placeholders never contain the delimiters of the components.
+func splitURIPattern(pattern string) uriPatternSections {
+	authorityStart := 0
+	if i := strings.Index(pattern, "://"); i >= 0 {
+		authorityStart = i + len("://")
+	}
+	hostEnd := len(pattern)
+	if i := strings.IndexAny(pattern[authorityStart:], "/?#"); i >= 0 {
+		hostEnd = authorityStart + i
+	}
+	userinfoEnd := -1
+	if i := strings.LastIndex(pattern[authorityStart:hostEnd], "@"); i >= 0 {
+		userinfoEnd = authorityStart + i
+	}
+	pathEnd := len(pattern)
+	if i := strings.IndexAny(pattern[hostEnd:], "?#"); i >= 0 {
+		pathEnd = hostEnd + i
+	}
+	return uriPatternSections{userinfoEnd: userinfoEnd, hostEnd: hostEnd, pathEnd: pathEnd}
+}
+
+// escape escapes the value of the placeholder at the offset of the pattern.
+func (s uriPatternSections) escape(offset int, value string) string {
+	switch {
+	case offset < s.userinfoEnd:
+		return url.User(value).String()
+	case offset < s.hostEnd:
+		return escapeURIValue(value, uriSubDelims+":[]")
+	case offset < s.pathEnd:
+		return escapeURIValue(value, uriSubDelims+":@/")
+	default:
+		// the delimiters of the query parameters are escaped.
+		return escapeURIValue(value, "!$'()*,:@/?")
+	}
+}
+
+// uriSubDelims are the sub-delimiters of RFC 3986.
+const uriSubDelims = "!$&'()*+,;="
+
+// escapeURIValue escapes the characters of the value other than the unreserved
+// characters of RFC 3986 and the allowed characters.
+func escapeURIValue(value string, allowed string) string {
+	const hex = "0123456789ABCDEF"
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+			strings.IndexByte("-._~", c) >= 0 || strings.IndexByte(allowed, c) >= 0 {
+			builder.WriteByte(c)
+			continue
+		}
+		builder.WriteByte('%')
+		builder.WriteByte(hex[c>>4])
+		builder.WriteByte(hex[c&15])
+	}
+	return builder.String()
+}
+
+// Marshal returns the json marshal format of a ChangefeedTemplate
+func (t *ChangefeedTemplate) Marshal() (string, error) {
+	data, err := json.Marshal(t)
+	return string(data), cerror.WrapError(cerror.ErrMarshalFailed, err)
+}
+
+// Unmarshal unmarshals into *ChangefeedTemplate from json marshal byte slice
+func (t *ChangefeedTemplate) Unmarshal(data []byte) error {
+	err := json.Unmarshal(data, t)
+	if err != nil {
+		return errors.Annotatef(
+			cerror.WrapError(cerror.ErrUnmarshalFailed, err), "Unmarshal data: %v", data)
+	}
+	return nil
+}
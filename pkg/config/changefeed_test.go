@@ -66,6 +66,39 @@ func TestChangeFeedInfoToChangefeedConfigBatchFields(t *testing.T) {
 	assertBatchFields(util.AddressOf(123), util.AddressOf(456))
 }
 
+func TestChangeFeedInfoRmUnusedFieldsKeepsTopicRuleForElasticsearch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		sinkURI   string
+		keepTopic bool
+	}{
+		{sinkURI: "es://127.0.0.1:9200/index", keepTopic: true},
+		{sinkURI: "opensearch+ssl://127.0.0.1:9200/index", keepTopic: true},
+		{sinkURI: "redis://127.0.0.1:6379/0", keepTopic: true},
+		{sinkURI: "mysql://127.0.0.1:3306/", keepTopic: false},
+	}
+
+	for _, tt := range tests {
+		cfg := GetDefaultReplicaConfig()
+		cfg.Sink.DispatchRules = []*DispatchRule{
+			{Matcher: []string{"test.*"}, PartitionRule: "table", TopicRule: "{schema}_{table}"},
+		}
+		info := &ChangeFeedInfo{
+			SinkURI: tt.sinkURI,
+			Config:  cfg,
+		}
+
+		info.RmUnusedFields()
+		require.Empty(t, info.Config.Sink.DispatchRules[0].PartitionRule)
+		if tt.keepTopic {
+			require.Equal(t, "{schema}_{table}", info.Config.Sink.DispatchRules[0].TopicRule)
+		} else {
+			require.Empty(t, info.Config.Sink.DispatchRules[0].TopicRule)
+		}
+	}
+}
+
 func TestChangeFeedInfoRmUnusedFieldsKeepsSchemaRegistryForAvroProtocols(t *testing.T) {
 	t.Parallel()
 
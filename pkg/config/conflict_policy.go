@@ -0,0 +1,94 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+const (
+	// ConflictPolicyLastWriterWins applies a row only if its timestamp column
+	// is not older than the one of the existing row.
+	ConflictPolicyLastWriterWins = "last-writer-wins"
+	// ConflictPolicyFirstWriterWins keeps the existing row when an inserted
+	// row, or an updated row moved to another key, conflicts with it. The
+	// other updates and the deletes are applied to the row of the same key.
+	ConflictPolicyFirstWriterWins = "first-writer-wins"
+	// ConflictPolicyIgnore ignores the rows failed by duplicate key errors.
+	ConflictPolicyIgnore = "ignore"
+	// ConflictPolicyDeadLetter resolves the conflicts like first-writer-wins,
+	// and writes the conflicting row to the dead letter queue of the sink, or
+	// to the dead letter table if the dead letter queue is not configured.
+	// The existing row equal to the written row is not a conflict.
+	ConflictPolicyDeadLetter = "dead-letter"
+
+	// DefaultConflictDeadLetterTable is the default dead letter table of the
+	// conflicting rows, it is created in the tidb_cdc schema.
+	DefaultConflictDeadLetterTable = "conflict_rows"
+)
+
+// ConflictPolicyRule represents the conflict policy of the tables matched by
+// the matcher. The first rule matching a table is used.
+type ConflictPolicyRule struct {
+	Matcher []string `toml:"matcher" json:"matcher"`
+	// Policy is one of last-writer-wins, first-writer-wins, ignore and dead-letter.
+	Policy string `toml:"policy" json:"policy"`
+	// TimestampColumn is the column compared by last-writer-wins.
+	TimestampColumn string `toml:"timestamp-column" json:"timestamp-column,omitempty"`
+	// DeadLetterTable is the table in the tidb_cdc schema which the conflicting
+	// rows are written to by dead-letter, if the sink has no dead letter queue.
+	DeadLetterTable string `toml:"dead-letter-table" json:"dead-letter-table,omitempty"`
+}
+
+func (r *ConflictPolicyRule) validateAndAdjust() error {
+	if len(r.Matcher) == 0 {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs("The matcher of the conflict policy can not be empty")
+	}
+	switch r.Policy {
+	case ConflictPolicyLastWriterWins:
+		if r.TimestampColumn == "" {
+			return cerror.ErrInvalidReplicaConfig.
+				FastGenByArgs("The timestamp-column of the last-writer-wins conflict policy can not be empty")
+		}
+	case ConflictPolicyFirstWriterWins, ConflictPolicyIgnore:
+	case ConflictPolicyDeadLetter:
+		if r.DeadLetterTable == "" {
+			r.DeadLetterTable = DefaultConflictDeadLetterTable
+		}
+	default:
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs(fmt.Sprintf("The conflict policy %s is not supported", r.Policy))
+	}
+	return nil
+}
+
+func (c *MySQLConfig) validateAndAdjustConflictPolicies(enableActiveActive bool) error {
+	for _, rule := range c.ConflictPolicies {
+		if rule == nil {
+			continue
+		}
+		// The conflicts of the active-active tables are resolved by the origin ts.
+		if enableActiveActive {
+			return cerror.ErrInvalidReplicaConfig.
+				FastGenByArgs("The conflict policies are not supported when enable-active-active is true")
+		}
+		if err := rule.validateAndAdjust(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAndAdjustConflictPolicies(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		rule    *ConflictPolicyRule
+		wantErr string
+	}{
+		{
+			name: "last writer wins",
+			rule: &ConflictPolicyRule{
+				Matcher:         []string{"test.*"},
+				Policy:          ConflictPolicyLastWriterWins,
+				TimestampColumn: "updated_at",
+			},
+		},
+		{
+			name: "first writer wins",
+			rule: &ConflictPolicyRule{Matcher: []string{"test.*"}, Policy: ConflictPolicyFirstWriterWins},
+		},
+		{
+			name: "ignore",
+			rule: &ConflictPolicyRule{Matcher: []string{"test.*"}, Policy: ConflictPolicyIgnore},
+		},
+		{
+			name:    "empty matcher",
+			rule:    &ConflictPolicyRule{Policy: ConflictPolicyIgnore},
+			wantErr: "matcher",
+		},
+		{
+			name:    "unknown policy",
+			rule:    &ConflictPolicyRule{Matcher: []string{"test.*"}, Policy: "merge"},
+			wantErr: "not supported",
+		},
+		{
+			name:    "last writer wins without timestamp column",
+			rule:    &ConflictPolicyRule{Matcher: []string{"test.*"}, Policy: ConflictPolicyLastWriterWins},
+			wantErr: "timestamp-column",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &MySQLConfig{ConflictPolicies: []*ConflictPolicyRule{tc.rule}}
+			err := cfg.validateAndAdjustConflictPolicies(false)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+
+	// the default dead letter table is used
+	cfg := &MySQLConfig{ConflictPolicies: []*ConflictPolicyRule{
+		{Matcher: []string{"test.*"}, Policy: ConflictPolicyDeadLetter},
+	}}
+	require.NoError(t, cfg.validateAndAdjustConflictPolicies(false))
+	require.Equal(t, DefaultConflictDeadLetterTable, cfg.ConflictPolicies[0].DeadLetterTable)
+
+	// the conflicts of the active-active tables are resolved by the origin ts
+	err := cfg.validateAndAdjustConflictPolicies(true)
+	require.True(t, cerror.ErrInvalidReplicaConfig.Equal(err))
+	require.ErrorContains(t, err, "enable-active-active")
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+
+	bf "github.com/pingcap/ticdc/pkg/binlog-filter"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+const (
+	// DDLPolicySkip skips the matched DDLs, they are not written to the downstream.
+	DDLPolicySkip = "skip"
+	// DDLPolicyTransform rewrites the query of the matched DDLs before they are
+	// written to the downstream.
+	DDLPolicyTransform = "transform"
+	// DDLPolicyPause blocks the changefeed at the matched DDLs until an operator
+	// approves or skips them.
+	DDLPolicyPause = "pause"
+)
+
+// DDLPolicyRule represents how the DDLs of the tables matched by the matcher
+// are handled. A DDL matches the rule if its type is one of the events or its
+// query matches one of the sql regular expressions. The first rule matching
+// a DDL is used.
+type DDLPolicyRule struct {
+	Matcher []string       `toml:"matcher" json:"matcher"`
+	Events  []bf.EventType `toml:"events" json:"events,omitempty"`
+	// regular expression
+	SQL []string `toml:"sql" json:"sql,omitempty"`
+	// Policy is one of skip, transform and pause.
+	Policy string `toml:"policy" json:"policy"`
+	// TransformPattern is the regular expression replaced by the
+	// TransformReplacement in the query of the DDLs matched by transform.
+	// The replacement can refer to the submatches like $1.
+	TransformPattern     string `toml:"transform-pattern" json:"transform-pattern,omitempty"`
+	TransformReplacement string `toml:"transform-replacement" json:"transform-replacement,omitempty"`
+}
+
+func (r *DDLPolicyRule) validate() error {
+	if len(r.Matcher) == 0 {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs("The matcher of the ddl policy can not be empty")
+	}
+	if len(r.Events) == 0 && len(r.SQL) == 0 {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs("The events and sql of the ddl policy can not be both empty")
+	}
+	for _, sql := range r.SQL {
+		if _, err := regexp.Compile(sql); err != nil {
+			return cerror.ErrInvalidReplicaConfig.
+				FastGenByArgs(fmt.Sprintf("The sql %s of the ddl policy is invalid: %s", sql, err))
+		}
+	}
+	switch r.Policy {
+	case DDLPolicySkip, DDLPolicyPause:
+	case DDLPolicyTransform:
+		if r.TransformPattern == "" {
+			return cerror.ErrInvalidReplicaConfig.
+				FastGenByArgs("The transform-pattern of the transform ddl policy can not be empty")
+		}
+		if _, err := regexp.Compile(r.TransformPattern); err != nil {
+			return cerror.ErrInvalidReplicaConfig.
+				FastGenByArgs(fmt.Sprintf("The transform-pattern %s of the ddl policy is invalid: %s",
+					r.TransformPattern, err))
+		}
+	default:
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs(fmt.Sprintf("The ddl policy %s is not supported", r.Policy))
+	}
+	return nil
+}
+
+func (c *FilterConfig) validateDDLPolicies() error {
+	for _, rule := range c.DDLPolicies {
+		if rule == nil {
+			continue
+		}
+		if err := rule.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	bf "github.com/pingcap/ticdc/pkg/binlog-filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDDLPolicies(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		rule    *DDLPolicyRule
+		wantErr string
+	}{
+		{
+			name: "skip by events",
+			rule: &DDLPolicyRule{
+				Matcher: []string{"test.*"},
+				Events:  []bf.EventType{bf.DropTable},
+				Policy:  DDLPolicySkip,
+			},
+		},
+		{
+			name: "pause by sql",
+			rule: &DDLPolicyRule{
+				Matcher: []string{"test.*"},
+				SQL:     []string{"^(?i)ALTER TABLE"},
+				Policy:  DDLPolicyPause,
+			},
+		},
+		{
+			name: "transform",
+			rule: &DDLPolicyRule{
+				Matcher:              []string{"test.*"},
+				Events:               []bf.EventType{bf.CreateTable},
+				Policy:               DDLPolicyTransform,
+				TransformPattern:     "ENGINE=InnoDB",
+				TransformReplacement: "",
+			},
+		},
+		{
+			name:    "empty matcher",
+			rule:    &DDLPolicyRule{Events: []bf.EventType{bf.DropTable}, Policy: DDLPolicySkip},
+			wantErr: "matcher",
+		},
+		{
+			name:    "empty events and sql",
+			rule:    &DDLPolicyRule{Matcher: []string{"test.*"}, Policy: DDLPolicySkip},
+			wantErr: "both empty",
+		},
+		{
+			name: "invalid sql",
+			rule: &DDLPolicyRule{
+				Matcher: []string{"test.*"},
+				SQL:     []string{"("},
+				Policy:  DDLPolicySkip,
+			},
+			wantErr: "sql",
+		},
+		{
+			name: "transform without pattern",
+			rule: &DDLPolicyRule{
+				Matcher: []string{"test.*"},
+				Events:  []bf.EventType{bf.CreateTable},
+				Policy:  DDLPolicyTransform,
+			},
+			wantErr: "transform-pattern",
+		},
+		{
+			name: "invalid transform pattern",
+			rule: &DDLPolicyRule{
+				Matcher:          []string{"test.*"},
+				Events:           []bf.EventType{bf.CreateTable},
+				Policy:           DDLPolicyTransform,
+				TransformPattern: "[",
+			},
+			wantErr: "transform-pattern",
+		},
+		{
+			name: "unknown policy",
+			rule: &DDLPolicyRule{
+				Matcher: []string{"test.*"},
+				Events:  []bf.EventType{bf.DropTable},
+				Policy:  "ignore",
+			},
+			wantErr: "not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &FilterConfig{DDLPolicies: []*DDLPolicyRule{tc.rule}}
+			err := cfg.validateDDLPolicies()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
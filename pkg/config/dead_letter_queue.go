@@ -0,0 +1,100 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/util"
+)
+
+const (
+	// DefaultDeadLetterQueueMaxRetries is the default number of times an event
+	// rejected by the downstream is retried before it is dead lettered.
+	DefaultDeadLetterQueueMaxRetries = 3
+	// DefaultDeadLetterQueueTable is the default table of the dead letter queue
+	// in a MySQL compatible destination, it is created in the tidb_cdc schema.
+	DefaultDeadLetterQueueTable = "dead_letter_events"
+)
+
+// DeadLetterQueueConfig represents the dead letter queue of a sink. The events
+// rejected by the downstream, such as the rows failed by the data too long
+// error or the messages exceeding the max message bytes, are written to the
+// dead letter queue with the error, and the changefeed moves on instead of
+// failing on them.
+type DeadLetterQueueConfig struct {
+	// URI is the destination of the dead letter queue, it can be a kafka topic
+	// such as kafka://127.0.0.1:9092/dlq, a storage uri such as s3://bucket/dlq,
+	// or a MySQL compatible database such as mysql://root@127.0.0.1:3306/.
+	URI string `toml:"uri" json:"uri"`
+	// Table is the table in the tidb_cdc schema which the events are written
+	// to, it's only used by the MySQL compatible destination.
+	Table string `toml:"table" json:"table,omitempty"`
+	// MaxRetries is the number of times an event rejected by the downstream is
+	// retried before it is written to the dead letter queue.
+	MaxRetries *int `toml:"max-retries" json:"max-retries,omitempty"`
+}
+
+// GetMaxRetries returns the max retries of the events rejected by the downstream.
+func (c *DeadLetterQueueConfig) GetMaxRetries() int {
+	if c == nil || c.MaxRetries == nil {
+		return DefaultDeadLetterQueueMaxRetries
+	}
+	return *c.MaxRetries
+}
+
+func (s *SinkConfig) validateAndAdjustDeadLetterQueue(sinkURI *url.URL) error {
+	c := s.DeadLetterQueue
+	if c == nil {
+		return nil
+	}
+	if !IsMySQLCompatibleScheme(sinkURI.Scheme) &&
+		sinkURI.Scheme != KafkaScheme && sinkURI.Scheme != KafkaSSLScheme {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The dead letter queue is not supported by %s sink", sinkURI.Scheme))
+	}
+	if c.MaxRetries != nil && *c.MaxRetries < 0 {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			"The max-retries of the dead letter queue can not be negative")
+	}
+	if c.URI == "" {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			"The uri of the dead letter queue can not be empty")
+	}
+	uri, err := url.Parse(c.URI)
+	if err != nil {
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The uri of the dead letter queue %s is invalid", util.MaskSensitiveDataInURI(c.URI)))
+	}
+	scheme := GetScheme(uri)
+	switch {
+	case IsMySQLCompatibleScheme(scheme):
+		if c.Table == "" {
+			c.Table = DefaultDeadLetterQueueTable
+		}
+	case scheme == KafkaScheme || scheme == KafkaSSLScheme:
+		if strings.Trim(uri.Path, "/") == "" {
+			return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+				"The topic of the kafka dead letter queue can not be empty")
+		}
+	case IsStorageScheme(scheme) && scheme != CloudStorageNoopScheme:
+	default:
+		return cerror.ErrInvalidReplicaConfig.FastGenByArgs(
+			fmt.Sprintf("The dead letter queue destination %s is not supported", scheme))
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAndAdjustDeadLetterQueue(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		sinkURI string
+		queue   *DeadLetterQueueConfig
+		wantErr string
+	}{
+		{
+			name:    "kafka topic",
+			sinkURI: "mysql://127.0.0.1:3306/",
+			queue:   &DeadLetterQueueConfig{URI: "kafka://127.0.0.1:9092/dlq"},
+		},
+		{
+			name:    "storage",
+			sinkURI: "kafka://127.0.0.1:9092/test",
+			queue:   &DeadLetterQueueConfig{URI: "s3://bucket/dlq", MaxRetries: util.AddressOf(0)},
+		},
+		{
+			name:    "unsupported sink",
+			sinkURI: "s3://bucket/data",
+			queue:   &DeadLetterQueueConfig{URI: "s3://bucket/dlq"},
+			wantErr: "not supported by s3 sink",
+		},
+		{
+			name:    "empty uri",
+			sinkURI: "mysql://127.0.0.1:3306/",
+			queue:   &DeadLetterQueueConfig{},
+			wantErr: "uri of the dead letter queue can not be empty",
+		},
+		{
+			name:    "kafka without topic",
+			sinkURI: "mysql://127.0.0.1:3306/",
+			queue:   &DeadLetterQueueConfig{URI: "kafka://127.0.0.1:9092/"},
+			wantErr: "topic",
+		},
+		{
+			name:    "unsupported destination",
+			sinkURI: "mysql://127.0.0.1:3306/",
+			queue:   &DeadLetterQueueConfig{URI: "pulsar://127.0.0.1:6650/dlq"},
+			wantErr: "destination pulsar is not supported",
+		},
+		{
+			name:    "negative max retries",
+			sinkURI: "mysql://127.0.0.1:3306/",
+			queue:   &DeadLetterQueueConfig{URI: "s3://bucket/dlq", MaxRetries: util.AddressOf(-1)},
+			wantErr: "max-retries",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sinkURI, err := url.Parse(tc.sinkURI)
+			require.NoError(t, err)
+			cfg := &SinkConfig{DeadLetterQueue: tc.queue}
+			err = cfg.validateAndAdjustDeadLetterQueue(sinkURI)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+
+	// the default table is used by the MySQL compatible destination
+	sinkURI, err := url.Parse("tidb://127.0.0.1:4000/")
+	require.NoError(t, err)
+	cfg := &SinkConfig{DeadLetterQueue: &DeadLetterQueueConfig{URI: "mysql://127.0.0.1:3306/"}}
+	require.NoError(t, cfg.validateAndAdjustDeadLetterQueue(sinkURI))
+	require.Equal(t, DefaultDeadLetterQueueTable, cfg.DeadLetterQueue.Table)
+	require.Equal(t, DefaultDeadLetterQueueMaxRetries, cfg.DeadLetterQueue.GetMaxRetries())
+}
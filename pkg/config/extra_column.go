@@ -0,0 +1,107 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+const (
+	// ExtraColumnTypeSourceCluster writes the value of the extra column, it is
+	// used to identify the upstream cluster when several clusters are
+	// replicated into one downstream.
+	ExtraColumnTypeSourceCluster = "source-cluster"
+	// ExtraColumnTypeSourceSchema writes the upstream schema name of the row.
+	ExtraColumnTypeSourceSchema = "source-schema"
+	// ExtraColumnTypeSourceTable writes the upstream table name of the row.
+	ExtraColumnTypeSourceTable = "source-table"
+	// ExtraColumnTypeCommitTs writes the commit ts of the upstream transaction.
+	ExtraColumnTypeCommitTs = "commit-ts"
+	// ExtraColumnTypeOpType writes the type of the upstream change, which is
+	// one of INSERT, UPDATE and DELETE.
+	ExtraColumnTypeOpType = "op-type"
+)
+
+// ExtraColumn represents a metadata column written by the MySQL sink with
+// every inserted or updated row.
+type ExtraColumn struct {
+	// Name is the name of the column in the downstream table.
+	Name string `toml:"name" json:"name"`
+	// Type is one of source-cluster, source-schema, source-table, commit-ts
+	// and op-type.
+	Type string `toml:"type" json:"type"`
+	// Value is the value written by the source-cluster column.
+	Value string `toml:"value" json:"value,omitempty"`
+}
+
+// GetExtraColumns returns the extra columns of the MySQL sink.
+func (c *MySQLConfig) GetExtraColumns() []*ExtraColumn {
+	if c == nil {
+		return nil
+	}
+	return c.ExtraColumns
+}
+
+// GetSoftDeleteColumn returns the name of the soft delete column, an empty
+// string means soft delete is disabled.
+func (c *MySQLConfig) GetSoftDeleteColumn() string {
+	if c == nil || c.SoftDeleteColumn == nil {
+		return ""
+	}
+	return *c.SoftDeleteColumn
+}
+
+func (c *MySQLConfig) validateExtraColumns() error {
+	names := make(map[string]struct{}, len(c.ExtraColumns)+1)
+	checkName := func(name string) error {
+		if name == "" {
+			return cerror.ErrInvalidReplicaConfig.
+				FastGenByArgs("The name of the extra column can not be empty")
+		}
+		lowerName := strings.ToLower(name)
+		if _, ok := names[lowerName]; ok {
+			return cerror.ErrInvalidReplicaConfig.
+				FastGenByArgs(fmt.Sprintf("The extra column %s is defined more than once", name))
+		}
+		names[lowerName] = struct{}{}
+		return nil
+	}
+	for _, column := range c.ExtraColumns {
+		if column == nil {
+			continue
+		}
+		if err := checkName(column.Name); err != nil {
+			return err
+		}
+		switch column.Type {
+		case ExtraColumnTypeSourceCluster:
+			if column.Value == "" {
+				return cerror.ErrInvalidReplicaConfig.
+					FastGenByArgs(fmt.Sprintf("The value of the source-cluster extra column %s can not be empty", column.Name))
+			}
+		case ExtraColumnTypeSourceSchema, ExtraColumnTypeSourceTable,
+			ExtraColumnTypeCommitTs, ExtraColumnTypeOpType:
+		default:
+			return cerror.ErrInvalidReplicaConfig.
+				FastGenByArgs(fmt.Sprintf("The extra column type %s of column %s is not supported", column.Type, column.Name))
+		}
+	}
+	if softDeleteColumn := c.GetSoftDeleteColumn(); softDeleteColumn != "" {
+		return checkName(softDeleteColumn)
+	}
+	return nil
+}
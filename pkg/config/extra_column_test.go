@@ -0,0 +1,89 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateExtraColumns(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		columns          []*ExtraColumn
+		softDeleteColumn *string
+		wantErr          string
+	}{
+		{
+			name: "valid extra columns",
+			columns: []*ExtraColumn{
+				{Name: "_source_cluster", Type: ExtraColumnTypeSourceCluster, Value: "cluster-a"},
+				{Name: "_source_schema", Type: ExtraColumnTypeSourceSchema},
+				{Name: "_source_table", Type: ExtraColumnTypeSourceTable},
+				{Name: "_commit_ts", Type: ExtraColumnTypeCommitTs},
+				{Name: "_op_type", Type: ExtraColumnTypeOpType},
+			},
+			softDeleteColumn: util.AddressOf("_is_deleted"),
+		},
+		{
+			name:             "only soft delete",
+			softDeleteColumn: util.AddressOf("_is_deleted"),
+		},
+		{
+			name:    "empty name",
+			columns: []*ExtraColumn{{Type: ExtraColumnTypeCommitTs}},
+			wantErr: "can not be empty",
+		},
+		{
+			name:    "unknown type",
+			columns: []*ExtraColumn{{Name: "_host", Type: "hostname"}},
+			wantErr: "not supported",
+		},
+		{
+			name:    "source cluster without value",
+			columns: []*ExtraColumn{{Name: "_source_cluster", Type: ExtraColumnTypeSourceCluster}},
+			wantErr: "value",
+		},
+		{
+			name: "duplicated column",
+			columns: []*ExtraColumn{
+				{Name: "_commit_ts", Type: ExtraColumnTypeCommitTs},
+				{Name: "_Commit_TS", Type: ExtraColumnTypeCommitTs},
+			},
+			wantErr: "more than once",
+		},
+		{
+			name:             "soft delete column conflicts with extra column",
+			columns:          []*ExtraColumn{{Name: "_deleted", Type: ExtraColumnTypeOpType}},
+			softDeleteColumn: util.AddressOf("_deleted"),
+			wantErr:          "more than once",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &MySQLConfig{ExtraColumns: tc.columns, SoftDeleteColumn: tc.softDeleteColumn}
+			err := cfg.validateExtraColumns()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
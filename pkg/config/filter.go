@@ -22,6 +22,8 @@ type FilterConfig struct {
 	Rules            []string           `toml:"rules" json:"rules"`
 	IgnoreTxnStartTs []uint64           `toml:"ignore-txn-start-ts" json:"ignore-txn-start-ts"`
 	EventFilters     []*EventFilterRule `toml:"event-filters" json:"event-filters"`
+	// DDLPolicies skips, transforms or pauses the DDLs matched by the rules.
+	DDLPolicies []*DDLPolicyRule `toml:"ddl-policies" json:"ddl-policies,omitempty"`
 }
 
 func NewDefaultFilterConfig() *FilterConfig {
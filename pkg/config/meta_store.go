@@ -0,0 +1,84 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"time"
+
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+const (
+	// MetaStoreTypeEtcd stores the changefeed metadata and campaigns the coordinator in the PD etcd.
+	MetaStoreTypeEtcd = "etcd"
+	// MetaStoreTypeMySQL stores the changefeed metadata and campaigns the coordinator
+	// in a MySQL compatible database.
+	MetaStoreTypeMySQL = "mysql"
+)
+
+// MetaStoreConfig represents the config of the store of the changefeed metadata
+// and the coordinator election.
+//
+// The meta store only takes over the changefeed metadata and the elections of the
+// coordinator and the log coordinator. The PD etcd is still required by the other
+// cluster metadata: the captures are registered with the etcd session, which also
+// decides the liveness of the captures, the nodes are discovered by watching the
+// capture keys, and the GC service id is read from the etcd.
+type MetaStoreConfig struct {
+	// Type is the type of the meta store, etcd or mysql.
+	Type string `toml:"type" json:"type"`
+	// DSN is the data source name of the meta database, such as
+	// "user:password@tcp(127.0.0.1:3306)/ticdc_meta", it is required by the mysql type.
+	DSN string `toml:"dsn" json:"dsn"`
+	// ElectionLeaseTTL is the lease of the coordinator elected by the mysql meta store,
+	// the coordinator steps down if it cannot renew the lease in time.
+	ElectionLeaseTTL TomlDuration `toml:"election-lease-ttl" json:"election-lease-ttl"`
+}
+
+// NewDefaultMetaStoreConfig returns the default meta store configuration.
+func NewDefaultMetaStoreConfig() *MetaStoreConfig {
+	return &MetaStoreConfig{
+		Type:             MetaStoreTypeEtcd,
+		ElectionLeaseTTL: TomlDuration(10 * time.Second),
+	}
+}
+
+// IsSQL returns whether the meta store is a database.
+func (c *MetaStoreConfig) IsSQL() bool {
+	return c != nil && c.Type == MetaStoreTypeMySQL
+}
+
+// ValidateAndAdjust validates and adjusts the meta store configuration.
+func (c *MetaStoreConfig) ValidateAndAdjust() error {
+	switch c.Type {
+	case "":
+		c.Type = MetaStoreTypeEtcd
+	case MetaStoreTypeEtcd:
+	case MetaStoreTypeMySQL:
+		if c.DSN == "" {
+			return cerror.ErrInvalidServerOption.GenWithStack("meta-store dsn is required by the mysql meta store")
+		}
+	default:
+		return cerror.ErrInvalidServerOption.GenWithStack(
+			"invalid meta-store type %s, the valid values are %s and %s", c.Type, MetaStoreTypeEtcd, MetaStoreTypeMySQL)
+	}
+	if c.ElectionLeaseTTL == 0 {
+		c.ElectionLeaseTTL = NewDefaultMetaStoreConfig().ElectionLeaseTTL
+	}
+	if time.Duration(c.ElectionLeaseTTL) < 3*time.Second {
+		return cerror.ErrInvalidServerOption.GenWithStack(
+			"meta-store election-lease-ttl must be at least 3s, but got %s", time.Duration(c.ElectionLeaseTTL))
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+// NotifierConfig represents the config of the changefeed lifecycle notifier of
+// the coordinator. The notifier is disabled if neither the webhook url nor the
+// kafka uri is set.
+type NotifierConfig struct {
+	// WebhookURL is the url the lifecycle events are posted to.
+	WebhookURL string `toml:"webhook-url" json:"webhook-url"`
+	// KafkaURI is the kafka sink uri the lifecycle events are produced to, such as
+	// "kafka://127.0.0.1:9092/ticdc-events", the path is the topic.
+	KafkaURI string `toml:"kafka-uri" json:"kafka-uri"`
+	// CheckpointLagThreshold is the checkpoint lag above which a checkpoint lag
+	// event is sent, 0 disables the checkpoint lag event.
+	CheckpointLagThreshold TomlDuration `toml:"checkpoint-lag-threshold" json:"checkpoint-lag-threshold"`
+	// GCSafepointRiskRatio is the ratio of the checkpoint lag to the gc-ttl above
+	// which a gc safepoint risk event is sent.
+	GCSafepointRiskRatio float64 `toml:"gc-safepoint-risk-ratio" json:"gc-safepoint-risk-ratio"`
+	// MaxRetries is the max number of retries to deliver an event.
+	MaxRetries int `toml:"max-retries" json:"max-retries"`
+	// Timeout is the timeout to deliver an event once.
+	Timeout TomlDuration `toml:"timeout" json:"timeout"`
+}
+
+// NewDefaultNotifierConfig returns the default notifier configuration.
+func NewDefaultNotifierConfig() *NotifierConfig {
+	return &NotifierConfig{
+		CheckpointLagThreshold: TomlDuration(10 * time.Minute),
+		GCSafepointRiskRatio:   0.8,
+		MaxRetries:             3,
+		Timeout:                TomlDuration(10 * time.Second),
+	}
+}
+
+// IsEnabled returns whether any destination of the lifecycle events is configured.
+func (c *NotifierConfig) IsEnabled() bool {
+	return c != nil && (c.WebhookURL != "" || c.KafkaURI != "")
+}
+
+// ValidateAndAdjust validates and adjusts the notifier configuration.
+func (c *NotifierConfig) ValidateAndAdjust() error {
+	if c.WebhookURL != "" {
+		u, err := url.Parse(c.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return cerror.ErrInvalidServerOption.GenWithStack(
+				"invalid notifier webhook-url %s, it must be a http or https url", c.WebhookURL)
+		}
+	}
+	if c.KafkaURI != "" {
+		u, err := url.Parse(c.KafkaURI)
+		if err != nil || (u.Scheme != KafkaScheme && u.Scheme != KafkaSSLScheme) || u.Host == "" {
+			return cerror.ErrInvalidServerOption.GenWithStack(
+				"invalid notifier kafka-uri, it must be a kafka sink uri")
+		}
+		if strings.Trim(u.Path, "/") == "" {
+			return cerror.ErrInvalidServerOption.GenWithStack(
+				"the topic is required in the notifier kafka-uri")
+		}
+	}
+	if c.CheckpointLagThreshold < 0 {
+		return cerror.ErrInvalidServerOption.GenWithStack(
+			"notifier checkpoint-lag-threshold must not be negative, but got %s",
+			time.Duration(c.CheckpointLagThreshold))
+	}
+	if c.GCSafepointRiskRatio == 0 {
+		c.GCSafepointRiskRatio = NewDefaultNotifierConfig().GCSafepointRiskRatio
+	}
+	if c.GCSafepointRiskRatio < 0 || c.GCSafepointRiskRatio > 1 {
+		return cerror.ErrInvalidServerOption.GenWithStack(
+			"notifier gc-safepoint-risk-ratio must be in (0, 1], but got %v", c.GCSafepointRiskRatio)
+	}
+	if c.MaxRetries < 0 {
+		return cerror.ErrInvalidServerOption.GenWithStack(
+			"notifier max-retries must not be negative, but got %d", c.MaxRetries)
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = NewDefaultNotifierConfig().Timeout
+	}
+	return nil
+}
@@ -122,6 +122,10 @@ var defaultReplicaConfig = &ReplicaConfig{
 	},
 	ActiveActiveProgressInterval:  util.AddressOf(defaultActiveActiveProgressInterval),
 	ActiveActiveSyncStatsInterval: util.AddressOf(defaultActiveActiveSyncStatsInterval),
+	Validation: &ValidationConfig{
+		Enable:           util.AddressOf(false),
+		SampleTableCount: util.AddressOf(defaultValidationSampleTableCount),
+	},
 }
 
 // GetDefaultReplicaConfig returns the default replica config.
@@ -178,6 +182,8 @@ type replicaConfig struct {
 	Integrity                    *integrity.Config   `toml:"integrity" json:"integrity"`
 	ChangefeedErrorStuckDuration *time.Duration      `toml:"changefeed-error-stuck-duration" json:"changefeed-error-stuck-duration,omitempty"`
 	SyncedStatus                 *SyncedStatusConfig `toml:"synced-status" json:"synced-status,omitempty"`
+	// Validation is only available when the downstream is DB with SyncPoint enabled.
+	Validation *ValidationConfig `toml:"validation" json:"validation,omitempty"`
 
 	// EnableActiveActive enables active-active replication mode on top of BDR.
 	// It requires BDRMode to be true and is only supported by TiDB and storage sinks.
@@ -288,7 +294,7 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error { // check sin
 	}
 
 	if c.Sink != nil {
-		err := c.Sink.validateAndAdjust(sinkURI)
+		err := c.Sink.validateAndAdjust(sinkURI, util.GetOrZero(c.EnableActiveActive))
 		if err != nil {
 			return err
 		}
@@ -301,6 +307,12 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error { // check sin
 		}
 	}
 
+	if c.Filter != nil {
+		if err := c.Filter.validateDDLPolicies(); err != nil {
+			return err
+		}
+	}
+
 	// check sync point config
 	if util.GetOrZero(c.EnableSyncPoint) {
 		if !IsMySQLCompatibleScheme(GetScheme(sinkURI)) {
@@ -324,6 +336,13 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error { // check sin
 						minSyncPointRetention.String()))
 		}
 	}
+	if c.Validation != nil {
+		err := c.Validation.validateAndAdjust(sinkURI,
+			util.GetOrZero(c.EnableSyncPoint), util.GetOrZero(c.SyncPointInterval))
+		if err != nil {
+			return err
+		}
+	}
 	if util.GetOrZero(c.MemoryQuota) == uint64(0) {
 		c.FixMemoryQuota()
 	}
@@ -356,6 +375,10 @@ func (c *ReplicaConfig) ValidateAndAdjust(sinkURI *url.URL) error { // check sin
 				"integrity check enabled and column selector set, not allowed")
 
 		}
+		if c.Integrity.Enabled() && len(c.Sink.Transforms) != 0 {
+			return cerror.ErrInvalidReplicaConfig.GenWithStack(
+				"integrity check enabled and transforms set, not allowed")
+		}
 	}
 
 	if c.ChangefeedErrorStuckDuration != nil &&
@@ -438,4 +461,7 @@ func (c *ReplicaConfig) MaskSensitiveData() {
 	if c.Consistent != nil {
 		c.Consistent.MaskSensitiveData()
 	}
+	if c.Validation != nil {
+		c.Validation.MaskSensitiveData()
+	}
 }
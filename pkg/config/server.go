@@ -121,6 +121,8 @@ var defaultServerConfig = &ServerConfig{
 	Security:   &security.Credential{},
 	KVClient:   NewDefaultKVClientConfig(),
 	Encryption: NewDefaultEncryptionConfig(),
+	MetaStore:  NewDefaultMetaStoreConfig(),
+	Notifier:   NewDefaultNotifierConfig(),
 	Debug: &DebugConfig{
 		DB:       NewDefaultDBConfig(),
 		Messages: defaultMessageConfig.Clone(),
@@ -162,6 +164,8 @@ type ServerConfig struct {
 	Security   *security.Credential `toml:"security" json:"security"`
 	KVClient   *KVClientConfig      `toml:"kv-client" json:"kv-client"`
 	Encryption *EncryptionConfig    `toml:"encryption" json:"encryption"`
+	MetaStore  *MetaStoreConfig     `toml:"meta-store" json:"meta-store"`
+	Notifier   *NotifierConfig      `toml:"notifier" json:"notifier"`
 	Debug      *DebugConfig         `toml:"debug" json:"debug"`
 	ClusterID  string               `toml:"cluster-id" json:"cluster-id"`
 	// Deprecated: we don't use this field anymore.
@@ -292,6 +296,20 @@ func (c *ServerConfig) ValidateAndAdjust() error {
 		c.Encryption = defaultCfg.Encryption
 	}
 
+	if c.MetaStore == nil {
+		c.MetaStore = defaultCfg.MetaStore
+	}
+	if err = c.MetaStore.ValidateAndAdjust(); err != nil {
+		return errors.Trace(err)
+	}
+
+	if c.Notifier == nil {
+		c.Notifier = defaultCfg.Notifier
+	}
+	if err = c.Notifier.ValidateAndAdjust(); err != nil {
+		return errors.Trace(err)
+	}
+
 	if c.Debug == nil {
 		c.Debug = defaultCfg.Debug
 	}
@@ -162,6 +162,59 @@ func TestDBConfigValidateAndAdjust(t *testing.T) {
 	require.Error(t, conf.ValidateAndAdjust())
 }
 
+func TestMetaStoreConfigValidateAndAdjust(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone().MetaStore
+
+	require.Nil(t, conf.ValidateAndAdjust())
+	require.False(t, conf.IsSQL())
+
+	conf.Type = MetaStoreTypeMySQL
+	require.ErrorContains(t, conf.ValidateAndAdjust(), "dsn is required")
+	conf.DSN = "root@tcp(127.0.0.1:3306)/ticdc_meta"
+	require.Nil(t, conf.ValidateAndAdjust())
+	require.True(t, conf.IsSQL())
+
+	conf.ElectionLeaseTTL = TomlDuration(time.Second)
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.ElectionLeaseTTL = 0
+	require.Nil(t, conf.ValidateAndAdjust())
+	require.Equal(t, TomlDuration(10*time.Second), conf.ElectionLeaseTTL)
+
+	conf.Type = "zookeeper"
+	require.Error(t, conf.ValidateAndAdjust())
+}
+
+func TestNotifierConfigValidateAndAdjust(t *testing.T) {
+	t.Parallel()
+	conf := GetDefaultServerConfig().Clone().Notifier
+
+	require.Nil(t, conf.ValidateAndAdjust())
+	require.False(t, conf.IsEnabled())
+
+	conf.WebhookURL = "127.0.0.1:8080/events"
+	require.ErrorContains(t, conf.ValidateAndAdjust(), "webhook-url")
+	conf.WebhookURL = "http://127.0.0.1:8080/events"
+	require.Nil(t, conf.ValidateAndAdjust())
+	require.True(t, conf.IsEnabled())
+
+	conf.KafkaURI = "kafka://127.0.0.1:9092"
+	require.ErrorContains(t, conf.ValidateAndAdjust(), "topic is required")
+	conf.KafkaURI = "mysql://127.0.0.1:3306/events"
+	require.ErrorContains(t, conf.ValidateAndAdjust(), "kafka-uri")
+	conf.KafkaURI = "kafka://127.0.0.1:9092/ticdc-events"
+	require.Nil(t, conf.ValidateAndAdjust())
+
+	conf.GCSafepointRiskRatio = 1.5
+	require.Error(t, conf.ValidateAndAdjust())
+	conf.GCSafepointRiskRatio = 0
+	require.Nil(t, conf.ValidateAndAdjust())
+	require.Equal(t, 0.8, conf.GCSafepointRiskRatio)
+
+	conf.CheckpointLagThreshold = TomlDuration(-time.Second)
+	require.Error(t, conf.ValidateAndAdjust())
+}
+
 func TestKVClientConfigValidateAndAdjust(t *testing.T) {
 	t.Parallel()
 	conf := GetDefaultServerConfig().Clone().KVClient
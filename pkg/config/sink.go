@@ -144,8 +144,13 @@ type SinkConfig struct {
 	DispatchRules []*DispatchRule `toml:"dispatchers" json:"dispatchers,omitempty"`
 
 	ColumnSelectors []*ColumnSelector `toml:"column-selectors" json:"column-selectors,omitempty"`
-	// SchemaRegistry is only available when the downstream is MQ using avro protocol
-	// or debezium protocol with Confluent Avro encoding.
+	// Transforms are the rules to transform the column values before the rows
+	// are written to the downstream, such as hashing or masking sensitive data.
+	Transforms []*TransformRule `toml:"transforms" json:"transforms,omitempty"`
+	// DeadLetterQueue is only available when the downstream is MySQL compatible or kafka.
+	DeadLetterQueue *DeadLetterQueueConfig `toml:"dead-letter-queue" json:"dead-letter-queue,omitempty"`
+	// SchemaRegistry is only available when the downstream is MQ using avro, json-schema
+	// or protobuf protocol, or debezium protocol with Confluent Avro encoding.
 	SchemaRegistry *string `toml:"schema-registry" json:"schema-registry,omitempty"`
 	// EncoderConcurrency is only available when the downstream is MQ.
 	EncoderConcurrency *int `toml:"encoder-concurrency" json:"encoder-concurrency,omitempty"`
@@ -498,8 +503,20 @@ type KafkaConfig struct {
 
 	// OutputRawChangeEvent controls whether to split the update pk/uk events.
 	OutputRawChangeEvent *bool `toml:"output-raw-change-event" json:"output-raw-change-event,omitempty"`
+
+	// TableFormat controls whether to maintain the table-format metadata,
+	// such as the iceberg manifests and snapshots, besides the data files.
+	TableFormat *string `toml:"table-format" json:"table-format,omitempty"`
 }
 
+const (
+	// TableFormatNone means only the data files and schema files are written.
+	TableFormatNone = "none"
+	// TableFormatIceberg means the iceberg metadata referencing the data files
+	// is committed at the checkpoint boundaries.
+	TableFormatIceberg = "iceberg"
+)
+
 // GetOutputRawChangeEvent returns the value of OutputRawChangeEvent
 func (k *KafkaConfig) GetOutputRawChangeEvent() bool {
 	if k == nil || k.OutputRawChangeEvent == nil {
@@ -724,6 +741,19 @@ type MySQLConfig struct {
 	EnableBatchDML               *bool   `toml:"enable-batch-dml" json:"enable-batch-dml,omitempty"`
 	EnableMultiStatement         *bool   `toml:"enable-multi-statement" json:"enable-multi-statement,omitempty"`
 	EnableCachePreparedStatement *bool   `toml:"enable-cache-prepared-statement" json:"enable-cache-prepared-statement,omitempty"`
+
+	// ExtraColumns are the metadata columns written with every inserted or
+	// updated row, they are also added to the tables created by the sink.
+	ExtraColumns []*ExtraColumn `toml:"extra-columns" json:"extra-columns,omitempty"`
+	// SoftDeleteColumn is the name of the deleted flag column. If it is set,
+	// the sink turns a deleted row into an update setting the flag to 1. The
+	// tables must have a primary key or a not null unique key, and safe mode
+	// is always enabled to overwrite the soft deleted rows.
+	SoftDeleteColumn *string `toml:"soft-delete-column" json:"soft-delete-column,omitempty"`
+	// ConflictPolicies are the policies resolving the rows conflicting with
+	// the existing rows of the downstream tables, safe mode is not used by
+	// the tables matched by a policy.
+	ConflictPolicies []*ConflictPolicyRule `toml:"conflict-policies" json:"conflict-policies,omitempty"`
 }
 
 // CloudStorageConfig represents a cloud storage sink configuration
@@ -774,7 +804,7 @@ func CheckUseTableIDAsPathCompatibility(
 	)
 }
 
-func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL) error {
+func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL, enableActiveActive bool) error {
 	if err := s.validateAndAdjustSinkURI(sinkURI); err != nil {
 		return err
 	}
@@ -783,7 +813,21 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL) error {
 		return err
 	}
 
+	if err := s.validateAndAdjustTransforms(); err != nil {
+		return err
+	}
+
+	if err := s.validateAndAdjustDeadLetterQueue(sinkURI); err != nil {
+		return err
+	}
+
 	if IsMySQLCompatibleScheme(sinkURI.Scheme) {
+		if s.MySQLConfig != nil {
+			if err := s.MySQLConfig.validateExtraColumns(); err != nil {
+				return err
+			}
+			return s.MySQLConfig.validateAndAdjustConflictPolicies(enableActiveActive)
+		}
 		return nil
 	}
 
@@ -853,6 +897,12 @@ func (s *SinkConfig) validateAndAdjust(sinkURI *url.URL) error {
 		}
 	}
 
+	if s.Debezium != nil {
+		if err := s.Debezium.validate(protocol); err != nil {
+			return err
+		}
+	}
+
 	if util.GetOrZero(s.EncoderConcurrency) < 0 {
 		return cerror.ErrSinkInvalidConfig.GenWithStack(
 			"encoder-concurrency should greater than 0, but got %d", s.EncoderConcurrency)
@@ -948,7 +998,7 @@ func (s *SinkConfig) validateAndAdjustSinkURI(sinkURI *url.URL) error {
 			"is incompatible with %s scheme", util.GetOrZero(s.Protocol), sinkURI.Scheme))
 	}
 	// For testing purposes, any protocol should be legal for blackhole.
-	if IsMQScheme(sinkURI.Scheme) || IsStorageScheme(sinkURI.Scheme) {
+	if IsMQScheme(sinkURI.Scheme) || IsStorageScheme(sinkURI.Scheme) || IsWebhookScheme(sinkURI.Scheme) {
 		return s.ValidateProtocol(sinkURI.Scheme)
 	}
 	return nil
@@ -974,7 +1024,7 @@ func (s *SinkConfig) ValidateProtocol(scheme string) error {
 		if s.CSVConfig != nil {
 			outputOldValue = s.CSVConfig.OutputOldValue
 		}
-	case ProtocolAvro:
+	case ProtocolAvro, ProtocolJSONSchema, ProtocolProtobuf:
 		outputOldValue = false
 	default:
 		return nil
@@ -1167,6 +1217,47 @@ type OpenProtocolConfig struct {
 // DebeziumConfig represents the configurations for debezium protocol encoding
 type DebeziumConfig struct {
 	OutputOldValue bool `toml:"output-old-value" json:"output-old-value"`
+	// SnapshotMode controls whether the tables are scanned at the start-ts of the changefeed,
+	// and the rows are sent as the read events before the incremental events.
+	SnapshotMode string `toml:"snapshot-mode" json:"snapshot-mode,omitempty"`
+}
+
+const (
+	// DebeziumSnapshotModeNever means no initial snapshot, only the changes after
+	// the start-ts of the changefeed are sent. It's the default snapshot mode.
+	DebeziumSnapshotModeNever = "never"
+	// DebeziumSnapshotModeInitial means each table is scanned at the start-ts of the
+	// changefeed, and the rows are sent as the "op":"r" events before the incremental events.
+	DebeziumSnapshotModeInitial = "initial"
+)
+
+// IsInitialSnapshotEnabled returns whether the initial snapshot is required by the debezium protocol.
+func (s *SinkConfig) IsInitialSnapshotEnabled() bool {
+	if s == nil || s.Debezium == nil || s.Debezium.SnapshotMode != DebeziumSnapshotModeInitial {
+		return false
+	}
+	protocol, err := ParseSinkProtocolFromString(util.GetOrZero(s.Protocol))
+	if err != nil {
+		return false
+	}
+	return protocol == ProtocolDebezium || protocol == ProtocolDebeziumAvro
+}
+
+func (c *DebeziumConfig) validate(protocol Protocol) error {
+	switch c.SnapshotMode {
+	case "", DebeziumSnapshotModeNever:
+	case DebeziumSnapshotModeInitial:
+		if protocol != ProtocolDebezium && protocol != ProtocolDebeziumAvro {
+			return cerror.ErrSinkInvalidConfig.GenWithStack(
+				"debezium snapshot-mode %s is only supported by the debezium protocols, but got %s",
+				c.SnapshotMode, protocol)
+		}
+	default:
+		return cerror.ErrSinkInvalidConfig.GenWithStack(
+			"invalid debezium snapshot-mode %s, the valid values are %s and %s",
+			c.SnapshotMode, DebeziumSnapshotModeNever, DebeziumSnapshotModeInitial)
+	}
+	return nil
 }
 
 // validRoutingExpressionRegexp accepts routing expressions made of literal text
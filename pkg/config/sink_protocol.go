@@ -42,6 +42,9 @@ const (
 	ProtocolDebezium
 	ProtocolSimple
 	ProtocolDebeziumAvro
+	ProtocolParquet
+	ProtocolJSONSchema
+	ProtocolProtobuf
 )
 
 // IsBatchEncode returns whether the protocol is a batch encoder.
@@ -49,6 +52,11 @@ func (p Protocol) IsBatchEncode() bool {
 	return p == ProtocolOpen || p == ProtocolCanal || p == ProtocolMaxwell || p == ProtocolCraft
 }
 
+// IsSchemaRegistryBased returns whether the protocol registers the schemas in the schema registry.
+func (p Protocol) IsSchemaRegistryBased() bool {
+	return p == ProtocolAvro || p == ProtocolDebeziumAvro || p == ProtocolJSONSchema || p == ProtocolProtobuf
+}
+
 // ParseSinkProtocolFromString converts the protocol from string to Protocol enum type.
 func ParseSinkProtocolFromString(protocol string) (Protocol, error) {
 	switch strings.ToLower(protocol) {
@@ -76,6 +84,12 @@ func ParseSinkProtocolFromString(protocol string) (Protocol, error) {
 		return ProtocolDebeziumAvro, nil
 	case "simple":
 		return ProtocolSimple, nil
+	case "parquet":
+		return ProtocolParquet, nil
+	case "json-schema":
+		return ProtocolJSONSchema, nil
+	case "protobuf":
+		return ProtocolProtobuf, nil
 	default:
 		return ProtocolUnknown, errors.ErrSinkUnknownProtocol.GenWithStackByArgs(protocol)
 	}
@@ -106,6 +120,12 @@ func (p Protocol) String() string {
 		return "simple"
 	case ProtocolDebeziumAvro:
 		return "debezium-avro"
+	case ProtocolParquet:
+		return "parquet"
+	case ProtocolJSONSchema:
+		return "json-schema"
+	case ProtocolProtobuf:
+		return "protobuf"
 	default:
 		panic("unreachable")
 	}
@@ -148,6 +168,22 @@ const (
 	PulsarHTTPScheme = "pulsar+http"
 	// PulsarHTTPSScheme indicates the schema is pulsar with https protocol
 	PulsarHTTPSScheme = "pulsar+https"
+	// ElasticsearchScheme indicates the scheme is elasticsearch.
+	ElasticsearchScheme = "es"
+	// ElasticsearchSSLScheme indicates the scheme is elasticsearch with https protocol.
+	ElasticsearchSSLScheme = "es+ssl"
+	// OpenSearchScheme indicates the scheme is opensearch.
+	OpenSearchScheme = "opensearch"
+	// OpenSearchSSLScheme indicates the scheme is opensearch with https protocol.
+	OpenSearchSSLScheme = "opensearch+ssl"
+	// WebhookScheme indicates the scheme is a http webhook.
+	WebhookScheme = "http"
+	// WebhookSSLScheme indicates the scheme is a https webhook.
+	WebhookSSLScheme = "https"
+	// RedisScheme indicates the scheme is redis.
+	RedisScheme = "redis"
+	// RedisSSLScheme indicates the scheme is redis with tls.
+	RedisSSLScheme = "rediss"
 )
 
 // IsMQScheme returns true if the scheme belong to mq scheme.
@@ -173,6 +209,22 @@ func IsPulsarScheme(scheme string) bool {
 	return scheme == PulsarScheme || scheme == PulsarSSLScheme || scheme == PulsarHTTPScheme || scheme == PulsarHTTPSScheme
 }
 
+// IsElasticsearchScheme returns true if the scheme belong to elasticsearch or opensearch scheme.
+func IsElasticsearchScheme(scheme string) bool {
+	return scheme == ElasticsearchScheme || scheme == ElasticsearchSSLScheme ||
+		scheme == OpenSearchScheme || scheme == OpenSearchSSLScheme
+}
+
+// IsWebhookScheme returns true if the scheme belong to webhook scheme.
+func IsWebhookScheme(scheme string) bool {
+	return scheme == WebhookScheme || scheme == WebhookSSLScheme
+}
+
+// IsRedisScheme returns true if the scheme belong to redis scheme.
+func IsRedisScheme(scheme string) bool {
+	return scheme == RedisScheme || scheme == RedisSSLScheme
+}
+
 // IsBlackHoleScheme returns true if the scheme belong to blackhole scheme.
 func IsBlackHoleScheme(scheme string) bool {
 	return scheme == BlackHoleScheme
@@ -66,6 +66,18 @@ func TestParseSinkProtocolFromString(t *testing.T) {
 			protocol:             "debezium-avro",
 			expectedProtocolEnum: ProtocolDebeziumAvro,
 		},
+		{
+			protocol:             "parquet",
+			expectedProtocolEnum: ProtocolParquet,
+		},
+		{
+			protocol:             "json-schema",
+			expectedProtocolEnum: ProtocolJSONSchema,
+		},
+		{
+			protocol:             "protobuf",
+			expectedProtocolEnum: ProtocolProtobuf,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -117,6 +129,18 @@ func TestString(t *testing.T) {
 			protocolEnum:     ProtocolDebeziumAvro,
 			expectedProtocol: "debezium-avro",
 		},
+		{
+			protocolEnum:     ProtocolParquet,
+			expectedProtocol: "parquet",
+		},
+		{
+			protocolEnum:     ProtocolJSONSchema,
+			expectedProtocol: "json-schema",
+		},
+		{
+			protocolEnum:     ProtocolProtobuf,
+			expectedProtocol: "protobuf",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -169,3 +193,18 @@ func TestIsBatchEncoder(t *testing.T) {
 		require.Equal(t, tc.expect, tc.protocolEnum.IsBatchEncode())
 	}
 }
+
+func TestIsSchemaRegistryBased(t *testing.T) {
+	t.Parallel()
+
+	for _, protocol := range []Protocol{
+		ProtocolAvro, ProtocolDebeziumAvro, ProtocolJSONSchema, ProtocolProtobuf,
+	} {
+		require.True(t, protocol.IsSchemaRegistryBased(), protocol.String())
+	}
+	for _, protocol := range []Protocol{
+		ProtocolOpen, ProtocolCanalJSON, ProtocolDebezium, ProtocolSimple, ProtocolParquet,
+	} {
+		require.False(t, protocol.IsSchemaRegistryBased(), protocol.String())
+	}
+}
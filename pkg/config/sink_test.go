@@ -106,10 +106,10 @@ func TestValidateTxnAtomicity(t *testing.T) {
 		parsedSinkURI, err := url.Parse(tc.sinkURI)
 		require.Nil(t, err)
 		if tc.expectedErr == "" {
-			require.Nil(t, cfg.validateAndAdjust(parsedSinkURI))
+			require.Nil(t, cfg.validateAndAdjust(parsedSinkURI, false))
 			require.Equal(t, tc.shouldSplitTxn, util.GetOrZero(cfg.TxnAtomicity).ShouldSplitTxn())
 		} else {
-			require.Regexp(t, tc.expectedErr, cfg.validateAndAdjust(parsedSinkURI))
+			require.Regexp(t, tc.expectedErr, cfg.validateAndAdjust(parsedSinkURI, false))
 		}
 	}
 }
@@ -582,3 +582,21 @@ func TestShouldSendAllBootstrapAtStart(t *testing.T) {
 	sinkConfig.SendAllBootstrapAtStart = &should
 	require.True(t, sinkConfig.ShouldSendAllBootstrapAtStart())
 }
+
+func TestValidateDebeziumSnapshotMode(t *testing.T) {
+	t.Parallel()
+
+	cfg := &DebeziumConfig{}
+	require.NoError(t, cfg.validate(ProtocolCanalJSON))
+
+	cfg.SnapshotMode = DebeziumSnapshotModeNever
+	require.NoError(t, cfg.validate(ProtocolCanalJSON))
+
+	cfg.SnapshotMode = DebeziumSnapshotModeInitial
+	require.NoError(t, cfg.validate(ProtocolDebezium))
+	require.NoError(t, cfg.validate(ProtocolDebeziumAvro))
+	require.ErrorContains(t, cfg.validate(ProtocolCanalJSON), "only supported by the debezium protocols")
+
+	cfg.SnapshotMode = "when_needed"
+	require.ErrorContains(t, cfg.validate(ProtocolDebezium), "invalid debezium snapshot-mode")
+}
@@ -13,6 +13,8 @@
 
 package config
 
+import "github.com/tikv/client-go/v2/oracle"
+
 // SyncedStatusConfig represents synced check interval config for a changefeed
 type SyncedStatusConfig struct {
 	// The minimum interval between the latest synced ts and now required to reach synced state
@@ -21,3 +23,23 @@ type SyncedStatusConfig struct {
 	// between latest sink's checkpoint ts and puller's checkpoint ts required to reach synced state
 	CheckpointInterval *int64 `toml:"checkpoint-interval" json:"checkpoint-interval"`
 }
+
+// IsSynced returns true if a changefeed reaches the synced status at the physical
+// time nowMs, which means no data has been synced to the downstream for
+// SyncedCheckInterval and the checkpoint ts lags behind now less than CheckpointInterval.
+func (c *SyncedStatusConfig) IsSynced(nowMs int64, checkpointTs, lastSyncedTs uint64) bool {
+	syncedCheckInterval, checkpointInterval := c.intervals()
+	return nowMs-oracle.ExtractPhysical(lastSyncedTs) > syncedCheckInterval*1000 &&
+		nowMs-oracle.ExtractPhysical(checkpointTs) < checkpointInterval*1000
+}
+
+// intervals returns the synced check interval and the checkpoint interval in seconds,
+// the default intervals are used if any of them is not set.
+func (c *SyncedStatusConfig) intervals() (int64, int64) {
+	if c != nil && c.SyncedCheckInterval != nil && c.CheckpointInterval != nil &&
+		*c.SyncedCheckInterval != 0 && *c.CheckpointInterval != 0 {
+		return *c.SyncedCheckInterval, *c.CheckpointInterval
+	}
+	defaultConfig := defaultReplicaConfig.SyncedStatus
+	return *defaultConfig.SyncedCheckInterval, *defaultConfig.CheckpointInterval
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+)
+
+const (
+	// TransformTypeHash replaces the value with the hex encoded SHA-256 hash of it,
+	// the column must be able to hold the 64 characters of the hash.
+	TransformTypeHash = "hash"
+	// TransformTypeMask replaces the characters of the value with the mask
+	// character, except the characters kept at the beginning and the end.
+	TransformTypeMask = "mask"
+	// TransformTypeTruncate keeps the first characters of the value.
+	TransformTypeTruncate = "truncate"
+	// TransformTypeConstant replaces the value with a constant.
+	TransformTypeConstant = "constant"
+	// TransformTypeExpression replaces the value with the result of a TiDB
+	// expression evaluated on the row.
+	TransformTypeExpression = "expression"
+
+	defaultTransformMaskChar = "*"
+)
+
+// TransformRule represents the column transformations of the tables matched
+// by the matcher. The first rule matching a table is used.
+type TransformRule struct {
+	Matcher []string           `toml:"matcher" json:"matcher"`
+	Columns []*ColumnTransform `toml:"columns" json:"columns"`
+}
+
+// ColumnTransform represents the transformation of a column.
+type ColumnTransform struct {
+	// Column is the name of the column to transform.
+	Column string `toml:"column" json:"column"`
+	// Type is one of hash, mask, truncate, constant and expression.
+	Type string `toml:"type" json:"type"`
+	// KeepPrefix and KeepSuffix are the numbers of the characters not masked
+	// at the beginning and the end of the value, only used by mask.
+	KeepPrefix int `toml:"keep-prefix" json:"keep-prefix,omitempty"`
+	KeepSuffix int `toml:"keep-suffix" json:"keep-suffix,omitempty"`
+	// MaskChar is the character used by mask, it is `*` by default.
+	MaskChar string `toml:"mask-char" json:"mask-char,omitempty"`
+	// Length is the number of the characters kept by truncate.
+	Length int `toml:"length" json:"length,omitempty"`
+	// Value is the constant used by constant, nil means NULL.
+	Value *string `toml:"value" json:"value,omitempty"`
+	// Expression is the TiDB expression used by expression, for example
+	// `concat(left(phone, 3), '****')`.
+	Expression string `toml:"expression" json:"expression,omitempty"`
+}
+
+func (t *ColumnTransform) validateAndAdjust() error {
+	if t.Column == "" {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs("The column of the transform can not be empty")
+	}
+	invalid := func(msg string) error {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs(fmt.Sprintf("The %s transform of column %s is invalid, %s", t.Type, t.Column, msg))
+	}
+	switch t.Type {
+	case TransformTypeHash:
+	case TransformTypeMask:
+		if t.KeepPrefix < 0 || t.KeepSuffix < 0 {
+			return invalid("keep-prefix and keep-suffix can not be negative")
+		}
+		if t.MaskChar == "" {
+			t.MaskChar = defaultTransformMaskChar
+		}
+		if len([]rune(t.MaskChar)) != 1 {
+			return invalid("mask-char must be a single character")
+		}
+	case TransformTypeTruncate:
+		if t.Length <= 0 {
+			return invalid("length must be larger than 0")
+		}
+	case TransformTypeConstant:
+	case TransformTypeExpression:
+		if t.Expression == "" {
+			return invalid("expression can not be empty")
+		}
+	default:
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs(fmt.Sprintf("The transform type %s of column %s is not supported", t.Type, t.Column))
+	}
+	return nil
+}
+
+func (s *SinkConfig) validateAndAdjustTransforms() error {
+	for _, rule := range s.Transforms {
+		if rule == nil {
+			continue
+		}
+		if len(rule.Matcher) == 0 {
+			return cerror.ErrInvalidReplicaConfig.
+				FastGenByArgs("The matcher of the transform rule can not be empty")
+		}
+		columns := make(map[string]struct{}, len(rule.Columns))
+		for _, column := range rule.Columns {
+			if err := column.validateAndAdjust(); err != nil {
+				return err
+			}
+			if _, ok := columns[column.Column]; ok {
+				return cerror.ErrInvalidReplicaConfig.
+					FastGenByArgs(fmt.Sprintf("The column %s is transformed more than once in a rule", column.Column))
+			}
+			columns[column.Column] = struct{}{}
+		}
+	}
+	return nil
+}
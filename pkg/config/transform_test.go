@@ -0,0 +1,128 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAndAdjustTransforms(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		rule    *TransformRule
+		wantErr string
+	}{
+		{
+			name: "valid transforms",
+			rule: &TransformRule{
+				Matcher: []string{"test.users"},
+				Columns: []*ColumnTransform{
+					{Column: "email", Type: TransformTypeHash},
+					{Column: "phone", Type: TransformTypeMask, KeepPrefix: 3, KeepSuffix: 4},
+					{Column: "name", Type: TransformTypeTruncate, Length: 1},
+					{Column: "note", Type: TransformTypeConstant},
+					{Column: "level", Type: TransformTypeExpression, Expression: "score > 60"},
+				},
+			},
+		},
+		{
+			name:    "empty matcher",
+			rule:    &TransformRule{Columns: []*ColumnTransform{{Column: "email", Type: TransformTypeHash}}},
+			wantErr: "matcher",
+		},
+		{
+			name: "empty column",
+			rule: &TransformRule{
+				Matcher: []string{"test.*"},
+				Columns: []*ColumnTransform{{Type: TransformTypeHash}},
+			},
+			wantErr: "column of the transform can not be empty",
+		},
+		{
+			name: "unknown type",
+			rule: &TransformRule{
+				Matcher: []string{"test.*"},
+				Columns: []*ColumnTransform{{Column: "email", Type: "encrypt"}},
+			},
+			wantErr: "not supported",
+		},
+		{
+			name: "invalid mask char",
+			rule: &TransformRule{
+				Matcher: []string{"test.*"},
+				Columns: []*ColumnTransform{{Column: "phone", Type: TransformTypeMask, MaskChar: "**"}},
+			},
+			wantErr: "mask-char",
+		},
+		{
+			name: "negative keep prefix",
+			rule: &TransformRule{
+				Matcher: []string{"test.*"},
+				Columns: []*ColumnTransform{{Column: "phone", Type: TransformTypeMask, KeepPrefix: -1}},
+			},
+			wantErr: "keep-prefix",
+		},
+		{
+			name: "invalid truncate length",
+			rule: &TransformRule{
+				Matcher: []string{"test.*"},
+				Columns: []*ColumnTransform{{Column: "name", Type: TransformTypeTruncate}},
+			},
+			wantErr: "length",
+		},
+		{
+			name: "empty expression",
+			rule: &TransformRule{
+				Matcher: []string{"test.*"},
+				Columns: []*ColumnTransform{{Column: "level", Type: TransformTypeExpression}},
+			},
+			wantErr: "expression",
+		},
+		{
+			name: "duplicated column",
+			rule: &TransformRule{
+				Matcher: []string{"test.*"},
+				Columns: []*ColumnTransform{
+					{Column: "email", Type: TransformTypeHash},
+					{Column: "email", Type: TransformTypeConstant},
+				},
+			},
+			wantErr: "more than once",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &SinkConfig{Transforms: []*TransformRule{tc.rule}}
+			err := cfg.validateAndAdjustTransforms()
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+
+	// the default mask char is used
+	cfg := &SinkConfig{Transforms: []*TransformRule{{
+		Matcher: []string{"test.*"},
+		Columns: []*ColumnTransform{{Column: "phone", Type: TransformTypeMask}},
+	}}}
+	require.NoError(t, cfg.validateAndAdjustTransforms())
+	require.Equal(t, "*", cfg.Transforms[0].Columns[0].MaskChar)
+}
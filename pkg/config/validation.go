@@ -0,0 +1,95 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/util"
+)
+
+const defaultValidationSampleTableCount = 10
+
+// ValidationConfig represents the config of the periodic data validation of
+// a changefeed. When it is enabled, the maintainer samples some tables after
+// each syncpoint is written, compares the checksums of the upstream snapshot
+// and the downstream snapshot, and records the results in the downstream.
+type ValidationConfig struct {
+	Enable *bool `toml:"enable" json:"enable,omitempty"`
+	// UpstreamURI is a MySQL compatible uri of the upstream TiDB, which is
+	// used to read the upstream snapshot.
+	UpstreamURI *string `toml:"upstream-uri" json:"upstream-uri,omitempty"`
+	// SampleTableCount is the number of tables validated at each syncpoint,
+	// the tables are validated in turn.
+	SampleTableCount *int `toml:"sample-table-count" json:"sample-table-count,omitempty"`
+	// Interval is the minimum interval between the primary ts of two validated
+	// syncpoints, it is the same as the sync point interval by default, so
+	// every syncpoint is validated.
+	Interval *time.Duration `toml:"interval" json:"interval,omitempty"`
+}
+
+// IsEnabled returns true if the validation is enabled.
+func (c *ValidationConfig) IsEnabled() bool {
+	return c != nil && util.GetOrZero(c.Enable)
+}
+
+// MaskSensitiveData masks sensitive data in ValidationConfig
+func (c *ValidationConfig) MaskSensitiveData() {
+	if c.UpstreamURI != nil {
+		c.UpstreamURI = util.AddressOf(util.MaskSensitiveDataInURI(*c.UpstreamURI))
+	}
+}
+
+func (c *ValidationConfig) validateAndAdjust(
+	sinkURI *url.URL, enableSyncPoint bool, syncPointInterval time.Duration,
+) error {
+	if !c.IsEnabled() {
+		return nil
+	}
+	if !enableSyncPoint {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs("The validation requires the SyncPoint to be enabled")
+	}
+	if !IsMySQLCompatibleScheme(GetScheme(sinkURI)) {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs("The validation must be disabled when the downstream is not tidb or mysql")
+	}
+	upstreamURI, err := url.Parse(util.GetOrZero(c.UpstreamURI))
+	if err != nil {
+		return cerror.WrapError(cerror.ErrInvalidReplicaConfig, err)
+	}
+	if !IsMySQLCompatibleScheme(GetScheme(upstreamURI)) {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs("The upstream-uri of the validation must be a MySQL compatible uri")
+	}
+	if c.SampleTableCount == nil {
+		c.SampleTableCount = util.AddressOf(defaultValidationSampleTableCount)
+	}
+	if *c.SampleTableCount <= 0 {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs("The sample-table-count of the validation must be larger than 0")
+	}
+	if c.Interval == nil {
+		c.Interval = util.AddressOf(syncPointInterval)
+	}
+	if *c.Interval < minSyncPointInterval {
+		return cerror.ErrInvalidReplicaConfig.
+			FastGenByArgs(fmt.Sprintf("The interval:%s of the validation must be larger than %s",
+				c.Interval.String(), minSyncPointInterval.String()))
+	}
+	return nil
+}
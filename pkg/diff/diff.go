@@ -111,6 +111,26 @@ type TableDiff struct {
 
 	// create after all chunks is splited, or load from checkpoint
 	summaryInfo *tableSummaryInfo
+
+	// chunks whose data is not equal or failed to check
+	mismatchedMu     sync.Mutex
+	mismatchedChunks []*ChunkRange
+}
+
+// MismatchedChunks returns the chunks whose data is not equal or failed to be
+// checked in the last call of Equal.
+func (t *TableDiff) MismatchedChunks() []*ChunkRange {
+	t.mismatchedMu.Lock()
+	defer t.mismatchedMu.Unlock()
+	chunks := make([]*ChunkRange, len(t.mismatchedChunks))
+	copy(chunks, t.mismatchedChunks)
+	return chunks
+}
+
+func (t *TableDiff) addMismatchedChunk(chunk *ChunkRange) {
+	t.mismatchedMu.Lock()
+	defer t.mismatchedMu.Unlock()
+	t.mismatchedChunks = append(t.mismatchedChunks, chunk)
 }
 
 func (t *TableDiff) setConfigHash() error {
@@ -129,6 +149,9 @@ func (t *TableDiff) setConfigHash() error {
 func (t *TableDiff) Equal(ctx context.Context, writeFixSQL func(string) error) (bool, bool, error) {
 	t.adjustConfig()
 	t.sqlCh = make(chan string)
+	t.mismatchedMu.Lock()
+	t.mismatchedChunks = nil
+	t.mismatchedMu.Unlock()
 
 	err := t.getTableInfo(ctx)
 	if err != nil {
@@ -434,6 +457,9 @@ func (t *TableDiff) checkChunkDataEqual(ctx context.Context, filterByRand bool,
 					t.summaryInfo.addFailedNum()
 				}
 			}
+			if chunk.State != successState {
+				t.addMismatchedChunk(chunk)
+			}
 		}
 		update()
 	}()
@@ -0,0 +1,290 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package election
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	// CoordinatorElection is the name of the election of the coordinator.
+	CoordinatorElection = "coordinator"
+	// LogCoordinatorElection is the name of the election of the log coordinator.
+	LogCoordinatorElection = "log-coordinator"
+)
+
+// sqlElectionTable is the table of the elections in the meta database, each row
+// is the lease of the leader of an election.
+const sqlElectionTable = "ticdc_election"
+
+const createSQLElectionTable = `CREATE TABLE IF NOT EXISTS ` + sqlElectionTable + ` (
+	cluster_id VARCHAR(128) NOT NULL,
+	name VARCHAR(64) NOT NULL,
+	leader_id VARCHAR(128) NOT NULL,
+	revision BIGINT NOT NULL,
+	lease_expire DATETIME(6) NOT NULL,
+	PRIMARY KEY (cluster_id, name)
+)`
+
+// SQLElection campaigns the leader by a lease in the meta database. The lease is
+// compared with the clock of the database, so the clocks of the nodes do not matter.
+// The revision is increased every time the leader changes, it's used as the version
+// of the leader like the revision of the etcd election key.
+type SQLElection struct {
+	db        *sql.DB
+	clusterID string
+	name      string
+	leaseTTL  time.Duration
+
+	mu       sync.Mutex
+	leaderID string
+	revision int64
+	// done is closed when the leadership of the current term is lost or resigned.
+	done   chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewSQLElection creates a SQLElection, and creates the election table if it does not exist.
+func NewSQLElection(
+	ctx context.Context, db *sql.DB, clusterID, name string, leaseTTL time.Duration,
+) (*SQLElection, error) {
+	if _, err := db.ExecContext(ctx, createSQLElectionTable); err != nil {
+		return nil, errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	done := make(chan struct{})
+	close(done)
+	return &SQLElection{
+		db:        db,
+		clusterID: clusterID,
+		name:      name,
+		leaseTTL:  leaseTTL,
+		done:      done,
+	}, nil
+}
+
+func (e *SQLElection) retryInterval() time.Duration {
+	return e.leaseTTL / 3
+}
+
+// Campaign blocks until it's elected as the leader with the value, or the context is done.
+// The lease held by the value is taken over with a new revision.
+func (e *SQLElection) Campaign(ctx context.Context, value string) error {
+	e.stopKeepAlive()
+	ticker := time.NewTicker(e.retryInterval())
+	defer ticker.Stop()
+	for {
+		elected, revision, err := e.tryAcquire(ctx, value)
+		if err != nil {
+			log.Warn("campaign the leader in the meta database failed, retry later",
+				zap.String("election", e.name), zap.String("value", value), zap.Error(err))
+		}
+		if elected {
+			e.startKeepAlive(value, revision)
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// tryAcquire takes the lease if there is no leader or the lease of the leader is expired.
+func (e *SQLElection) tryAcquire(ctx context.Context, value string) (bool, int64, error) {
+	tx, err := e.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, 0, errors.WrapError(errors.ErrMySQLTxnError, err)
+	}
+	defer func() {
+		// The rollback after the commit is a no-op.
+		_ = tx.Rollback()
+	}()
+
+	var (
+		leaderID string
+		revision int64
+		alive    bool
+	)
+	err = tx.QueryRowContext(ctx,
+		"SELECT leader_id, revision, lease_expire > NOW(6) FROM "+sqlElectionTable+
+			" WHERE cluster_id = ? AND name = ? FOR UPDATE",
+		e.clusterID, e.name).Scan(&leaderID, &revision, &alive)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		revision = 1
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO "+sqlElectionTable+" (cluster_id, name, leader_id, revision, lease_expire)"+
+				" VALUES (?, ?, ?, ?, NOW(6) + INTERVAL ? MICROSECOND)",
+			e.clusterID, e.name, value, revision, e.leaseTTL.Microseconds())
+	case err != nil:
+		return false, 0, errors.WrapError(errors.ErrMySQLQueryError, err)
+	case alive && leaderID != value:
+		return false, 0, nil
+	default:
+		revision++
+		_, err = tx.ExecContext(ctx,
+			"UPDATE "+sqlElectionTable+" SET leader_id = ?, revision = ?, lease_expire = NOW(6) + INTERVAL ? MICROSECOND"+
+				" WHERE cluster_id = ? AND name = ?",
+			value, revision, e.leaseTTL.Microseconds(), e.clusterID, e.name)
+	}
+	if err != nil {
+		return false, 0, errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	if err = tx.Commit(); err != nil {
+		return false, 0, errors.WrapError(errors.ErrMySQLTxnError, err)
+	}
+	return true, revision, nil
+}
+
+func (e *SQLElection) startKeepAlive(value string, revision int64) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	e.mu.Lock()
+	e.leaderID = value
+	e.revision = revision
+	e.done = done
+	e.cancel = cancel
+	e.mu.Unlock()
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer close(done)
+		e.keepAlive(ctx, value, revision)
+	}()
+}
+
+// keepAlive renews the lease until the context is canceled or the lease is lost.
+// The leader steps down before the lease is expired in the meta database if it
+// cannot renew the lease, so there is at most one leader at any time.
+func (e *SQLElection) keepAlive(ctx context.Context, value string, revision int64) {
+	ticker := time.NewTicker(e.retryInterval())
+	defer ticker.Stop()
+	lastRenewed := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		renewed, err := e.renew(ctx, value, revision)
+		switch {
+		case err != nil:
+			if time.Since(lastRenewed) >= e.leaseTTL-e.retryInterval() {
+				log.Warn("renew the leader lease timeout, step down",
+					zap.String("election", e.name), zap.String("value", value),
+					zap.Int64("revision", revision), zap.Error(err))
+				return
+			}
+			log.Warn("renew the leader lease failed, retry later",
+				zap.String("election", e.name), zap.String("value", value), zap.Error(err))
+		case !renewed:
+			log.Warn("the leader lease is taken by others, step down",
+				zap.String("election", e.name), zap.String("value", value), zap.Int64("revision", revision))
+			return
+		default:
+			lastRenewed = time.Now()
+		}
+	}
+}
+
+func (e *SQLElection) renew(ctx context.Context, value string, revision int64) (bool, error) {
+	result, err := e.db.ExecContext(ctx,
+		"UPDATE "+sqlElectionTable+" SET lease_expire = NOW(6) + INTERVAL ? MICROSECOND"+
+			" WHERE cluster_id = ? AND name = ? AND leader_id = ? AND revision = ?",
+		e.leaseTTL.Microseconds(), e.clusterID, e.name, value, revision)
+	if err != nil {
+		return false, errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	return affected > 0, nil
+}
+
+// stopKeepAlive stops renewing the lease of the current term, it returns false
+// if the lease is not renewed.
+func (e *SQLElection) stopKeepAlive() (string, int64, bool) {
+	e.mu.Lock()
+	cancel, value, revision := e.cancel, e.leaderID, e.revision
+	e.cancel = nil
+	e.mu.Unlock()
+	if cancel == nil {
+		return "", 0, false
+	}
+	cancel()
+	e.wg.Wait()
+	return value, revision, true
+}
+
+// Done returns a channel which is closed when the leadership is lost or resigned.
+func (e *SQLElection) Done() <-chan struct{} {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.done
+}
+
+// Revision returns the revision of the current term.
+func (e *SQLElection) Revision() int64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.revision
+}
+
+// Resign stops renewing the lease and expires it, so other nodes can be elected at once.
+func (e *SQLElection) Resign(ctx context.Context) error {
+	value, revision, ok := e.stopKeepAlive()
+	if !ok {
+		return nil
+	}
+	_, err := e.db.ExecContext(ctx,
+		"UPDATE "+sqlElectionTable+" SET lease_expire = NOW(6)"+
+			" WHERE cluster_id = ? AND name = ? AND leader_id = ? AND revision = ?",
+		e.clusterID, e.name, value, revision)
+	if err != nil {
+		return errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	return nil
+}
+
+// Leader returns the value of the leader whose lease is not expired.
+func (e *SQLElection) Leader(ctx context.Context) (string, error) {
+	return GetSQLLeader(ctx, e.db, e.clusterID, e.name)
+}
+
+// GetSQLLeader returns the value of the leader of the election in the meta database.
+func GetSQLLeader(ctx context.Context, db *sql.DB, clusterID, name string) (string, error) {
+	var leaderID string
+	err := db.QueryRowContext(ctx,
+		"SELECT leader_id FROM "+sqlElectionTable+" WHERE cluster_id = ? AND name = ? AND lease_expire > NOW(6)",
+		clusterID, name).Scan(&leaderID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errors.ErrOwnerNotFound.GenWithStackByArgs()
+		}
+		return "", errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	return leaderID, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package election
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newSQLElectionForTest(t *testing.T, leaseTTL time.Duration) (*SQLElection, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS ticdc_election")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	e, err := NewSQLElection(context.Background(), db, "default", CoordinatorElection, leaseTTL)
+	require.NoError(t, err)
+	return e, mock
+}
+
+func TestSQLElectionCampaignAndResign(t *testing.T) {
+	e, mock := newSQLElectionForTest(t, time.Hour)
+	select {
+	case <-e.Done():
+	default:
+		require.FailNow(t, "the election is not the leader before campaign")
+	}
+
+	// There is no leader, so the lease is created.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT leader_id, revision, lease_expire > NOW(6) FROM ticdc_election")).
+		WithArgs("default", CoordinatorElection).
+		WillReturnRows(sqlmock.NewRows([]string{"leader_id", "revision", "alive"}))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO ticdc_election")).
+		WithArgs("default", CoordinatorElection, "node-1", int64(1), time.Hour.Microseconds()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, e.Campaign(context.Background(), "node-1"))
+	require.Equal(t, int64(1), e.Revision())
+	done := e.Done()
+	select {
+	case <-done:
+		require.FailNow(t, "the leader is lost unexpectedly")
+	default:
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ticdc_election SET lease_expire = NOW(6) WHERE")).
+		WithArgs("default", CoordinatorElection, "node-1", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, e.Resign(context.Background()))
+	<-done
+	// Resign twice is a no-op.
+	require.NoError(t, e.Resign(context.Background()))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLElectionCampaignExpiredLeader(t *testing.T) {
+	e, mock := newSQLElectionForTest(t, time.Hour)
+
+	// The lease of the leader is alive, so the campaign waits.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT leader_id, revision, lease_expire > NOW(6) FROM ticdc_election")).
+		WillReturnRows(sqlmock.NewRows([]string{"leader_id", "revision", "alive"}).AddRow("node-1", 3, true))
+	mock.ExpectRollback()
+	elected, _, err := e.tryAcquire(context.Background(), "node-2")
+	require.NoError(t, err)
+	require.False(t, elected)
+
+	// The lease of the leader is expired, so the revision is increased.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT leader_id, revision, lease_expire > NOW(6) FROM ticdc_election")).
+		WillReturnRows(sqlmock.NewRows([]string{"leader_id", "revision", "alive"}).AddRow("node-1", 3, false))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ticdc_election SET leader_id = ?, revision = ?")).
+		WithArgs("node-2", int64(4), time.Hour.Microseconds(), "default", CoordinatorElection).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	elected, revision, err := e.tryAcquire(context.Background(), "node-2")
+	require.NoError(t, err)
+	require.True(t, elected)
+	require.Equal(t, int64(4), revision)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSQLElectionLoseLease(t *testing.T) {
+	e, mock := newSQLElectionForTest(t, 30*time.Millisecond)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT leader_id, revision, lease_expire > NOW(6) FROM ticdc_election")).
+		WillReturnRows(sqlmock.NewRows([]string{"leader_id", "revision", "alive"}).AddRow("node-1", 1, false))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ticdc_election SET leader_id = ?, revision = ?")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	// The lease is taken by others when it's renewed.
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ticdc_election SET lease_expire = NOW(6) + INTERVAL ? MICROSECOND")).
+		WithArgs(int64(30000), "default", CoordinatorElection, "node-2", int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, e.Campaign(context.Background(), "node-2"))
+
+	select {
+	case <-e.Done():
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "the lost leadership is not detected")
+	}
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ticdc_election SET lease_expire = NOW(6) WHERE")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, e.Resign(context.Background()))
+}
+
+func TestGetSQLLeader(t *testing.T) {
+	e, mock := newSQLElectionForTest(t, time.Hour)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT leader_id FROM ticdc_election")).
+		WithArgs("default", CoordinatorElection).
+		WillReturnRows(sqlmock.NewRows([]string{"leader_id"}).AddRow("node-1"))
+	leader, err := e.Leader(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "node-1", leader)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT leader_id FROM ticdc_election")).
+		WillReturnRows(sqlmock.NewRows([]string{"leader_id"}))
+	_, err = e.Leader(context.Background())
+	require.True(t, errors.ErrOwnerNotFound.Equal(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -30,6 +30,30 @@ var (
 		"changefeed already exists, %s",
 		errors.RFCCodeText("CDC:ErrChangeFeedAlreadyExists"),
 	)
+	ErrChangefeedTemplateNotExists = errors.Normalize(
+		"changefeed template not exists, %s",
+		errors.RFCCodeText("CDC:ErrChangefeedTemplateNotExists"),
+	)
+	ErrChangefeedTemplateAlreadyExists = errors.Normalize(
+		"changefeed template already exists, %s",
+		errors.RFCCodeText("CDC:ErrChangefeedTemplateAlreadyExists"),
+	)
+	ErrInvalidChangefeedTemplate = errors.Normalize(
+		"invalid changefeed template, %s",
+		errors.RFCCodeText("CDC:ErrInvalidChangefeedTemplate"),
+	)
+	ErrChangefeedConfigRevisionNotExists = errors.Normalize(
+		"changefeed config revision not exists, %s",
+		errors.RFCCodeText("CDC:ErrChangefeedConfigRevisionNotExists"),
+	)
+	ErrInvalidChangefeedStopPolicy = errors.Normalize(
+		"invalid changefeed stop policy, %s",
+		errors.RFCCodeText("CDC:ErrInvalidChangefeedStopPolicy"),
+	)
+	ErrSyncPointNotFound = errors.Normalize(
+		"syncpoint not found, %s",
+		errors.RFCCodeText("CDC:ErrSyncPointNotFound"),
+	)
 	ErrEtcdAPIError = errors.Normalize(
 		"etcd api returns error",
 		errors.RFCCodeText("CDC:ErrEtcdAPIError"),
@@ -94,6 +118,22 @@ var (
 		"column selector failed",
 		errors.RFCCodeText("CDC:ErrColumnSelectorFailed"),
 	)
+	ErrTransformFailed = errors.Normalize(
+		"transform failed",
+		errors.RFCCodeText("CDC:ErrTransformFailed"),
+	)
+	ErrExtraColumnFailed = errors.Normalize(
+		"add extra columns failed, %s",
+		errors.RFCCodeText("CDC:ErrExtraColumnFailed"),
+	)
+	ErrConflictPolicyFailed = errors.Normalize(
+		"apply conflict policy failed, %s",
+		errors.RFCCodeText("CDC:ErrConflictPolicyFailed"),
+	)
+	ErrDeadLetterQueueFailed = errors.Normalize(
+		"dead letter queue failed, %s",
+		errors.RFCCodeText("CDC:ErrDeadLetterQueueFailed"),
+	)
 
 	// Errors caused by unexpected behavior from external systems
 	ErrTiDBUnexpectedJobMeta = errors.Normalize(
@@ -143,6 +183,30 @@ var (
 		"kafka admin API %s failed: %s",
 		errors.RFCCodeText("CDC:ErrKafkaAdminAPI"),
 	)
+	ErrElasticsearchInvalidConfig = errors.Normalize(
+		"elasticsearch config invalid",
+		errors.RFCCodeText("CDC:ErrElasticsearchInvalidConfig"),
+	)
+	ErrElasticsearchRequestFailed = errors.Normalize(
+		"elasticsearch request failed",
+		errors.RFCCodeText("CDC:ErrElasticsearchRequestFailed"),
+	)
+	ErrWebhookInvalidConfig = errors.Normalize(
+		"webhook config invalid",
+		errors.RFCCodeText("CDC:ErrWebhookInvalidConfig"),
+	)
+	ErrWebhookRequestFailed = errors.Normalize(
+		"webhook request failed",
+		errors.RFCCodeText("CDC:ErrWebhookRequestFailed"),
+	)
+	ErrRedisInvalidConfig = errors.Normalize(
+		"redis config invalid",
+		errors.RFCCodeText("CDC:ErrRedisInvalidConfig"),
+	)
+	ErrRedisRequestFailed = errors.Normalize(
+		"redis request failed",
+		errors.RFCCodeText("CDC:ErrRedisRequestFailed"),
+	)
 	ErrPulsarInvalidTopicExpression = errors.Normalize(
 		"invalid topic expression",
 		errors.RFCCodeText("CDC:ErrPulsarTopicExprInvalid"),
@@ -224,10 +288,18 @@ var (
 		"open-protocol codec invalid data",
 		errors.RFCCodeText("CDC:ErrOpenProtocolCodecInvalidData"),
 	)
+	ErrCraftCodecInvalidData = errors.Normalize(
+		"craft codec invalid data",
+		errors.RFCCodeText("CDC:ErrCraftCodecInvalidData"),
+	)
 	ErrCanalEncodeFailed = errors.Normalize(
 		"canal encode failed",
 		errors.RFCCodeText("CDC:ErrCanalEncodeFailed"),
 	)
+	ErrMaxwellEncodeFailed = errors.Normalize(
+		"maxwell encode failed",
+		errors.RFCCodeText("CDC:ErrMaxwellEncodeFailed"),
+	)
 	ErrSinkInvalidConfig = errors.Normalize(
 		"sink config invalid",
 		errors.RFCCodeText("CDC:ErrSinkInvalidConfig"),
@@ -260,6 +332,10 @@ var (
 		"csv decode failed",
 		errors.RFCCodeText("CDC:ErrCSVDecodeFailed"),
 	)
+	ErrParquetEncodeFailed = errors.Normalize(
+		"parquet encode failed",
+		errors.RFCCodeText("CDC:ErrParquetEncodeFailed"),
+	)
 	ErrDebeziumEncodeFailed = errors.Normalize(
 		"debezium encode failed",
 		errors.RFCCodeText("CDC:ErrDebeziumEncodeFailed"),
@@ -280,6 +356,10 @@ var (
 		"filename in storage sink is invalid",
 		errors.RFCCodeText("CDC:ErrStorageSinkInvalidFileName"),
 	)
+	ErrStorageSinkTableFormatCommitFailed = errors.Normalize(
+		"commit table format metadata in storage sink failed",
+		errors.RFCCodeText("CDC:ErrStorageSinkTableFormatCommitFailed"),
+	)
 
 	// utilities related errors
 	ErrToTLSConfigFailed = errors.Normalize(
@@ -729,6 +809,10 @@ var (
 		"initialize meta for redo log",
 		errors.RFCCodeText("CDC:ErrRedoMetaInitialize"),
 	)
+	ErrRedoApplyInvalidTargetTs = errors.Normalize(
+		"redo apply target-ts %d is out of the consistent range [%d, %d] of the redo log",
+		errors.RFCCodeText("CDC:ErrRedoApplyInvalidTargetTs"),
+	)
 	ErrPulsarInvalidConfig = errors.Normalize(
 		"pulsar config invalid %s",
 		errors.RFCCodeText("CDC:ErrPulsarInvalidConfig"),
@@ -793,6 +877,14 @@ var (
 		"maintainer is not found",
 		errors.RFCCodeText("CDC:ErrMaintainerNotFounded"),
 	)
+	ErrPendingDDLNotFound = errors.Normalize(
+		"pending ddl is not found, commit ts %d",
+		errors.RFCCodeText("CDC:ErrPendingDDLNotFound"),
+	)
+	ErrPendingDDLAmbiguous = errors.Normalize(
+		"%d pending ddls have the commit ts %d, the query of the ddl must be specified",
+		errors.RFCCodeText("CDC:ErrPendingDDLAmbiguous"),
+	)
 	ErrTimeout = errors.Normalize(
 		"timeout",
 		errors.RFCCodeText("CDC:ErrTimeout"),
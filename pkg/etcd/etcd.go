@@ -48,6 +48,16 @@ func LogCoordinatorKey(clusterID string) string {
 	return NewCDCBaseKey(clusterID) + metaPrefix + "/log_coordinator"
 }
 
+// ChangefeedTemplateKeyPrefix is the prefix of the changefeed template keys
+func ChangefeedTemplateKeyPrefix(clusterID string) string {
+	return NewCDCBaseKey(clusterID) + metaPrefix + "/changefeed-template"
+}
+
+// GetEtcdKeyChangefeedTemplate returns the key of a changefeed template
+func GetEtcdKeyChangefeedTemplate(clusterID, name string) string {
+	return ChangefeedTemplateKeyPrefix(clusterID) + "/" + name
+}
+
 // CaptureInfoKeyPrefix is the capture info path that is saved to etcd
 func CaptureInfoKeyPrefix(clusterID string) string {
 	return BaseKey(clusterID) + metaPrefix + captureKey
@@ -74,6 +84,21 @@ func GetEtcdKeyChangeFeedInfo(clusterID string, changefeedID common.ChangeFeedDi
 		changefeedID.Keyspace), changefeedID.Name)
 }
 
+// ChangefeedConfigHistoryKeyPrefix returns the prefix of the config revisions of a changefeed.
+// It's not under the changefeed info prefix, so the revisions are not listed as changefeeds.
+func ChangefeedConfigHistoryKeyPrefix(clusterID string, changefeedID common.ChangeFeedDisplayName) string {
+	return fmt.Sprintf("%s/changefeed-config-history/%s/",
+		KeyspacePrefix(clusterID, changefeedID.Keyspace), changefeedID.Name)
+}
+
+// GetEtcdKeyChangefeedConfigRevision returns the key of a changefeed config revision,
+// the revision is zero padded so the keys are sorted by the revision.
+func GetEtcdKeyChangefeedConfigRevision(
+	clusterID string, changefeedID common.ChangeFeedDisplayName, revision uint64,
+) string {
+	return fmt.Sprintf("%s%020d", ChangefeedConfigHistoryKeyPrefix(clusterID, changefeedID), revision)
+}
+
 // GetEtcdKeyCaptureInfo returns the key of a capture info
 func GetEtcdKeyCaptureInfo(clusterID, id string) string {
 	return CaptureInfoKeyPrefix(clusterID) + "/" + id
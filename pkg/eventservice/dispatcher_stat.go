@@ -112,6 +112,11 @@ type dispatcherStat struct {
 	// from an invalid position.
 	lastScanProgress atomic.Pointer[scanProgress]
 
+	// snapshotProgress is the progress of the initial snapshot, it's nil if the
+	// dispatcher does not need the initial snapshot. The incremental scan is not
+	// started until the snapshot is done.
+	snapshotProgress atomic.Pointer[snapshotProgress]
+
 	largeTxnStateMu sync.Mutex
 	largeTxnState   *largeTxnScanState
 
@@ -189,6 +194,11 @@ func newDispatcherStat(
 	dispStat.sentResolvedTs.Store(startTs)
 
 	dispStat.storeScanProgress(newTxnScanProgress(startTs, 0))
+	span := info.GetTableSpan()
+	if snapshotTs := info.GetInitialSnapshotTs(); snapshotTs != 0 &&
+		!common.IsRedoMode(info.GetMode()) && !span.Equal(common.KeyspaceDDLSpan(span.KeyspaceID)) {
+		dispStat.storeSnapshotProgress(snapshotProgress{ts: snapshotTs})
+	}
 	dispStat.lastReadySendTime.Store(0)
 	dispStat.readyInterval.Store(1)
 	dispStat.resetScanLimit()
@@ -289,6 +299,24 @@ func (a *dispatcherStat) loadScanProgress() scanProgress {
 	return result
 }
 
+func (a *dispatcherStat) storeSnapshotProgress(progress snapshotProgress) {
+	a.snapshotProgress.Store(&progress)
+}
+
+func (a *dispatcherStat) loadSnapshotProgress() snapshotProgress {
+	progress := a.snapshotProgress.Load()
+	if progress == nil {
+		return snapshotProgress{done: true}
+	}
+	return *progress
+}
+
+// hasPendingSnapshot returns whether the initial snapshot of the dispatcher is not done.
+func (a *dispatcherStat) hasPendingSnapshot() bool {
+	progress := a.snapshotProgress.Load()
+	return progress != nil && !progress.done
+}
+
 // onResolvedTs try to update the resolved ts of the dispatcher.
 func (a *dispatcherStat) onResolvedTs(resolvedTs uint64) bool {
 	if resolvedTs <= a.receivedResolvedTs.Load() {
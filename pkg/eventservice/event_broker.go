@@ -33,6 +33,7 @@ import (
 	"github.com/pingcap/ticdc/pkg/metrics"
 	"github.com/pingcap/ticdc/pkg/node"
 	"github.com/pingcap/ticdc/pkg/pdutil"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
@@ -101,6 +102,10 @@ type eventBroker struct {
 
 	scanRateLimiter  *rate.Limiter
 	scanLimitInBytes uint64
+
+	// getSnapshotStorage returns the kv storage of the keyspace,
+	// it's used to read the initial snapshot of the tables.
+	getSnapshotStorage func(ctx context.Context, keyspaceName string) (kv.Storage, error)
 }
 
 func newEventBroker(
@@ -149,6 +154,7 @@ func newEventBroker(
 		g:                       g,
 		scanRateLimiter:         rate.NewLimiter(rate.Limit(scanLimitInBytes), scanLimitInBytes),
 		scanLimitInBytes:        uint64(scanLimitInBytes),
+		getSnapshotStorage:      getKeyspaceStorage,
 	}
 
 	// Initialize metrics collector
@@ -562,6 +568,12 @@ func (c *eventBroker) scanReady(task scanTask) bool {
 
 	c.sendHandshakeIfNeed(task)
 
+	// The resolved ts must not be sent before the initial snapshot is done,
+	// otherwise the snapshot events are regarded as stale by the dispatcher.
+	if task.hasPendingSnapshot() {
+		return true
+	}
+
 	ok, _ := c.getScanTaskRequest(task)
 	return ok
 }
@@ -683,9 +695,16 @@ func (c *eventBroker) doScan(ctx context.Context, task scanTask) {
 		return
 	}
 
-	needScan, request := c.getScanTaskRequest(task)
-	if !needScan {
-		return
+	var (
+		snapshotPending = task.hasPendingSnapshot() && task.isHandshaked()
+		request         eventstore.ScanRequest
+	)
+	if !snapshotPending {
+		var needScan bool
+		needScan, request = c.getScanTaskRequest(task)
+		if !needScan {
+			return
+		}
 	}
 
 	// TODO: Currently, this rate limit does not take into account the priority of each task, which may lead to situations where certain tasks are starved and cannot be scheduled for a long time.
@@ -744,6 +763,25 @@ func (c *eventBroker) doScan(ctx context.Context, task scanTask) {
 		return
 	}
 
+	if snapshotPending {
+		scannedBytes, err := c.doSnapshotScan(scanCtx, task, remoteID, sl.maxDMLBytes)
+		releaseQuota(available, uint64(sl.maxDMLBytes-min(scannedBytes, sl.maxDMLBytes)))
+		if err != nil {
+			if !task.isRemoved.Load() {
+				log.Error("scan initial snapshot failed",
+					zap.Stringer("changefeedID", changefeedID),
+					zap.Stringer("dispatcherID", task.id),
+					zap.Int64("tableID", task.info.GetTableSpan().GetTableID()),
+					zap.Error(err))
+			}
+			return
+		}
+		task.lastScanBytes.Store(min(scannedBytes, int64(c.scanLimitInBytes)))
+		// Continue the snapshot or start the incremental scan by the next task.
+		interrupted = true
+		return
+	}
+
 	scanner := newEventScanner(c.eventStore, c.schemaStore, c.mounter, task.info.GetMode())
 	scannedBytes, events, progress, interrupted, err := scanner.scan(scanCtx, task, request, sl)
 	if interrupted {
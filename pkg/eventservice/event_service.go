@@ -60,6 +60,9 @@ type DispatcherInfo interface {
 	GetEpoch() uint64
 	IsOutputRawChangeEvent() bool
 	EnableIgnoreUpdateOnlyColumns() bool
+	// GetInitialSnapshotTs returns the ts to read the initial snapshot of the table span,
+	// 0 means the dispatcher does not need the initial snapshot.
+	GetInitialSnapshotTs() uint64
 }
 
 type DispatcherHeartBeatWithServerID struct {
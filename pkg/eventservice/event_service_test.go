@@ -494,6 +494,7 @@ type mockDispatcherInfo struct {
 	enableSyncPoint   bool
 	nextSyncPoint     uint64
 	syncPointInterval time.Duration
+	initialSnapshotTs uint64
 }
 
 func newMockDispatcherInfo(t *testing.T, startTs uint64, dispatcherID common.DispatcherID, tableID int64, actionType eventpb.ActionType) *mockDispatcherInfo {
@@ -596,6 +597,10 @@ func (m *mockDispatcherInfo) EnableIgnoreUpdateOnlyColumns() bool {
 	return false
 }
 
+func (m *mockDispatcherInfo) GetInitialSnapshotTs() uint64 {
+	return m.initialSnapshotTs
+}
+
 func (m *mockDispatcherInfo) GetTxnAtomicity() config.AtomicityLevel {
 	return config.DefaultAtomicityLevel()
 }
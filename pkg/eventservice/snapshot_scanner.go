@@ -0,0 +1,220 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventservice
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/heartbeatpb"
+	"github.com/pingcap/ticdc/pkg/common"
+	appcontext "github.com/pingcap/ticdc/pkg/common/context"
+	"github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+	"github.com/pingcap/ticdc/pkg/keyspace"
+	"github.com/pingcap/ticdc/pkg/node"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"go.uber.org/zap"
+)
+
+// snapshotProgress is the progress of the initial snapshot of a dispatcher.
+// The rows of the table span are read at ts, and sent as the DML events whose
+// commitTs is ts before any incremental events of the dispatcher.
+type snapshotProgress struct {
+	ts uint64
+	// nextKey is the key to resume the snapshot scan, nil means the start of the span.
+	nextKey kv.Key
+	done    bool
+}
+
+func getKeyspaceStorage(ctx context.Context, keyspaceName string) (kv.Storage, error) {
+	keyspaceManager := appcontext.GetService[keyspace.Manager](appcontext.KeyspaceManager)
+	return keyspaceManager.GetStorage(ctx, keyspaceName)
+}
+
+// snapshotKeyRange returns the record key range of the table span to read the snapshot.
+// The span keys are in the memcomparable format and may have the keyspace prefix,
+// but the snapshot of the keyspace storage is read by the raw keys without the prefix.
+func snapshotKeyRange(span *heartbeatpb.TableSpan) (kv.Key, kv.Key, error) {
+	recordStart := tablecodec.GenTableRecordPrefix(span.TableID)
+	recordEnd := recordStart.PrefixNext()
+
+	start, end := recordStart, recordEnd
+	if len(span.StartKey) != 0 {
+		_, key, err := codec.DecodeBytes(span.StartKey, nil)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		key = event.RemoveKeyspacePrefix(key)
+		if bytes.Compare(key, start) > 0 {
+			start = key
+		}
+	}
+	if len(span.EndKey) != 0 {
+		_, key, err := codec.DecodeBytes(span.EndKey, nil)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		key = event.RemoveKeyspacePrefix(key)
+		if bytes.Compare(key, end) < 0 {
+			end = key
+		}
+	}
+	return start, end, nil
+}
+
+// snapshotScanner reads the rows of a table span from the upstream snapshot,
+// and assembles them into the DML events of the initial snapshot.
+type snapshotScanner struct {
+	storage kv.Storage
+	mounter event.Mounter
+}
+
+func newSnapshotScanner(storage kv.Storage, mounter event.Mounter) *snapshotScanner {
+	return &snapshotScanner{
+		storage: storage,
+		mounter: mounter,
+	}
+}
+
+// scan reads the rows of the span from progress.nextKey, it stops after the
+// scanned bytes reach maxBytes, and the returned progress is used to resume the scan.
+// The rows are decoded by the tableInfo, which must be the table info the dispatcher
+// uses to assemble the rows of the events.
+func (s *snapshotScanner) scan(
+	ctx context.Context,
+	dispatcherID common.DispatcherID,
+	span *heartbeatpb.TableSpan,
+	tableInfo *common.TableInfo,
+	dmlFilter filter.Filter,
+	progress snapshotProgress,
+	maxBytes int64,
+) (*event.BatchDMLEvent, snapshotProgress, int64, error) {
+	start, end, err := snapshotKeyRange(span)
+	if err != nil {
+		return nil, progress, 0, err
+	}
+	if len(progress.nextKey) != 0 {
+		start = progress.nextKey
+	}
+	batchDML := event.NewBatchDMLEvent()
+	if bytes.Compare(start, end) >= 0 {
+		progress.done = true
+		return batchDML, progress, 0, nil
+	}
+
+	txn, err := newTxnEvent(batchDML, dispatcherID, span.TableID, tableInfo, progress.ts, progress.ts, true)
+	if err != nil {
+		return nil, progress, 0, err
+	}
+	iter, err := s.storage.GetSnapshot(kv.NewVersion(progress.ts)).Iter(start, end)
+	if err != nil {
+		return nil, progress, 0, errors.Trace(err)
+	}
+	defer iter.Close()
+
+	var scannedBytes int64
+	for iter.Valid() {
+		if err = ctx.Err(); err != nil {
+			return nil, progress, 0, errors.Trace(err)
+		}
+		raw := &common.RawKVEntry{
+			OpType:  common.OpTypePut,
+			Key:     iter.Key(),
+			Value:   iter.Value(),
+			StartTs: progress.ts,
+			CRTs:    progress.ts,
+		}
+		if err = txn.AppendRow(raw, s.mounter.DecodeToChunk, dmlFilter, filter.DMLFilterContext{}); err != nil {
+			return nil, progress, 0, errors.Trace(err)
+		}
+		scannedBytes += raw.GetSize()
+		progress.nextKey = iter.Key().Next()
+		if err = iter.Next(); err != nil {
+			return nil, progress, 0, errors.Trace(err)
+		}
+		if scannedBytes >= maxBytes {
+			break
+		}
+	}
+	progress.done = !iter.Valid()
+
+	dmls := batchDML.DMLEvents[:0]
+	for _, dml := range batchDML.DMLEvents {
+		// The rows can be all filtered out.
+		if dml.Len() == 0 {
+			continue
+		}
+		dml.IsSnapshot = true
+		dmls = append(dmls, dml)
+	}
+	batchDML.DMLEvents = dmls
+	batchDML.DMLEventCount = int32(len(dmls))
+	return batchDML, progress, scannedBytes, nil
+}
+
+// doSnapshotScan reads a batch of the initial snapshot of the dispatcher and sends it,
+// the scan is resumed by the next scan task until the snapshot is done.
+func (c *eventBroker) doSnapshotScan(ctx context.Context, task scanTask, remoteID node.ID, maxBytes int64) (int64, error) {
+	progress := task.loadSnapshotProgress()
+	if task.startTableInfo == nil {
+		// The table is dropped before the dispatcher is created, so there is nothing to read.
+		progress.done = true
+		task.storeSnapshotProgress(progress)
+		return 0, nil
+	}
+
+	storage, err := c.getSnapshotStorage(ctx, task.changefeedStat.changefeedID.Keyspace())
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	scanner := newSnapshotScanner(storage, c.mounter)
+	batchDML, next, scannedBytes, err := scanner.scan(
+		ctx, task.id, task.info.GetTableSpan(), task.startTableInfo, task.filter, progress, maxBytes)
+	if err != nil {
+		return 0, err
+	}
+	if task.isRemoved.Load() {
+		return scannedBytes, nil
+	}
+	c.sendSnapshotDML(remoteID, batchDML, task)
+	task.storeSnapshotProgress(next)
+	if next.done {
+		log.Info("initial snapshot of the dispatcher is finished",
+			zap.Stringer("changefeedID", task.changefeedStat.changefeedID),
+			zap.Stringer("dispatcherID", task.id),
+			zap.Int64("tableID", task.info.GetTableSpan().GetTableID()),
+			zap.Uint64("snapshotTs", next.ts))
+	}
+	return scannedBytes, nil
+}
+
+// sendSnapshotDML sends the DML events of the initial snapshot. Unlike sendDML,
+// the scan range of the incremental scan is not touched, since the snapshot events
+// are not read from the event store.
+func (c *eventBroker) sendSnapshotDML(remoteID node.ID, batchEvent *event.BatchDMLEvent, d *dispatcherStat) {
+	if len(batchEvent.DMLEvents) == 0 {
+		return
+	}
+	for _, dml := range batchEvent.DMLEvents {
+		dml.Seq = d.seq.Add(1)
+		dml.Epoch = d.epoch
+	}
+	c.getMessageCh(d.messageWorkerIndex, common.IsRedoMode(d.info.GetMode())) <- newWrapBatchDMLEvent(remoteID, batchEvent)
+	updateMetricEventServiceSendKvCount(d.info.GetMode(), float64(batchEvent.Len()))
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap/ticdc/eventpb"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/integrity"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestSnapshotScannerScan(t *testing.T) {
+	helper := event.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	job := helper.DDL2Job("create table t (id int primary key, name varchar(16))")
+	tableInfo := helper.GetTableInfo(job)
+	helper.Tk().MustExec("insert into t values (1, 'a'), (2, 'b'), (3, 'c')")
+
+	ver, err := helper.Storage().CurrentVersion(oracle.GlobalTxnScope)
+	require.NoError(t, err)
+	snapshotTs := ver.Ver
+	// The rows written after the snapshot ts are not read.
+	helper.Tk().MustExec("insert into t values (4, 'd')")
+
+	span := common.TableIDToComparableSpan(common.DefaultKeyspaceID, tableInfo.TableName.TableID)
+	scanner := newSnapshotScanner(helper.Storage(), event.NewMounter(time.UTC, &integrity.Config{}))
+	dispatcherID := common.NewDispatcherID()
+
+	var (
+		ids      []int64
+		progress = snapshotProgress{ts: snapshotTs}
+	)
+	for !progress.done {
+		// Read one row in each scan to verify the scan can be resumed.
+		batchDML, next, scannedBytes, err := scanner.scan(
+			context.Background(), dispatcherID, &span, tableInfo, nil, progress, 1)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(ids), 3)
+		if !next.done {
+			require.Positive(t, scannedBytes)
+			require.NotEmpty(t, next.nextKey)
+		}
+		for _, dml := range batchDML.DMLEvents {
+			require.True(t, dml.IsSnapshot)
+			require.Equal(t, snapshotTs, dml.GetCommitTs())
+			require.Equal(t, dispatcherID, dml.GetDispatcherID())
+			for {
+				row, ok := dml.GetNextRow()
+				if !ok {
+					break
+				}
+				require.Equal(t, common.RowTypeInsert, row.RowType)
+				ids = append(ids, row.Row.GetInt64(0))
+			}
+		}
+		progress = next
+	}
+	require.Equal(t, []int64{1, 2, 3}, ids)
+
+	// The scan of a done snapshot returns nothing.
+	batchDML, next, _, err := scanner.scan(
+		context.Background(), dispatcherID, &span, tableInfo, nil, progress, 1)
+	require.NoError(t, err)
+	require.True(t, next.done)
+	require.Empty(t, batchDML.DMLEvents)
+}
+
+func TestSnapshotKeyRange(t *testing.T) {
+	t.Parallel()
+
+	span := common.TableIDToComparableSpan(common.DefaultKeyspaceID, 100)
+	start, end, err := snapshotKeyRange(&span)
+	require.NoError(t, err)
+	startKey, endKey, err := common.GetKeyspaceTableRange(common.DefaultKeyspaceID, 100)
+	require.NoError(t, err)
+	require.Equal(t, startKey, []byte(start))
+	require.Equal(t, endKey, []byte(end))
+
+	// The sub span of a split table is kept.
+	subSpan := span
+	subSpan.StartKey = common.ToComparableKey(append(startKey, 1))
+	start, end, err = snapshotKeyRange(&subSpan)
+	require.NoError(t, err)
+	require.Equal(t, append(startKey, 1), []byte(start))
+	require.Equal(t, endKey, []byte(end))
+}
+
+func TestDispatcherStatInitialSnapshot(t *testing.T) {
+	t.Parallel()
+
+	info := newMockDispatcherInfo(t, 100, common.NewDispatcherID(), 1, eventpb.ActionType_ACTION_TYPE_REGISTER)
+	stat := newDispatcherStat(info, 1, 1, nil, newChangefeedStatusForTest(t, info))
+	require.False(t, stat.hasPendingSnapshot())
+	require.True(t, stat.loadSnapshotProgress().done)
+
+	info = newMockDispatcherInfo(t, 100, common.NewDispatcherID(), 1, eventpb.ActionType_ACTION_TYPE_REGISTER)
+	info.initialSnapshotTs = 100
+	stat = newDispatcherStat(info, 1, 1, nil, newChangefeedStatusForTest(t, info))
+	require.True(t, stat.hasPendingSnapshot())
+	require.Equal(t, uint64(100), stat.loadSnapshotProgress().ts)
+
+	stat.storeSnapshotProgress(snapshotProgress{ts: 100, done: true})
+	require.False(t, stat.hasPendingSnapshot())
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"regexp"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	bf "github.com/pingcap/ticdc/pkg/binlog-filter"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	tfilter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"go.uber.org/zap"
+)
+
+// ddlPolicyRule only be used by DDLPolicy.
+type ddlPolicyRule struct {
+	tf     tfilter.Filter
+	bf     *bf.BinlogEvent
+	policy string
+
+	transformPattern     *regexp.Regexp
+	transformReplacement string
+}
+
+func newDDLPolicyRule(cfg *config.DDLPolicyRule, caseSensitive bool) (*ddlPolicyRule, error) {
+	tf, err := tfilter.Parse(cfg.Matcher)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, cfg.Matcher)
+	}
+	if !caseSensitive {
+		tf = tfilter.CaseInsensitive(tf)
+	}
+	if err := verifyIgnoreEvents(cfg.Events); err != nil {
+		return nil, err
+	}
+
+	res := &ddlPolicyRule{
+		tf:                   tf,
+		policy:               cfg.Policy,
+		transformReplacement: cfg.TransformReplacement,
+	}
+	// The binlog filter ignores the DDLs matched by the events or the sql,
+	// which are the DDLs matched by the policy.
+	bfRule := &bf.BinlogEventRule{
+		SchemaPattern: binlogFilterSchemaPlaceholder,
+		TablePattern:  binlogFilterTablePlaceholder,
+		Events:        cfg.Events,
+		SQLPattern:    cfg.SQL,
+		Action:        bf.Ignore,
+	}
+	res.bf, err = bf.NewBinlogEvent(caseSensitive, []*bf.BinlogEventRule{bfRule})
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, "failed to create ddl policy")
+	}
+	if cfg.Policy == config.DDLPolicyTransform {
+		res.transformPattern, err = regexp.Compile(cfg.TransformPattern)
+		if err != nil {
+			return nil, cerror.WrapError(cerror.ErrFilterRuleInvalid, err, cfg.TransformPattern)
+		}
+	}
+	return res, nil
+}
+
+func (r *ddlPolicyRule) match(schema, table, query string, ddlType model.ActionType) (bool, error) {
+	if len(table) == 0 {
+		if !r.tf.MatchSchema(schema) {
+			return false, nil
+		}
+	} else if !r.tf.MatchTable(schema, table) {
+		return false, nil
+	}
+
+	eventTypes := []bf.EventType{ddlToEventType(ddlType)}
+	// If the ddl is alter table's subtype, it's also matched by bf.AlterTable.
+	if isAlterTable(ddlType) {
+		eventTypes = append(eventTypes, bf.AlterTable)
+	}
+	for _, et := range eventTypes {
+		action, err := r.bf.Filter(binlogFilterSchemaPlaceholder, binlogFilterTablePlaceholder, et, query)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		if action == bf.Ignore {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// DDLPolicy decides how a DDL is handled by the ddl policies of a changefeed.
+type DDLPolicy struct {
+	rules []*ddlPolicyRule
+}
+
+// NewDDLPolicy creates a DDLPolicy, it returns nil if there is no ddl policy.
+func NewDDLPolicy(cfg *config.FilterConfig, caseSensitive bool) (*DDLPolicy, error) {
+	if cfg == nil || len(cfg.DDLPolicies) == 0 {
+		return nil, nil
+	}
+	res := &DDLPolicy{}
+	for _, ruleCfg := range cfg.DDLPolicies {
+		if ruleCfg == nil {
+			continue
+		}
+		rule, err := newDDLPolicyRule(ruleCfg, caseSensitive)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		res.rules = append(res.rules, rule)
+	}
+	return res, nil
+}
+
+// Decide returns the policy of the DDL, and the query rewritten by the
+// transform policy. It returns an empty policy if the DDL is not matched.
+func (p *DDLPolicy) Decide(schema, table, query string, ddlType model.ActionType) (policy string, newQuery string) {
+	if p == nil {
+		return "", query
+	}
+	for _, rule := range p.rules {
+		matched, err := rule.match(schema, table, query, ddlType)
+		if err != nil {
+			// The ddl type may be unknown to the binlog filter, it's not
+			// matched by the policy.
+			log.Warn("ddl policy can not match the ddl, ignore it",
+				zap.String("schema", schema),
+				zap.String("table", table),
+				zap.String("query", query),
+				zap.Error(err))
+			continue
+		}
+		if !matched {
+			continue
+		}
+		if rule.policy == config.DDLPolicyTransform {
+			return rule.policy, rule.transformPattern.ReplaceAllString(query, rule.transformReplacement)
+		}
+		return rule.policy, query
+	}
+	return "", query
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"testing"
+
+	bf "github.com/pingcap/ticdc/pkg/binlog-filter"
+	"github.com/pingcap/ticdc/pkg/config"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDDLPolicyDecide(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.FilterConfig{
+		DDLPolicies: []*config.DDLPolicyRule{
+			{
+				Matcher: []string{"test.t1"},
+				Events:  []bf.EventType{bf.DropTable, bf.TruncateTable},
+				Policy:  config.DDLPolicySkip,
+			},
+			{
+				Matcher:              []string{"test.*"},
+				Events:               []bf.EventType{bf.CreateTable},
+				Policy:               config.DDLPolicyTransform,
+				TransformPattern:     `(?i)\s*ENGINE\s*=\s*\w+`,
+				TransformReplacement: "",
+			},
+			{
+				Matcher: []string{"test.*"},
+				Events:  []bf.EventType{bf.AlterTable},
+				Policy:  config.DDLPolicyPause,
+			},
+			{
+				Matcher: []string{"prod.*"},
+				SQL:     []string{"^(?i)DROP"},
+				Policy:  config.DDLPolicyPause,
+			},
+		},
+	}
+	policy, err := NewDDLPolicy(cfg, false)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		schema     string
+		table      string
+		query      string
+		ddlType    timodel.ActionType
+		wantPolicy string
+		wantQuery  string
+	}{
+		{
+			name:       "skip drop table",
+			schema:     "test",
+			table:      "t1",
+			query:      "DROP TABLE t1",
+			ddlType:    timodel.ActionDropTable,
+			wantPolicy: config.DDLPolicySkip,
+			wantQuery:  "DROP TABLE t1",
+		},
+		{
+			name:       "drop table of other table is not matched",
+			schema:     "test",
+			table:      "t2",
+			query:      "DROP TABLE t2",
+			ddlType:    timodel.ActionDropTable,
+			wantPolicy: "",
+			wantQuery:  "DROP TABLE t2",
+		},
+		{
+			name:       "transform create table",
+			schema:     "test",
+			table:      "t2",
+			query:      "CREATE TABLE t2 (id INT PRIMARY KEY) ENGINE=MyISAM",
+			ddlType:    timodel.ActionCreateTable,
+			wantPolicy: config.DDLPolicyTransform,
+			wantQuery:  "CREATE TABLE t2 (id INT PRIMARY KEY)",
+		},
+		{
+			name:       "alter table subtype is paused",
+			schema:     "test",
+			table:      "t2",
+			query:      "ALTER TABLE t2 ADD COLUMN c INT",
+			ddlType:    timodel.ActionAddColumn,
+			wantPolicy: config.DDLPolicyPause,
+			wantQuery:  "ALTER TABLE t2 ADD COLUMN c INT",
+		},
+		{
+			name:       "pause by sql",
+			schema:     "prod",
+			table:      "t1",
+			query:      "DROP TABLE t1",
+			ddlType:    timodel.ActionDropTable,
+			wantPolicy: config.DDLPolicyPause,
+			wantQuery:  "DROP TABLE t1",
+		},
+		{
+			name:       "case insensitive matcher",
+			schema:     "TEST",
+			table:      "T1",
+			query:      "TRUNCATE TABLE T1",
+			ddlType:    timodel.ActionTruncateTable,
+			wantPolicy: config.DDLPolicySkip,
+			wantQuery:  "TRUNCATE TABLE T1",
+		},
+		{
+			name:       "schema ddl is not matched",
+			schema:     "other",
+			query:      "CREATE DATABASE other",
+			ddlType:    timodel.ActionCreateSchema,
+			wantPolicy: "",
+			wantQuery:  "CREATE DATABASE other",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, query := policy.Decide(tc.schema, tc.table, tc.query, tc.ddlType)
+			require.Equal(t, tc.wantPolicy, p)
+			require.Equal(t, tc.wantQuery, query)
+		})
+	}
+
+	// no ddl policy
+	policy, err = NewDDLPolicy(&config.FilterConfig{}, false)
+	require.NoError(t, err)
+	require.Nil(t, policy)
+	p, query := policy.Decide("test", "t1", "DROP TABLE t1", timodel.ActionDropTable)
+	require.Equal(t, "", p)
+	require.Equal(t, "DROP TABLE t1", query)
+
+	// invalid event
+	_, err = NewDDLPolicy(&config.FilterConfig{
+		DDLPolicies: []*config.DDLPolicyRule{
+			{
+				Matcher: []string{"test.*"},
+				Events:  []bf.EventType{bf.EventType("unknown")},
+				Policy:  config.DDLPolicySkip,
+			},
+		},
+	}, false)
+	require.Error(t, err)
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integrity
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+)
+
+// TableChecksum represents the checksum of all rows of a table. The checksum
+// of a row is the crc32 of its normalized column values, and the checksum of a
+// table is the sum of the checksums of its rows, so it does not depend on the
+// order of rows, and the duplicate rows do not cancel each other out.
+type TableChecksum struct {
+	RowCount int64  `json:"row_count"`
+	Checksum uint64 `json:"checksum"`
+}
+
+// Equal returns true if the two checksums are the same.
+func (c TableChecksum) Equal(other TableChecksum) bool {
+	return c.RowCount == other.RowCount && c.Checksum == other.Checksum
+}
+
+// TableColumn is a column of a table with its data type.
+type TableColumn struct {
+	Name     string
+	DataType string
+}
+
+// QueryTableColumns returns the columns of a table in the ordinal order.
+func QueryTableColumns(ctx context.Context, db *sql.DB, schema, table string) ([]TableColumn, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT COLUMN_NAME, DATA_TYPE FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ? ORDER BY ORDINAL_POSITION",
+		schema, table)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	defer rows.Close()
+
+	var columns []TableColumn
+	for rows.Next() {
+		var column TableColumn
+		if err = rows.Scan(&column.Name, &column.DataType); err != nil {
+			return nil, errors.WrapError(errors.ErrMySQLQueryError, err)
+		}
+		columns = append(columns, column)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	if len(columns) == 0 {
+		return nil, errors.ErrMySQLQueryError.GenWithStack("table %s does not exist", dbutil.TableName(schema, table))
+	}
+	return columns, nil
+}
+
+// CalculateTableChecksum calculates the checksum of a table by the given
+// columns, so the same table in different databases can be compared even if
+// the column orders are different.
+func CalculateTableChecksum(
+	ctx context.Context, db *sql.DB, schema, table string, columns []TableColumn,
+) (TableChecksum, error) {
+	var checksum TableChecksum
+	err := db.QueryRowContext(ctx, buildTableChecksumQuery(schema, table, columns)).
+		Scan(&checksum.RowCount, &checksum.Checksum)
+	if err != nil {
+		return checksum, errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	return checksum, nil
+}
+
+func buildTableChecksumQuery(schema, table string, columns []TableColumn) string {
+	values := make([]string, 0, len(columns))
+	isNulls := make([]string, 0, len(columns))
+	for _, column := range columns {
+		name := dbutil.ColumnName(column.Name)
+		values = append(values, normalizeColumn(name, column.DataType))
+		isNulls = append(isNulls, fmt.Sprintf("ISNULL(%s)", name))
+	}
+	return fmt.Sprintf(
+		"SELECT COUNT(*), CAST(COALESCE(SUM(CRC32(CONCAT_WS(',', %s, CONCAT(%s)))), 0) AS UNSIGNED) FROM %s",
+		strings.Join(values, ", "), strings.Join(isNulls, ", "), dbutil.TableName(schema, table))
+}
+
+// normalizeColumn returns the expression formatting the column value in the
+// same way in TiDB and MySQL, whatever the charset, the time zone and the
+// float formatting of the database are. The strings are hex encoded, so the
+// separators in them do not make different rows have the same checksum.
+func normalizeColumn(name, dataType string) string {
+	switch strings.ToLower(dataType) {
+	case "float", "double":
+		return fmt.Sprintf("CAST(%s AS DECIMAL(65, 6))", name)
+	case "timestamp":
+		return fmt.Sprintf("UNIX_TIMESTAMP(%s)", name)
+	case "bit":
+		return fmt.Sprintf("CAST(%s AS UNSIGNED)", name)
+	case "char", "varchar", "tinytext", "text", "mediumtext", "longtext", "enum", "set":
+		return fmt.Sprintf("HEX(CONVERT(%s USING utf8mb4))", name)
+	case "json":
+		return fmt.Sprintf("HEX(CAST(%s AS CHAR))", name)
+	case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob":
+		return fmt.Sprintf("HEX(%s)", name)
+	default:
+		return name
+	}
+}
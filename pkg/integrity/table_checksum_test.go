@@ -0,0 +1,81 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integrity
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateTableChecksum(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COLUMN_NAME, DATA_TYPE FROM information_schema.COLUMNS")).
+		WithArgs("test", "t").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "DATA_TYPE"}).
+			AddRow("id", "int").AddRow("name", "varchar"))
+	columns, err := QueryTableColumns(ctx, db, "test", "t")
+	require.NoError(t, err)
+	require.Equal(t, []TableColumn{{Name: "id", DataType: "int"}, {Name: "name", DataType: "varchar"}}, columns)
+
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT COUNT(*), CAST(COALESCE(SUM(CRC32(CONCAT_WS(',', `id`, HEX(CONVERT(`name` USING utf8mb4)), " +
+			"CONCAT(ISNULL(`id`), ISNULL(`name`))))), 0) AS UNSIGNED) FROM `test`.`t`")).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "checksum"}).AddRow(10, uint64(12345)))
+	checksum, err := CalculateTableChecksum(ctx, db, "test", "t", columns)
+	require.NoError(t, err)
+	require.Equal(t, TableChecksum{RowCount: 10, Checksum: 12345}, checksum)
+	require.True(t, checksum.Equal(TableChecksum{RowCount: 10, Checksum: 12345}))
+	require.False(t, checksum.Equal(TableChecksum{RowCount: 10, Checksum: 1}))
+
+	// the table does not exist
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COLUMN_NAME, DATA_TYPE FROM information_schema.COLUMNS")).
+		WithArgs("test", "t1").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME", "DATA_TYPE"}))
+	_, err = QueryTableColumns(ctx, db, "test", "t1")
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNormalizeColumn(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		dataType string
+		expected string
+	}{
+		{dataType: "int", expected: "`c`"},
+		{dataType: "decimal", expected: "`c`"},
+		{dataType: "DOUBLE", expected: "CAST(`c` AS DECIMAL(65, 6))"},
+		{dataType: "timestamp", expected: "UNIX_TIMESTAMP(`c`)"},
+		{dataType: "bit", expected: "CAST(`c` AS UNSIGNED)"},
+		{dataType: "varchar", expected: "HEX(CONVERT(`c` USING utf8mb4))"},
+		{dataType: "enum", expected: "HEX(CONVERT(`c` USING utf8mb4))"},
+		{dataType: "json", expected: "HEX(CAST(`c` AS CHAR))"},
+		{dataType: "blob", expected: "HEX(`c`)"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.dataType, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, normalizeColumn("`c`", tc.dataType))
+		})
+	}
+}
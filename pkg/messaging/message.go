@@ -297,6 +297,10 @@ func (r DispatcherRequest) EnableIgnoreUpdateOnlyColumns() bool {
 	return r.DispatcherRequest.EnableIgnoreUpdateOnlyColumns
 }
 
+func (r DispatcherRequest) GetInitialSnapshotTs() uint64 {
+	return r.DispatcherRequest.InitialSnapshotTs
+}
+
 func (r DispatcherRequest) GetTxnAtomicity() config.AtomicityLevel {
 	return config.AtomicityLevel(r.TxnAtomicity)
 }
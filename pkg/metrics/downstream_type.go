@@ -59,6 +59,14 @@ func downstreamTypeFromScheme(scheme string) string {
 		return config.AzblobScheme
 	case config.CloudStorageNoopScheme:
 		return config.CloudStorageNoopScheme
+	case config.ElasticsearchScheme, config.ElasticsearchSSLScheme:
+		return config.ElasticsearchScheme
+	case config.OpenSearchScheme, config.OpenSearchSSLScheme:
+		return config.OpenSearchScheme
+	case config.WebhookScheme, config.WebhookSSLScheme:
+		return "webhook"
+	case config.RedisScheme, config.RedisSSLScheme:
+		return config.RedisScheme
 	case config.BlackHoleScheme:
 		return config.BlackHoleScheme
 	case "":
@@ -44,6 +44,14 @@ func TestDownstreamTypeFromSinkURI(t *testing.T) {
 		{sinkURI: "azure://bucket/prefix", expectedLabel: "azblob"},
 		{sinkURI: "azblob://bucket/prefix", expectedLabel: "azblob"},
 		{sinkURI: "noop://bucket/prefix", expectedLabel: "noop"},
+		{sinkURI: "es://127.0.0.1:9200/index", expectedLabel: "es"},
+		{sinkURI: "es+ssl://127.0.0.1:9200/index", expectedLabel: "es"},
+		{sinkURI: "opensearch://127.0.0.1:9200/index", expectedLabel: "opensearch"},
+		{sinkURI: "opensearch+ssl://127.0.0.1:9200/index", expectedLabel: "opensearch"},
+		{sinkURI: "http://127.0.0.1:8080/events", expectedLabel: "webhook"},
+		{sinkURI: "https://127.0.0.1:8443/events", expectedLabel: "webhook"},
+		{sinkURI: "redis://127.0.0.1:6379/0", expectedLabel: "redis"},
+		{sinkURI: "rediss://127.0.0.1:6380/0", expectedLabel: "redis"},
 		{sinkURI: "blackhole:///", expectedLabel: "blackhole"},
 		{sinkURI: "127.0.0.1:3306", expectedLabel: "unknown"},
 		{sinkURI: "mysql://[::1", expectedLabel: "unknown"},
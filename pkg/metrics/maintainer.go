@@ -57,6 +57,31 @@ var (
 			Help:      "Bucketed histogram of processing time (s) of finished operator.",
 			Buckets:   []float64{0.5, 1, 2, 4, 8, 16, 20, 40, 60, 90, 120, 180, 240, 300, 480, 600, 720, 900, 1200, 1800, 3600},
 		}, []string{getKeyspaceLabel(), "changefeed", "type", "mode"})
+
+	ValidationTableCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "maintainer",
+			Name:      "validation_table_count",
+			Help:      "number of tables validated at syncpoints, by the result of validation",
+		}, []string{getKeyspaceLabel(), "changefeed", "result"})
+
+	ValidationPrimaryTsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "ticdc",
+			Subsystem: "maintainer",
+			Name:      "validation_primary_ts",
+			Help:      "the primary ts of the latest validated syncpoint",
+		}, []string{getKeyspaceLabel(), "changefeed"})
+
+	ValidationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "ticdc",
+			Subsystem: "maintainer",
+			Name:      "validation_duration_seconds",
+			Help:      "Bucketed histogram of the time (s) of a validation at a syncpoint.",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 16),
+		}, []string{getKeyspaceLabel(), "changefeed"})
 )
 
 func initMaintainerMetrics(registry *prometheus.Registry) {
@@ -65,4 +90,7 @@ func initMaintainerMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(OperatorCount)
 	registry.MustRegister(OperatorDuration)
 	registry.MustRegister(TotalOperatorCount)
+	registry.MustRegister(ValidationTableCounter)
+	registry.MustRegister(ValidationPrimaryTsGauge)
+	registry.MustRegister(ValidationDuration)
 }
@@ -231,6 +231,29 @@ var (
 		}, []string{getKeyspaceLabel(), "changefeed"})
 )
 
+// ---------- Metrics for the dead letter queue. ---------- //
+var (
+	// DeadLetterEventCounter records the events rejected by the downstream and
+	// written to the dead letter queue.
+	DeadLetterEventCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "ticdc",
+			Subsystem: "sink",
+			Name:      "dead_letter_event_count",
+			Help:      "Total count of the events written to the dead letter queue.",
+		}, []string{getKeyspaceLabel(), "changefeed", "event_type"})
+
+	// DeadLetterWriteDuration records the duration of writing an event to the dead letter queue.
+	DeadLetterWriteDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "ticdc",
+			Subsystem: "sink",
+			Name:      "dead_letter_write_duration",
+			Help:      "Duration(s) of writing an event to the dead letter queue.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 20), // 1ms~524s
+		}, []string{getKeyspaceLabel(), "changefeed"})
+)
+
 // InitMetrics registers all metrics in this file.
 func initSinkMetrics(registry *prometheus.Registry) {
 	// common sink metrics
@@ -262,6 +285,10 @@ func initSinkMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(CheckpointTsMessageDuration)
 	registry.MustRegister(CheckpointTsMessageCount)
 
+	// dead letter queue metrics
+	registry.MustRegister(DeadLetterEventCounter)
+	registry.MustRegister(DeadLetterWriteDuration)
+
 	// pulsar sink metrics
 	initPulsarMetrics(registry)
 }
@@ -0,0 +1,218 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"container/heap"
+	"context"
+	"net/url"
+	"path/filepath"
+	"sort"
+
+	"github.com/pingcap/log"
+	pevent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/compression"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/redo"
+	"github.com/pingcap/tidb/pkg/objstore/storeapi"
+	tfilter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"go.uber.org/zap"
+)
+
+// LogFile describes a file in the redo log storage.
+type LogFile struct {
+	Name     string
+	Size     int64
+	FileType string
+	// MaxCommitTs is the max commit ts of the logs in the file, it is 0 for
+	// the meta files.
+	MaxCommitTs uint64
+}
+
+// ListLogFiles lists the redo log files and meta files in the storage, the
+// files that are not written by the redo writers are ignored.
+func ListLogFiles(ctx context.Context, uri url.URL) ([]LogFile, error) {
+	extStorage, err := redo.InitExternalStorage(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	defer extStorage.Close()
+	return listLogFiles(ctx, extStorage)
+}
+
+func listLogFiles(ctx context.Context, extStorage storeapi.Storage) ([]LogFile, error) {
+	var files []LogFile
+	err := extStorage.WalkDir(ctx, &storeapi.WalkOption{},
+		func(path string, size int64) error {
+			commitTs, fileType, err := redo.ParseLogFileName(filepath.Base(path))
+			if err != nil || fileType == "" {
+				log.Debug("ignore unknown file in redo log storage",
+					zap.String("file", path), zap.Error(err))
+				return nil
+			}
+			files = append(files, LogFile{
+				Name:        path,
+				Size:        size,
+				FileType:    fileType,
+				MaxCommitTs: commitTs,
+			})
+			return nil
+		})
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrExternalStorageAPI, err)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].FileType != files[j].FileType {
+			return files[i].FileType < files[j].FileType
+		}
+		if files[i].MaxCommitTs != files[j].MaxCommitTs {
+			return files[i].MaxCommitTs < files[j].MaxCommitTs
+		}
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
+// DumpConfig is the configuration used to dump redo logs.
+type DumpConfig struct {
+	URI url.URL
+	// FileType is the type of the log files to dump, both the row and the ddl
+	// log files are dumped if it is empty.
+	FileType string
+	// StartTs and EndTs limit the commit ts of the dumped logs to
+	// (StartTs, EndTs], EndTs 0 means no upper limit.
+	StartTs uint64
+	EndTs   uint64
+	// TableFilter filters the dumped logs by table, a nil filter matches all
+	// tables.
+	TableFilter tfilter.Filter
+}
+
+// DumpLogs reads the redo log files in the storage and calls fn for each log
+// that matches the config. The logs are decoded file by file, so they are
+// ordered by commit ts in a file but not across files.
+// Both the logs written by the file writer and the lz4 compressed logs written
+// by the memory writer are supported.
+func DumpLogs(
+	ctx context.Context, cfg *DumpConfig,
+	fn func(file string, log *pevent.RedoLog) error,
+) error {
+	extStorage, err := redo.InitExternalStorage(ctx, cfg.URI)
+	if err != nil {
+		return err
+	}
+	defer extStorage.Close()
+
+	files, err := listLogFiles(ctx, extStorage)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if !cfg.shouldRead(f) {
+			continue
+		}
+		if err = dumpFile(ctx, extStorage, f.Name, cfg, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cfg *DumpConfig) shouldRead(f LogFile) bool {
+	if f.FileType == redo.RedoMetaFileType {
+		return false
+	}
+	if cfg.FileType != "" && f.FileType != cfg.FileType {
+		return false
+	}
+	// the .tmp file is not finished, so its commit ts in the file name is
+	// not reliable.
+	if filepath.Ext(f.Name) == redo.TmpEXT {
+		return true
+	}
+	return f.MaxCommitTs > cfg.StartTs
+}
+
+func (cfg *DumpConfig) match(rl *pevent.RedoLog) bool {
+	commitTs := rl.GetCommitTs()
+	if commitTs <= cfg.StartTs || (cfg.EndTs != 0 && commitTs > cfg.EndTs) {
+		return false
+	}
+	switch rl.Type {
+	case pevent.RedoLogTypeRow:
+		table := rl.RedoRow.Row.Table
+		if cfg.TableFilter == nil || table == nil {
+			return true
+		}
+		return cfg.TableFilter.MatchTable(table.Schema, table.Table)
+	case pevent.RedoLogTypeDDL:
+		return MatchDDL(cfg.TableFilter, rl.RedoDDL)
+	}
+	return false
+}
+
+func dumpFile(
+	ctx context.Context, extStorage storeapi.Storage,
+	fileName string, cfg *DumpConfig,
+	fn func(file string, log *pevent.RedoLog) error,
+) error {
+	fileContent, err := extStorage.ReadFile(ctx, fileName)
+	if err != nil {
+		return errors.WrapError(errors.ErrExternalStorageAPI, err)
+	}
+	if len(fileContent) == 0 {
+		return nil
+	}
+	if isLZ4Compressed(fileContent) {
+		if fileContent, err = compression.Decode(compression.LZ4, fileContent); err != nil {
+			return err
+		}
+	}
+	h, err := readAllFromBuffer(fileContent)
+	if err != nil {
+		return err
+	}
+	heap.Init(&h)
+	for h.Len() != 0 {
+		item := heap.Pop(&h).(*logWithIdx).data
+		if !cfg.match(item) {
+			continue
+		}
+		if err = fn(fileName, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MatchDDL returns whether the ddl matches the table filter. A schema level
+// ddl is matched by the schema, and a cross table ddl, such as rename tables,
+// matches if any of the tables it blocks matches.
+func MatchDDL(f tfilter.Filter, ddl *pevent.RedoDDLEvent) bool {
+	if f == nil {
+		return true
+	}
+	if ddl.TableName.Table == "" {
+		return f.MatchSchema(ddl.TableName.Schema)
+	}
+	if f.MatchTable(ddl.TableName.Schema, ddl.TableName.Table) {
+		return true
+	}
+	for _, name := range ddl.DDL.BlockedTableNames {
+		if f.MatchTable(name.SchemaName, name.TableName) {
+			return true
+		}
+	}
+	return false
+}
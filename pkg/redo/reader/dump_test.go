@@ -0,0 +1,109 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	pevent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/compression"
+	"github.com/pingcap/ticdc/pkg/redo"
+	tfilter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpLogs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ctx := context.Background()
+	genLogFile(ctx, t, dir, redo.RedoRowLogFileType, 10, 12)
+	genLogFile(ctx, t, dir, redo.RedoRowLogFileType, 20, 22)
+	genLogFile(ctx, t, dir, redo.RedoDDLLogFileType, 15, 15)
+
+	// the logs written by the memory writer are lz4 compressed.
+	tmpDir := t.TempDir()
+	genLogFile(ctx, t, tmpDir, redo.RedoRowLogFileType, 30, 31)
+	logFiles, err := filepath.Glob(filepath.Join(tmpDir, "*"+redo.LogEXT))
+	require.NoError(t, err)
+	require.Len(t, logFiles, 1)
+	data, err := os.ReadFile(logFiles[0])
+	require.NoError(t, err)
+	compressed, err := compression.Encode(compression.LZ4, data)
+	require.NoError(t, err)
+	compressedName := fmt.Sprintf(redo.RedoLogFileFormatV2, "capture", "default",
+		"changefeed", redo.RedoRowLogFileType, 31, uuid.NewString(), redo.LogEXT)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, compressedName), compressed, 0o644))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "capture_changefeed_meta"+redo.MetaEXT), []byte("{}"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("unknown"), 0o644))
+
+	uri, err := url.Parse(fmt.Sprintf("file://%s", dir))
+	require.NoError(t, err)
+
+	files, err := ListLogFiles(ctx, *uri)
+	require.NoError(t, err)
+	var fileTypes []string
+	for _, f := range files {
+		fileTypes = append(fileTypes, f.FileType)
+	}
+	require.Equal(t, []string{
+		redo.RedoDDLLogFileType, redo.RedoMetaFileType,
+		redo.RedoRowLogFileType, redo.RedoRowLogFileType, redo.RedoRowLogFileType,
+	}, fileTypes)
+	require.Equal(t, compressedName, filepath.Base(files[4].Name))
+
+	dump := func(cfg *DumpConfig) (rows, ddls []uint64) {
+		err := DumpLogs(ctx, cfg, func(_ string, log *pevent.RedoLog) error {
+			switch log.Type {
+			case pevent.RedoLogTypeRow:
+				rows = append(rows, log.GetCommitTs())
+			case pevent.RedoLogTypeDDL:
+				ddls = append(ddls, log.GetCommitTs())
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return rows, ddls
+	}
+
+	rows, ddls := dump(&DumpConfig{URI: *uri})
+	require.Equal(t, []uint64{10, 11, 12, 20, 21, 22, 30, 31}, rows)
+	require.Equal(t, []uint64{15}, ddls)
+
+	rows, ddls = dump(&DumpConfig{URI: *uri, StartTs: 11, EndTs: 21})
+	require.Equal(t, []uint64{12, 20, 21}, rows)
+	require.Equal(t, []uint64{15}, ddls)
+
+	rows, ddls = dump(&DumpConfig{URI: *uri, FileType: redo.RedoDDLLogFileType})
+	require.Empty(t, rows)
+	require.Equal(t, []uint64{15}, ddls)
+
+	f, err := tfilter.Parse([]string{"other.*"})
+	require.NoError(t, err)
+	rows, ddls = dump(&DumpConfig{URI: *uri, TableFilter: f})
+	require.Empty(t, rows)
+	require.Empty(t, ddls)
+
+	f, err = tfilter.Parse([]string{"test.t"})
+	require.NoError(t, err)
+	rows, _ = dump(&DumpConfig{URI: *uri, TableFilter: f, EndTs: 12})
+	require.Equal(t, []uint64{10, 11, 12}, rows)
+}
@@ -45,12 +45,27 @@ type Coordinator interface {
 	PauseChangefeed(ctx context.Context, id common.ChangeFeedID) error
 	// ResumeChangefeed resumes a changefeed
 	ResumeChangefeed(ctx context.Context, id common.ChangeFeedID, newCheckpointTs uint64, overwriteCheckpointTs bool) error
-	// UpdateChangefeed updates a changefeed
-	UpdateChangefeed(ctx context.Context, change *config.ChangeFeedInfo) error
+	// UpdateChangefeed updates a changefeed, a config revision is recorded if
+	// revision is not nil, the caller only needs to set the author and the
+	// rollback target of the revision
+	UpdateChangefeed(ctx context.Context, change *config.ChangeFeedInfo, revision *config.ChangefeedConfigRevision) error
+	// GetChangefeedConfigHistory returns the config revisions of a changefeed
+	GetChangefeedConfigHistory(ctx context.Context, id common.ChangeFeedID) ([]*config.ChangefeedConfigRevision, error)
 	// RequestResolvedTsFromLogCoordinator requests the log coordinator to report the resolved ts of the changefeed,
 	// and coordinator will update the changefeed status after receiving the resolved ts from log coordinator.
 	RequestResolvedTsFromLogCoordinator(ctx context.Context, changefeedDisplayName common.ChangeFeedDisplayName)
 
+	// ListChangefeedTemplates returns all changefeed templates
+	ListChangefeedTemplates(ctx context.Context) ([]*config.ChangefeedTemplate, error)
+	// GetChangefeedTemplate returns a changefeed template
+	GetChangefeedTemplate(ctx context.Context, name string) (*config.ChangefeedTemplate, error)
+	// CreateChangefeedTemplate creates a new changefeed template
+	CreateChangefeedTemplate(ctx context.Context, template *config.ChangefeedTemplate) error
+	// UpdateChangefeedTemplate updates a changefeed template
+	UpdateChangefeedTemplate(ctx context.Context, template *config.ChangefeedTemplate) error
+	// RemoveChangefeedTemplate removes a changefeed template
+	RemoveChangefeedTemplate(ctx context.Context, name string) error
+
 	// DrainNode requests best-effort draining on the target node and returns a remaining-work estimate.
 	DrainNode(ctx context.Context, target node.ID) (int, error)
 
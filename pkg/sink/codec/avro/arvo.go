@@ -41,7 +41,7 @@ import (
 
 func (a *BatchEncoder) getValueSchemaCodec(
 	ctx context.Context, topic string, tableName *commonType.TableName, tableVersion uint64, input *avroEncodeInput,
-) (*goavro.Codec, []byte, error) {
+) (recordCodec, []byte, error) {
 	schemaGen := func() (string, error) {
 		schema, err := a.value2AvroSchema(tableName, input)
 		if err != nil {
@@ -52,16 +52,12 @@ func (a *BatchEncoder) getValueSchemaCodec(
 	}
 
 	subject := topicName2SchemaSubjects(topic, valueSchemaSuffix)
-	avroCodec, header, err := a.schemaM.GetCachedOrRegister(ctx, subject, tableVersion, schemaGen)
-	if err != nil {
-		return nil, nil, errors.Trace(err)
-	}
-	return avroCodec, header, nil
+	return a.getCachedOrRegister(ctx, subject, tableVersion, schemaGen)
 }
 
 func (a *BatchEncoder) getKeySchemaCodec(
 	ctx context.Context, topic string, tableName *commonType.TableName, tableVersion uint64, keyColumns *avroEncodeInput,
-) (*goavro.Codec, []byte, error) {
+) (recordCodec, []byte, error) {
 	schemaGen := func() (string, error) {
 		schema, err := a.key2AvroSchema(tableName, keyColumns)
 		if err != nil {
@@ -72,6 +68,19 @@ func (a *BatchEncoder) getKeySchemaCodec(
 	}
 
 	subject := topicName2SchemaSubjects(topic, keySchemaSuffix)
+	return a.getCachedOrRegister(ctx, subject, tableVersion, schemaGen)
+}
+
+func (a *BatchEncoder) getCachedOrRegister(
+	ctx context.Context, subject string, tableVersion uint64, schemaGen SchemaGenerator,
+) (recordCodec, []byte, error) {
+	if a.registryM != nil {
+		codec, header, err := a.registryM.GetCachedOrRegister(ctx, subject, tableVersion, schemaGen)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		return codec, header, nil
+	}
 	avroCodec, header, err := a.schemaM.GetCachedOrRegister(ctx, subject, tableVersion, schemaGen)
 	if err != nil {
 		return nil, nil, errors.Trace(err)
@@ -79,6 +88,24 @@ func (a *BatchEncoder) getKeySchemaCodec(
 	return avroCodec, header, nil
 }
 
+// marshalSchema converts the avro record schema into the schema definition
+// of the schema type of the encoder.
+func (a *BatchEncoder) marshalSchema(top *avroSchemaTop) (string, error) {
+	if a.registryM != nil {
+		switch a.registryM.SchemaType() {
+		case SchemaTypeJSON:
+			return avroSchemaTop2JSONSchema(top)
+		case SchemaTypeProtobuf:
+			return avroSchemaTop2Proto(top)
+		}
+	}
+	str, err := json.Marshal(top)
+	if err != nil {
+		return "", errors.WrapError(errors.ErrAvroMarshalFailed, err)
+	}
+	return string(str), nil
+}
+
 func (a *BatchEncoder) encodeKey(ctx context.Context, topic string, e *event.RowEvent) ([]byte, error) {
 	index, colInfos := e.PrimaryKeyColumn()
 	// result may be nil if the event has no handle key columns, this may happen in the force replicate mode.
@@ -422,15 +449,15 @@ func (a *BatchEncoder) value2AvroSchema(
 		top = a.schemaWithExtension(top)
 	}
 
-	str, err := json.Marshal(top)
+	str, err := a.marshalSchema(top)
 	if err != nil {
-		return "", errors.WrapError(errors.ErrAvroMarshalFailed, err)
+		return "", err
 	}
 	log.Info("avro: row to schema",
-		zap.ByteString("schema", str),
+		zap.String("schema", str),
 		zap.Bool("enableTiDBExtension", a.config.EnableRowChecksum),
 		zap.Bool("enableRowLevelChecksum", a.config.EnableRowChecksum))
-	return string(str), nil
+	return str, nil
 }
 
 func (a *BatchEncoder) key2AvroSchema(
@@ -442,12 +469,12 @@ func (a *BatchEncoder) key2AvroSchema(
 		return "", err
 	}
 
-	str, err := json.Marshal(top)
+	str, err := a.marshalSchema(top)
 	if err != nil {
-		return "", errors.WrapError(errors.ErrAvroMarshalFailed, err)
+		return "", err
 	}
-	log.Info("avro: key to schema", zap.ByteString("schema", str))
-	return string(str), nil
+	log.Info("avro: key to schema", zap.String("schema", str))
+	return str, nil
 }
 
 func (a *BatchEncoder) columns2AvroData(
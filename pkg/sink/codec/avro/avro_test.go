@@ -199,8 +199,8 @@ func TestAvroEncodeDeleteEventWithWatermarkCarriesCommitTs(t *testing.T) {
 
 func TestAvroEnvelope(t *testing.T) {
 	t.Parallel()
-	cManager := &confluentSchemaManager{}
-	gManager := &glueSchemaManager{}
+	cManager := &confluentSchemaManager[*goavro.Codec]{format: avroFormat{}}
+	gManager := &glueSchemaManager[*goavro.Codec]{format: avroFormat{}}
 	avroCodec, err := GenCodec(`
        {
          "type": "record",
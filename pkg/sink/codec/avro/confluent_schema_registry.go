@@ -43,23 +43,25 @@ const (
 	schemaRegistryRequestTimeout = 30 * time.Second
 )
 
-// confluentSchemaManager is used to register Avro Schemas to the confluent Registry server,
+// confluentSchemaManager is used to register Schemas to the confluent Registry server,
 // look up local cache according to the table's name, and fetch from the Registry
 // in cache the local cache entry is missing.
-type confluentSchemaManager struct {
+type confluentSchemaManager[C any] struct {
 	registryURL string
 
 	credential *security.Credential // placeholder, currently always nil
 
+	format       SchemaFormat[C]
 	cacheRWLock  sync.RWMutex
-	cache        map[string]*schemaCacheEntry
+	cache        map[string]*schemaCacheEntry[C]
 	registryType string
 }
 
 type registerRequest struct {
 	Schema string `json:"schema"`
-	// Commented out for compatibility with Confluent 5.4.x
-	// SchemaType string `json:"schemaType"`
+	// SchemaType is omitted for the avro schemas for compatibility with Confluent 5.4.x,
+	// which doesn't support the other schema types.
+	SchemaType string `json:"schemaType,omitempty"`
 }
 
 type registerResponse struct {
@@ -67,18 +69,30 @@ type registerResponse struct {
 }
 
 type lookupResponse struct {
-	Name     string `json:"name"`
-	SchemaID int    `json:"id"`
-	Schema   string `json:"schema"`
+	Name       string `json:"name"`
+	SchemaID   int    `json:"id"`
+	Schema     string `json:"schema"`
+	SchemaType string `json:"schemaType,omitempty"`
 }
 
-// NewConfluentSchemaManager create schema managers,
+// NewConfluentSchemaManager create schema managers of the avro schemas,
 // and test connectivity to the schema registry
 func NewConfluentSchemaManager(
 	ctx context.Context,
 	registryURL string,
 	credential *security.Credential,
 ) (SchemaManager, error) {
+	return NewConfluentSchemaManagerWithFormat(ctx, registryURL, credential, SchemaFormat[*goavro.Codec](avroFormat{}))
+}
+
+// NewConfluentSchemaManagerWithFormat create schema managers of the schema type
+// of the format, and test connectivity to the schema registry
+func NewConfluentSchemaManagerWithFormat[C any](
+	ctx context.Context,
+	registryURL string,
+	credential *security.Credential,
+	format SchemaFormat[C],
+) (TypedSchemaManager[C], error) {
 	registryURL = strings.TrimRight(registryURL, "/")
 	httpCli, err := httputil.NewClient(credential)
 	if err != nil {
@@ -113,31 +127,39 @@ func NewConfluentSchemaManager(
 		zap.String("registryURL", registryURL),
 	)
 
-	return &confluentSchemaManager{
+	return &confluentSchemaManager[C]{
 		registryURL:  registryURL,
-		cache:        make(map[string]*schemaCacheEntry, 1),
+		format:       format,
+		cache:        make(map[string]*schemaCacheEntry[C], 1),
 		registryType: common.SchemaRegistryTypeConfluent,
 	}, nil
 }
 
 // Register a schema in schema registry, no cache
-func (m *confluentSchemaManager) Register(
+func (m *confluentSchemaManager[C]) Register(
 	ctx context.Context,
 	schemaName string,
 	schemaDefinition string,
 ) (schemaID, error) {
-	// The Schema Registry expects the JSON to be without newline characters
 	id := schemaID{}
 	log.Info("confluentSchemaManager", zap.String("schemaDefinition", schemaDefinition), zap.String("schemaName", schemaName))
 
-	buffer := new(bytes.Buffer)
-	err := json.Compact(buffer, []byte(schemaDefinition))
-	if err != nil {
-		log.Error("Could not compact schema", zap.Error(err))
-		return id, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
-	}
 	reqBody := registerRequest{
-		Schema: buffer.String(),
+		Schema: schemaDefinition,
+	}
+	// The Schema Registry expects the JSON to be without newline characters,
+	// the protobuf schemas are not JSON and are registered as they are.
+	if m.format.Type() != SchemaTypeProtobuf {
+		buffer := new(bytes.Buffer)
+		err := json.Compact(buffer, []byte(schemaDefinition))
+		if err != nil {
+			log.Error("Could not compact schema", zap.Error(err))
+			return id, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
+		}
+		reqBody.Schema = buffer.String()
+	}
+	if m.format.Type() != SchemaTypeAvro {
+		reqBody.SchemaType = string(m.format.Type())
 	}
 	payload, err := json.Marshal(&reqBody)
 	if err != nil {
@@ -210,11 +232,11 @@ func (m *confluentSchemaManager) Register(
 }
 
 // Lookup the cached schema entry first, if not found, fetch from the Registry server.
-func (m *confluentSchemaManager) Lookup(
+func (m *confluentSchemaManager[C]) Lookup(
 	ctx context.Context,
 	schemaName string,
 	schemaID schemaID,
-) (*goavro.Codec, error) {
+) (codec C, err error) {
 	m.cacheRWLock.RLock()
 	entry, exists := m.cache[schemaName]
 	if exists && entry.schemaID.confluentSchemaID == schemaID.confluentSchemaID {
@@ -228,7 +250,7 @@ func (m *confluentSchemaManager) Lookup(
 	req, err := http.NewRequestWithContext(ctx, "GET", uri, nil)
 	if err != nil {
 		log.Error("Error constructing request for Registry lookup", zap.Error(err))
-		return nil, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
+		return codec, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
 	}
 	req.Header.Add(
 		"Accept",
@@ -238,14 +260,14 @@ func (m *confluentSchemaManager) Lookup(
 
 	resp, err := httpRetry(ctx, m.credential, req)
 	if err != nil {
-		return nil, err
+		return codec, err
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		log.Error("Failed to parse result from Registry", zap.Error(err))
-		return nil, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
+		return codec, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 404 {
@@ -253,7 +275,7 @@ func (m *confluentSchemaManager) Lookup(
 			zap.Int("status", resp.StatusCode),
 			zap.String("uri", uri),
 			zap.ByteString("responseBody", body))
-		return nil, errors.ErrAvroSchemaAPIError.GenWithStack(
+		return codec, errors.ErrAvroSchemaAPIError.GenWithStack(
 			"Failed to query schema from the Registry, HTTP error",
 		)
 	}
@@ -262,7 +284,7 @@ func (m *confluentSchemaManager) Lookup(
 		log.Warn("Specified schema not found in Registry",
 			zap.String("key", schemaName),
 			zap.Int("schemaID", schemaID.confluentSchemaID))
-		return nil, errors.ErrAvroSchemaAPIError.GenWithStackByArgs(
+		return codec, errors.ErrAvroSchemaAPIError.GenWithStackByArgs(
 			"Schema not found in Registry",
 		)
 	}
@@ -271,19 +293,19 @@ func (m *confluentSchemaManager) Lookup(
 	err = json.Unmarshal(body, &jsonResp)
 	if err != nil {
 		log.Error("Failed to parse result from Registry", zap.Error(err))
-		return nil, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
+		return codec, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
 	}
 
-	cacheEntry := new(schemaCacheEntry)
-	cacheEntry.codec, err = GenCodec(jsonResp.Schema)
+	cacheEntry := new(schemaCacheEntry[C])
+	cacheEntry.codec, err = m.format.Compile(jsonResp.Schema)
 	if err != nil {
-		log.Error("Creating Avro codec failed", zap.Error(err))
-		return nil, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
+		log.Error("Creating codec failed", zap.Error(err))
+		return codec, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
 	}
 	cacheEntry.schemaID.confluentSchemaID = schemaID.confluentSchemaID
 	cacheEntry.header, err = m.getMsgHeader(schemaID.confluentSchemaID)
 	if err != nil {
-		return nil, err
+		return codec, err
 	}
 
 	m.cacheRWLock.Lock()
@@ -292,16 +314,16 @@ func (m *confluentSchemaManager) Lookup(
 	return cacheEntry.codec, nil
 }
 
-// GetCachedOrRegister checks if the suitable Avro schema has been cached.
+// GetCachedOrRegister checks if the suitable schema has been cached.
 // If not, a new schema is generated, registered and cached.
 // Re-registering an existing schema shall return the same id(and version), so even if the
 // cache is out-of-sync with schema registry, we could reload it.
-func (m *confluentSchemaManager) GetCachedOrRegister(
+func (m *confluentSchemaManager[C]) GetCachedOrRegister(
 	ctx context.Context,
 	schemaSubject string,
 	tableVersion uint64,
 	schemaGen SchemaGenerator,
-) (*goavro.Codec, []byte, error) {
+) (codec C, header []byte, err error) {
 	m.cacheRWLock.RLock()
 	if entry, exists := m.cache[schemaSubject]; exists && entry.tableVersion == tableVersion {
 		log.Debug("Avro schema GetCachedOrRegister cache hit",
@@ -319,28 +341,28 @@ func (m *confluentSchemaManager) GetCachedOrRegister(
 
 	schema, err := schemaGen()
 	if err != nil {
-		return nil, nil, err
+		return codec, nil, err
 	}
 
-	codec, err := GenCodec(schema)
+	codec, err = m.format.Compile(schema)
 	if err != nil {
-		log.Error("GetCachedOrRegister: Could not make goavro codec", zap.Error(err))
-		return nil, nil, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
+		log.Error("GetCachedOrRegister: Could not make codec", zap.Error(err))
+		return codec, nil, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
 	}
 
 	id, err := m.Register(ctx, schemaSubject, schema)
 	if err != nil {
 		log.Error("GetCachedOrRegister: Could not register schema", zap.Error(err))
-		return nil, nil, errors.Trace(err)
+		return codec, nil, errors.Trace(err)
 	}
 
-	cacheEntry := new(schemaCacheEntry)
+	cacheEntry := new(schemaCacheEntry[C])
 	cacheEntry.codec = codec
 	cacheEntry.schemaID = id
 	cacheEntry.tableVersion = tableVersion
-	header, err := m.getMsgHeader(cacheEntry.schemaID.confluentSchemaID)
+	header, err = m.getMsgHeader(cacheEntry.schemaID.confluentSchemaID)
 	if err != nil {
-		return nil, nil, err
+		return codec, nil, err
 	}
 	cacheEntry.header = header
 
@@ -351,7 +373,7 @@ func (m *confluentSchemaManager) GetCachedOrRegister(
 	log.Info("Avro schema GetCachedOrRegister successful with cache miss",
 		zap.Uint64("tableVersion", cacheEntry.tableVersion),
 		zap.Int("schemaID", cacheEntry.schemaID.confluentSchemaID),
-		zap.String("schema", cacheEntry.codec.Schema()))
+		zap.String("schema", schema))
 
 	return codec, cacheEntry.header, nil
 }
@@ -359,7 +381,7 @@ func (m *confluentSchemaManager) GetCachedOrRegister(
 // ClearRegistry clears the Registry subject for the given table. Should be idempotent.
 // Exported for testing.
 // NOT USED for now, reserved for future use.
-func (m *confluentSchemaManager) ClearRegistry(ctx context.Context, schemaSubject string) error {
+func (m *confluentSchemaManager[C]) ClearRegistry(ctx context.Context, schemaSubject string) error {
 	uri := m.registryURL + "/subjects/" + url.QueryEscape(schemaSubject)
 	req, err := http.NewRequestWithContext(ctx, "DELETE", uri, nil)
 	if err != nil {
@@ -397,14 +419,18 @@ func (m *confluentSchemaManager) ClearRegistry(ctx context.Context, schemaSubjec
 	)
 }
 
-func (m *confluentSchemaManager) RegistryType() string {
+func (m *confluentSchemaManager[C]) RegistryType() string {
 	return m.registryType
 }
 
+func (m *confluentSchemaManager[C]) SchemaType() SchemaType {
+	return m.format.Type()
+}
+
 // confluent avro wire format, confluent avro is not same as apache avro
 // https://rmoff.net/2020/07/03/why-json-isnt-the-same-as-json-schema-in-kafka-connect-converters \
 // -and-ksqldb-viewing-kafka-messages-bytes-as-hex/
-func (m *confluentSchemaManager) getMsgHeader(schemaID int) ([]byte, error) {
+func (m *confluentSchemaManager[C]) getMsgHeader(schemaID int) ([]byte, error) {
 	head := new(bytes.Buffer)
 	err := head.WriteByte(magicByte)
 	if err != nil {
@@ -414,6 +440,11 @@ func (m *confluentSchemaManager) getMsgHeader(schemaID int) ([]byte, error) {
 	if err != nil {
 		return nil, errors.WrapError(errors.ErrEncodeFailed, err)
 	}
+	// the protobuf wire format carries the indexes of the message in the schema
+	// after the schema ID, a single 0 stands for the first message.
+	if m.format != nil && m.format.Type() == SchemaTypeProtobuf {
+		head.WriteByte(0)
+	}
 	return head.Bytes(), nil
 }
 
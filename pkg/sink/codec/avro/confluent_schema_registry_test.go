@@ -302,3 +302,51 @@ func TestHTTPRetryReturnsServerError(t *testing.T) {
 	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 	_ = resp.Body.Close()
 }
+
+func TestSchemaRegistryWithFormat(t *testing.T) {
+	startHTTPInterceptForTestingRegistry()
+	defer stopHTTPInterceptForTestingRegistry()
+
+	ctx := getTestingContext()
+	top := &avroSchemaTop{
+		Tp:        "record",
+		Name:      "test",
+		Namespace: "default.cdctest",
+		Fields: []map[string]any{
+			{"name": "id", "type": avroSchema{Type: "long"}},
+			{"name": "name", "type": []any{"null", avroSchema{Type: "string"}}},
+		},
+	}
+
+	jsonSchema, err := avroSchemaTop2JSONSchema(top)
+	require.NoError(t, err)
+	protoSchema, err := avroSchemaTop2Proto(top)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		format     SchemaFormat[recordCodec]
+		schema     string
+		headerSize int
+	}{
+		{format: jsonSchemaFormat{}, schema: jsonSchema, headerSize: 5},
+		{format: protobufFormat{}, schema: protoSchema, headerSize: 6},
+	} {
+		manager, err := NewConfluentSchemaManagerWithFormat(ctx, "http://127.0.0.1:8081", nil, tc.format)
+		require.NoError(t, err)
+		require.Equal(t, tc.format.Type(), manager.SchemaType())
+
+		subject := "cdctest-" + string(tc.format.Type())
+		codec, header, err := manager.GetCachedOrRegister(ctx, subject, 1,
+			func() (string, error) { return tc.schema, nil })
+		require.NoError(t, err)
+		require.Equal(t, tc.schema, codec.Schema())
+		require.Len(t, header, tc.headerSize)
+		require.Equal(t, magicByte, header[0])
+
+		id, err := getConfluentSchemaIDFromHeader(header)
+		require.NoError(t, err)
+		lookupCodec, err := manager.Lookup(ctx, subject, schemaID{confluentSchemaID: int(id)})
+		require.NoError(t, err)
+		require.Equal(t, codec.Schema(), lookupCodec.Schema())
+	}
+}
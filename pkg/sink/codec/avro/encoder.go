@@ -26,11 +26,21 @@ import (
 	"go.uber.org/zap"
 )
 
+// recordCodec encodes the native records into the binary data of the schema,
+// *goavro.Codec implements it.
+type recordCodec interface {
+	Schema() string
+	BinaryFromNative(buf []byte, native interface{}) ([]byte, error)
+}
+
 // BatchEncoder converts the events to binary Avro data
 type BatchEncoder struct {
 	keyspace string
 	schemaM  SchemaManager
-	result   []*common.Message
+	// registryM is used instead of the schemaM if the messages are encoded by
+	// the JSON schema or the protobuf schema.
+	registryM TypedSchemaManager[recordCodec]
+	result    []*common.Message
 
 	config *common.Config
 }
@@ -63,6 +73,51 @@ func NewAvroEncoder(ctx context.Context, config *common.Config) (common.EventEnc
 	}, nil
 }
 
+// NewJSONSchemaEncoder return an encoder which encodes the messages as the JSON documents,
+// the JSON schemas are registered to the schema registry.
+func NewJSONSchemaEncoder(ctx context.Context, config *common.Config) (common.EventEncoder, error) {
+	return newRegistryEncoder(ctx, config, jsonSchemaFormat{})
+}
+
+// NewProtobufEncoder return an encoder which encodes the messages as the protobuf messages,
+// the protobuf schemas are registered to the schema registry.
+func NewProtobufEncoder(ctx context.Context, config *common.Config) (common.EventEncoder, error) {
+	return newRegistryEncoder(ctx, config, protobufFormat{})
+}
+
+func newRegistryEncoder(
+	ctx context.Context, config *common.Config, format SchemaFormat[recordCodec],
+) (*BatchEncoder, error) {
+	var registryM TypedSchemaManager[recordCodec]
+	var err error
+
+	schemaRegistryType := config.SchemaRegistryType()
+	switch schemaRegistryType {
+	case common.SchemaRegistryTypeConfluent:
+		registryM, err = NewConfluentSchemaManagerWithFormat(ctx, config.AvroConfluentSchemaRegistry, nil, format)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	case common.SchemaRegistryTypeGlue:
+		registryM, err = NewGlueSchemaManagerWithFormat(ctx, config.AvroGlueSchemaRegistry, format)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	default:
+		return nil, errors.ErrAvroSchemaAPIError.GenWithStackByArgs(schemaRegistryType)
+	}
+	// neither the JSON schema nor protobuf has the decimal type,
+	// so the decimal values are always encoded as strings.
+	cfg := *config
+	cfg.AvroDecimalHandlingMode = common.DecimalHandlingModeString
+	return &BatchEncoder{
+		keyspace:  config.ChangefeedID.Keyspace(),
+		registryM: registryM,
+		result:    make([]*common.Message, 0, 1),
+		config:    &cfg,
+	}, nil
+}
+
 // AppendRowChangedEvent appends a row change event to the encoder
 // NOTE: the encoder can only store one RowChangedEvent!
 func (a *BatchEncoder) AppendRowChangedEvent(
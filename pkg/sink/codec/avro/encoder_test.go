@@ -15,6 +15,7 @@ package avro
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/pingcap/ticdc/downstreamadapter/sink/columnselector"
@@ -23,6 +24,7 @@ import (
 	"github.com/pingcap/ticdc/pkg/sink/codec/common"
 	timodel "github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 func newAvroEncoderForTest(keyspace string, schemaM SchemaManager, config *common.Config) common.EventEncoder {
@@ -235,3 +237,59 @@ func TestArvoAppendDMLEventWithCallback(t *testing.T) {
 		require.Equal(t, expected, count, "expected one callback be called")
 	}
 }
+
+func TestRegistryEncoderDMLEvent(t *testing.T) {
+	startHTTPInterceptForTestingRegistry()
+	defer stopHTTPInterceptForTestingRegistry()
+
+	ctx := t.Context()
+	_, event, _, _ := common.NewLargeEvent4Test(t)
+
+	for _, protocol := range []config.Protocol{config.ProtocolJSONSchema, config.ProtocolProtobuf} {
+		codecConfig := common.NewConfig(protocol)
+		codecConfig.EnableTiDBExtension = true
+		codecConfig.AvroConfluentSchemaRegistry = "http://127.0.0.1:8081"
+
+		var (
+			encoder common.EventEncoder
+			err     error
+		)
+		if protocol == config.ProtocolJSONSchema {
+			encoder, err = NewJSONSchemaEncoder(ctx, codecConfig)
+		} else {
+			encoder, err = NewProtobufEncoder(ctx, codecConfig)
+		}
+		require.NoError(t, err)
+
+		topic := "registry-test-topic-" + protocol.String()
+		require.NoError(t, encoder.AppendRowChangedEvent(ctx, topic, event))
+		messages := encoder.Build()
+		require.Len(t, messages, 1)
+		value := messages[0].Value
+		id, err := getConfluentSchemaIDFromHeader(value)
+		require.NoError(t, err)
+
+		registryM := encoder.(*BatchEncoder).registryM
+		codec, err := registryM.Lookup(ctx, topic+valueSchemaSuffix, schemaID{confluentSchemaID: int(id)})
+		require.NoError(t, err)
+
+		if protocol == config.ProtocolJSONSchema {
+			require.Equal(t, SchemaTypeJSON, registryM.SchemaType())
+			var native map[string]any
+			require.NoError(t, json.Unmarshal(value[5:], &native))
+			require.Equal(t, "c", native[tidbOp])
+			require.Contains(t, codec.Schema(), `"connect.index"`)
+		} else {
+			require.Equal(t, SchemaTypeProtobuf, registryM.SchemaType())
+			// the message indexes follows the schema ID in the protobuf wire format.
+			require.Equal(t, byte(0), value[5])
+			data := value[6:]
+			for len(data) > 0 {
+				_, _, n := protowire.ConsumeField(data)
+				require.Positive(t, n)
+				data = data[n:]
+			}
+			require.Contains(t, codec.Schema(), "message ")
+		}
+	}
+}
@@ -35,25 +35,36 @@ import (
 )
 
 // ---------- Glue schema manager
-// schemaManager is used to register Avro Schemas to the Registry server,
+// schemaManager is used to register Schemas to the Registry server,
 // look up local cache according to the table's name, and fetch from the Registry
 // in cache the local cache entry is missing.
-type glueSchemaManager struct {
+type glueSchemaManager[C any] struct {
 	registryName string
 	client       glueClient
 
+	format       SchemaFormat[C]
 	cacheRWLock  sync.RWMutex
-	cache        map[string]*schemaCacheEntry
+	cache        map[string]*schemaCacheEntry[C]
 	registryType string
 }
 
-// NewGlueSchemaManager creates a new schema manager for AWS Glue Schema Registry
+// NewGlueSchemaManager creates a new schema manager of the avro schemas for AWS Glue Schema Registry
 // It will load the default AWS credentials if no credentials are provided.
 // It will check if the registry exists, if not, it will return an error.
 func NewGlueSchemaManager(
 	ctx context.Context,
 	cfg *config.GlueSchemaRegistryConfig,
 ) (SchemaManager, error) {
+	return NewGlueSchemaManagerWithFormat(ctx, cfg, SchemaFormat[*goavro.Codec](avroFormat{}))
+}
+
+// NewGlueSchemaManagerWithFormat creates a new schema manager of the schema type
+// of the format for AWS Glue Schema Registry.
+func NewGlueSchemaManagerWithFormat[C any](
+	ctx context.Context,
+	cfg *config.GlueSchemaRegistryConfig,
+	format SchemaFormat[C],
+) (TypedSchemaManager[C], error) {
 	var awsCfg aws.Config
 	var err error
 	if cfg.NoCredentials() {
@@ -69,10 +80,11 @@ func NewGlueSchemaManager(
 			NewStaticCredentialsProvider(cfg.AccessKey, cfg.SecretAccessKey, cfg.Token)
 	}
 	client := glue.NewFromConfig(awsCfg)
-	res := &glueSchemaManager{
+	res := &glueSchemaManager[C]{
 		registryName: cfg.RegistryName,
 		client:       client,
-		cache:        make(map[string]*schemaCacheEntry),
+		format:       format,
+		cache:        make(map[string]*schemaCacheEntry[C]),
 		registryType: common.SchemaRegistryTypeGlue,
 	}
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
@@ -93,7 +105,7 @@ func NewGlueSchemaManager(
 }
 
 // Register a schema into schema registry, no cache
-func (m *glueSchemaManager) Register(
+func (m *glueSchemaManager[C]) Register(
 	ctx context.Context,
 	schemaName string,
 	schemaDefinition string,
@@ -123,11 +135,11 @@ func (m *glueSchemaManager) Register(
 	return id, nil
 }
 
-func (m *glueSchemaManager) Lookup(
+func (m *glueSchemaManager[C]) Lookup(
 	ctx context.Context,
 	schemaName string,
 	schemaID schemaID,
-) (*goavro.Codec, error) {
+) (codec C, err error) {
 	m.cacheRWLock.RLock()
 	entry, exists := m.cache[schemaName]
 	if exists && entry.schemaID.confluentSchemaID == schemaID.confluentSchemaID {
@@ -145,28 +157,28 @@ func (m *glueSchemaManager) Lookup(
 
 	ok, schema, err := m.getSchemaByID(ctx, schemaID.glueSchemaID)
 	if err != nil {
-		return nil, errors.Trace(err)
+		return codec, errors.Trace(err)
 	}
 	if !ok {
-		return nil, errors.ErrAvroSchemaAPIError.
+		return codec, errors.ErrAvroSchemaAPIError.
 			GenWithStackByArgs("schema not found in registry, name: %s, id: %s", schemaName, schemaID.glueSchemaID)
 	}
 
-	codec, err := GenCodec(schema)
+	codec, err = m.format.Compile(schema)
 	if err != nil {
-		log.Error("could not make goavro codec", zap.Error(err))
-		return nil, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
+		log.Error("could not make codec", zap.Error(err))
+		return codec, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
 	}
 
-	header, err := m.getMsgHeader(schemaID.glueSchemaID)
+	header, err = m.getMsgHeader(schemaID.glueSchemaID)
 	if err != nil {
 		log.Error("could not get message header", zap.Error(err))
-		return nil, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
+		return codec, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
 	}
 
 	m.cacheRWLock.Lock()
 	defer m.cacheRWLock.Unlock()
-	m.cache[schemaName] = &schemaCacheEntry{
+	m.cache[schemaName] = &schemaCacheEntry[C]{
 		schemaID: schemaID,
 		codec:    codec,
 		header:   header,
@@ -175,16 +187,16 @@ func (m *glueSchemaManager) Lookup(
 	return codec, nil
 }
 
-// GetCachedOrRegister checks if the suitable Avro schema has been cached.
+// GetCachedOrRegister checks if the suitable schema has been cached.
 // If not, a new schema is generated, registered and cached.
 // Re-registering an existing schema shall return the same id(and version), so even if the
 // cache is out-of-sync with schema registry, we could reload it.
-func (m *glueSchemaManager) GetCachedOrRegister(
+func (m *glueSchemaManager[C]) GetCachedOrRegister(
 	ctx context.Context,
 	schemaName string,
 	tableVersion uint64,
 	schemaGen SchemaGenerator,
-) (*goavro.Codec, []byte, error) {
+) (codec C, header []byte, err error) {
 	m.cacheRWLock.RLock()
 	if entry, exists := m.cache[schemaName]; exists && entry.tableVersion == tableVersion {
 		log.Debug("Avro schema GetCachedOrRegister cache hit",
@@ -202,13 +214,13 @@ func (m *glueSchemaManager) GetCachedOrRegister(
 
 	schema, err := schemaGen()
 	if err != nil {
-		return nil, nil, err
+		return codec, nil, err
 	}
 
-	codec, err := GenCodec(schema)
+	codec, err = m.format.Compile(schema)
 	if err != nil {
-		log.Error("GetCachedOrRegister: Could not make goavro codec", zap.Error(err))
-		return nil, nil, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
+		log.Error("GetCachedOrRegister: Could not make codec", zap.Error(err))
+		return codec, nil, errors.WrapError(errors.ErrAvroSchemaAPIError, err)
 	}
 
 	log.Info(fmt.Sprintf("The code to be registered: %#v", schema))
@@ -216,16 +228,16 @@ func (m *glueSchemaManager) GetCachedOrRegister(
 	id, err := m.Register(ctx, schemaName, schema)
 	if err != nil {
 		log.Error("GetCachedOrRegister: Could not register schema", zap.Error(err))
-		return nil, nil, errors.Trace(err)
+		return codec, nil, errors.Trace(err)
 	}
 
-	header, err := m.getMsgHeader(id.glueSchemaID)
+	header, err = m.getMsgHeader(id.glueSchemaID)
 	if err != nil {
 		log.Error("GetCachedOrRegister: Could not get message header", zap.Error(err))
-		return nil, nil, errors.Trace(err)
+		return codec, nil, errors.Trace(err)
 	}
 
-	cacheEntry := &schemaCacheEntry{
+	cacheEntry := &schemaCacheEntry[C]{
 		tableVersion: tableVersion,
 		schemaID:     id,
 		codec:        codec,
@@ -245,21 +257,25 @@ func (m *glueSchemaManager) GetCachedOrRegister(
 }
 
 // ClearRegistry implements SchemaManager, it is not used.
-func (m *glueSchemaManager) ClearRegistry(ctx context.Context, schemaSubject string) error {
+func (m *glueSchemaManager[C]) ClearRegistry(ctx context.Context, schemaSubject string) error {
 	return nil
 }
 
-func (m *glueSchemaManager) RegistryType() string {
+func (m *glueSchemaManager[C]) RegistryType() string {
 	return m.registryType
 }
 
-func (m *glueSchemaManager) createSchema(ctx context.Context, schemaName, schemaDefinition string) (string, error) {
+func (m *glueSchemaManager[C]) SchemaType() SchemaType {
+	return m.format.Type()
+}
+
+func (m *glueSchemaManager[C]) createSchema(ctx context.Context, schemaName, schemaDefinition string) (string, error) {
 	createSchemaInput := &glue.CreateSchemaInput{
 		RegistryId: &types.RegistryId{
 			RegistryName: &m.registryName,
 		},
 		SchemaName:       aws.String(schemaName),
-		DataFormat:       types.DataFormatAvro,
+		DataFormat:       m.dataFormat(),
 		SchemaDefinition: aws.String(schemaDefinition),
 		// cdc don't need to set compatibility check for schema registry
 		// TiDB do the schema compatibility check for us, we need to accept all schema changes
@@ -274,7 +290,18 @@ func (m *glueSchemaManager) createSchema(ctx context.Context, schemaName, schema
 	return *output.SchemaVersionId, nil
 }
 
-func (m *glueSchemaManager) updateSchema(ctx context.Context, schemaName, schemaDefinition string) (string, error) {
+func (m *glueSchemaManager[C]) dataFormat() types.DataFormat {
+	switch m.format.Type() {
+	case SchemaTypeJSON:
+		return types.DataFormatJson
+	case SchemaTypeProtobuf:
+		return types.DataFormatProtobuf
+	default:
+		return types.DataFormatAvro
+	}
+}
+
+func (m *glueSchemaManager[C]) updateSchema(ctx context.Context, schemaName, schemaDefinition string) (string, error) {
 	input := &glue.RegisterSchemaVersionInput{
 		SchemaId: &types.SchemaId{
 			RegistryName: aws.String(m.registryName),
@@ -290,7 +317,7 @@ func (m *glueSchemaManager) updateSchema(ctx context.Context, schemaName, schema
 	return *resp.SchemaVersionId, nil
 }
 
-func (m *glueSchemaManager) getSchemaByName(ctx context.Context, schemaName string) (bool, string, error) {
+func (m *glueSchemaManager[C]) getSchemaByName(ctx context.Context, schemaName string) (bool, string, error) {
 	input := &glue.GetSchemaVersionInput{
 		SchemaId: &types.SchemaId{
 			RegistryName: aws.String(m.registryName),
@@ -308,7 +335,7 @@ func (m *glueSchemaManager) getSchemaByName(ctx context.Context, schemaName stri
 	return true, *result.SchemaDefinition, nil
 }
 
-func (m *glueSchemaManager) getSchemaByID(ctx context.Context, schemaID string) (bool, string, error) {
+func (m *glueSchemaManager[C]) getSchemaByID(ctx context.Context, schemaID string) (bool, string, error) {
 	input := &glue.GetSchemaVersionInput{
 		SchemaVersionId: aws.String(schemaID),
 	}
@@ -331,7 +358,7 @@ const (
 	compressionDefaultByte = uint8(0) // 0  no compression
 )
 
-func (m *glueSchemaManager) getMsgHeader(schemaID string) ([]byte, error) {
+func (m *glueSchemaManager[C]) getMsgHeader(schemaID string) ([]byte, error) {
 	header := []byte{}
 	header = append(header, headerVersionByte)
 	header = append(header, compressionDefaultByte)
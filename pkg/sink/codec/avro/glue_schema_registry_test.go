@@ -17,15 +17,17 @@ import (
 	"context"
 	"testing"
 
+	"github.com/linkedin/goavro/v2"
 	"github.com/pingcap/ticdc/pkg/sink/codec/common"
 	"github.com/stretchr/testify/require"
 )
 
-func newClueSchemaManagerForTest() *glueSchemaManager {
-	res := &glueSchemaManager{
+func newClueSchemaManagerForTest() *glueSchemaManager[*goavro.Codec] {
+	res := &glueSchemaManager[*goavro.Codec]{
 		registryName: "test_registry",
 		client:       newMockGlueClientImpl(),
-		cache:        make(map[string]*schemaCacheEntry),
+		format:       avroFormat{},
+		cache:        make(map[string]*schemaCacheEntry[*goavro.Codec]),
 		registryType: common.SchemaRegistryTypeGlue,
 	}
 	return res
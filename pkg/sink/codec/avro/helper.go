@@ -17,6 +17,7 @@ import (
 	"strings"
 
 	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
 	"github.com/pingcap/ticdc/pkg/sink/codec/common"
 	timodel "github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
@@ -167,3 +168,38 @@ func getAvroNamespace(namespace string, schema string) string {
 	}
 	return ns
 }
+
+// unwrapAvroFieldType returns the primitive type and the connect parameters of the
+// avro field type generated by columns2AvroSchema, and whether the field is nullable.
+func unwrapAvroFieldType(fieldType any) (string, map[string]string, bool, error) {
+	nullable := false
+	if union, ok := fieldType.([]any); ok {
+		nullable = true
+		for _, tp := range union {
+			if tp != "null" {
+				fieldType = tp
+				break
+			}
+		}
+	}
+	switch tp := fieldType.(type) {
+	case string:
+		return tp, nil, nullable, nil
+	case avroSchema:
+		return tp.Type, tp.Parameters, nullable, nil
+	case avroLogicalTypeSchema:
+		return tp.Type, tp.Parameters, nullable, nil
+	default:
+		return "", nil, false, errors.ErrAvroEncodeFailed.GenWithStack("unsupported avro field type %v", fieldType)
+	}
+}
+
+// unwrapAvroUnion returns the value wrapped by goavro.Union.
+func unwrapAvroUnion(value any) any {
+	if union, ok := value.(map[string]any); ok && len(union) == 1 {
+		for _, v := range union {
+			return v
+		}
+	}
+	return value
+}
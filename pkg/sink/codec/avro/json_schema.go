@@ -0,0 +1,136 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"encoding/json"
+
+	"github.com/pingcap/ticdc/pkg/errors"
+)
+
+// jsonSchemaFormat is the SchemaFormat of the JSON schemas.
+type jsonSchemaFormat struct{}
+
+func (jsonSchemaFormat) Type() SchemaType {
+	return SchemaTypeJSON
+}
+
+func (jsonSchemaFormat) Compile(schemaDefinition string) (recordCodec, error) {
+	var schema struct {
+		Type       string                     `json:"type"`
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(schemaDefinition), &schema); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if schema.Type != "object" {
+		return nil, errors.Errorf("the type of the JSON schema must be object, but got %s", schema.Type)
+	}
+	properties := make(map[string]struct{}, len(schema.Properties))
+	for name := range schema.Properties {
+		properties[name] = struct{}{}
+	}
+	return &jsonSchemaCodec{
+		schema:     schemaDefinition,
+		properties: properties,
+	}, nil
+}
+
+// jsonSchemaCodec encodes the records into the JSON documents of the schema.
+type jsonSchemaCodec struct {
+	schema     string
+	properties map[string]struct{}
+}
+
+func (c *jsonSchemaCodec) Schema() string {
+	return c.schema
+}
+
+// BinaryFromNative encodes the native record built by columns2AvroData,
+// the values not described by the schema are dropped.
+func (c *jsonSchemaCodec) BinaryFromNative(buf []byte, native any) ([]byte, error) {
+	record, ok := native.(map[string]any)
+	if !ok {
+		return nil, errors.Errorf("cannot encode %T by the JSON schema", native)
+	}
+	values := make(map[string]any, len(record))
+	for name, value := range record {
+		if _, ok := c.properties[name]; ok {
+			values[name] = unwrapAvroUnion(value)
+		}
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return append(buf, data...), nil
+}
+
+// avroType2JSONSchemaType maps the avro primitive types to the JSON schema types
+// and the connect.type used by the Kafka Connect JSON schema converter.
+var avroType2JSONSchemaType = map[string][2]string{
+	"boolean": {"boolean", ""},
+	"int":     {"integer", "int32"},
+	"long":    {"integer", "int64"},
+	"float":   {"number", "float32"},
+	"double":  {"number", "float64"},
+	"string":  {"string", ""},
+	"bytes":   {"string", "bytes"},
+}
+
+// avroSchemaTop2JSONSchema converts the avro record schema into the JSON schema,
+// the nullable fields are described by oneOf with the null type.
+func avroSchemaTop2JSONSchema(top *avroSchemaTop) (string, error) {
+	properties := make(map[string]any, len(top.Fields))
+	for index, field := range top.Fields {
+		name, _ := field["name"].(string)
+		avroType, parameters, nullable, err := unwrapAvroFieldType(field["type"])
+		if err != nil {
+			return "", err
+		}
+		tp, ok := avroType2JSONSchemaType[avroType]
+		if !ok {
+			return "", errors.ErrAvroEncodeFailed.GenWithStack(
+				"avro type %s of the field %s is not supported by the JSON schema", avroType, name)
+		}
+		property := map[string]any{"type": tp[0]}
+		if tp[1] != "" {
+			property["connect.type"] = tp[1]
+		}
+		if len(parameters) != 0 {
+			property["connect.parameters"] = parameters
+		}
+		if nullable {
+			property = map[string]any{
+				"oneOf": []any{map[string]any{"type": "null"}, property},
+			}
+		}
+		property["connect.index"] = index
+		properties[name] = property
+	}
+
+	title := top.Name
+	if top.Namespace != "" {
+		title = top.Namespace + "." + top.Name
+	}
+	str, err := json.Marshal(map[string]any{
+		"type":       "object",
+		"title":      title,
+		"properties": properties,
+	})
+	if err != nil {
+		return "", errors.WrapError(errors.ErrAvroMarshalFailed, err)
+	}
+	return string(str), nil
+}
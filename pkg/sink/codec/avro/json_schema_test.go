@@ -0,0 +1,82 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvroSchemaTop2JSONSchema(t *testing.T) {
+	t.Parallel()
+
+	top := &avroSchemaTop{
+		Tp:        "record",
+		Name:      "t",
+		Namespace: "default.test",
+		Fields: []map[string]any{
+			{"name": "id", "type": avroSchema{Type: "int", Parameters: map[string]string{tidbType: "INT"}}},
+			{"name": "name", "type": []any{"null", avroSchema{Type: "string"}}},
+			{"name": "data", "type": []any{avroSchema{Type: "bytes"}, "null"}},
+			{"name": tidbOp, "type": "string"},
+		},
+	}
+	schema, err := avroSchemaTop2JSONSchema(top)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"type": "object",
+		"title": "default.test.t",
+		"properties": {
+			"id": {"type": "integer", "connect.type": "int32", "connect.index": 0,
+				"connect.parameters": {"tidb_type": "INT"}},
+			"name": {"oneOf": [{"type": "null"}, {"type": "string"}], "connect.index": 1},
+			"data": {"oneOf": [{"type": "null"}, {"type": "string", "connect.type": "bytes"}], "connect.index": 2},
+			"_tidb_op": {"type": "string", "connect.index": 3}
+		}
+	}`, schema)
+
+	top.Fields = append(top.Fields, map[string]any{"name": "unknown", "type": "fixed"})
+	_, err = avroSchemaTop2JSONSchema(top)
+	require.Error(t, err)
+}
+
+func TestJSONSchemaCodec(t *testing.T) {
+	t.Parallel()
+
+	_, err := jsonSchemaFormat{}.Compile(`{"type": "array"}`)
+	require.Error(t, err)
+	_, err = jsonSchemaFormat{}.Compile(`not a json`)
+	require.Error(t, err)
+
+	codec, err := jsonSchemaFormat{}.Compile(`{
+		"type": "object",
+		"properties": {"id": {"type": "integer"}, "name": {"type": "string"}, "data": {"type": "string"}}
+	}`)
+	require.NoError(t, err)
+
+	bin, err := codec.BinaryFromNative(nil, map[string]any{
+		"id":      int32(1),
+		"name":    goavro.Union("string", "a"),
+		"data":    goavro.Union("null", nil),
+		"dropped": "x",
+	})
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(bin, &decoded))
+	require.Equal(t, map[string]any{"id": float64(1), "name": "a", "data": nil}, decoded)
+}
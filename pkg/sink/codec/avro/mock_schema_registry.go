@@ -23,9 +23,10 @@ import (
 )
 
 type mockConfluentRegistrySchema struct {
-	content string
-	version int
-	ID      int
+	content    string
+	schemaType string
+	version    int
+	ID         int
 }
 
 type mockRegistry struct {
@@ -72,9 +73,10 @@ func startHTTPInterceptForTestingRegistry() {
 			item, exists := registry.subjects[subject]
 			if !exists {
 				item = &mockConfluentRegistrySchema{
-					content: reqData.Schema,
-					version: 1,
-					ID:      registry.newID,
+					content:    reqData.Schema,
+					schemaType: reqData.SchemaType,
+					version:    1,
+					ID:         registry.newID,
 				}
 				registry.subjects[subject] = item
 				respData.SchemaID = registry.newID
@@ -83,6 +85,7 @@ func startHTTPInterceptForTestingRegistry() {
 					respData.SchemaID = item.ID
 				} else {
 					item.content = reqData.Schema
+					item.schemaType = reqData.SchemaType
 					item.version++
 					item.ID = registry.newID
 					respData.SchemaID = registry.newID
@@ -106,6 +109,7 @@ func startHTTPInterceptForTestingRegistry() {
 					respData.Schema = item.content
 					respData.Name = key
 					respData.SchemaID = item.ID
+					respData.SchemaType = item.schemaType
 					return httpmock.NewJsonResponse(200, &respData)
 				}
 			}
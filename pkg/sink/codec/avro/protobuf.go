@@ -0,0 +1,235 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/ticdc/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// protobufFormat is the SchemaFormat of the protobuf schemas. Only the schemas
+// generated by avroSchemaTop2Proto are supported, which is a proto3 file with
+// a single message of the scalar fields.
+type protobufFormat struct{}
+
+func (protobufFormat) Type() SchemaType {
+	return SchemaTypeProtobuf
+}
+
+var protoFieldRegexp = regexp.MustCompile(`^(optional\s+)?(\w+)\s+(\w+)\s*=\s*(\d+)\s*;$`)
+
+func (protobufFormat) Compile(schemaDefinition string) (recordCodec, error) {
+	var (
+		fields   []protoField
+		messages int
+	)
+	for _, line := range strings.Split(schemaDefinition, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "", line == "}", strings.HasPrefix(line, "//"),
+			strings.HasPrefix(line, "syntax "), strings.HasPrefix(line, "package "),
+			strings.HasPrefix(line, "option "):
+			continue
+		case strings.HasPrefix(line, "message "):
+			messages++
+			continue
+		}
+		matches := protoFieldRegexp.FindStringSubmatch(line)
+		if matches == nil {
+			return nil, errors.Errorf("unsupported protobuf schema line: %s", line)
+		}
+		if _, ok := protoType2WireType[matches[2]]; !ok {
+			return nil, errors.Errorf("unsupported protobuf field type %s", matches[2])
+		}
+		number, err := strconv.ParseInt(matches[4], 10, 32)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		fields = append(fields, protoField{
+			name:     matches[3],
+			tp:       matches[2],
+			number:   protowire.Number(number),
+			optional: matches[1] != "",
+		})
+	}
+	if messages != 1 {
+		return nil, errors.Errorf("the protobuf schema must have exactly one message, but got %d", messages)
+	}
+	return &protobufCodec{
+		schema: schemaDefinition,
+		fields: fields,
+	}, nil
+}
+
+type protoField struct {
+	name     string
+	tp       string
+	number   protowire.Number
+	optional bool
+}
+
+// protobufCodec encodes the records into the protobuf messages of the schema.
+type protobufCodec struct {
+	schema string
+	fields []protoField
+}
+
+func (c *protobufCodec) Schema() string {
+	return c.schema
+}
+
+// BinaryFromNative encodes the native record built by columns2AvroData,
+// the null values are left unset, and the values not described by the schema are dropped.
+func (c *protobufCodec) BinaryFromNative(buf []byte, native any) ([]byte, error) {
+	record, ok := native.(map[string]any)
+	if !ok {
+		return nil, errors.Errorf("cannot encode %T by the protobuf schema", native)
+	}
+	for _, field := range c.fields {
+		value := unwrapAvroUnion(record[field.name])
+		if value == nil {
+			continue
+		}
+		buf = protowire.AppendTag(buf, field.number, protoType2WireType[field.tp])
+		switch field.tp {
+		case "int32", "int64":
+			v, ok := toInt64(value)
+			if !ok {
+				return nil, errors.Errorf("cannot encode %T as %s of the field %s", value, field.tp, field.name)
+			}
+			buf = protowire.AppendVarint(buf, uint64(v))
+		case "bool":
+			v, ok := value.(bool)
+			if !ok {
+				return nil, errors.Errorf("cannot encode %T as bool of the field %s", value, field.name)
+			}
+			buf = protowire.AppendVarint(buf, protowire.EncodeBool(v))
+		case "float":
+			v, ok := toFloat64(value)
+			if !ok {
+				return nil, errors.Errorf("cannot encode %T as float of the field %s", value, field.name)
+			}
+			buf = protowire.AppendFixed32(buf, math.Float32bits(float32(v)))
+		case "double":
+			v, ok := toFloat64(value)
+			if !ok {
+				return nil, errors.Errorf("cannot encode %T as double of the field %s", value, field.name)
+			}
+			buf = protowire.AppendFixed64(buf, math.Float64bits(v))
+		case "string":
+			v, ok := value.(string)
+			if !ok {
+				return nil, errors.Errorf("cannot encode %T as string of the field %s", value, field.name)
+			}
+			buf = protowire.AppendString(buf, v)
+		case "bytes":
+			v, ok := value.([]byte)
+			if !ok {
+				return nil, errors.Errorf("cannot encode %T as bytes of the field %s", value, field.name)
+			}
+			buf = protowire.AppendBytes(buf, v)
+		}
+	}
+	return buf, nil
+}
+
+func toInt64(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func toFloat64(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+var protoType2WireType = map[string]protowire.Type{
+	"bool":   protowire.VarintType,
+	"int32":  protowire.VarintType,
+	"int64":  protowire.VarintType,
+	"float":  protowire.Fixed32Type,
+	"double": protowire.Fixed64Type,
+	"string": protowire.BytesType,
+	"bytes":  protowire.BytesType,
+}
+
+var avroType2ProtoType = map[string]string{
+	"boolean": "bool",
+	"int":     "int32",
+	"long":    "int64",
+	"float":   "float",
+	"double":  "double",
+	"string":  "string",
+	"bytes":   "bytes",
+}
+
+// avroSchemaTop2Proto converts the avro record schema into a proto3 schema,
+// the nullable fields are optional, and the field numbers follow the order of the fields.
+func avroSchemaTop2Proto(top *avroSchemaTop) (string, error) {
+	var b strings.Builder
+	b.WriteString("syntax = \"proto3\";\n\n")
+	if namespace := strings.Trim(top.Namespace, "."); namespace != "" {
+		fmt.Fprintf(&b, "package %s;\n\n", namespace)
+	}
+	fmt.Fprintf(&b, "message %s {\n", top.Name)
+	for index, field := range top.Fields {
+		name, _ := field["name"].(string)
+		avroType, _, nullable, err := unwrapAvroFieldType(field["type"])
+		if err != nil {
+			return "", err
+		}
+		tp, ok := avroType2ProtoType[avroType]
+		if !ok {
+			return "", errors.ErrAvroEncodeFailed.GenWithStack(
+				"avro type %s of the field %s is not supported by protobuf", avroType, name)
+		}
+		label := ""
+		if nullable {
+			label = "optional "
+		}
+		fmt.Fprintf(&b, "  %s%s %s = %d;\n", label, tp, name, index+1)
+	}
+	b.WriteString("}\n")
+	return b.String(), nil
+}
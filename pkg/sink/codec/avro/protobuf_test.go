@@ -0,0 +1,134 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"math"
+	"testing"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestAvroSchemaTop2Proto(t *testing.T) {
+	t.Parallel()
+
+	top := &avroSchemaTop{
+		Tp:        "record",
+		Name:      "t",
+		Namespace: "default.test",
+		Fields: []map[string]any{
+			{"name": "id", "type": avroSchema{Type: "long"}},
+			{"name": "name", "type": []any{"null", avroSchema{Type: "string"}}},
+			{"name": "score", "type": []any{avroSchema{Type: "double"}, "null"}},
+			{"name": tidbCorrupted, "type": "boolean"},
+		},
+	}
+	schema, err := avroSchemaTop2Proto(top)
+	require.NoError(t, err)
+	require.Equal(t, `syntax = "proto3";
+
+package default.test;
+
+message t {
+  int64 id = 1;
+  optional string name = 2;
+  optional double score = 3;
+  bool _tidb_corrupted = 4;
+}
+`, schema)
+
+	codec, err := protobufFormat{}.Compile(schema)
+	require.NoError(t, err)
+	require.Equal(t, schema, codec.Schema())
+	require.Len(t, codec.(*protobufCodec).fields, 4)
+
+	top.Fields = append(top.Fields, map[string]any{"name": "unknown", "type": "fixed"})
+	_, err = avroSchemaTop2Proto(top)
+	require.Error(t, err)
+}
+
+func TestProtobufCodec(t *testing.T) {
+	t.Parallel()
+
+	for _, schema := range []string{
+		"message a {\n}\nmessage b {\n}\n",
+		"message a {\n  uint32 id = 1;\n}\n",
+		"message a {\n  repeated int32 id = 1;\n}\n",
+	} {
+		_, err := protobufFormat{}.Compile(schema)
+		require.Error(t, err, schema)
+	}
+
+	codec, err := protobufFormat{}.Compile(`syntax = "proto3";
+
+message t {
+  int32 id = 1;
+  optional string name = 2;
+  optional bytes data = 3;
+  float score = 4;
+  bool ok = 5;
+}
+`)
+	require.NoError(t, err)
+
+	bin, err := codec.BinaryFromNative(nil, map[string]any{
+		"id":    int32(-1),
+		"name":  goavro.Union("string", "a"),
+		"data":  goavro.Union("null", nil),
+		"score": float32(1.5),
+		"ok":    true,
+	})
+	require.NoError(t, err)
+
+	type field struct {
+		number protowire.Number
+		value  any
+	}
+	var fields []field
+	for len(bin) > 0 {
+		number, tp, n := protowire.ConsumeTag(bin)
+		require.Positive(t, n)
+		bin = bin[n:]
+		switch tp {
+		case protowire.VarintType:
+			v, n := protowire.ConsumeVarint(bin)
+			require.Positive(t, n)
+			fields = append(fields, field{number, v})
+			bin = bin[n:]
+		case protowire.Fixed32Type:
+			v, n := protowire.ConsumeFixed32(bin)
+			require.Positive(t, n)
+			fields = append(fields, field{number, math.Float32frombits(v)})
+			bin = bin[n:]
+		case protowire.BytesType:
+			v, n := protowire.ConsumeBytes(bin)
+			require.Positive(t, n)
+			fields = append(fields, field{number, string(v)})
+			bin = bin[n:]
+		default:
+			t.Fatalf("unexpected wire type %d", tp)
+		}
+	}
+	require.Equal(t, []field{
+		{1, uint64(math.MaxUint64)},
+		{2, "a"},
+		{4, float32(1.5)},
+		{5, uint64(1)},
+	}, fields)
+
+	_, err = codec.BinaryFromNative(nil, map[string]any{"id": "1"})
+	require.Error(t, err)
+}
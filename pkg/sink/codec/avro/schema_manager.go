@@ -19,20 +19,58 @@ import (
 	"github.com/linkedin/goavro/v2"
 )
 
-// SchemaManager is an interface for schema registry
-type SchemaManager interface {
+// SchemaType is the type of the schemas in the schema registry, the value is
+// the same as the schemaType of the Confluent Schema Registry API.
+type SchemaType string
+
+const (
+	// SchemaTypeAvro is the type of the avro schemas.
+	SchemaTypeAvro SchemaType = "AVRO"
+	// SchemaTypeJSON is the type of the JSON schemas.
+	SchemaTypeJSON SchemaType = "JSON"
+	// SchemaTypeProtobuf is the type of the protobuf schemas.
+	SchemaTypeProtobuf SchemaType = "PROTOBUF"
+)
+
+// SchemaFormat makes the schema type of the schema managers pluggable,
+// C is the codec compiled from the schema definition.
+type SchemaFormat[C any] interface {
+	// Type returns the type of the schemas.
+	Type() SchemaType
+	// Compile validates the schema definition and compiles it into the codec.
+	Compile(schemaDefinition string) (C, error)
+}
+
+// TypedSchemaManager is an interface for schema registry, the schemas are
+// compiled into the codec C by the SchemaFormat of the manager.
+type TypedSchemaManager[C any] interface {
 	Register(ctx context.Context, schemaName string, schemaDefinition string) (schemaID, error)
-	Lookup(ctx context.Context, schemaName string, schemaID schemaID) (*goavro.Codec, error)
+	Lookup(ctx context.Context, schemaName string, schemaID schemaID) (C, error)
 	GetCachedOrRegister(ctx context.Context, topicName string,
-		tableVersion uint64, schemaGen SchemaGenerator) (*goavro.Codec, []byte, error)
+		tableVersion uint64, schemaGen SchemaGenerator) (C, []byte, error)
 	RegistryType() string
+	SchemaType() SchemaType
 	ClearRegistry(ctx context.Context, schemaName string) error
 }
 
-// SchemaGenerator represents a function that returns an Avro schema in JSON.
+// SchemaManager is an interface for schema registry of the avro schemas.
+type SchemaManager = TypedSchemaManager[*goavro.Codec]
+
+// SchemaGenerator represents a function that returns a schema definition.
 // Used for lazy evaluation
 type SchemaGenerator func() (string, error)
 
+// avroFormat is the SchemaFormat of the avro schemas.
+type avroFormat struct{}
+
+func (avroFormat) Type() SchemaType {
+	return SchemaTypeAvro
+}
+
+func (avroFormat) Compile(schemaDefinition string) (*goavro.Codec, error) {
+	return GenCodec(schemaDefinition)
+}
+
 type schemaID struct {
 	// confluentSchemaID is the Confluent Schema ID, it represents
 	// a unique schema in Confluent Schema Registry
@@ -42,7 +80,7 @@ type schemaID struct {
 	glueSchemaID string
 }
 
-type schemaCacheEntry struct {
+type schemaCacheEntry[C any] struct {
 	// tableVersion is the table's version which the message associated with.
 	// encoder use it as the cache key.
 	tableVersion uint64
@@ -51,6 +89,6 @@ type schemaCacheEntry struct {
 	// decoder use it as the cache key.
 	schemaID schemaID
 	// codec is associated with the schemaID, used to decode the message
-	codec  *goavro.Codec
+	codec  C
 	header []byte
 }
@@ -23,9 +23,12 @@ import (
 	"github.com/pingcap/ticdc/pkg/sink/codec/avro"
 	"github.com/pingcap/ticdc/pkg/sink/codec/canal"
 	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"github.com/pingcap/ticdc/pkg/sink/codec/craft"
 	"github.com/pingcap/ticdc/pkg/sink/codec/csv"
 	"github.com/pingcap/ticdc/pkg/sink/codec/debezium"
+	"github.com/pingcap/ticdc/pkg/sink/codec/maxwell"
 	"github.com/pingcap/ticdc/pkg/sink/codec/open"
+	"github.com/pingcap/ticdc/pkg/sink/codec/parquet"
 	"github.com/pingcap/ticdc/pkg/sink/codec/simple"
 	"github.com/pingcap/ticdc/pkg/sink/kafka/claimcheck"
 	"go.uber.org/zap"
@@ -43,8 +46,16 @@ func NewEventEncoder(ctx context.Context, cfg *common.Config, claimCheck *claimc
 		return debezium.NewBatchEncoder(cfg, config.GetGlobalServerConfig().ClusterID), nil
 	case config.ProtocolDebeziumAvro:
 		return debezium.NewAvroBatchEncoder(ctx, cfg, config.GetGlobalServerConfig().ClusterID)
+	case config.ProtocolJSONSchema:
+		return avro.NewJSONSchemaEncoder(ctx, cfg)
+	case config.ProtocolProtobuf:
+		return avro.NewProtobufEncoder(ctx, cfg)
 	case config.ProtocolSimple:
 		return simple.NewEncoder(cfg, claimCheck)
+	case config.ProtocolMaxwell:
+		return maxwell.NewEncoder(cfg), nil
+	case config.ProtocolCraft:
+		return craft.NewBatchEncoder(cfg), nil
 	default:
 		return nil, errors.ErrSinkUnknownProtocol.GenWithStackByArgs(cfg.Protocol)
 	}
@@ -71,6 +82,10 @@ func NewEventDecoder(
 		return debezium.NewDecoder(codecConfig, idx, upstreamTiDB), nil
 	case config.ProtocolDebeziumAvro:
 		return debezium.NewAvroDecoder(ctx, codecConfig, idx, upstreamTiDB)
+	case config.ProtocolMaxwell:
+		return maxwell.NewDecoder(codecConfig), nil
+	case config.ProtocolCraft:
+		return craft.NewDecoder(), nil
 	default:
 	}
 	log.Panic("Protocol not supported", zap.Any("Protocol", codecConfig.Protocol))
@@ -86,7 +101,20 @@ func NewTxnEventEncoder(
 		return csv.NewTxnEventEncoder(c), nil
 	case config.ProtocolCanalJSON:
 		return canal.NewJSONTxnEventEncoder(c), nil
+	case config.ProtocolParquet:
+		return parquet.NewTxnEventEncoder(c), nil
 	default:
 		return nil, errors.ErrSinkUnknownProtocol.GenWithStackByArgs(c.Protocol)
 	}
 }
+
+// NewFileEncoder returns the FileEncoder of the protocol, it returns nil if
+// the file is produced by concatenating the messages built by TxnEventEncoder.
+func NewFileEncoder(c *common.Config) common.FileEncoder {
+	switch c.Protocol {
+	case config.ProtocolParquet:
+		return parquet.NewFileEncoder(c)
+	default:
+		return nil
+	}
+}
@@ -110,6 +110,8 @@ const (
 	EncodingFormatJSON EncodingFormatType = "json"
 	// EncodingFormatAvro is the avro format
 	EncodingFormatAvro EncodingFormatType = "avro"
+	// EncodingFormatProtobuf is the protobuf format
+	EncodingFormatProtobuf EncodingFormatType = "protobuf"
 )
 
 // NewConfig return a Config for codec
@@ -242,10 +244,9 @@ func (c *Config) Apply(sinkURI *url.URL, sinkConfig *config.SinkConfig) error {
 		sinkConfig.KafkaConfig.GlueSchemaRegistryConfig != nil {
 		c.AvroGlueSchemaRegistry = sinkConfig.KafkaConfig.GlueSchemaRegistryConfig
 	}
-	if (c.Protocol == config.ProtocolAvro || c.Protocol == config.ProtocolDebeziumAvro) &&
-		util.GetOrZero(sinkConfig.ForceReplicate) {
+	if c.Protocol.IsSchemaRegistryBased() && util.GetOrZero(sinkConfig.ForceReplicate) {
 		return errors.ErrCodecInvalidConfig.GenWithStack(
-			`force-replicate must be disabled, when using avro or debezium-avro protocol`)
+			`force-replicate must be disabled, when using avro, debezium-avro, json-schema or protobuf protocol`)
 	}
 
 	if sinkConfig != nil {
@@ -296,7 +297,7 @@ func (c *Config) Apply(sinkURI *url.URL, sinkConfig *config.SinkConfig) error {
 		if s != "" {
 			encodingFormat := EncodingFormatType(s)
 			switch encodingFormat {
-			case EncodingFormatJSON, EncodingFormatAvro:
+			case EncodingFormatJSON, EncodingFormatAvro, EncodingFormatProtobuf:
 				c.EncodingFormat = encodingFormat
 			default:
 				return errors.ErrCodecInvalidConfig.GenWithStack(
@@ -367,9 +368,11 @@ func (c *Config) WithChangefeedID(id common.ChangeFeedID) *Config {
 func (c *Config) Validate() error {
 	if c.EnableTiDBExtension &&
 		(c.Protocol != config.ProtocolCanalJSON && c.Protocol != config.ProtocolAvro &&
-			c.Protocol != config.ProtocolDebezium && c.Protocol != config.ProtocolDebeziumAvro) {
+			c.Protocol != config.ProtocolDebezium && c.Protocol != config.ProtocolDebeziumAvro &&
+			c.Protocol != config.ProtocolMaxwell && c.Protocol != config.ProtocolJSONSchema &&
+			c.Protocol != config.ProtocolProtobuf) {
 		log.Warn("ignore invalid config, enable-tidb-extension"+
-			"only supports canal-json/avro/debezium/debezium-avro protocol",
+			"only supports canal-json/avro/debezium/debezium-avro/maxwell/json-schema/protobuf protocol",
 			zap.Bool("enableTidbExtension", c.EnableTiDBExtension),
 			zap.String("protocol", c.Protocol.String()))
 	}
@@ -381,12 +384,18 @@ func (c *Config) Validate() error {
 		)
 	}
 
-	if c.Protocol == config.ProtocolAvro || c.Protocol == config.ProtocolDebeziumAvro {
+	if c.Protocol.IsSchemaRegistryBased() {
+		protocol := "Avro"
+		switch c.Protocol {
+		case config.ProtocolDebeziumAvro:
+			protocol = "Debezium Avro"
+		case config.ProtocolJSONSchema:
+			protocol = "JSON Schema"
+		case config.ProtocolProtobuf:
+			protocol = "Protobuf"
+		default:
+		}
 		if c.AvroConfluentSchemaRegistry != "" && c.AvroGlueSchemaRegistry != nil {
-			protocol := "Avro"
-			if c.Protocol == config.ProtocolDebeziumAvro {
-				protocol = "Debezium Avro"
-			}
 			return errors.ErrCodecInvalidConfig.GenWithStack(
 				`%s protocol requires only one of "%s" or "%s" to specify the schema registry`,
 				protocol,
@@ -396,10 +405,6 @@ func (c *Config) Validate() error {
 		}
 
 		if c.AvroConfluentSchemaRegistry == "" && c.AvroGlueSchemaRegistry == nil {
-			protocol := "Avro"
-			if c.Protocol == config.ProtocolDebeziumAvro {
-				protocol = "Debezium Avro"
-			}
 			return errors.ErrCodecInvalidConfig.GenWithStack(
 				`%s protocol requires parameter "%s" or "%s" to specify the schema registry`,
 				protocol,
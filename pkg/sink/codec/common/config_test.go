@@ -158,3 +158,23 @@ func TestDebeziumAvroWatermarkConfig(t *testing.T) {
 	require.True(t, cfg.AvroEnableWatermark)
 	require.Equal(t, "http://127.0.0.1:8081", cfg.AvroConfluentSchemaRegistry)
 }
+
+func TestSchemaRegistryBasedProtocolConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		protocol config.Protocol
+		name     string
+	}{
+		{protocol: config.ProtocolJSONSchema, name: "JSON Schema"},
+		{protocol: config.ProtocolProtobuf, name: "Protobuf"},
+	} {
+		cfg := NewConfig(tc.protocol)
+		cfg.AvroConfluentSchemaRegistry = "http://127.0.0.1:8081"
+		require.NoError(t, cfg.Validate())
+
+		cfg = NewConfig(tc.protocol)
+		require.ErrorContains(t, cfg.Validate(),
+			tc.name+` protocol requires parameter "schema-registry" or "glue-schema-registry"`)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"bytes"
 	"context"
 
+	commonType "github.com/pingcap/ticdc/pkg/common"
 	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
 )
 
@@ -42,6 +43,13 @@ type TxnEventEncoder interface {
 	Build() []*Message
 }
 
+// FileEncoder is an abstraction for the encoders of file based formats, which
+// cannot be produced by concatenating the messages built by TxnEventEncoder.
+type FileEncoder interface {
+	// EncodeFile encodes the concatenated messages of one data file into the file content.
+	EncodeFile(tableInfo *commonType.TableInfo, data []byte) ([]byte, error)
+}
+
 // IsColumnValueEqual checks whether the preValue and updatedValue are equal.
 func IsColumnValueEqual(preValue, updatedValue interface{}) bool {
 	if preValue == nil || updatedValue == nil {
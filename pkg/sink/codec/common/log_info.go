@@ -52,6 +52,17 @@ func AttachMessageLogInfo(messages []*Message, events []*commonEvent.RowEvent) e
 	return mismatchErr
 }
 
+// AttachMessageRowEvents binds the row events onto the sink messages which
+// they are encoded in, the rows count of the messages must match the events.
+func AttachMessageRowEvents(messages []*Message, events []*commonEvent.RowEvent) {
+	eventIdx := 0
+	for _, message := range messages {
+		end := min(eventIdx+message.GetRowsCount(), len(events))
+		message.RowEvents = events[eventIdx:end]
+		eventIdx = end
+	}
+}
+
 func annotateMismatchError(existing error, format string, args ...interface{}) error {
 	if existing == nil {
 		return perrors.Annotatef(errors.New("message rows count mismatches row events"), format, args...)
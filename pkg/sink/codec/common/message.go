@@ -16,6 +16,8 @@ package common
 import (
 	"encoding/binary"
 	"encoding/json"
+
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
 )
 
 // MaxRecordOverhead is used to calculate message size by sarama kafka client.
@@ -50,6 +52,9 @@ type Message struct {
 	PartitionKey *string
 	// LogInfo carries diagnostic information of the message.
 	LogInfo *MessageLogInfo
+	// RowEvents are the row events encoded in the message, they are only
+	// attached if the rows rejected by the downstream are dead lettered.
+	RowEvents []*commonEvent.RowEvent
 }
 
 // MessageLogInfo captures diagnostic context of a sink message.
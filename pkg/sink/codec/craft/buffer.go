@@ -0,0 +1,151 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package craft
+
+// newBufferSize returns the next size of the buffer to grow.
+func newBufferSize(oldSize int) int {
+	var newSize int
+	if oldSize > 128 {
+		newSize = oldSize + 128
+	} else {
+		if oldSize > 0 {
+			newSize = oldSize * 2
+		} else {
+			newSize = 8
+		}
+	}
+	return newSize
+}
+
+// sliceAllocator allocates small slices from a pre-allocated buffer,
+// to reduce the number of allocations when encoding and decoding messages.
+type sliceAllocator[T any] struct {
+	buffer []T
+	offset int
+}
+
+func newGenericSliceAllocator[T any](batchSize int) *sliceAllocator[T] {
+	return &sliceAllocator[T]{buffer: make([]T, batchSize)}
+}
+
+func (b *sliceAllocator[T]) alloc(size int) []T {
+	if len(b.buffer)-b.offset < size {
+		if size > len(b.buffer)/4 {
+			// large allocation
+			return make([]T, size)
+		}
+		b.buffer = make([]T, len(b.buffer))
+		b.offset = 0
+	}
+	result := b.buffer[b.offset : b.offset+size]
+	b.offset += size
+	return result
+}
+
+func (b *sliceAllocator[T]) realloc(old []T, newSize int) []T {
+	n := b.alloc(newSize)
+	copy(n, old)
+	return n
+}
+
+func (b *sliceAllocator[T]) one(x T) []T {
+	r := b.alloc(1)
+	r[0] = x
+	return r
+}
+
+// SliceAllocator allocates slices of different types used by the craft codec.
+// It's not thread-safe, each encoder and decoder should hold its own allocator.
+type SliceAllocator struct {
+	intAllocator             *sliceAllocator[int]
+	int64Allocator           *sliceAllocator[int64]
+	uint64Allocator          *sliceAllocator[uint64]
+	stringAllocator          *sliceAllocator[string]
+	nullableStringAllocator  *sliceAllocator[*string]
+	byteAllocator            *sliceAllocator[byte]
+	bytesAllocator           *sliceAllocator[[]byte]
+	columnGroupAllocator     *sliceAllocator[*columnGroup]
+	rowChangedEventAllocator *sliceAllocator[rowChangedEvent]
+}
+
+// NewSliceAllocator creates a new slice allocator with given batch allocation size.
+func NewSliceAllocator(batchSize int) *SliceAllocator {
+	return &SliceAllocator{
+		intAllocator:             newGenericSliceAllocator[int](batchSize),
+		int64Allocator:           newGenericSliceAllocator[int64](batchSize),
+		uint64Allocator:          newGenericSliceAllocator[uint64](batchSize),
+		stringAllocator:          newGenericSliceAllocator[string](batchSize),
+		nullableStringAllocator:  newGenericSliceAllocator[*string](batchSize),
+		byteAllocator:            newGenericSliceAllocator[byte](batchSize),
+		bytesAllocator:           newGenericSliceAllocator[[]byte](batchSize),
+		columnGroupAllocator:     newGenericSliceAllocator[*columnGroup](batchSize),
+		rowChangedEventAllocator: newGenericSliceAllocator[rowChangedEvent](batchSize),
+	}
+}
+
+func (b *SliceAllocator) intSlice(size int) []int {
+	return b.intAllocator.alloc(size)
+}
+
+func (b *SliceAllocator) int64Slice(size int) []int64 {
+	return b.int64Allocator.alloc(size)
+}
+
+func (b *SliceAllocator) resizeInt64Slice(old []int64, newSize int) []int64 {
+	return b.int64Allocator.realloc(old, newSize)
+}
+
+func (b *SliceAllocator) uint64Slice(size int) []uint64 {
+	return b.uint64Allocator.alloc(size)
+}
+
+func (b *SliceAllocator) oneUint64Slice(x uint64) []uint64 {
+	return b.uint64Allocator.one(x)
+}
+
+func (b *SliceAllocator) resizeUint64Slice(old []uint64, newSize int) []uint64 {
+	return b.uint64Allocator.realloc(old, newSize)
+}
+
+func (b *SliceAllocator) stringSlice(size int) []string {
+	return b.stringAllocator.alloc(size)
+}
+
+func (b *SliceAllocator) nullableStringSlice(size int) []*string {
+	return b.nullableStringAllocator.alloc(size)
+}
+
+func (b *SliceAllocator) oneNullableStringSlice(x *string) []*string {
+	return b.nullableStringAllocator.one(x)
+}
+
+func (b *SliceAllocator) resizeNullableStringSlice(old []*string, newSize int) []*string {
+	return b.nullableStringAllocator.realloc(old, newSize)
+}
+
+func (b *SliceAllocator) byteSlice(size int) []byte {
+	return b.byteAllocator.alloc(size)
+}
+
+func (b *SliceAllocator) bytesSlice(size int) [][]byte {
+	return b.bytesAllocator.alloc(size)
+}
+
+func (b *SliceAllocator) columnGroupSlice(size int) []*columnGroup {
+	return b.columnGroupAllocator.alloc(size)
+}
+
+func (b *SliceAllocator) resizeRowChangedEventSlice(old []rowChangedEvent, newSize int) []rowChangedEvent {
+	return b.rowChangedEventAllocator.realloc(old, newSize)
+}
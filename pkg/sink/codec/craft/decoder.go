@@ -0,0 +1,293 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package craft
+
+import (
+	"github.com/pingcap/log"
+	commonType "github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	ptypes "github.com/pingcap/tidb/pkg/parser/types"
+	tiTypes "github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"go.uber.org/zap"
+)
+
+var tableIDAllocator = common.NewTableIDAllocator()
+
+// batchDecoder decodes the byte of a batch into the original messages.
+type batchDecoder struct {
+	headers *Headers
+	decoder *MessageDecoder
+	index   int
+
+	allocator *SliceAllocator
+}
+
+// NewDecoder creates a new craft decoder.
+func NewDecoder() common.Decoder {
+	tableIDAllocator.Clean()
+	return &batchDecoder{
+		allocator: NewSliceAllocator(64),
+	}
+}
+
+// AddKeyValue implements the Decoder interface
+func (b *batchDecoder) AddKeyValue(_, value []byte) {
+	if b.headers != nil && b.index < b.headers.Count() {
+		log.Panic("add key / value to the decoder failed, since it's already set")
+	}
+	// the decoded names and values refer to the underlying bytes,
+	// copy it to avoid being affected by the caller reusing the buffer.
+	value = append([]byte(nil), value...)
+	decoder, err := NewMessageDecoder(value, b.allocator)
+	if err != nil {
+		log.Panic("craft decode message failed", zap.Error(err))
+	}
+	headers, err := decoder.Headers()
+	if err != nil {
+		log.Panic("craft decode headers failed", zap.Error(err))
+	}
+	b.decoder = decoder
+	b.headers = headers
+	b.index = 0
+}
+
+// HasNext implements the Decoder interface
+func (b *batchDecoder) HasNext() (common.MessageType, bool) {
+	if b.headers == nil || b.index >= b.headers.Count() {
+		return common.MessageTypeUnknown, false
+	}
+	return b.headers.GetType(b.index), true
+}
+
+// NextResolvedEvent implements the Decoder interface
+func (b *batchDecoder) NextResolvedEvent() uint64 {
+	ty, hasNext := b.HasNext()
+	if !hasNext || ty != common.MessageTypeResolved {
+		log.Panic("message type is not watermark", zap.Any("messageType", ty))
+	}
+	ts := b.headers.GetTs(b.index)
+	b.index++
+	return ts
+}
+
+// NextDDLEvent implements the Decoder interface
+func (b *batchDecoder) NextDDLEvent() *commonEvent.DDLEvent {
+	ty, hasNext := b.HasNext()
+	if !hasNext || ty != common.MessageTypeDDL {
+		log.Panic("message type is not DDL", zap.Any("messageType", ty))
+	}
+	ddlType, query, err := b.decoder.DDLEvent(b.index)
+	if err != nil {
+		log.Panic("craft decode DDL event failed", zap.Error(err))
+	}
+
+	result := new(commonEvent.DDLEvent)
+	result.FinishedTs = b.headers.GetTs(b.index)
+	result.SchemaName = b.headers.GetSchema(b.index)
+	result.TableName = b.headers.GetTable(b.index)
+	result.Query = query
+	result.Type = byte(ddlType)
+	tableIDAllocator.AddBlockTableID(result.SchemaName, result.TableName,
+		tableIDAllocator.Allocate(result.SchemaName, result.TableName))
+	result.BlockedTables = common.GetBlockedTables(tableIDAllocator, result)
+
+	b.index++
+	return result
+}
+
+// NextDMLMessage implements the Decoder interface
+func (b *batchDecoder) NextDMLMessage() *common.DMLMessage {
+	ty, hasNext := b.HasNext()
+	if !hasNext || ty != common.MessageTypeRow {
+		log.Panic("message type is not row changed", zap.Any("messageType", ty))
+	}
+	preColumns, columns, err := b.decoder.RowChangedEvent(b.index)
+	if err != nil {
+		log.Panic("craft decode row changed event failed", zap.Error(err))
+	}
+
+	var rowType commonType.RowType
+	switch {
+	case preColumns != nil && columns != nil:
+		rowType = commonType.RowTypeUpdate
+	case columns != nil:
+		rowType = commonType.RowTypeInsert
+	case preColumns != nil:
+		rowType = commonType.RowTypeDelete
+	default:
+		log.Panic("craft row changed event has no column group", zap.Int("index", b.index))
+	}
+
+	var (
+		schema   = b.headers.GetSchema(b.index)
+		table    = b.headers.GetTable(b.index)
+		commitTs = b.headers.GetTs(b.index)
+	)
+	b.index++
+
+	tableID := tableIDAllocator.Allocate(schema, table)
+	tableIDAllocator.AddBlockTableID(schema, table, tableID)
+	return common.NewDMLMessage(tableID, schema, table, commitTs, rowType, func() *commonEvent.DMLEvent {
+		return assembleDMLEvent(tableID, schema, table, commitTs, rowType, preColumns, columns)
+	})
+}
+
+func assembleDMLEvent(
+	tableID int64, schema, table string, commitTs uint64,
+	rowType commonType.RowType, preColumns, columns *columnGroup,
+) *commonEvent.DMLEvent {
+	group := columns
+	if group == nil {
+		group = preColumns
+	}
+	tableInfo := newTableInfo(tableID, schema, table, group)
+
+	result := new(commonEvent.DMLEvent)
+	result.TableInfo = tableInfo
+	result.PhysicalTableID = tableID
+	result.StartTs = commitTs
+	result.CommitTs = commitTs
+	result.Rows = chunk.NewChunkFromPoolWithCapacity(tableInfo.GetFieldSlice(), chunk.InitialCapacity)
+	result.AddPostFlushFunc(func() {
+		result.Rows.Destroy(chunk.InitialCapacity, tableInfo.GetFieldSlice())
+	})
+	result.Length++
+
+	tiColumns := tableInfo.GetColumns()
+	switch rowType {
+	case commonType.RowTypeInsert:
+		common.AppendRow2Chunk(decodeColumnGroupValues(columns, tiColumns), tiColumns, result.Rows)
+		result.RowTypes = append(result.RowTypes, commonType.RowTypeInsert)
+	case commonType.RowTypeDelete:
+		common.AppendRow2Chunk(decodeColumnGroupValues(preColumns, tiColumns), tiColumns, result.Rows)
+		result.RowTypes = append(result.RowTypes, commonType.RowTypeDelete)
+	case commonType.RowTypeUpdate:
+		common.AppendRow2Chunk(decodeColumnGroupValues(preColumns, tiColumns), tiColumns, result.Rows)
+		common.AppendRow2Chunk(decodeColumnGroupValues(columns, tiColumns), tiColumns, result.Rows)
+		result.RowTypes = append(result.RowTypes, commonType.RowTypeUpdate, commonType.RowTypeUpdate)
+	default:
+		log.Panic("unknown row type", zap.Any("rowType", rowType))
+	}
+	return result
+}
+
+func decodeColumnGroupValues(group *columnGroup, columns []*timodel.ColumnInfo) map[string]any {
+	offsets := make(map[string]int, len(group.names))
+	for idx, name := range group.names {
+		offsets[name] = idx
+	}
+	result := make(map[string]any, len(group.names))
+	for _, col := range columns {
+		idx, ok := offsets[col.Name.O]
+		if !ok {
+			continue
+		}
+		value, err := decodeColumnValue(group.values[idx], col)
+		if err != nil {
+			log.Panic("craft decode column value failed",
+				zap.String("column", col.Name.O), zap.Any("type", col.GetType()), zap.Error(err))
+		}
+		result[col.Name.O] = value
+	}
+	return result
+}
+
+func newTableInfo(tableID int64, schema, table string, group *columnGroup) *commonType.TableInfo {
+	columns := make([]*timodel.ColumnInfo, 0, len(group.names))
+	for idx, name := range group.names {
+		columns = append(columns, newTiColumn(int64(idx), name, byte(group.types[idx]), commonType.ColumnFlagType(group.flags[idx])))
+	}
+
+	tidbTableInfo := new(timodel.TableInfo)
+	tidbTableInfo.ID = tableID
+	tidbTableInfo.Name = ast.NewCIStr(table)
+	tidbTableInfo.Columns = columns
+	tidbTableInfo.Indices = newTiIndices(columns)
+	tidbTableInfo.PKIsHandle = len(tidbTableInfo.Indices) != 0
+	return commonType.NewTableInfo4Decoder(schema, tidbTableInfo)
+}
+
+func newTiColumn(id int64, name string, tp byte, flag commonType.ColumnFlagType) *timodel.ColumnInfo {
+	col := new(timodel.ColumnInfo)
+	col.ID = id
+	col.Name = ast.NewCIStr(name)
+	col.FieldType = *ptypes.NewFieldType(tp)
+	if flag.IsPrimaryKey() || flag.IsHandleKey() {
+		col.AddFlag(mysql.PriKeyFlag | mysql.NotNullFlag)
+	}
+	if flag.IsUniqueKey() {
+		col.AddFlag(mysql.UniqueKeyFlag)
+	}
+	if flag.IsMultipleKey() {
+		col.AddFlag(mysql.MultipleKeyFlag)
+	}
+	if !flag.IsNullable() {
+		col.AddFlag(mysql.NotNullFlag)
+	}
+	if flag.IsUnsigned() {
+		col.AddFlag(mysql.UnsignedFlag)
+	}
+	if flag.IsGeneratedColumn() {
+		col.AddFlag(mysql.GeneratedColumnFlag)
+		col.GeneratedExprString = "holder" // just to make it not empty
+		col.GeneratedStored = true
+	}
+	switch tp {
+	case mysql.TypeVarchar, mysql.TypeVarString, mysql.TypeString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if flag.IsBinary() {
+			col.AddFlag(mysql.BinaryFlag)
+			col.SetCharset("binary")
+			col.SetCollate("binary")
+		} else {
+			col.SetCharset("utf8mb4")
+			col.SetCollate("utf8mb4_bin")
+		}
+	case mysql.TypeEnum, mysql.TypeSet:
+		col.SetCharset("utf8mb4")
+		col.SetCollate("utf8mb4_bin")
+	case mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration:
+		// craft does not carry the fsp, use the max one to keep the fractional part.
+		col.SetDecimal(tiTypes.MaxFsp)
+	default:
+	}
+	return col
+}
+
+func newTiIndices(columns []*timodel.ColumnInfo) []*timodel.IndexInfo {
+	indexColumns := make([]*timodel.IndexColumn, 0, 1)
+	for idx, col := range columns {
+		if mysql.HasPriKeyFlag(col.GetFlag()) {
+			indexColumns = append(indexColumns, &timodel.IndexColumn{
+				Name:   col.Name,
+				Offset: idx,
+			})
+		}
+	}
+	if len(indexColumns) == 0 {
+		return nil
+	}
+	return []*timodel.IndexInfo{{
+		ID:      1,
+		Name:    ast.NewCIStr("primary"),
+		Columns: indexColumns,
+		Primary: true,
+		Unique:  true,
+	}}
+}
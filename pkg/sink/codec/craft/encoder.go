@@ -0,0 +1,106 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package craft
+
+import (
+	"context"
+
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+)
+
+// BatchEncoder encodes the events into the byte of a batch into craft binary format.
+// One message can contain at most MaxBatchSize row changed events,
+// the message is flushed once its estimated size exceeds MaxMessageBytes.
+type BatchEncoder struct {
+	rowChangedBuffer *RowChangedEventBuffer
+	messageBuf       []*common.Message
+	callbackBuf      []func()
+
+	config *common.Config
+
+	allocator *SliceAllocator
+}
+
+// NewBatchEncoder creates a new BatchEncoder.
+func NewBatchEncoder(config *common.Config) common.EventEncoder {
+	// 64 is a magic number that come up with these assumptions and manual benchmark.
+	// 1. Most table will not have more than 64 columns
+	// 2. It only worth allocating slices in batch for slices that's small enough
+	return NewBatchEncoderWithAllocator(NewSliceAllocator(64), config)
+}
+
+// NewBatchEncoderWithAllocator creates a new BatchEncoder with given allocator.
+func NewBatchEncoderWithAllocator(allocator *SliceAllocator, config *common.Config) common.EventEncoder {
+	return &BatchEncoder{
+		allocator:        allocator,
+		messageBuf:       make([]*common.Message, 0, 2),
+		callbackBuf:      make([]func(), 0),
+		rowChangedBuffer: NewRowChangedEventBuffer(allocator),
+		config:           config,
+	}
+}
+
+// EncodeCheckpointEvent implements the EventEncoder interface
+func (e *BatchEncoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	return common.NewMsg(nil, NewResolvedEventEncoder(e.allocator, ts).Encode()), nil
+}
+
+// AppendRowChangedEvent implements the EventEncoder interface
+func (e *BatchEncoder) AppendRowChangedEvent(
+	_ context.Context, _ string, event *commonEvent.RowEvent,
+) error {
+	rows, size := e.rowChangedBuffer.AppendRowChangedEvent(event, e.config.DeleteOnlyHandleKeyColumns)
+	e.callbackBuf = append(e.callbackBuf, event.Callback)
+	if size > e.config.MaxMessageBytes || rows >= e.config.MaxBatchSize {
+		e.flush()
+	}
+	return nil
+}
+
+// EncodeDDLEvent implements the EventEncoder interface
+func (e *BatchEncoder) EncodeDDLEvent(event *commonEvent.DDLEvent) (*common.Message, error) {
+	return common.NewMsg(nil, NewDDLEventEncoder(e.allocator, event).Encode()), nil
+}
+
+// Build implements the EventEncoder interface
+func (e *BatchEncoder) Build() []*common.Message {
+	if e.rowChangedBuffer.RowsCount() > 0 {
+		// flush buffered data to message buffer
+		e.flush()
+	}
+	if len(e.messageBuf) == 0 {
+		return nil
+	}
+	result := e.messageBuf
+	e.messageBuf = make([]*common.Message, 0, 2)
+	return result
+}
+
+func (e *BatchEncoder) flush() {
+	rowsCount := e.rowChangedBuffer.RowsCount()
+	message := common.NewMsg(nil, e.rowChangedBuffer.Encode())
+	message.SetRowsCount(rowsCount)
+
+	callbacks := e.callbackBuf
+	message.Callback = func() {
+		for _, cb := range callbacks {
+			if cb != nil {
+				cb()
+			}
+		}
+	}
+	e.callbackBuf = make([]func(), 0)
+	e.messageBuf = append(e.messageBuf, message)
+}
@@ -0,0 +1,519 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package craft
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+// Primitive type decoders
+func decodeUint8(bits []byte) ([]byte, byte, error) {
+	if len(bits) < 1 {
+		return bits, 0, errors.ErrCraftCodecInvalidData.GenWithStack("buffer underflow")
+	}
+	return bits[1:], bits[0], nil
+}
+
+func decodeVarint(bits []byte) ([]byte, int64, error) {
+	x, rd := binary.Varint(bits)
+	if rd <= 0 {
+		return bits, 0, errors.ErrCraftCodecInvalidData.GenWithStack("invalid varint data")
+	}
+	return bits[rd:], x, nil
+}
+
+func decodeUvarint(bits []byte) ([]byte, uint64, error) {
+	x, rd := binary.Uvarint(bits)
+	if rd <= 0 {
+		return bits, 0, errors.ErrCraftCodecInvalidData.GenWithStack("invalid uvarint data")
+	}
+	return bits[rd:], x, nil
+}
+
+func decodeUvarintReversed(bits []byte) (int, uint64, error) {
+	// Decode uint64 in varint format that is similar to protobuf but with bytes order reversed
+	// Reference: https://developers.google.com/protocol-buffers/docs/encoding#varints
+	l := len(bits) - 1
+	var x uint64
+	var s uint
+	i := 0
+	for l >= 0 {
+		b := bits[l]
+		if b < 0x80 {
+			if i >= binary.MaxVarintLen64 || i == binary.MaxVarintLen64-1 && b > 1 {
+				return 0, 0, errors.ErrCraftCodecInvalidData.GenWithStack("invalid reversed uvarint data")
+			}
+			return i + 1, x | uint64(b)<<s, nil
+		}
+		x |= uint64(b&0x7f) << s
+		s += 7
+		i++
+		l--
+	}
+	return i, x, nil
+}
+
+func decodeUvarintReversedLength(bits []byte) (int, int, error) {
+	nb, x, err := decodeUvarintReversed(bits)
+	if err != nil {
+		return 0, 0, errors.Trace(err)
+	}
+	if x > math.MaxInt32 {
+		return 0, 0, errors.ErrCraftCodecInvalidData.GenWithStack("length is greater than max int32")
+	}
+	return nb, int(x), nil
+}
+
+func decodeUvarint32(bits []byte) ([]byte, int32, error) {
+	newBits, x, err := decodeUvarint(bits)
+	if err != nil {
+		return bits, 0, errors.Trace(err)
+	}
+	if x > math.MaxInt32 {
+		return bits, 0, errors.ErrCraftCodecInvalidData.GenWithStack("length is greater than max int32")
+	}
+	return newBits, int32(x), nil
+}
+
+func decodeVarint32(bits []byte) ([]byte, int32, error) {
+	newBits, x, err := decodeVarint(bits)
+	if err != nil {
+		return bits, 0, errors.Trace(err)
+	}
+	if x > math.MaxInt32 || x < math.MinInt32 {
+		return bits, 0, errors.ErrCraftCodecInvalidData.GenWithStack("length is out of int32 range")
+	}
+	return newBits, int32(x), nil
+}
+
+func decodeUvarintLength(bits []byte) ([]byte, int, error) {
+	bits, x, err := decodeUvarint32(bits)
+	return bits, int(x), err
+}
+
+func decodeVarintLength(bits []byte) ([]byte, int, error) {
+	bits, x, err := decodeVarint32(bits)
+	return bits, int(x), err
+}
+
+func decodeFloat64(bits []byte) ([]byte, float64, error) {
+	if len(bits) < 8 {
+		return bits, 0, errors.ErrCraftCodecInvalidData.GenWithStack("buffer underflow")
+	}
+	x := binary.LittleEndian.Uint64(bits)
+	return bits[8:], math.Float64frombits(x), nil
+}
+
+func decodeBytes(bits []byte) ([]byte, []byte, error) {
+	newBits, l, err := decodeUvarintLength(bits)
+	if err != nil {
+		return bits, nil, errors.Trace(err)
+	}
+	if len(newBits) < l {
+		return bits, nil, errors.ErrCraftCodecInvalidData.GenWithStack("buffer underflow")
+	}
+	return newBits[l:], newBits[:l], nil
+}
+
+func decodeString(bits []byte) ([]byte, string, error) {
+	bits, bytes, err := decodeBytes(bits)
+	if err != nil {
+		return bits, "", errors.Trace(err)
+	}
+	return bits, common.UnsafeBytesToString(bytes), nil
+}
+
+// Chunk decoders
+func decodeStringChunk(bits []byte, size int, allocator *SliceAllocator) ([]byte, []string, error) {
+	larray := allocator.intSlice(size)
+	newBits := bits
+	var bl int
+	var err error
+	for i := 0; i < size; i++ {
+		newBits, bl, err = decodeUvarintLength(newBits)
+		if err != nil {
+			return bits, nil, errors.Trace(err)
+		}
+		larray[i] = bl
+	}
+
+	data := allocator.stringSlice(size)
+	for i := 0; i < size; i++ {
+		if len(newBits) < larray[i] {
+			return bits, nil, errors.ErrCraftCodecInvalidData.GenWithStack("buffer underflow")
+		}
+		data[i] = common.UnsafeBytesToString(newBits[:larray[i]])
+		newBits = newBits[larray[i]:]
+	}
+	return newBits, data, nil
+}
+
+func decodeNullableStringChunk(bits []byte, size int, allocator *SliceAllocator) ([]byte, []*string, error) {
+	larray := allocator.intSlice(size)
+	newBits := bits
+	var bl int
+	var err error
+	for i := 0; i < size; i++ {
+		newBits, bl, err = decodeVarintLength(newBits)
+		if err != nil {
+			return bits, nil, errors.Trace(err)
+		}
+		larray[i] = bl
+	}
+
+	data := allocator.nullableStringSlice(size)
+	for i := 0; i < size; i++ {
+		if larray[i] == -1 {
+			data[i] = nil
+			continue
+		}
+		if larray[i] < 0 || len(newBits) < larray[i] {
+			return bits, nil, errors.ErrCraftCodecInvalidData.GenWithStack("buffer underflow")
+		}
+		s := common.UnsafeBytesToString(newBits[:larray[i]])
+		data[i] = &s
+		newBits = newBits[larray[i]:]
+	}
+	return newBits, data, nil
+}
+
+func decodeNullableBytesChunk(bits []byte, size int, allocator *SliceAllocator) ([]byte, [][]byte, error) {
+	larray := allocator.intSlice(size)
+	newBits := bits
+	var bl int
+	var err error
+	for i := 0; i < size; i++ {
+		newBits, bl, err = decodeVarintLength(newBits)
+		if err != nil {
+			return bits, nil, errors.Trace(err)
+		}
+		larray[i] = bl
+	}
+
+	data := allocator.bytesSlice(size)
+	for i := 0; i < size; i++ {
+		if larray[i] == -1 {
+			data[i] = nil
+			continue
+		}
+		if larray[i] < 0 || len(newBits) < larray[i] {
+			return bits, nil, errors.ErrCraftCodecInvalidData.GenWithStack("buffer underflow")
+		}
+		// keep the empty value distinguishable from the null value.
+		data[i] = newBits[:larray[i]:larray[i]]
+		newBits = newBits[larray[i]:]
+	}
+	return newBits, data, nil
+}
+
+func decodeVarintChunk(bits []byte, size int, allocator *SliceAllocator) ([]byte, []int64, error) {
+	array := allocator.int64Slice(size)
+	newBits := bits
+	var i64 int64
+	var err error
+	for i := 0; i < size; i++ {
+		newBits, i64, err = decodeVarint(newBits)
+		if err != nil {
+			return bits, nil, errors.Trace(err)
+		}
+		array[i] = i64
+	}
+	return newBits, array, nil
+}
+
+func decodeUvarintChunk(bits []byte, size int, allocator *SliceAllocator) ([]byte, []uint64, error) {
+	array := allocator.uint64Slice(size)
+	newBits := bits
+	var u64 uint64
+	var err error
+	for i := 0; i < size; i++ {
+		newBits, u64, err = decodeUvarint(newBits)
+		if err != nil {
+			return bits, nil, errors.Trace(err)
+		}
+		array[i] = u64
+	}
+	return newBits, array, nil
+}
+
+func decodeDeltaVarintChunk(bits []byte, size int, allocator *SliceAllocator) ([]byte, []int64, error) {
+	array := allocator.int64Slice(size)
+	if size == 0 {
+		return bits, array, nil
+	}
+	newBits := bits
+	var err error
+	newBits, array[0], err = decodeVarint(newBits)
+	if err != nil {
+		return bits, nil, errors.Trace(err)
+	}
+	for i := 1; i < size; i++ {
+		newBits, array[i], err = decodeVarint(newBits)
+		if err != nil {
+			return bits, nil, errors.Trace(err)
+		}
+		array[i] = array[i-1] + array[i]
+	}
+	return newBits, array, nil
+}
+
+func decodeDeltaUvarintChunk(bits []byte, size int, allocator *SliceAllocator) ([]byte, []uint64, error) {
+	array := allocator.uint64Slice(size)
+	if size == 0 {
+		return bits, array, nil
+	}
+	newBits := bits
+	var err error
+	newBits, array[0], err = decodeUvarint(newBits)
+	if err != nil {
+		return bits, nil, errors.Trace(err)
+	}
+	for i := 1; i < size; i++ {
+		newBits, array[i], err = decodeUvarint(newBits)
+		if err != nil {
+			return bits, nil, errors.Trace(err)
+		}
+		array[i] = array[i-1] + array[i]
+	}
+	return newBits, array, nil
+}
+
+// size tables are always at end of serialized data, there is no unread bytes to return
+func decodeSizeTables(bits []byte, allocator *SliceAllocator) (int, [][]int64, error) {
+	nb, size, err := decodeUvarintReversedLength(bits)
+	if err != nil {
+		return 0, nil, errors.Trace(err)
+	}
+	sizeOffset := len(bits) - nb
+	tablesOffset := sizeOffset - size
+	if tablesOffset < 0 {
+		return 0, nil, errors.ErrCraftCodecInvalidData.GenWithStack("buffer underflow")
+	}
+	tables := bits[tablesOffset:sizeOffset]
+
+	tableSize := size + nb
+	var table []int64
+	result := make([][]int64, 0, 1)
+	for len(tables) > 0 {
+		tables, size, err = decodeUvarintLength(tables)
+		if err != nil {
+			return 0, nil, errors.Trace(err)
+		}
+		tables, table, err = decodeDeltaVarintChunk(tables, size, allocator)
+		if err != nil {
+			return 0, nil, errors.Trace(err)
+		}
+		result = append(result, table)
+	}
+
+	return tableSize, result, nil
+}
+
+// decodeColumnValue decodes the column value into the type accepted by `common.AppendRow2Chunk`.
+func decodeColumnValue(bits []byte, col *timodel.ColumnInfo) (any, error) {
+	if bits == nil {
+		return nil, nil
+	}
+	switch col.GetType() {
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeNewDate, mysql.TypeTimestamp:
+		value, err := types.ParseTime(types.DefaultStmtNoWarningContext, string(bits), col.GetType(), col.GetDecimal())
+		return value, errors.WrapError(errors.ErrCraftCodecInvalidData, err)
+	case mysql.TypeDuration:
+		value, _, err := types.ParseDuration(types.DefaultStmtNoWarningContext, string(bits), col.GetDecimal())
+		return value, errors.WrapError(errors.ErrCraftCodecInvalidData, err)
+	case mysql.TypeJSON:
+		value, err := types.ParseBinaryJSONFromString(string(bits))
+		return value, errors.WrapError(errors.ErrCraftCodecInvalidData, err)
+	case mysql.TypeNewDecimal:
+		value := new(types.MyDecimal)
+		err := value.FromString(bits)
+		return value, errors.WrapError(errors.ErrCraftCodecInvalidData, err)
+	case mysql.TypeEnum:
+		_, value, err := decodeUvarint(bits)
+		return types.Enum{Value: value}, err
+	case mysql.TypeSet:
+		_, value, err := decodeUvarint(bits)
+		return types.Set{Value: value}, err
+	case mysql.TypeBit:
+		_, value, err := decodeUvarint(bits)
+		return types.NewBinaryLiteralFromUint(value, -1), err
+	case mysql.TypeString, mysql.TypeVarString, mysql.TypeVarchar,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		return bits, nil
+	case mysql.TypeFloat:
+		_, value, err := decodeFloat64(bits)
+		return float32(value), err
+	case mysql.TypeDouble:
+		_, value, err := decodeFloat64(bits)
+		return value, err
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeLong, mysql.TypeLonglong, mysql.TypeInt24:
+		if mysql.HasUnsignedFlag(col.GetFlag()) {
+			_, value, err := decodeUvarint(bits)
+			return value, err
+		}
+		_, value, err := decodeVarint(bits)
+		return value, err
+	case mysql.TypeYear:
+		_, value, err := decodeVarint(bits)
+		return value, err
+	case mysql.TypeTiDBVectorFloat32:
+		value, err := types.ParseVectorFloat32(string(bits))
+		return value, errors.WrapError(errors.ErrCraftCodecInvalidData, err)
+	default:
+	}
+	return nil, errors.ErrCraftCodecInvalidData.GenWithStack("unsupported column type %d", col.GetType())
+}
+
+// MessageDecoder decoder
+type MessageDecoder struct {
+	bits            []byte
+	sizeTables      [][]int64
+	metaSizeTable   []int64
+	bodyOffsetTable []int
+	allocator       *SliceAllocator
+	dict            *termDictionary
+}
+
+// NewMessageDecoder create a new message decode with bits and allocator
+func NewMessageDecoder(bits []byte, allocator *SliceAllocator) (*MessageDecoder, error) {
+	bits, version, err := decodeUvarint(bits)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if version < Version1 {
+		return nil, errors.ErrCraftCodecInvalidData.GenWithStack("unexpected craft version")
+	}
+	sizeTablesSize, sizeTables, err := decodeSizeTables(bits, allocator)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(sizeTables) < columnGroupSizeTableStartIndex {
+		return nil, errors.ErrCraftCodecInvalidData.GenWithStack("size tables not found")
+	}
+
+	// truncate tailing size tables
+	bits = bits[:len(bits)-sizeTablesSize]
+
+	// get size table for each body element
+	bodySizeTable := sizeTables[bodySizeTableIndex]
+	// build body offset table from size of each body
+	// offset table has number of bodies plus 1 elements
+	bodyOffsetTable := make([]int, len(bodySizeTable)+1)
+
+	// start offset of last body element
+	start := 0
+	for i, size := range bodySizeTable {
+		bodyOffsetTable[i] = start
+		start += int(size)
+	}
+	// start equals total size of body elements
+	bodyOffsetTable[len(bodySizeTable)] = start
+
+	// get meta data size table which contains size of headers and term dictionary
+	metaSizeTable := sizeTables[metaSizeTableIndex]
+	if len(metaSizeTable) <= maxMetaSizeIndex {
+		return nil, errors.ErrCraftCodecInvalidData.GenWithStack("invalid meta size table")
+	}
+
+	var dict *termDictionary
+	// term dictionary offset starts from header size + body size
+	termDictionaryOffset := int(metaSizeTable[headerSizeIndex]) + start
+	termDictionaryEnd := termDictionaryOffset + int(metaSizeTable[termDictionarySizeIndex])
+	if termDictionaryEnd > len(bits) {
+		return nil, errors.ErrCraftCodecInvalidData.GenWithStack("buffer underflow")
+	}
+	if metaSizeTable[termDictionarySizeIndex] > 0 {
+		_, dict, err = decodeTermDictionary(bits[termDictionaryOffset:termDictionaryEnd], allocator)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	} else {
+		dict = emptyDecodingTermDictionary
+	}
+	return &MessageDecoder{
+		bits:            bits[:termDictionaryOffset],
+		sizeTables:      sizeTables,
+		metaSizeTable:   metaSizeTable,
+		bodyOffsetTable: bodyOffsetTable,
+		allocator:       allocator,
+		dict:            dict,
+	}, nil
+}
+
+// Headers decode headers of message
+func (d *MessageDecoder) Headers() (*Headers, error) {
+	// get number of pairs from size of body size table
+	pairs := len(d.sizeTables[bodySizeTableIndex])
+	headersSize := int(d.metaSizeTable[headerSizeIndex])
+	headers, err := decodeHeaders(d.bits[:headersSize], pairs, d.allocator, d.dict)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// skip headers
+	d.bits = d.bits[headersSize:]
+	return headers, nil
+}
+
+func (d *MessageDecoder) bodyBits(index int) []byte {
+	return d.bits[d.bodyOffsetTable[index]:d.bodyOffsetTable[index+1]]
+}
+
+// DDLEvent decode a DDL event
+func (d *MessageDecoder) DDLEvent(index int) (timodel.ActionType, string, error) {
+	bits, ty, err := decodeUvarint(d.bodyBits(index))
+	if err != nil {
+		return timodel.ActionNone, "", errors.Trace(err)
+	}
+	_, query, err := decodeString(bits)
+	return timodel.ActionType(ty), query, err
+}
+
+// RowChangedEvent decode a row changed event
+func (d *MessageDecoder) RowChangedEvent(index int) (preColumns, columns *columnGroup, err error) {
+	bits := d.bodyBits(index)
+	if columnGroupSizeTableStartIndex+index >= len(d.sizeTables) {
+		return nil, nil, errors.ErrCraftCodecInvalidData.GenWithStack("column group size table not found")
+	}
+	columnGroupSizeTable := d.sizeTables[columnGroupSizeTableStartIndex+index]
+	columnGroupIndex := 0
+	for len(bits) > 0 {
+		if columnGroupIndex >= len(columnGroupSizeTable) {
+			return nil, nil, errors.ErrCraftCodecInvalidData.GenWithStack("too many column groups")
+		}
+		columnGroupSize := columnGroupSizeTable[columnGroupIndex]
+		if int(columnGroupSize) > len(bits) {
+			return nil, nil, errors.ErrCraftCodecInvalidData.GenWithStack("buffer underflow")
+		}
+		group, err := decodeColumnGroup(bits[:columnGroupSize], d.allocator, d.dict)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		bits = bits[columnGroupSize:]
+		columnGroupIndex++
+		switch group.ty {
+		case columnGroupTypeOld:
+			preColumns = group
+		case columnGroupTypeNew:
+			columns = group
+		}
+	}
+	return preColumns, columns, nil
+}
@@ -291,6 +291,9 @@ func (c *dbzCodec) buildDebeziumAvroValueMessage(
 	switch {
 	case e.IsInsert():
 		payload["op"] = "c"
+		if e.IsSnapshot {
+			payload["op"] = "r"
+		}
 		payload["before"] = nil
 		after, err := c.buildDebeziumAvroRowPayload(
 			e.GetRows(), e.TableInfo, e.ColumnSelector)
@@ -418,12 +421,16 @@ func (c *dbzCodec) buildDebeziumAvroSourcePayload(
 	tableName string,
 ) map[string]any {
 	commitTime := oracle.GetTimeFromTS(e.CommitTs)
+	var snapshot any
+	if e.IsSnapshot {
+		snapshot = "true"
+	}
 	return map[string]any{
 		"version":    "2.4.0.Final",
 		"connector":  "TiCDC",
 		"name":       c.clusterID,
 		"ts_ms":      commitTime.UnixMilli(),
-		"snapshot":   nil,
+		"snapshot":   snapshot,
 		"db":         schemaName,
 		"table":      tableName,
 		"server_id":  int64(0),
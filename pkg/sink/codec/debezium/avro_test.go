@@ -112,6 +112,49 @@ func TestDebeziumConfluentAvroEncodeRowEvent(t *testing.T) {
 	require.NotContains(t, valueSchema, `"field":"transaction"`)
 }
 
+func TestDebeziumConfluentAvroEncodeSnapshotRowEvent(t *testing.T) {
+	ctx := context.Background()
+	_, err := avro.SetupEncoderAndSchemaRegistry4Testing(
+		ctx,
+		common.NewConfig(config.ProtocolAvro),
+	)
+	require.NoError(t, err)
+	defer avro.TeardownEncoderAndSchemaRegistry4Testing()
+
+	helper := NewSQLTestHelper(t, "foo", `create table foo(id int primary key, name varchar(16))`)
+	defer helper.Close()
+
+	dmls := helper.helper.DML2Event("test", "foo", "insert into foo values (1, 'alice')")
+	row, ok := dmls.GetNextRow()
+	require.True(t, ok)
+
+	cfg := common.NewConfig(config.ProtocolDebeziumAvro)
+	cfg.AvroConfluentSchemaRegistry = "http://127.0.0.1:8081"
+	cfg.DebeziumDisableSchema = true
+	cfg.TimeZone = time.UTC
+
+	encoder, err := NewAvroBatchEncoder(ctx, cfg, "dbserver1")
+	require.NoError(t, err)
+	require.NoError(t, encoder.AppendRowChangedEvent(ctx, "dbserver1.test.foo", &commonEvent.RowEvent{
+		TableInfo:      helper.tableInfo,
+		CommitTs:       1,
+		Event:          row,
+		ColumnSelector: columnselector.NewDefaultColumnSelector(),
+		Callback:       func() {},
+		IsSnapshot:     true,
+	}))
+
+	messages := encoder.Build()
+	require.Len(t, messages, 1)
+
+	value := decodeConfluentAvroForTest(t, messages[0].Value)
+	require.Equal(t, "r", value["op"])
+	require.Nil(t, value["before"])
+	source, ok := value["source"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "true", unwrapAvroUnionForTest(t, source["snapshot"], "string"))
+}
+
 func TestDebeziumConfluentAvroSanitizesFullNameAndUnionBranch(t *testing.T) {
 	ctx := context.Background()
 	_, err := avro.SetupEncoderAndSchemaRegistry4Testing(
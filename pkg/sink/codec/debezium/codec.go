@@ -1063,7 +1063,9 @@ func (c *dbzCodec) EncodeValue(
 				// https://debezium.io/documentation/reference/stable/connectors/mysql.html#mysql-create-events
 				jWriter.WriteInt64Field("ts_ms", commitTime.UnixMilli())
 				// snapshot field is a string of true,last,false,incremental
-				if c.isDebeziumAvro() {
+				if e.IsSnapshot {
+					jWriter.WriteStringField("snapshot", "true")
+				} else if c.isDebeziumAvro() {
 					jWriter.WriteNullField("snapshot")
 				} else {
 					jWriter.WriteStringField("snapshot", "false")
@@ -1101,7 +1103,11 @@ func (c *dbzCodec) EncodeValue(
 				// d = delete
 				// r = read (applies to only snapshots)
 				// https://debezium.io/documentation/reference/stable/connectors/mysql.html#mysql-create-events
-				jWriter.WriteStringField("op", "c")
+				if e.IsSnapshot {
+					jWriter.WriteStringField("op", "r")
+				} else {
+					jWriter.WriteStringField("op", "c")
+				}
 
 				// before: An optional field that specifies the state of the row before the event occurred.
 				// When the op field is c for create, the before field is null since this change event is for new content.
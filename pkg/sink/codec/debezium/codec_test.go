@@ -15,10 +15,12 @@ package debezium
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/pingcap/ticdc/downstreamadapter/sink/columnselector"
+	commonType "github.com/pingcap/ticdc/pkg/common"
 	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
 	"github.com/pingcap/ticdc/pkg/config"
 	"github.com/pingcap/ticdc/pkg/errors"
@@ -969,6 +971,58 @@ func TestEncodeInsert(t *testing.T) {
 	`, buf.String())
 }
 
+func TestEncodeSnapshotInsert(t *testing.T) {
+	codec := &dbzCodec{
+		config:    common.NewConfig(config.ProtocolDebezium),
+		clusterID: "test_cluster",
+		nowFunc:   func() time.Time { return time.Unix(1701326309, 0) },
+	}
+	codec.config.DebeziumDisableSchema = true
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+
+	job := helper.DDL2Job(`create table test.table1(tiny tinyint primary key)`)
+	dmlEvent := helper.DML2Event("test", "table1", `insert into test.table1 values (1)`)
+	require.NotNil(t, dmlEvent)
+	row, ok := dmlEvent.GetNextRow()
+	require.True(t, ok)
+
+	e := &commonEvent.RowEvent{
+		TableInfo:      helper.GetTableInfo(job),
+		CommitTs:       1,
+		Event:          row,
+		ColumnSelector: columnselector.NewDefaultColumnSelector(),
+		Callback:       func() {},
+		IsSnapshot:     true,
+	}
+
+	buf := bytes.NewBuffer(nil)
+	require.NoError(t, codec.EncodeValue(e, buf))
+
+	var value struct {
+		Payload struct {
+			Op     string         `json:"op"`
+			Before map[string]any `json:"before"`
+			After  map[string]any `json:"after"`
+			Source struct {
+				Snapshot string `json:"snapshot"`
+			} `json:"source"`
+		} `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &value))
+	require.Equal(t, "r", value.Payload.Op)
+	require.Equal(t, "true", value.Payload.Source.Snapshot)
+	require.Nil(t, value.Payload.Before)
+	require.EqualValues(t, 1, value.Payload.After["tiny"])
+
+	// The rows of the snapshot can be decoded as the insert events.
+	valuePayload := map[string]any{"op": value.Payload.Op}
+	require.Equal(t, commonType.RowTypeInsert, rowTypeFromPayload(valuePayload))
+}
+
 func TestEncodeUpdate(t *testing.T) {
 	codec := &dbzCodec{
 		config:    common.NewConfig(config.ProtocolDebezium),
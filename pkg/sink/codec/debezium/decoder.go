@@ -102,7 +102,7 @@ func (d *decoder) HasNext() (common.MessageType, bool) {
 		return common.MessageTypeDDL, true
 	}
 	switch op {
-	case "c", "u", "d":
+	case "c", "r", "u", "d":
 		return common.MessageTypeRow, true
 	case "m":
 		return common.MessageTypeResolved, true
@@ -183,7 +183,7 @@ func rowTypeFromPayload(valuePayload map[string]any) commonType.RowType {
 		log.Panic("DML message op not found")
 	}
 	switch op {
-	case "c":
+	case "c", "r":
 		return commonType.RowTypeInsert
 	case "u":
 		return commonType.RowTypeUpdate
@@ -209,6 +209,7 @@ func (d *decoder) assembleDMLEventFromPayload(
 		TableInfo:       tableInfo,
 		PhysicalTableID: tableInfo.TableName.TableID,
 		Length:          1,
+		IsSnapshot:      valuePayload["op"] == "r",
 	}
 	event.AddPostFlushFunc(func() {
 		event.Rows.Destroy(chunk.InitialCapacity, tableInfo.GetFieldSlice())
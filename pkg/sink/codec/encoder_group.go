@@ -47,6 +47,15 @@ type EncoderGroup interface {
 	Output() <-chan *future
 }
 
+// DeadLetterQueue is the queue which the rows rejected by the downstream,
+// such as the rows exceeding the max message bytes, are written to.
+type DeadLetterQueue interface {
+	// IsRejectedError returns whether the error means the row is rejected.
+	IsRejectedError(err error) bool
+	// WriteRowEvent writes the rejected row with the error.
+	WriteRowEvent(event *commonEvent.RowEvent, cause error) error
+}
+
 type encoderGroup struct {
 	changefeedID commonType.ChangeFeedID
 
@@ -61,6 +70,9 @@ type encoderGroup struct {
 	outputCh chan *future
 
 	bootstrapWorker *bootstrapWorker
+
+	// deadLetterQueue is nil if the dead letter queue is not configured.
+	deadLetterQueue DeadLetterQueue
 }
 
 // NewEncoderGroup creates a new EncoderGroup instance
@@ -69,6 +81,7 @@ func NewEncoderGroup(
 	cfg *config.SinkConfig,
 	encoderConfig *common.Config,
 	claimCheck *claimcheck.ClaimCheck,
+	deadLetterQueue DeadLetterQueue,
 	changefeedID commonType.ChangeFeedID,
 ) (*encoderGroup, error) {
 	concurrency := util.GetOrZero(cfg.EncoderConcurrency)
@@ -116,6 +129,7 @@ func NewEncoderGroup(
 		index:            0,
 		outputCh:         outCh,
 		bootstrapWorker:  bw,
+		deadLetterQueue:  deadLetterQueue,
 	}, nil
 }
 
@@ -156,11 +170,33 @@ func (g *encoderGroup) runEncoder(ctx context.Context, idx int) error {
 		case <-ticker.C:
 			metric.Set(float64(len(inputCh)))
 		case future := <-inputCh:
-			for _, event := range future.events {
+			// accepted is nil until a row is rejected by the downstream.
+			var accepted []*commonEvent.RowEvent
+			for i, event := range future.events {
 				err := g.rowEventEncoders[idx].AppendRowChangedEvent(ctx, future.Key.Topic, event)
-				if err != nil {
+				if err == nil {
+					if accepted != nil {
+						accepted = append(accepted, event)
+					}
+					continue
+				}
+				if g.deadLetterQueue == nil || !g.deadLetterQueue.IsRejectedError(err) {
+					return errors.Trace(err)
+				}
+				// The rejected row is not sent, it's flushed once it's in the dead letter queue.
+				if err = g.deadLetterQueue.WriteRowEvent(event, err); err != nil {
 					return errors.Trace(err)
 				}
+				if event.Callback != nil {
+					event.Callback()
+				}
+				if accepted == nil {
+					accepted = make([]*commonEvent.RowEvent, i, len(future.events))
+					copy(accepted, future.events[:i])
+				}
+			}
+			if accepted != nil {
+				future.events = accepted
 			}
 			future.Messages = g.rowEventEncoders[idx].Build()
 			if err := common.AttachMessageLogInfo(future.Messages, future.events); err != nil {
@@ -168,6 +204,10 @@ func (g *encoderGroup) runEncoder(ctx context.Context, idx int) error {
 					"message rows count mismatches row events, keyspace:%s, changefeed:%s, messageCount:%d, eventCount:%d",
 					g.changefeedID.Keyspace(), g.changefeedID.Name(), len(future.Messages), len(future.events))
 			}
+			// The rows of a message rejected by the brokers are dead lettered by the producer.
+			if g.deadLetterQueue != nil {
+				common.AttachMessageRowEvents(future.Messages, future.events)
+			}
 			// TODO: Is it necessary to clear after use?
 			close(future.done)
 		}
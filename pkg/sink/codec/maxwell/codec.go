@@ -0,0 +1,172 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maxwell
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/pingcap/log"
+	commonType "github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"go.uber.org/zap"
+)
+
+// collectColumnValues returns all selected column values of the row, and the handle key column values.
+func collectColumnValues(
+	row *chunk.Row,
+	tableInfo *commonType.TableInfo,
+	selector commonEvent.Selector,
+	onlyHandleKeyColumns bool,
+) (map[string]any, map[string]any, error) {
+	columns := tableInfo.GetColumns()
+	values := make(map[string]any, len(columns))
+	keys := make(map[string]any, 1)
+	for idx, col := range columns {
+		if col == nil || col.IsVirtualGenerated() || !selector.Select(col) {
+			continue
+		}
+		isHandle := tableInfo.IsHandleKey(col.ID)
+		if onlyHandleKeyColumns && !isHandle {
+			continue
+		}
+		value := formatColumnValue(row, idx, col)
+		values[col.Name.O] = value
+		if isHandle {
+			keys[col.Name.O] = value
+		}
+	}
+	if len(values) == 0 {
+		return nil, nil, errors.ErrMaxwellEncodeFailed.GenWithStack(
+			"not found valid columns for the event, table: %s", tableInfo.TableName.String())
+	}
+	return values, keys, nil
+}
+
+// formatColumnValue converts the column value to the maxwell representation:
+// numbers are encoded as JSON numbers, binary values are base64 encoded,
+// json values are embedded as JSON, and set values are encoded as a string array.
+func formatColumnValue(row *chunk.Row, idx int, col *timodel.ColumnInfo) any {
+	if row.IsNull(idx) {
+		return nil
+	}
+	switch col.GetType() {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong:
+		if mysql.HasUnsignedFlag(col.GetFlag()) {
+			return row.GetUint64(idx)
+		}
+		return row.GetInt64(idx)
+	case mysql.TypeYear:
+		return row.GetInt64(idx)
+	case mysql.TypeFloat:
+		return row.GetFloat32(idx)
+	case mysql.TypeDouble:
+		return row.GetFloat64(idx)
+	case mysql.TypeNewDecimal:
+		return json.Number(row.GetMyDecimal(idx).String())
+	case mysql.TypeVarchar, mysql.TypeVarString, mysql.TypeString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		value := row.GetBytes(idx)
+		if mysql.HasBinaryFlag(col.GetFlag()) {
+			return base64.StdEncoding.EncodeToString(value)
+		}
+		return string(value)
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeNewDate, mysql.TypeTimestamp:
+		return row.GetTime(idx).String()
+	case mysql.TypeDuration:
+		return row.GetDuration(idx, col.GetDecimal()).String()
+	case mysql.TypeEnum:
+		value := row.GetEnum(idx).Value
+		enum, err := types.ParseEnumValue(col.GetElems(), value)
+		if err != nil {
+			log.Panic("parse enum value failed", zap.Any("value", value), zap.Error(err))
+		}
+		return enum.Name
+	case mysql.TypeSet:
+		value := row.GetSet(idx).Value
+		set, err := types.ParseSetValue(col.GetElems(), value)
+		if err != nil {
+			log.Panic("parse set value failed", zap.Any("value", value), zap.Error(err))
+		}
+		if set.Name == "" {
+			return []string{}
+		}
+		return strings.Split(set.Name, ",")
+	case mysql.TypeBit:
+		d := row.GetDatum(idx, &col.FieldType)
+		value, err := d.GetBinaryLiteral().ToInt(types.DefaultStmtNoWarningContext)
+		if err != nil {
+			log.Panic("parse bit value failed", zap.Any("value", d), zap.Error(err))
+		}
+		return value
+	case mysql.TypeJSON:
+		return json.RawMessage(row.GetJSON(idx).String())
+	case mysql.TypeTiDBVectorFloat32:
+		return row.GetVectorFloat32(idx).String()
+	default:
+	}
+	d := row.GetDatum(idx, &col.FieldType)
+	return d.GetValue()
+}
+
+func isValueEqual(a, b any) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+func handleKeyColumnNames(tableInfo *commonType.TableInfo) []string {
+	ids := tableInfo.GetOrderedHandleKeyColumnIDs()
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		col, ok := tableInfo.GetColumnInfo(id)
+		if !ok {
+			continue
+		}
+		result = append(result, col.Name.O)
+	}
+	return result
+}
+
+func newColumnDef(col *timodel.ColumnInfo) *columnDef {
+	result := &columnDef{
+		Type: types.TypeToStr(col.GetType(), col.GetCharset()),
+		Name: col.Name.O,
+	}
+	switch col.GetType() {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong,
+		mysql.TypeFloat, mysql.TypeDouble, mysql.TypeNewDecimal:
+		signed := !mysql.HasUnsignedFlag(col.GetFlag())
+		result.Signed = &signed
+	case mysql.TypeVarchar, mysql.TypeVarString, mysql.TypeString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		result.Charset = col.GetCharset()
+	case mysql.TypeEnum, mysql.TypeSet:
+		result.Charset = col.GetCharset()
+		result.EnumValues = col.GetElems()
+	case mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration:
+		fsp := col.GetDecimal()
+		result.ColumnLen = &fsp
+	case mysql.TypeBit:
+		flen := col.GetFlen()
+		result.ColumnLen = &flen
+	default:
+	}
+	return result
+}
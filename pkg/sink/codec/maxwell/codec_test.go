@@ -0,0 +1,155 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maxwell
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pingcap/ticdc/downstreamadapter/sink/columnselector"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDMLEventE2E(t *testing.T) {
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	ddlEvent := helper.DDL2Event(`create table test.t(
+		id int primary key, a tinyint unsigned, b varchar(32), c decimal(10, 2),
+		d datetime(3), e enum('a', 'b', 'c'), f set('x', 'y'), g json, h blob)`)
+
+	ctx := context.Background()
+	codecConfig := common.NewConfig(config.ProtocolMaxwell)
+	codecConfig.EnableTiDBExtension = true
+
+	enc := NewEncoder(codecConfig)
+	dec := NewDecoder(codecConfig)
+
+	m, err := enc.EncodeDDLEvent(ddlEvent)
+	require.NoError(t, err)
+	dec.AddKeyValue(m.Key, m.Value)
+	messageType, hasNext := dec.HasNext()
+	require.True(t, hasNext)
+	require.Equal(t, common.MessageTypeDDL, messageType)
+	decodedDDL := dec.NextDDLEvent()
+	require.Equal(t, ddlEvent.Query, decodedDDL.Query)
+	require.Equal(t, ddlEvent.GetCommitTs(), decodedDDL.GetCommitTs())
+	require.Equal(t, "test", decodedDDL.SchemaName)
+	require.Equal(t, "t", decodedDDL.TableName)
+
+	insertEvent := helper.DML2Event("test", "t",
+		`insert into test.t values (1, 255, "abc", 12.34, "2026-01-02 03:04:05.678", "b", "x,y", '{"k": 1}', x'0102')`)
+	updateEvent, _ := helper.DML2UpdateEvent("test", "t",
+		`insert into test.t values (2, 1, "old", 1.00, "2026-01-02 03:04:05", "a", "", null, null)`,
+		`update test.t set b = "new", c = 2.50 where id = 2`)
+	deleteEvent := helper.DML2DeleteEvent("test", "t",
+		`insert into test.t values (3, 2, "deleted", 3.00, null, "c", "y", '[1, 2]', x'ff')`,
+		`delete from test.t where id = 3`)
+
+	for _, event := range []*commonEvent.DMLEvent{insertEvent, updateEvent, deleteEvent} {
+		row, ok := event.GetNextRow()
+		require.True(t, ok)
+		rowEvent := &commonEvent.RowEvent{
+			TableInfo:      event.TableInfo,
+			StartTs:        event.StartTs,
+			CommitTs:       event.CommitTs,
+			Event:          row,
+			ColumnSelector: columnselector.NewDefaultColumnSelector(),
+			Callback:       func() {},
+		}
+		err = enc.AppendRowChangedEvent(ctx, "", rowEvent)
+		require.NoError(t, err)
+
+		messages := enc.Build()
+		require.Len(t, messages, 1)
+		dec.AddKeyValue(messages[0].Key, messages[0].Value)
+
+		messageType, hasNext = dec.HasNext()
+		require.True(t, hasNext)
+		require.Equal(t, common.MessageTypeRow, messageType)
+
+		decoded := dec.NextDMLMessage().ToDMLEvent()
+		require.Equal(t, event.CommitTs, decoded.CommitTs)
+		require.Equal(t, event.StartTs, decoded.StartTs)
+		change, ok := decoded.GetNextRow()
+		require.True(t, ok)
+		require.Equal(t, row.RowType, change.RowType)
+		common.CompareRow(t, row, event.TableInfo, change, decoded.TableInfo)
+		require.True(t, decoded.TableInfo.IsHandleKey(decoded.TableInfo.ForceGetColumnIDByName("id")))
+	}
+
+	_, hasNext = dec.HasNext()
+	require.False(t, hasNext)
+}
+
+func TestUpdateOnlyOutputChangedOldValue(t *testing.T) {
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	_ = helper.DDL2Event(`create table test.t(id int primary key, a int, b varchar(10))`)
+	event, _ := helper.DML2UpdateEvent("test", "t",
+		`insert into test.t values (1, 1, "a")`,
+		`update test.t set b = "b" where id = 1`)
+	row, ok := event.GetNextRow()
+	require.True(t, ok)
+
+	enc := NewEncoder(common.NewConfig(config.ProtocolMaxwell))
+	err := enc.AppendRowChangedEvent(context.Background(), "", &commonEvent.RowEvent{
+		TableInfo:      event.TableInfo,
+		CommitTs:       event.CommitTs,
+		Event:          row,
+		ColumnSelector: columnselector.NewDefaultColumnSelector(),
+	})
+	require.NoError(t, err)
+	messages := enc.Build()
+	require.Len(t, messages, 1)
+
+	var msg map[string]any
+	require.NoError(t, json.Unmarshal(messages[0].Value, &msg))
+	require.Equal(t, "update", msg["type"])
+	require.Equal(t, map[string]any{"b": "a"}, msg["old"])
+	require.Equal(t, []any{"id"}, msg["primary_key_columns"])
+	require.NotContains(t, msg, "_tidb")
+
+	var key map[string]any
+	require.NoError(t, json.Unmarshal(messages[0].Key, &key))
+	require.Equal(t, map[string]any{"database": "test", "table": "t", "pk.id": float64(1)}, key)
+}
+
+func TestWatermarkEvent(t *testing.T) {
+	codecConfig := common.NewConfig(config.ProtocolMaxwell)
+	enc := NewEncoder(codecConfig)
+
+	m, err := enc.EncodeCheckpointEvent(446266400629063682)
+	require.NoError(t, err)
+	require.Nil(t, m)
+
+	codecConfig.EnableTiDBExtension = true
+	m, err = enc.EncodeCheckpointEvent(446266400629063682)
+	require.NoError(t, err)
+	require.NotNil(t, m)
+
+	dec := NewDecoder(codecConfig)
+	dec.AddKeyValue(m.Key, m.Value)
+	messageType, hasNext := dec.HasNext()
+	require.True(t, hasNext)
+	require.Equal(t, common.MessageTypeResolved, messageType)
+	require.Equal(t, uint64(446266400629063682), dec.NextResolvedEvent())
+}
@@ -0,0 +1,481 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maxwell
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/log"
+	commonType "github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"github.com/pingcap/ticdc/pkg/util"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	ptypes "github.com/pingcap/tidb/pkg/parser/types"
+	tiTypes "github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"go.uber.org/zap"
+)
+
+var tableIDAllocator = common.NewTableIDAllocator()
+
+type tableNameKey struct {
+	schema string
+	table  string
+}
+
+// decoder decodes the maxwell JSON message into the TiCDC events.
+// The maxwell protocol does not carry the column type for the DML events,
+// so the decoder uses the table definition of the latest DDL event if it's found,
+// otherwise the column type is inferred from the JSON value.
+type decoder struct {
+	value []byte
+	msg   *rawMessage
+
+	config *common.Config
+
+	tableDefs map[tableNameKey]*tableDef
+}
+
+// NewDecoder creates a new maxwell decoder.
+func NewDecoder(config *common.Config) common.Decoder {
+	tableIDAllocator.Clean()
+	return &decoder{
+		config:    config,
+		tableDefs: make(map[tableNameKey]*tableDef),
+	}
+}
+
+// AddKeyValue implements the Decoder interface
+func (d *decoder) AddKeyValue(_, value []byte) {
+	if len(d.value) != 0 {
+		log.Panic("add key / value to the decoder failed, since it's already set")
+	}
+	value, err := common.Decompress(d.config.LargeMessageHandle.LargeMessageHandleCompression, value)
+	if err != nil {
+		log.Panic("decompress data failed",
+			zap.String("compression", d.config.LargeMessageHandle.LargeMessageHandleCompression),
+			zap.Any("value", util.RedactAny(value)), zap.Error(err))
+	}
+	d.value = value
+}
+
+// HasNext implements the Decoder interface
+func (d *decoder) HasNext() (common.MessageType, bool) {
+	if len(d.value) == 0 {
+		return common.MessageTypeUnknown, false
+	}
+	msg := new(rawMessage)
+	if err := json.Unmarshal(d.value, msg); err != nil {
+		log.Panic("maxwell decode failed", zap.String("data", util.RedactAny(d.value)), zap.Error(err))
+	}
+	d.value = nil
+	d.msg = msg
+	return msg.messageType(), true
+}
+
+// NextResolvedEvent implements the Decoder interface
+func (d *decoder) NextResolvedEvent() uint64 {
+	if d.msg == nil || d.msg.messageType() != common.MessageTypeResolved {
+		log.Panic("message type is not watermark", zap.Any("msg", d.msg))
+	}
+	if d.msg.Extensions == nil {
+		log.Panic("maxwell watermark message should have tidb extension, but not found", zap.Any("msg", d.msg))
+	}
+	ts := d.msg.Extensions.WatermarkTs
+	d.msg = nil
+	return ts
+}
+
+// NextDDLEvent implements the Decoder interface
+func (d *decoder) NextDDLEvent() *commonEvent.DDLEvent {
+	if d.msg == nil || d.msg.messageType() != common.MessageTypeDDL {
+		log.Panic("message type is not DDL", zap.Any("msg", d.msg))
+	}
+	msg := d.msg
+	d.msg = nil
+
+	result := new(commonEvent.DDLEvent)
+	result.FinishedTs = msg.commitTs()
+	result.SchemaName = msg.Database
+	result.TableName = msg.Table
+	result.Query = msg.SQL
+	result.Type = byte(common.GetDDLActionType(result.Query))
+	tableIDAllocator.AddBlockTableID(result.SchemaName, result.TableName,
+		tableIDAllocator.Allocate(result.SchemaName, result.TableName))
+	result.BlockedTables = common.GetBlockedTables(tableIDAllocator, result)
+
+	switch msg.Type {
+	case typeDatabaseDrop:
+		for key := range d.tableDefs {
+			if key.schema == msg.Database {
+				delete(d.tableDefs, key)
+			}
+		}
+	case typeTableDrop:
+		delete(d.tableDefs, tableNameKey{schema: msg.Database, table: msg.Table})
+	default:
+		if msg.Def != nil {
+			d.tableDefs[tableNameKey{schema: msg.Database, table: msg.Table}] = msg.Def
+		}
+	}
+	return result
+}
+
+// NextDMLMessage implements the Decoder interface
+func (d *decoder) NextDMLMessage() *common.DMLMessage {
+	if d.msg == nil || d.msg.messageType() != common.MessageTypeRow {
+		log.Panic("message type is not row changed", zap.Any("msg", d.msg))
+	}
+	msg := d.msg
+	d.msg = nil
+
+	var rowType commonType.RowType
+	switch msg.Type {
+	case typeInsert:
+		rowType = commonType.RowTypeInsert
+	case typeUpdate:
+		rowType = commonType.RowTypeUpdate
+	case typeDelete:
+		rowType = commonType.RowTypeDelete
+	}
+	tableID := tableIDAllocator.Allocate(msg.Database, msg.Table)
+	tableIDAllocator.AddBlockTableID(msg.Database, msg.Table, tableID)
+	def := d.tableDefs[tableNameKey{schema: msg.Database, table: msg.Table}]
+	return common.NewDMLMessage(tableID, msg.Database, msg.Table, msg.commitTs(), rowType, func() *commonEvent.DMLEvent {
+		return assembleDMLEvent(tableID, msg, def)
+	})
+}
+
+func assembleDMLEvent(tableID int64, msg *rawMessage, def *tableDef) *commonEvent.DMLEvent {
+	tableInfo := newTableInfo(tableID, msg, def)
+
+	result := new(commonEvent.DMLEvent)
+	result.TableInfo = tableInfo
+	result.PhysicalTableID = tableID
+	result.StartTs = msg.Xid
+	result.CommitTs = msg.commitTs()
+	result.Rows = chunk.NewChunkFromPoolWithCapacity(tableInfo.GetFieldSlice(), chunk.InitialCapacity)
+	result.AddPostFlushFunc(func() {
+		result.Rows.Destroy(chunk.InitialCapacity, tableInfo.GetFieldSlice())
+	})
+	result.Length++
+
+	columns := tableInfo.GetColumns()
+	data := formatAllColumnsValue(msg.Data, columns)
+	switch msg.Type {
+	case typeInsert:
+		common.AppendRow2Chunk(data, columns, result.Rows)
+		result.RowTypes = append(result.RowTypes, commonType.RowTypeInsert)
+	case typeDelete:
+		common.AppendRow2Chunk(data, columns, result.Rows)
+		result.RowTypes = append(result.RowTypes, commonType.RowTypeDelete)
+	case typeUpdate:
+		// the old value only contains the updated columns, fill the others by the new value.
+		previous := formatAllColumnsValue(msg.Old, columns)
+		for k, v := range data {
+			if _, ok := msg.Old[k]; !ok {
+				previous[k] = v
+			}
+		}
+		common.AppendRow2Chunk(previous, columns, result.Rows)
+		common.AppendRow2Chunk(data, columns, result.Rows)
+		result.RowTypes = append(result.RowTypes, commonType.RowTypeUpdate, commonType.RowTypeUpdate)
+	default:
+		log.Panic("unknown event type for the DML event", zap.String("type", msg.Type))
+	}
+	return result
+}
+
+func newTableInfo(tableID int64, msg *rawMessage, def *tableDef) *commonType.TableInfo {
+	columnDefs := make(map[string]*columnDef)
+	primaryKeys := msg.PrimaryKeyColumns
+	if def != nil {
+		for _, col := range def.Columns {
+			columnDefs[col.Name] = col
+		}
+		if len(primaryKeys) == 0 {
+			primaryKeys = def.PrimaryKey
+		}
+	}
+
+	names := make([]string, 0, len(msg.Data))
+	for name := range msg.Data {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	columns := make([]*timodel.ColumnInfo, 0, len(names))
+	for idx, name := range names {
+		var col *timodel.ColumnInfo
+		if colDef, ok := columnDefs[name]; ok {
+			col = newColumnFromDef(colDef)
+		} else {
+			col = newColumnFromValue(name, msg.Data[name])
+		}
+		col.ID = int64(idx)
+		if slices.Contains(primaryKeys, name) {
+			col.AddFlag(mysql.PriKeyFlag | mysql.NotNullFlag)
+		}
+		columns = append(columns, col)
+	}
+
+	tidbTableInfo := new(timodel.TableInfo)
+	tidbTableInfo.ID = tableID
+	tidbTableInfo.Name = ast.NewCIStr(msg.Table)
+	tidbTableInfo.Columns = columns
+	tidbTableInfo.Indices = newTiIndices(columns)
+	tidbTableInfo.PKIsHandle = len(tidbTableInfo.Indices) != 0
+	return commonType.NewTableInfo4Decoder(msg.Database, tidbTableInfo)
+}
+
+func newTiIndices(columns []*timodel.ColumnInfo) []*timodel.IndexInfo {
+	indexColumns := make([]*timodel.IndexColumn, 0, 1)
+	for idx, col := range columns {
+		if mysql.HasPriKeyFlag(col.GetFlag()) {
+			indexColumns = append(indexColumns, &timodel.IndexColumn{
+				Name:   col.Name,
+				Offset: idx,
+			})
+		}
+	}
+	if len(indexColumns) == 0 {
+		return nil
+	}
+	return []*timodel.IndexInfo{{
+		ID:      1,
+		Name:    ast.NewCIStr("primary"),
+		Columns: indexColumns,
+		Primary: true,
+		Unique:  true,
+	}}
+}
+
+func newColumnFromDef(def *columnDef) *timodel.ColumnInfo {
+	col := new(timodel.ColumnInfo)
+	col.Name = ast.NewCIStr(def.Name)
+	tp := ptypes.StrToType(def.Type)
+	col.FieldType = *ptypes.NewFieldType(tp)
+	if def.Signed != nil && !*def.Signed {
+		col.AddFlag(mysql.UnsignedFlag)
+	}
+	switch tp {
+	case mysql.TypeVarchar, mysql.TypeVarString, mysql.TypeString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if def.Charset == "binary" {
+			col.AddFlag(mysql.BinaryFlag)
+			col.SetCharset("binary")
+			col.SetCollate("binary")
+		} else {
+			col.SetCharset("utf8mb4")
+			col.SetCollate("utf8mb4_bin")
+		}
+	case mysql.TypeEnum, mysql.TypeSet:
+		col.SetCharset("utf8mb4")
+		col.SetCollate("utf8mb4_bin")
+		col.SetElems(def.EnumValues)
+	case mysql.TypeDatetime, mysql.TypeTimestamp, mysql.TypeDuration:
+		col.SetDecimal(tiTypes.MaxFsp)
+		if def.ColumnLen != nil {
+			col.SetDecimal(*def.ColumnLen)
+		}
+	case mysql.TypeBit:
+		if def.ColumnLen != nil {
+			col.SetFlen(*def.ColumnLen)
+		}
+	default:
+	}
+	return col
+}
+
+// newColumnFromValue infers the column type by the JSON value kind.
+func newColumnFromValue(name string, raw json.RawMessage) *timodel.ColumnInfo {
+	col := new(timodel.ColumnInfo)
+	col.Name = ast.NewCIStr(name)
+
+	value := bytes.TrimSpace(raw)
+	tp := mysql.TypeVarchar
+	if len(value) != 0 {
+		switch value[0] {
+		case '{', '[':
+			tp = mysql.TypeJSON
+		case 't', 'f':
+			tp = mysql.TypeTiny
+		case 'n', '"':
+		default:
+			s := string(value)
+			switch {
+			case strings.ContainsAny(s, "eE"):
+				tp = mysql.TypeDouble
+			case strings.Contains(s, "."):
+				tp = mysql.TypeNewDecimal
+			default:
+				tp = mysql.TypeLonglong
+				if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+					col.AddFlag(mysql.UnsignedFlag)
+				}
+			}
+		}
+	}
+	col.FieldType = *ptypes.NewFieldType(tp)
+	if tp == mysql.TypeVarchar {
+		col.SetCharset("utf8mb4")
+		col.SetCollate("utf8mb4_bin")
+	}
+	return col
+}
+
+func formatAllColumnsValue(data map[string]json.RawMessage, columns []*timodel.ColumnInfo) map[string]any {
+	result := make(map[string]any, len(data))
+	for _, col := range columns {
+		raw, ok := data[col.Name.O]
+		if !ok {
+			continue
+		}
+		result[col.Name.O] = formatValue(raw, &col.FieldType)
+	}
+	return result
+}
+
+func formatValue(raw json.RawMessage, ft *ptypes.FieldType) any {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil
+	}
+	switch ft.GetType() {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong:
+		s := string(raw)
+		switch s {
+		case "true":
+			s = "1"
+		case "false":
+			s = "0"
+		}
+		if mysql.HasUnsignedFlag(ft.GetFlag()) {
+			value, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				log.Panic("invalid column value for unsigned integer", zap.String("value", util.RedactValue(s)), zap.Error(err))
+			}
+			return value
+		}
+		value, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			log.Panic("invalid column value for integer", zap.String("value", util.RedactValue(s)), zap.Error(err))
+		}
+		return value
+	case mysql.TypeYear:
+		value, err := strconv.ParseInt(string(raw), 10, 64)
+		if err != nil {
+			log.Panic("invalid column value for year", zap.String("value", util.RedactAny(raw)), zap.Error(err))
+		}
+		return value
+	case mysql.TypeFloat:
+		value, err := strconv.ParseFloat(string(raw), 32)
+		if err != nil {
+			log.Panic("invalid column value for float", zap.String("value", util.RedactAny(raw)), zap.Error(err))
+		}
+		return float32(value)
+	case mysql.TypeDouble:
+		value, err := strconv.ParseFloat(string(raw), 64)
+		if err != nil {
+			log.Panic("invalid column value for double", zap.String("value", util.RedactAny(raw)), zap.Error(err))
+		}
+		return value
+	case mysql.TypeNewDecimal:
+		value := new(tiTypes.MyDecimal)
+		if err := value.FromString(bytes.Trim(raw, `"`)); err != nil {
+			log.Panic("invalid column value for decimal", zap.String("value", util.RedactAny(raw)), zap.Error(err))
+		}
+		return value
+	case mysql.TypeBit:
+		value, err := strconv.ParseUint(string(raw), 10, 64)
+		if err != nil {
+			log.Panic("invalid column value for bit", zap.String("value", util.RedactAny(raw)), zap.Error(err))
+		}
+		byteSize := (ft.GetFlen() + 7) >> 3
+		if byteSize < 1 || byteSize > 8 {
+			byteSize = -1
+		}
+		return tiTypes.NewBinaryLiteralFromUint(value, byteSize)
+	case mysql.TypeJSON:
+		value, err := tiTypes.ParseBinaryJSONFromString(string(raw))
+		if err != nil {
+			log.Panic("invalid column value for json", zap.String("value", util.RedactAny(raw)), zap.Error(err))
+		}
+		return value
+	case mysql.TypeSet:
+		var names []string
+		if err := json.Unmarshal(raw, &names); err != nil {
+			log.Panic("invalid column value for set", zap.String("value", util.RedactAny(raw)), zap.Error(err))
+		}
+		value, err := tiTypes.ParseSetName(ft.GetElems(), strings.Join(names, ","), ft.GetCollate())
+		if err != nil {
+			log.Panic("invalid column value for set", zap.String("value", util.RedactAny(raw)), zap.Error(err))
+		}
+		return value
+	default:
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		log.Panic("maxwell encoded value should be string",
+			zap.Any("type", ft.GetType()), zap.String("value", util.RedactAny(raw)), zap.Error(err))
+	}
+	switch ft.GetType() {
+	case mysql.TypeVarchar, mysql.TypeVarString, mysql.TypeString,
+		mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if mysql.HasBinaryFlag(ft.GetFlag()) {
+			value, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				log.Panic("invalid column value for binary", zap.String("value", util.RedactValue(s)), zap.Error(err))
+			}
+			return value
+		}
+		return []byte(s)
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeTimestamp:
+		value, err := tiTypes.ParseTime(tiTypes.DefaultStmtNoWarningContext, s, ft.GetType(), ft.GetDecimal())
+		if err != nil {
+			log.Panic("invalid column value for time", zap.String("value", util.RedactValue(s)), zap.Error(err))
+		}
+		return value
+	case mysql.TypeDuration:
+		value, _, err := tiTypes.ParseDuration(tiTypes.DefaultStmtNoWarningContext, s, ft.GetDecimal())
+		if err != nil {
+			log.Panic("invalid column value for duration", zap.String("value", util.RedactValue(s)), zap.Error(err))
+		}
+		return value
+	case mysql.TypeEnum:
+		value, err := tiTypes.ParseEnumName(ft.GetElems(), s, ft.GetCollate())
+		if err != nil {
+			log.Panic("invalid column value for enum", zap.String("value", util.RedactValue(s)), zap.Error(err))
+		}
+		return value
+	case mysql.TypeTiDBVectorFloat32:
+		value, err := tiTypes.ParseVectorFloat32(s)
+		if err != nil {
+			log.Panic("invalid column value for vector float32", zap.String("value", util.RedactValue(s)), zap.Error(err))
+		}
+		return value
+	default:
+	}
+	log.Panic("unknown column type", zap.Any("type", ft.GetType()), zap.String("value", util.RedactValue(s)))
+	return nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maxwell
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pingcap/log"
+	commonType "github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+)
+
+// encoder encodes each row changed event into a maxwell JSON message.
+type encoder struct {
+	messages []*common.Message
+
+	config *common.Config
+}
+
+// NewEncoder creates a new maxwell encoder.
+func NewEncoder(config *common.Config) common.EventEncoder {
+	return &encoder{
+		messages: make([]*common.Message, 0, 1),
+		config:   config,
+	}
+}
+
+// AppendRowChangedEvent implements the EventEncoder interface
+func (e *encoder) AppendRowChangedEvent(
+	_ context.Context, _ string, event *commonEvent.RowEvent,
+) error {
+	key, value, err := e.encodeRowChangedEvent(event)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	value, err = common.Compress(
+		e.config.ChangefeedID, e.config.LargeMessageHandle.LargeMessageHandleCompression, value,
+	)
+	if err != nil {
+		return errors.WrapError(errors.ErrMaxwellEncodeFailed, err)
+	}
+
+	m := common.NewMsg(key, value)
+	m.Callback = event.Callback
+	m.IncRowsCount()
+
+	length := m.Length()
+	if length > e.config.MaxMessageBytes {
+		log.Warn("Single message is too large for maxwell",
+			zap.Int("maxMessageBytes", e.config.MaxMessageBytes),
+			zap.Int("length", length),
+			zap.Any("table", event.TableInfo.TableName))
+		return errors.ErrMessageTooLarge.GenWithStackByArgs(
+			event.TableInfo.GetTargetTableName(), length, e.config.MaxMessageBytes)
+	}
+	e.messages = append(e.messages, m)
+	return nil
+}
+
+func (e *encoder) encodeRowChangedEvent(event *commonEvent.RowEvent) ([]byte, []byte, error) {
+	tableInfo := event.TableInfo
+	msg := &message{
+		Database: tableInfo.GetTargetSchemaName(),
+		Table:    tableInfo.GetTargetTableName(),
+		Ts:       oracle.GetTimeFromTS(event.CommitTs).Unix(),
+		Xid:      event.StartTs,
+	}
+	if e.config.EnableTiDBExtension {
+		msg.Extensions = &tidbExtension{CommitTs: event.CommitTs}
+	}
+
+	var (
+		keyColumns map[string]any
+		err        error
+	)
+	switch {
+	case event.IsInsert():
+		msg.Type = typeInsert
+		msg.Data, keyColumns, err = collectColumnValues(event.GetRows(), tableInfo, event.ColumnSelector, false)
+	case event.IsDelete():
+		msg.Type = typeDelete
+		msg.Data, keyColumns, err = collectColumnValues(
+			event.GetPreRows(), tableInfo, event.ColumnSelector, e.config.DeleteOnlyHandleKeyColumns)
+	case event.IsUpdate():
+		msg.Type = typeUpdate
+		msg.Data, keyColumns, err = collectColumnValues(event.GetRows(), tableInfo, event.ColumnSelector, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		// maxwell only outputs the old value of the updated columns.
+		var old map[string]any
+		old, _, err = collectColumnValues(event.GetPreRows(), tableInfo, event.ColumnSelector, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		msg.Old = make(map[string]any, len(old))
+		for name, value := range old {
+			if !isValueEqual(value, msg.Data[name]) {
+				msg.Old[name] = value
+			}
+		}
+	default:
+		log.Panic("unreachable event type", zap.Any("event", event))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, name := range handleKeyColumnNames(tableInfo) {
+		if _, ok := keyColumns[name]; ok {
+			msg.PrimaryKeyColumns = append(msg.PrimaryKeyColumns, name)
+		}
+	}
+
+	key, err := messageKey(msg.Database, msg.Table, keyColumns)
+	if err != nil {
+		return nil, nil, errors.WrapError(errors.ErrMaxwellEncodeFailed, err)
+	}
+	value, err := json.Marshal(msg)
+	if err != nil {
+		return nil, nil, errors.WrapError(errors.ErrMaxwellEncodeFailed, err)
+	}
+	return key, value, nil
+}
+
+// EncodeDDLEvent implements the EventEncoder interface
+func (e *encoder) EncodeDDLEvent(event *commonEvent.DDLEvent) (*common.Message, error) {
+	msg := &message{
+		Database: event.GetTargetSchemaName(),
+		Table:    event.GetTargetTableName(),
+		Type:     ddlType(timodel.ActionType(event.Type)),
+		Ts:       oracle.GetTimeFromTS(event.GetCommitTs()).Unix(),
+		SQL:      event.Query,
+	}
+	if event.TableInfo != nil && msg.Type != typeTableDrop && msg.Table != "" {
+		msg.Def = newTableDef(msg.Database, msg.Table, event.TableInfo)
+	}
+	if e.config.EnableTiDBExtension {
+		msg.Extensions = &tidbExtension{CommitTs: event.GetCommitTs()}
+	}
+
+	key, err := messageKey(msg.Database, msg.Table, nil)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrMaxwellEncodeFailed, err)
+	}
+	value, err := json.Marshal(msg)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrMaxwellEncodeFailed, err)
+	}
+	value, err = common.Compress(
+		e.config.ChangefeedID, e.config.LargeMessageHandle.LargeMessageHandleCompression, value,
+	)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrMaxwellEncodeFailed, err)
+	}
+	return common.NewMsg(key, value), nil
+}
+
+// EncodeCheckpointEvent implements the EventEncoder interface
+// the official maxwell format does not have the watermark concept,
+// so the checkpoint event is only sent if the TiDB extension is enabled.
+func (e *encoder) EncodeCheckpointEvent(ts uint64) (*common.Message, error) {
+	if !e.config.EnableTiDBExtension {
+		return nil, nil
+	}
+	msg := &message{
+		Type:       typeTiDBWatermark,
+		Ts:         oracle.GetTimeFromTS(ts).Unix(),
+		Extensions: &tidbExtension{WatermarkTs: ts},
+	}
+	value, err := json.Marshal(msg)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrMaxwellEncodeFailed, err)
+	}
+	value, err = common.Compress(
+		e.config.ChangefeedID, e.config.LargeMessageHandle.LargeMessageHandleCompression, value,
+	)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrMaxwellEncodeFailed, err)
+	}
+	return common.NewMsg(nil, value), nil
+}
+
+// Build implements the EventEncoder interface
+func (e *encoder) Build() []*common.Message {
+	if len(e.messages) == 0 {
+		return nil
+	}
+	result := e.messages
+	e.messages = nil
+	return result
+}
+
+func newTableDef(schema, table string, tableInfo *commonType.TableInfo) *tableDef {
+	result := &tableDef{
+		Database:   schema,
+		Table:      table,
+		Charset:    tableInfo.Charset,
+		Columns:    make([]*columnDef, 0, len(tableInfo.GetColumns())),
+		PrimaryKey: handleKeyColumnNames(tableInfo),
+	}
+	for _, col := range tableInfo.GetColumns() {
+		if col == nil || col.IsVirtualGenerated() {
+			continue
+		}
+		result.Columns = append(result.Columns, newColumnDef(col))
+	}
+	return result
+}
@@ -0,0 +1,142 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maxwell
+
+import (
+	"encoding/json"
+
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+)
+
+const (
+	typeInsert = "insert"
+	typeUpdate = "update"
+	typeDelete = "delete"
+
+	typeDatabaseCreate = "database-create"
+	typeDatabaseAlter  = "database-alter"
+	typeDatabaseDrop   = "database-drop"
+	typeTableCreate    = "table-create"
+	typeTableAlter     = "table-alter"
+	typeTableDrop      = "table-drop"
+
+	// typeTiDBWatermark is a TiCDC specific type, only sent if the TiDB extension is enabled.
+	typeTiDBWatermark = "tidb-watermark"
+)
+
+// message is the value of the maxwell protocol, it's adapted from
+// https://maxwells-daemon.io/dataformat/
+// Both DML and DDL share the same structure, fields that not used by one kind are omitted.
+type message struct {
+	Database string `json:"database"`
+	Table    string `json:"table,omitempty"`
+	Type     string `json:"type"`
+	// Ts is the commit time of the event, in seconds since Epoch.
+	Ts int64 `json:"ts"`
+	// Xid is the transaction identifier, TiCDC set it to the start-ts of the transaction.
+	Xid uint64 `json:"xid,omitempty"`
+
+	Data              map[string]any `json:"data,omitempty"`
+	Old               map[string]any `json:"old,omitempty"`
+	PrimaryKeyColumns []string       `json:"primary_key_columns,omitempty"`
+
+	// Def and SQL only works for DDL events.
+	Def *tableDef `json:"def,omitempty"`
+	SQL string    `json:"sql,omitempty"`
+
+	// Extensions is a TiCDC custom field that different from official maxwell format.
+	// It's only set when the `enable-tidb-extension` is true.
+	Extensions *tidbExtension `json:"_tidb,omitempty"`
+}
+
+type tidbExtension struct {
+	CommitTs    uint64 `json:"commitTs,omitempty"`
+	WatermarkTs uint64 `json:"watermarkTs,omitempty"`
+}
+
+// tableDef is the table definition carried by the DDL event.
+type tableDef struct {
+	Database   string       `json:"database"`
+	Table      string       `json:"table"`
+	Charset    string       `json:"charset,omitempty"`
+	Columns    []*columnDef `json:"columns"`
+	PrimaryKey []string     `json:"primary-key"`
+}
+
+type columnDef struct {
+	Type       string   `json:"type"`
+	Name       string   `json:"name"`
+	Charset    string   `json:"charset,omitempty"`
+	Signed     *bool    `json:"signed,omitempty"`
+	ColumnLen  *int     `json:"column-length,omitempty"`
+	EnumValues []string `json:"enum-values,omitempty"`
+}
+
+// rawMessage is used by the decoder to keep the number precision.
+type rawMessage struct {
+	message
+	Data map[string]json.RawMessage `json:"data,omitempty"`
+	Old  map[string]json.RawMessage `json:"old,omitempty"`
+}
+
+func (m *rawMessage) messageType() common.MessageType {
+	switch m.Type {
+	case typeInsert, typeUpdate, typeDelete:
+		return common.MessageTypeRow
+	case typeTiDBWatermark:
+		return common.MessageTypeResolved
+	case typeDatabaseCreate, typeDatabaseAlter, typeDatabaseDrop,
+		typeTableCreate, typeTableAlter, typeTableDrop:
+		return common.MessageTypeDDL
+	default:
+	}
+	return common.MessageTypeUnknown
+}
+
+func (m *rawMessage) commitTs() uint64 {
+	if m.Extensions == nil {
+		return 0
+	}
+	return m.Extensions.CommitTs
+}
+
+// messageKey is the key of the maxwell message, it's used by the downstream to do the partitioning.
+// The primary key values are encoded as `"pk.<column>": <value>`.
+func messageKey(schema, table string, primaryKeys map[string]any) ([]byte, error) {
+	key := make(map[string]any, len(primaryKeys)+2)
+	key["database"] = schema
+	key["table"] = table
+	for name, value := range primaryKeys {
+		key["pk."+name] = value
+	}
+	return json.Marshal(key)
+}
+
+func ddlType(action timodel.ActionType) string {
+	switch action {
+	case timodel.ActionCreateSchema:
+		return typeDatabaseCreate
+	case timodel.ActionDropSchema:
+		return typeDatabaseDrop
+	case timodel.ActionModifySchemaCharsetAndCollate:
+		return typeDatabaseAlter
+	case timodel.ActionCreateTable, timodel.ActionCreateTables, timodel.ActionCreateView:
+		return typeTableCreate
+	case timodel.ActionDropTable, timodel.ActionDropView:
+		return typeTableDrop
+	default:
+	}
+	return typeTableAlter
+}
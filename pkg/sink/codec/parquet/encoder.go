@@ -0,0 +1,76 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+)
+
+// txnEncoder encodes the rows of a txn into the intermediate format,
+// which is converted into a parquet file by the fileEncoder.
+type txnEncoder struct {
+	key       []byte
+	valueBuf  []byte
+	callback  func()
+	batchSize int
+	config    *common.Config
+}
+
+// NewTxnEventEncoder creates a new parquet TxnEventEncoder.
+func NewTxnEventEncoder(config *common.Config) common.TxnEventEncoder {
+	return &txnEncoder{
+		config: config,
+	}
+}
+
+// AppendTxnEvent implements the TxnEventEncoder interface
+func (e *txnEncoder) AppendTxnEvent(rowEvents []*commonEvent.RowEvent) error {
+	if len(rowEvents) == 0 {
+		return nil
+	}
+	offsets, names := selectColumns(rowEvents[0].TableInfo, rowEvents[0].ColumnSelector)
+	if e.batchSize == 0 {
+		e.key = encodeColumnNames(names)
+	}
+	var err error
+	for _, rowEvent := range rowEvents {
+		e.valueBuf, err = rowEvent2Row(rowEvent, offsets, e.valueBuf)
+		if err != nil {
+			return err
+		}
+		e.batchSize++
+	}
+	e.callback = rowEvents[len(rowEvents)-1].Callback
+	return nil
+}
+
+// Build implements the TxnEventEncoder interface
+func (e *txnEncoder) Build() []*common.Message {
+	if e.batchSize == 0 {
+		return nil
+	}
+	ret := common.NewMsg(e.key, e.valueBuf)
+	ret.SetRowsCount(e.batchSize)
+	ret.Callback = e.callback
+	if cap(e.valueBuf) > common.MemBufShrinkThreshold {
+		e.valueBuf = nil
+	} else {
+		e.valueBuf = e.valueBuf[:0]
+	}
+	e.key = nil
+	e.callback = nil
+	e.batchSize = 0
+	return []*common.Message{ret}
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+	"github.com/pingcap/ticdc/downstreamadapter/sink/columnselector"
+	"github.com/pingcap/ticdc/pkg/cloudstorage"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeRow(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"a", "", "ccc"}
+	data := encodeColumnNames(names)
+	data = encodeRow(data, [][]byte{[]byte("1"), nil, {}})
+	data = encodeRow(data, [][]byte{nil, []byte("xyz"), []byte("2")})
+
+	decodedNames, data, err := decodeColumnNames(data)
+	require.NoError(t, err)
+	require.Equal(t, names, decodedNames)
+
+	values, data, err := decodeRow(data, nil)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("1"), nil, {}}, values)
+	values, data, err = decodeRow(data, values)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{nil, []byte("xyz"), []byte("2")}, values)
+	require.Len(t, data, 0)
+
+	_, _, err = decodeRow([]byte{1, 10, 'a'}, nil)
+	require.Error(t, err)
+}
+
+func TestEncodeFile(t *testing.T) {
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	_ = helper.DDL2Event(`create table test.t(
+		id int primary key, a decimal(10, 2), b datetime(3), c json, d varchar(32), e blob, f bigint unsigned)`)
+	insertEvent := helper.DML2Event("test", "t",
+		`insert into test.t values (1, 12.34, "2026-01-02 03:04:05.678", '{"k": 1}', "abc", x'0102', 18446744073709551615)`,
+		`insert into test.t values (2, -0.01, null, null, null, null, null)`)
+	deleteEvent := helper.DML2DeleteEvent("test", "t",
+		`insert into test.t values (3, 1, "2026-01-02 00:00:00", '[1]', "x", x'ff', 1)`,
+		`delete from test.t where id = 3`)
+
+	codecConfig := common.NewConfig(config.ProtocolParquet)
+	encoder := NewTxnEventEncoder(codecConfig)
+	var data []byte
+	for _, event := range []*commonEvent.DMLEvent{insertEvent, deleteEvent} {
+		var rowEvents []*commonEvent.RowEvent
+		for {
+			row, ok := event.GetNextRow()
+			if !ok {
+				break
+			}
+			rowEvents = append(rowEvents, &commonEvent.RowEvent{
+				TableInfo:      event.TableInfo,
+				CommitTs:       event.CommitTs,
+				Event:          row,
+				ColumnSelector: columnselector.NewDefaultColumnSelector(),
+			})
+		}
+		require.NoError(t, encoder.AppendTxnEvent(rowEvents))
+		messages := encoder.Build()
+		require.Len(t, messages, 1)
+		require.Equal(t, len(rowEvents), messages[0].GetRowsCount())
+		// the storage sink only writes the key of the first message.
+		if data == nil {
+			data = append(data, messages[0].Key...)
+		}
+		data = append(data, messages[0].Value...)
+	}
+
+	content, err := NewFileEncoder(codecConfig).EncodeFile(insertEvent.TableInfo, data)
+	require.NoError(t, err)
+
+	reader, err := file.NewParquetReader(bytes.NewReader(content))
+	require.NoError(t, err)
+	defer reader.Close()
+	require.EqualValues(t, 3, reader.NumRows())
+
+	fileSchema := reader.MetaData().Schema
+	require.Equal(t, 9, fileSchema.NumColumns())
+	expectedNames := []string{OperationColumnName, CommitTsColumnName, "id", "a", "b", "c", "d", "e", "f"}
+	for idx, name := range expectedNames {
+		require.Equal(t, name, fileSchema.Column(idx).Name())
+	}
+	decimalType, ok := fileSchema.Column(3).LogicalType().(schema.DecimalLogicalType)
+	require.True(t, ok)
+	require.EqualValues(t, 10, decimalType.Precision())
+	require.EqualValues(t, 2, decimalType.Scale())
+	_, ok = fileSchema.Column(4).LogicalType().(schema.TimestampLogicalType)
+	require.True(t, ok)
+	_, ok = fileSchema.Column(5).LogicalType().(schema.StringLogicalType)
+	require.True(t, ok)
+	decimalType, ok = fileSchema.Column(8).LogicalType().(schema.DecimalLogicalType)
+	require.True(t, ok)
+	require.Equal(t, parquet.Types.FixedLenByteArray, fileSchema.Column(8).PhysicalType())
+	require.EqualValues(t, 20, decimalType.Precision())
+	require.EqualValues(t, 0, decimalType.Scale())
+
+	// the iceberg types match the types of the parquet columns.
+	columns, err := IcebergColumns(insertEvent.TableInfo, data)
+	require.NoError(t, err)
+	require.Equal(t, []cloudstorage.IcebergColumn{
+		{Name: OperationColumnName, Type: "string", Required: true},
+		{Name: CommitTsColumnName, Type: "long", Required: true},
+		{Name: "id", Type: "int", Required: true, Identifier: true},
+		{Name: "a", Type: "decimal(10, 2)"},
+		{Name: "b", Type: "timestamp"},
+		{Name: "c", Type: "string"},
+		{Name: "d", Type: "string"},
+		{Name: "e", Type: "binary"},
+		{Name: "f", Type: "decimal(20, 0)"},
+	}, columns)
+
+	rowGroup := reader.RowGroup(0)
+	ops := readByteArrayColumn(t, rowGroup, 0, 3)
+	require.Equal(t, []string{"I", "I", "D"}, ops)
+	require.Equal(t, []string{"abc", "x"}, readByteArrayColumn(t, rowGroup, 7, 3))
+	require.Equal(t, []string{`{"k": 1}`, "[1]"}, readByteArrayColumn(t, rowGroup, 5, 3))
+
+	chunkReader, err := rowGroup.Column(3)
+	require.NoError(t, err)
+	decimals := make([]int64, 3)
+	_, valuesRead, err := chunkReader.(*file.Int64ColumnChunkReader).ReadBatch(3, decimals, make([]int16, 3), nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, valuesRead)
+	require.Equal(t, []int64{1234, -1, 100}, decimals)
+
+	chunkReader, err = rowGroup.Column(4)
+	require.NoError(t, err)
+	timestamps := make([]int64, 3)
+	_, valuesRead, err = chunkReader.(*file.Int64ColumnChunkReader).ReadBatch(3, timestamps, make([]int16, 3), nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, valuesRead)
+	expected := time.Date(2026, 1, 2, 3, 4, 5, 678000000, time.UTC)
+	require.Equal(t, expected.UnixMicro(), timestamps[0])
+
+	// the unsigned bigint is encoded as a big-endian two's complement decimal.
+	chunkReader, err = rowGroup.Column(8)
+	require.NoError(t, err)
+	unsigned := make([]parquet.FixedLenByteArray, 3)
+	_, valuesRead, err = chunkReader.(*file.FixedLenByteArrayColumnChunkReader).ReadBatch(3, unsigned, make([]int16, 3), nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, valuesRead)
+	require.Equal(t, parquet.FixedLenByteArray{0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, unsigned[0])
+	require.Equal(t, parquet.FixedLenByteArray{0, 0, 0, 0, 0, 0, 0, 0, 1}, unsigned[1])
+}
+
+func readByteArrayColumn(t *testing.T, rowGroup *file.RowGroupReader, column, rows int) []string {
+	t.Helper()
+	chunkReader, err := rowGroup.Column(column)
+	require.NoError(t, err)
+	values := make([]parquet.ByteArray, rows)
+	_, valuesRead, err := chunkReader.(*file.ByteArrayColumnChunkReader).ReadBatch(int64(rows), values, make([]int16, rows), nil)
+	require.NoError(t, err)
+	result := make([]string, 0, valuesRead)
+	for _, value := range values[:valuesRead] {
+		result = append(result, string(value))
+	}
+	return result
+}
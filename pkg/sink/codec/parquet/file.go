@@ -0,0 +1,184 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"database/sql"
+
+	commonType "github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"github.com/pingcap/tidb/pkg/dumpformat/parquetfile"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+)
+
+// fileEncoder converts the rows buffered in the intermediate format into a parquet file.
+type fileEncoder struct {
+	config *common.Config
+}
+
+// NewFileEncoder creates a new parquet FileEncoder.
+func NewFileEncoder(config *common.Config) common.FileEncoder {
+	return &fileEncoder{config: config}
+}
+
+// EncodeFile implements the FileEncoder interface
+func (e *fileEncoder) EncodeFile(tableInfo *commonType.TableInfo, data []byte) ([]byte, error) {
+	names, data, err := decodeColumnNames(data)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := newColumnInfos(tableInfo, names)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	writer, err := parquetfile.NewWriter(buf, columns)
+	if err != nil {
+		return nil, errors.WrapError(errors.ErrParquetEncodeFailed, err)
+	}
+	var (
+		values [][]byte
+		row    = make([]sql.RawBytes, len(columns))
+	)
+	for len(data) > 0 {
+		values, data, err = decodeRow(data, values)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) != len(columns) {
+			return nil, errors.ErrParquetEncodeFailed.GenWithStack(
+				"the column count of the row %d doesn't equal to that of the schema %d", len(values), len(columns))
+		}
+		for idx, value := range values {
+			row[idx] = value
+		}
+		if err = writer.Write(row); err != nil {
+			_ = writer.Close()
+			return nil, errors.WrapError(errors.ErrParquetEncodeFailed, err)
+		}
+	}
+	if err = writer.Close(); err != nil {
+		return nil, errors.WrapError(errors.ErrParquetEncodeFailed, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// newColumnInfos builds the parquet schema of the file, the meta columns go first.
+func newColumnInfos(tableInfo *commonType.TableInfo, names []string) ([]*parquetfile.ColumnInfo, error) {
+	columns := make([]*parquetfile.ColumnInfo, 0, len(names)+2)
+	columns = append(columns,
+		&parquetfile.ColumnInfo{Name: OperationColumnName, DatabaseTypeName: "VARCHAR"},
+		&parquetfile.ColumnInfo{Name: CommitTsColumnName, DatabaseTypeName: "BIGINT"},
+	)
+	for _, name := range names {
+		col, ok := tableInfo.GetColumnInfoByName(name)
+		if !ok {
+			return nil, errors.ErrParquetEncodeFailed.GenWithStack(
+				"column %s not found in table %s", name, tableInfo.TableName.String())
+		}
+		columns = append(columns, newColumnInfo(col))
+	}
+	return columns, nil
+}
+
+func newColumnInfo(col *timodel.ColumnInfo) *parquetfile.ColumnInfo {
+	return &parquetfile.ColumnInfo{
+		Name:             col.Name.O,
+		DatabaseTypeName: databaseTypeName(col),
+		Nullable:         !mysql.HasNotNullFlag(col.GetFlag()),
+		Precision:        int64(col.GetFlen()),
+		Scale:            int64(col.GetDecimal()),
+	}
+}
+
+// databaseTypeName returns the type name of the column in the same form as
+// the database/sql ColumnType.DatabaseTypeName, which decides the parquet type.
+func databaseTypeName(col *timodel.ColumnInfo) string {
+	var (
+		unsigned = mysql.HasUnsignedFlag(col.GetFlag())
+		binary   = mysql.HasBinaryFlag(col.GetFlag())
+	)
+	switch col.GetType() {
+	case mysql.TypeTiny:
+		if unsigned {
+			return "UNSIGNED TINYINT"
+		}
+		return "TINYINT"
+	case mysql.TypeShort:
+		if unsigned {
+			return "UNSIGNED SMALLINT"
+		}
+		return "SMALLINT"
+	case mysql.TypeInt24:
+		return "MEDIUMINT"
+	case mysql.TypeLong:
+		if unsigned {
+			return "UNSIGNED INT"
+		}
+		return "INT"
+	case mysql.TypeLonglong:
+		if unsigned {
+			return "UNSIGNED BIGINT"
+		}
+		return "BIGINT"
+	case mysql.TypeYear:
+		return "YEAR"
+	case mysql.TypeFloat:
+		return "FLOAT"
+	case mysql.TypeDouble:
+		return "DOUBLE"
+	case mysql.TypeNewDecimal:
+		return "DECIMAL"
+	case mysql.TypeDate, mysql.TypeNewDate:
+		return "DATE"
+	case mysql.TypeDatetime:
+		return "DATETIME"
+	case mysql.TypeTimestamp:
+		return "TIMESTAMP"
+	case mysql.TypeDuration:
+		return "TIME"
+	case mysql.TypeVarchar, mysql.TypeVarString:
+		if binary {
+			return "VARBINARY"
+		}
+		return "VARCHAR"
+	case mysql.TypeString:
+		if binary {
+			return "BINARY"
+		}
+		return "CHAR"
+	case mysql.TypeTinyBlob, mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		if binary {
+			return "BLOB"
+		}
+		return "TEXT"
+	case mysql.TypeEnum:
+		return "ENUM"
+	case mysql.TypeSet:
+		return "SET"
+	case mysql.TypeJSON:
+		return "JSON"
+	case mysql.TypeBit:
+		return "BIT"
+	case mysql.TypeGeometry:
+		return "GEOMETRY"
+	default:
+		// the other types, such as vector, are written as the raw text.
+		return ""
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"fmt"
+
+	"github.com/pingcap/ticdc/pkg/cloudstorage"
+	commonType "github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/tidb/pkg/dumpformat/parquetfile"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+)
+
+// IcebergColumns returns the iceberg columns of the parquet file encoded from
+// the buffered data, the type of each column matches the physical and logical
+// type which the file encoder writes for it.
+func IcebergColumns(tableInfo *commonType.TableInfo, data []byte) ([]cloudstorage.IcebergColumn, error) {
+	names, _, err := decodeColumnNames(data)
+	if err != nil {
+		return nil, err
+	}
+	infos, err := newColumnInfos(tableInfo, names)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]cloudstorage.IcebergColumn, 0, len(infos))
+	for _, info := range infos {
+		column := cloudstorage.IcebergColumn{
+			Name:     info.Name,
+			Type:     icebergType(info),
+			Required: !info.Nullable,
+		}
+		// the invalid timestamps are written as NULL, so the column is always optional.
+		if column.Type == "timestamp" {
+			column.Required = false
+		}
+		if col, ok := tableInfo.GetColumnInfoByName(info.Name); ok {
+			column.Identifier = mysql.HasPriKeyFlag(col.GetFlag())
+		}
+		columns = append(columns, column)
+	}
+	return columns, nil
+}
+
+// icebergType maps the column to the iceberg type, it must be kept in sync
+// with the parquet type chosen by parquetfile for the database type name.
+func icebergType(info *parquetfile.ColumnInfo) string {
+	switch info.DatabaseTypeName {
+	case "CHAR", "VARCHAR", "TEXT", "DATE", "TIME", "SET", "JSON", "ENUM", "GEOMETRY":
+		return "string"
+	case "BLOB", "BINARY", "VARBINARY", "BIT":
+		return "binary"
+	case "TIMESTAMP", "DATETIME":
+		// the timestamps are written in microseconds without being adjusted to UTC.
+		return "timestamp"
+	case "YEAR", "TINYINT", "SMALLINT", "MEDIUMINT", "UNSIGNED TINYINT", "UNSIGNED SMALLINT", "INT":
+		return "int"
+	case "BIGINT", "UNSIGNED INT":
+		return "long"
+	case "UNSIGNED BIGINT":
+		// written as a fixed length decimal, since it overflows the int64.
+		return "decimal(20, 0)"
+	case "FLOAT", "DOUBLE":
+		// the floats are written as doubles to keep the precision of the values.
+		return "double"
+	case "DECIMAL":
+		// the decimals with a precision larger than 38 are written as strings.
+		if info.Precision <= 0 || info.Precision > 38 {
+			return "string"
+		}
+		return fmt.Sprintf("decimal(%d, %d)", info.Precision, info.Scale)
+	default:
+		return "binary"
+	}
+}
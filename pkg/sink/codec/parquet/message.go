@@ -0,0 +1,234 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	commonType "github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	timodel "github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+const (
+	// OperationColumnName is the name of the meta column which holds the operation type.
+	OperationColumnName = "ticdc_meta_operation"
+	// CommitTsColumnName is the name of the meta column which holds the commit-ts of the txn.
+	CommitTsColumnName = "ticdc_meta_commit_ts"
+)
+
+const (
+	operationInsert = "I"
+	operationUpdate = "U"
+	operationDelete = "D"
+)
+
+// The rows of a data file are buffered in the spool in an intermediate format
+// before the whole file is encoded, since a parquet file can only be written
+// once all of its rows are known.
+//
+// The key of a message holds the names of the encoded table columns:
+// | uvarint count | uvarint len | name | ... |
+// The value of a message holds one or more rows, each row is encoded as:
+// | uvarint count | uvarint len+1 | value | ... |
+// the length of a NULL value is encoded as 0.
+
+func encodeColumnNames(names []string) []byte {
+	buf := binary.AppendUvarint(nil, uint64(len(names)))
+	for _, name := range names {
+		buf = binary.AppendUvarint(buf, uint64(len(name)))
+		buf = append(buf, name...)
+	}
+	return buf
+}
+
+func decodeColumnNames(data []byte) ([]string, []byte, error) {
+	count, data, err := decodeUvarint(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := make([]string, 0, count)
+	for i := uint64(0); i < count; i++ {
+		var length uint64
+		length, data, err = decodeUvarint(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if uint64(len(data)) < length {
+			return nil, nil, errors.ErrParquetEncodeFailed.GenWithStack("column name is truncated")
+		}
+		names = append(names, string(data[:length]))
+		data = data[length:]
+	}
+	return names, data, nil
+}
+
+func encodeRow(buf []byte, values [][]byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(values)))
+	for _, value := range values {
+		if value == nil {
+			buf = binary.AppendUvarint(buf, 0)
+			continue
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(value))+1)
+		buf = append(buf, value...)
+	}
+	return buf
+}
+
+// decodeRow decodes one row from the data, the decoded values refer to the data.
+func decodeRow(data []byte, values [][]byte) ([][]byte, []byte, error) {
+	count, data, err := decodeUvarint(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	values = values[:0]
+	for i := uint64(0); i < count; i++ {
+		var length uint64
+		length, data, err = decodeUvarint(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if length == 0 {
+			values = append(values, nil)
+			continue
+		}
+		length--
+		if uint64(len(data)) < length {
+			return nil, nil, errors.ErrParquetEncodeFailed.GenWithStack("column value is truncated")
+		}
+		values = append(values, data[:length:length])
+		data = data[length:]
+	}
+	return values, data, nil
+}
+
+func decodeUvarint(data []byte) (uint64, []byte, error) {
+	value, n := binary.Uvarint(data)
+	if n <= 0 {
+		return 0, nil, errors.ErrParquetEncodeFailed.GenWithStack("invalid uvarint")
+	}
+	return value, data[n:], nil
+}
+
+// rowEvent2Row converts the row event into a row of the parquet file,
+// an update event is converted into one row with the new values.
+func rowEvent2Row(e *commonEvent.RowEvent, offsets []int, buf []byte) ([]byte, error) {
+	var (
+		op  string
+		row *chunk.Row
+	)
+	switch {
+	case e.IsDelete():
+		op, row = operationDelete, e.GetPreRows()
+	case e.IsInsert():
+		op, row = operationInsert, e.GetRows()
+	default:
+		op, row = operationUpdate, e.GetRows()
+	}
+
+	columns := e.TableInfo.GetColumns()
+	values := make([][]byte, 0, len(offsets)+2)
+	values = append(values, []byte(op), strconv.AppendUint(nil, e.CommitTs, 10))
+	for _, offset := range offsets {
+		value, err := formatColumnValue(row, offset, columns[offset])
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return encodeRow(buf, values), nil
+}
+
+// formatColumnValue formats the column value into the text representation
+// accepted by the parquet writer, the binary values are kept as is.
+func formatColumnValue(row *chunk.Row, idx int, col *timodel.ColumnInfo) ([]byte, error) {
+	if row.IsNull(idx) {
+		return nil, nil
+	}
+
+	switch col.GetType() {
+	case mysql.TypeVarchar, mysql.TypeString, mysql.TypeVarString, mysql.TypeTinyBlob,
+		mysql.TypeMediumBlob, mysql.TypeLongBlob, mysql.TypeBlob:
+		// copy the value, since the row is released after the event is flushed.
+		return append([]byte{}, row.GetBytes(idx)...), nil
+	case mysql.TypeDate, mysql.TypeDatetime, mysql.TypeNewDate, mysql.TypeTimestamp:
+		return []byte(row.GetTime(idx).String()), nil
+	case mysql.TypeDuration:
+		return []byte(row.GetDuration(idx, col.GetDecimal()).String()), nil
+	case mysql.TypeEnum:
+		enumVar, err := types.ParseEnumValue(col.GetElems(), row.GetEnum(idx).Value)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrParquetEncodeFailed, err)
+		}
+		return []byte(enumVar.Name), nil
+	case mysql.TypeSet:
+		setVar, err := types.ParseSetValue(col.GetElems(), row.GetEnum(idx).Value)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrParquetEncodeFailed, err)
+		}
+		return []byte(setVar.Name), nil
+	case mysql.TypeBit:
+		d := row.GetDatum(idx, &col.FieldType)
+		return append([]byte{}, d.GetBinaryLiteral()...), nil
+	case mysql.TypeNewDecimal:
+		return []byte(row.GetMyDecimal(idx).String()), nil
+	case mysql.TypeJSON:
+		return []byte(row.GetJSON(idx).String()), nil
+	case mysql.TypeTiDBVectorFloat32:
+		return []byte(row.GetVectorFloat32(idx).String()), nil
+	case mysql.TypeFloat:
+		return strconv.AppendFloat(nil, float64(row.GetFloat32(idx)), 'g', -1, 32), nil
+	case mysql.TypeDouble:
+		return strconv.AppendFloat(nil, row.GetFloat64(idx), 'g', -1, 64), nil
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeLonglong, mysql.TypeYear:
+		if mysql.HasUnsignedFlag(col.GetFlag()) {
+			return strconv.AppendUint(nil, row.GetUint64(idx), 10), nil
+		}
+		return strconv.AppendInt(nil, row.GetInt64(idx), 10), nil
+	default:
+		d := row.GetDatum(idx, &col.FieldType)
+		value, err := d.ToString()
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrParquetEncodeFailed, err)
+		}
+		return []byte(value), nil
+	}
+}
+
+// selectColumns returns the offsets and names of the columns written to the parquet file.
+func selectColumns(tableInfo *commonType.TableInfo, selector commonEvent.Selector) ([]int, []string) {
+	var (
+		offsets []int
+		names   []string
+	)
+	for idx, col := range tableInfo.GetColumns() {
+		// column could be nil in a condition described in
+		// https://github.com/pingcap/ticdc/issues/6198#issuecomment-1191132951
+		if col == nil || col.IsVirtualGenerated() {
+			continue
+		}
+		if selector != nil && !selector.Select(col) {
+			continue
+		}
+		offsets = append(offsets, idx)
+		names = append(names, col.Name.O)
+	}
+	return offsets, names
+}
@@ -373,7 +373,7 @@ func (a *avroMarshaller) collectColumns(
 			if onlyHandleKey && !tableInfo.IsHandleKey(colInfo.ID) {
 				continue
 			}
-			value, avroType := a.encodeValue4Avro(row, i, &colInfo.FieldType)
+			value, avroType := encodeTypedValue(row, i, &colInfo.FieldType, a.config.TimeZone.String())
 			holder := genericMapPool.Get().(map[string]interface{})
 			holder[avroType] = value
 			result[colInfo.Name.O] = holder
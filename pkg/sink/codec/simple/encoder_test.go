@@ -56,6 +56,7 @@ func TestEncodeCheckpoint(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 
@@ -101,6 +102,7 @@ func TestEncodeDMLEnableChecksum(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 		for _, compressionType := range []string{
@@ -201,6 +203,7 @@ func TestEncodeRoutedEventsUsesTargetNames(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatJSON,
 		common.EncodingFormatAvro,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig := common.NewConfig(config.ProtocolSimple)
 		codecConfig.EncodingFormat = format
@@ -279,6 +282,7 @@ func TestE2EPartitionTable(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatJSON,
 		common.EncodingFormatAvro,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 		enc, err := NewEncoder(codecConfig, nil)
@@ -419,6 +423,7 @@ func TestEncodeDDLSequence(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 		for _, compressionType := range []string{
@@ -854,6 +859,7 @@ func TestEncodeDDLEvent(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 		for _, compressionType := range []string{
@@ -1007,6 +1013,7 @@ func TestColumnFlags(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 		enc, err := NewEncoder(codecConfig, nil)
@@ -1086,6 +1093,7 @@ func TestEncodeIntegerTypes(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		minValues.Rewind()
 		maxValues.Rewind()
@@ -1166,6 +1174,7 @@ func TestEncoderOtherTypes(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		event.Rewind()
 		codecConfig.EncodingFormat = format
@@ -1244,6 +1253,7 @@ func TestE2EPartitionTableDMLBeforeDDL(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatJSON,
 		common.EncodingFormatAvro,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 		enc, err := NewEncoder(codecConfig, nil)
@@ -1390,6 +1400,7 @@ func TestEncodeBootstrapEvent(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 		for _, compressionType := range []string{
@@ -1467,6 +1478,7 @@ func TestEncodeLargeEventsNormal(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatJSON,
 		common.EncodingFormatAvro,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 		for _, compressionType := range []string{
@@ -1556,6 +1568,7 @@ func TestDDLMessageTooLarge(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 		enc, err := NewEncoder(codecConfig, nil)
@@ -1578,6 +1591,7 @@ func TestDMLMessageTooLarge(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 
@@ -1628,6 +1642,7 @@ func TestLargerMessageHandleClaimCheck(t *testing.T) {
 		for _, format := range []common.EncodingFormatType{
 			common.EncodingFormatAvro,
 			common.EncodingFormatJSON,
+			common.EncodingFormatProtobuf,
 		} {
 			codecConfig.EncodingFormat = format
 			for _, compressionType := range []string{
@@ -1708,6 +1723,7 @@ func TestLargeMessageHandleKeyOnly(t *testing.T) {
 	for _, format := range []common.EncodingFormatType{
 		common.EncodingFormatAvro,
 		common.EncodingFormatJSON,
+		common.EncodingFormatProtobuf,
 	} {
 		codecConfig.EncodingFormat = format
 		for _, compressionType := range []string{
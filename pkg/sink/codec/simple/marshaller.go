@@ -51,6 +51,8 @@ func newMarshaller(config *common.Config) (marshaller, error) {
 		result = newJSONMarshaller(config)
 	case common.EncodingFormatAvro:
 		result, err = newAvroMarshaller(config, string(avroSchemaBytes))
+	case common.EncodingFormatProtobuf:
+		result = newProtobufMarshaller(config)
 	}
 	return result, errors.Trace(err)
 }
@@ -89,7 +91,7 @@ func (m *jsonMarshaller) MarshalRowChangedEvent(
 	event *commonEvent.RowEvent,
 	handleKeyOnly bool, claimCheckFileName string,
 ) ([]byte, error) {
-	msg := m.newDMLMessage(event, handleKeyOnly, claimCheckFileName)
+	msg := newDMLMessage(m.config, event, handleKeyOnly, claimCheckFileName, m.formatColumns)
 	value, err := json.Marshal(msg)
 	return value, errors.WrapError(errors.ErrEncodeFailed, err)
 }
@@ -63,3 +63,73 @@ func BenchmarkMarshalRowChangedEvent(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkMarshalRowChangedEventByFormat compares the encoding formats of the
+// simple protocol, the size of the encoded message is reported as bytes/msg.
+func BenchmarkMarshalRowChangedEventByFormat(b *testing.B) {
+	rowEvent := eventGenerator()
+	if rowEvent == nil {
+		panic(errors.New("event is nil"))
+	}
+	for _, format := range []common.EncodingFormatType{
+		common.EncodingFormatJSON,
+		common.EncodingFormatAvro,
+		common.EncodingFormatProtobuf,
+	} {
+		b.Run(string(format), func(b *testing.B) {
+			codecConfig := common.NewConfig(config.ProtocolSimple)
+			codecConfig.EncodingFormat = format
+			m, err := newMarshaller(codecConfig)
+			if err != nil {
+				panic(err)
+			}
+
+			var size int
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				value, err := m.MarshalRowChangedEvent(rowEvent, false, "")
+				if err != nil {
+					panic(errors.Trace(err))
+				}
+				size = len(value)
+			}
+			b.ReportMetric(float64(size), "bytes/msg")
+		})
+	}
+}
+
+// BenchmarkUnmarshalRowChangedEventByFormat compares the decoding of the
+// encoding formats of the simple protocol.
+func BenchmarkUnmarshalRowChangedEventByFormat(b *testing.B) {
+	rowEvent := eventGenerator()
+	if rowEvent == nil {
+		panic(errors.New("event is nil"))
+	}
+	for _, format := range []common.EncodingFormatType{
+		common.EncodingFormatJSON,
+		common.EncodingFormatAvro,
+		common.EncodingFormatProtobuf,
+	} {
+		b.Run(string(format), func(b *testing.B) {
+			codecConfig := common.NewConfig(config.ProtocolSimple)
+			codecConfig.EncodingFormat = format
+			m, err := newMarshaller(codecConfig)
+			if err != nil {
+				panic(err)
+			}
+			value, err := m.MarshalRowChangedEvent(rowEvent, false, "")
+			if err != nil {
+				panic(errors.Trace(err))
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err = m.Unmarshal(value, new(message)); err != nil {
+					panic(errors.Trace(err))
+				}
+			}
+		})
+	}
+}
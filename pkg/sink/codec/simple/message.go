@@ -317,9 +317,18 @@ func newDDLMessage(ddl *commonEvent.DDLEvent) *message {
 	return msg
 }
 
-func (a *jsonMarshaller) newDMLMessage(
+// columnsFormatter collects the values of the columns in the row.
+type columnsFormatter func(
+	row *chunk.Row, tableInfo *commonType.TableInfo, onlyHandleKey bool, columnSelector commonEvent.Selector,
+) map[string]interface{}
+
+// newDMLMessage creates the DML message, the column values are collected by
+// the formatColumns, since each encoding format has its own value representation.
+func newDMLMessage(
+	config *common.Config,
 	event *commonEvent.RowEvent,
 	onlyHandleKey bool, claimCheckFileName string,
+	formatColumns columnsFormatter,
 ) *message {
 	m := &message{
 		Version:            defaultVersion,
@@ -334,16 +343,16 @@ func (a *jsonMarshaller) newDMLMessage(
 	}
 	if event.IsInsert() {
 		m.Type = DMLTypeInsert
-		m.Data = a.formatColumns(event.GetRows(), event.TableInfo, onlyHandleKey, event.ColumnSelector)
+		m.Data = formatColumns(event.GetRows(), event.TableInfo, onlyHandleKey, event.ColumnSelector)
 	} else if event.IsDelete() {
 		m.Type = DMLTypeDelete
-		m.Old = a.formatColumns(event.GetPreRows(), event.TableInfo, onlyHandleKey, event.ColumnSelector)
+		m.Old = formatColumns(event.GetPreRows(), event.TableInfo, onlyHandleKey, event.ColumnSelector)
 	} else if event.IsUpdate() {
 		m.Type = DMLTypeUpdate
-		m.Data = a.formatColumns(event.GetRows(), event.TableInfo, onlyHandleKey, event.ColumnSelector)
-		m.Old = a.formatColumns(event.GetPreRows(), event.TableInfo, onlyHandleKey, event.ColumnSelector)
+		m.Data = formatColumns(event.GetRows(), event.TableInfo, onlyHandleKey, event.ColumnSelector)
+		m.Old = formatColumns(event.GetPreRows(), event.TableInfo, onlyHandleKey, event.ColumnSelector)
 	}
-	if a.config.EnableRowChecksum && event.Checksum != nil {
+	if config.EnableRowChecksum && event.Checksum != nil {
 		m.Checksum = &checksum{
 			Version:   event.Checksum.Version,
 			Corrupted: event.Checksum.Corrupted,
@@ -374,7 +383,9 @@ func (a *jsonMarshaller) formatColumns(
 	return result
 }
 
-func (a *avroMarshaller) encodeValue4Avro(row *chunk.Row, i int, ft *types.FieldType) (interface{}, string) {
+// encodeTypedValue returns the value of the column in its native type, along
+// with the name of the avro type. It's shared by the avro and protobuf formats.
+func encodeTypedValue(row *chunk.Row, i int, ft *types.FieldType, location string) (interface{}, string) {
 	if row.IsNull(i) {
 		return nil, "null"
 	}
@@ -382,7 +393,7 @@ func (a *avroMarshaller) encodeValue4Avro(row *chunk.Row, i int, ft *types.Field
 	switch ft.GetType() {
 	case mysql.TypeTimestamp:
 		return map[string]interface{}{
-			"location": a.config.TimeZone.String(),
+			"location": location,
 			"value":    d.GetMysqlTime().String(),
 		}, "com.pingcap.simple.avro.Timestamp"
 	case mysql.TypeLonglong:
@@ -0,0 +1,646 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simple
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	commonType "github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/sink/codec/common"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	ptypes "github.com/pingcap/tidb/pkg/parser/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The protobuf format encodes the messages according to message.proto. The wire
+// format is written directly, so the messages built for the json format are
+// reused, and the typed values shared with the avro format are kept in the rows.
+
+// protobufMessageTypes is indexed by the MessageType enum in message.proto.
+var protobufMessageTypes = []MessageType{
+	"", MessageTypeWatermark, MessageTypeBootstrap, MessageTypeDDL, MessageTypeDML,
+}
+
+// protobufDDLTypes is indexed by the DDLType enum in message.proto.
+var protobufDDLTypes = []MessageType{
+	"", DDLTypeCreate, DDLTypeAlter, DDLTypeErase, DDLTypeRename,
+	DDLTypeTruncate, DDLTypeCIndex, DDLTypeDIndex, DDLTypeQuery,
+}
+
+// protobufDMLTypes is indexed by the DMLType enum in message.proto.
+var protobufDMLTypes = []MessageType{
+	"", DMLTypeInsert, DMLTypeUpdate, DMLTypeDelete,
+}
+
+type protobufMarshaller struct {
+	config *common.Config
+}
+
+func newProtobufMarshaller(config *common.Config) *protobufMarshaller {
+	return &protobufMarshaller{
+		config: config,
+	}
+}
+
+// MarshalCheckpoint implement the marshaller interface
+func (m *protobufMarshaller) MarshalCheckpoint(ts uint64) ([]byte, error) {
+	return appendProtobufMessage(nil, newResolvedMessage(ts))
+}
+
+// MarshalDDLEvent implement the marshaller interface
+func (m *protobufMarshaller) MarshalDDLEvent(event *commonEvent.DDLEvent) ([]byte, error) {
+	var msg *message
+	if event.IsBootstrap {
+		msg = newBootstrapMessage(event.TableInfo)
+	} else {
+		msg = newDDLMessage(event)
+	}
+	return appendProtobufMessage(nil, msg)
+}
+
+// MarshalRowChangedEvent implement the marshaller interface
+func (m *protobufMarshaller) MarshalRowChangedEvent(
+	event *commonEvent.RowEvent,
+	handleKeyOnly bool, claimCheckFileName string,
+) ([]byte, error) {
+	msg := newDMLMessage(m.config, event, handleKeyOnly, claimCheckFileName, m.collectColumns)
+	return appendProtobufMessage(nil, msg)
+}
+
+// Unmarshal implement the marshaller interface
+func (m *protobufMarshaller) Unmarshal(data []byte, v any) error {
+	return errors.Trace(decodeProtobufMessage(data, v.(*message)))
+}
+
+func (m *protobufMarshaller) collectColumns(
+	row *chunk.Row, tableInfo *commonType.TableInfo, onlyHandleKey bool, columnSelector commonEvent.Selector,
+) map[string]interface{} {
+	colInfos := tableInfo.GetColumns()
+	result := make(map[string]interface{}, len(colInfos))
+	for i, colInfo := range colInfos {
+		if colInfo != nil {
+			if colInfo.IsVirtualGenerated() || !columnSelector.Select(colInfo) {
+				continue
+			}
+			if onlyHandleKey && !tableInfo.IsHandleKey(colInfo.ID) {
+				continue
+			}
+			value, _ := encodeTypedValue(row, i, &colInfo.FieldType, m.config.TimeZone.String())
+			result[colInfo.Name.O] = value
+		}
+	}
+	return result
+}
+
+func appendProtobufMessage(b []byte, m *message) ([]byte, error) {
+	switch {
+	case m.Type == MessageTypeWatermark:
+		b = appendEnum(b, 1, protobufMessageTypes, MessageTypeWatermark)
+		b = appendEmbedded(b, 2, func(b []byte) []byte {
+			b = appendVarint(b, 1, uint64(m.Version))
+			b = appendVarint(b, 2, m.CommitTs)
+			return appendVarint(b, 3, uint64(m.BuildTs))
+		})
+	case m.Type == MessageTypeBootstrap:
+		b = appendEnum(b, 1, protobufMessageTypes, MessageTypeBootstrap)
+		b = appendEmbedded(b, 3, func(b []byte) []byte {
+			b = appendVarint(b, 1, uint64(m.Version))
+			b = appendVarint(b, 2, uint64(m.BuildTs))
+			return appendTableSchema(b, 3, m.TableSchema)
+		})
+	case slices.Contains(protobufDDLTypes[1:], m.Type):
+		b = appendEnum(b, 1, protobufMessageTypes, MessageTypeDDL)
+		b = appendEmbedded(b, 4, func(b []byte) []byte {
+			b = appendVarint(b, 1, uint64(m.Version))
+			b = appendEnum(b, 2, protobufDDLTypes, m.Type)
+			b = appendString(b, 3, m.SQL)
+			b = appendVarint(b, 4, m.CommitTs)
+			b = appendVarint(b, 5, uint64(m.BuildTs))
+			b = appendTableSchema(b, 6, m.TableSchema)
+			return appendTableSchema(b, 7, m.PreTableSchema)
+		})
+	case slices.Contains(protobufDMLTypes[1:], m.Type):
+		b = appendEnum(b, 1, protobufMessageTypes, MessageTypeDML)
+		b = appendEmbedded(b, 5, func(b []byte) []byte {
+			b = appendVarint(b, 1, uint64(m.Version))
+			b = appendEnum(b, 2, protobufDMLTypes, m.Type)
+			b = appendString(b, 3, m.Schema)
+			b = appendString(b, 4, m.Table)
+			b = appendVarint(b, 5, uint64(m.TableID))
+			b = appendVarint(b, 6, m.CommitTs)
+			b = appendVarint(b, 7, uint64(m.BuildTs))
+			b = appendVarint(b, 8, m.SchemaVersion)
+			b = appendString(b, 9, m.ClaimCheckLocation)
+			b = appendBool(b, 10, m.HandleKeyOnly)
+			if m.Checksum != nil {
+				b = appendEmbedded(b, 11, func(b []byte) []byte {
+					b = appendVarint(b, 1, uint64(m.Checksum.Version))
+					b = appendBool(b, 2, m.Checksum.Corrupted)
+					b = appendVarint(b, 3, uint64(m.Checksum.Current))
+					return appendVarint(b, 4, uint64(m.Checksum.Previous))
+				})
+			}
+			b = appendValueMap(b, 12, m.Data)
+			return appendValueMap(b, 13, m.Old)
+		})
+	default:
+		return nil, errors.ErrEncodeFailed.GenWithStack("unknown message type %s", m.Type)
+	}
+	return b, nil
+}
+
+func appendTableSchema(b []byte, num protowire.Number, schema *TableSchema) []byte {
+	if schema == nil {
+		return b
+	}
+	return appendEmbedded(b, num, func(b []byte) []byte {
+		b = appendString(b, 1, schema.Schema)
+		b = appendString(b, 2, schema.Table)
+		b = appendVarint(b, 3, uint64(schema.TableID))
+		b = appendVarint(b, 4, schema.Version)
+		for _, col := range schema.Columns {
+			b = appendEmbedded(b, 5, func(b []byte) []byte {
+				b = appendString(b, 1, col.Name)
+				b = appendEmbedded(b, 2, func(b []byte) []byte {
+					b = appendString(b, 1, col.DataType.MySQLType)
+					b = appendString(b, 2, col.DataType.Charset)
+					b = appendString(b, 3, col.DataType.Collate)
+					b = appendVarint(b, 4, uint64(col.DataType.Length))
+					b = appendVarint(b, 5, uint64(col.DataType.Decimal))
+					for _, element := range col.DataType.Elements {
+						b = protowire.AppendTag(b, 6, protowire.BytesType)
+						b = protowire.AppendString(b, element)
+					}
+					b = appendBool(b, 7, col.DataType.Unsigned)
+					return appendBool(b, 8, col.DataType.Zerofill)
+				})
+				b = appendBool(b, 3, col.Nullable)
+				if col.Default != nil {
+					// the default value is optional, so it's written even if it's empty.
+					var value string
+					switch v := col.Default.(type) {
+					case string:
+						value = v
+					case uint64:
+						value = strconv.FormatUint(v, 10)
+					default:
+						value = fmt.Sprintf("%v", v)
+					}
+					b = protowire.AppendTag(b, 4, protowire.BytesType)
+					b = protowire.AppendString(b, value)
+				}
+				return b
+			})
+		}
+		for _, index := range schema.Indexes {
+			b = appendEmbedded(b, 6, func(b []byte) []byte {
+				b = appendString(b, 1, index.Name)
+				b = appendBool(b, 2, index.Unique)
+				b = appendBool(b, 3, index.Primary)
+				b = appendBool(b, 4, index.Nullable)
+				for _, col := range index.Columns {
+					b = protowire.AppendTag(b, 5, protowire.BytesType)
+					b = protowire.AppendString(b, col)
+				}
+				return b
+			})
+		}
+		return b
+	})
+}
+
+func appendValueMap(b []byte, num protowire.Number, values map[string]interface{}) []byte {
+	for name, value := range values {
+		b = appendEmbedded(b, num, func(b []byte) []byte {
+			b = appendString(b, 1, name)
+			// the value is always written, an empty value is NULL.
+			return appendEmbedded(b, 2, func(b []byte) []byte {
+				return appendValue(b, value)
+			})
+		})
+	}
+	return b
+}
+
+// appendValue appends the value returned by encodeTypedValue, the fields of
+// the oneof are written even if they are zero.
+func appendValue(b []byte, value interface{}) []byte {
+	switch v := value.(type) {
+	case nil:
+	case int64:
+		b = protowire.AppendTag(b, 1, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(v))
+	case float32:
+		b = protowire.AppendTag(b, 2, protowire.Fixed32Type)
+		b = protowire.AppendFixed32(b, math.Float32bits(v))
+	case float64:
+		b = protowire.AppendTag(b, 3, protowire.Fixed64Type)
+		b = protowire.AppendFixed64(b, math.Float64bits(v))
+	case string:
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendString(b, v)
+	case []byte:
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		b = protowire.AppendBytes(b, v)
+	case map[string]interface{}:
+		if location, ok := v["location"]; ok {
+			b = appendEmbedded(b, 6, func(b []byte) []byte {
+				b = appendString(b, 1, location.(string))
+				return appendString(b, 2, v["value"].(string))
+			})
+		} else {
+			b = protowire.AppendTag(b, 7, protowire.VarintType)
+			b = protowire.AppendVarint(b, uint64(v["value"].(int64)))
+		}
+	default:
+		b = protowire.AppendTag(b, 4, protowire.BytesType)
+		b = protowire.AppendString(b, fmt.Sprintf("%v", v))
+	}
+	return b
+}
+
+func appendEmbedded(b []byte, num protowire.Number, fn func([]byte) []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, fn(nil))
+}
+
+func appendEnum(b []byte, num protowire.Number, values []MessageType, value MessageType) []byte {
+	return appendVarint(b, num, uint64(slices.Index(values, value)))
+}
+
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendBool(b []byte, num protowire.Number, v bool) []byte {
+	return appendVarint(b, num, protowire.EncodeBool(v))
+}
+
+func appendString(b []byte, num protowire.Number, v string) []byte {
+	if v == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, v)
+}
+
+// protobufField is a field consumed from the wire, the value of the varint and
+// fixed fields is kept in number, and the length delimited one is kept in bytes.
+type protobufField struct {
+	num    protowire.Number
+	number uint64
+	bytes  []byte
+}
+
+// rangeFields calls fn for each field in b, the unknown fields are ignored by fn.
+func rangeFields(b []byte, fn func(f *protobufField) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return errors.WrapError(errors.ErrDecodeFailed, protowire.ParseError(n))
+		}
+		b = b[n:]
+		f := protobufField{num: num}
+		switch typ {
+		case protowire.VarintType:
+			f.number, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			f.number = uint64(v)
+		case protowire.Fixed64Type:
+			f.number, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return errors.WrapError(errors.ErrDecodeFailed, protowire.ParseError(n))
+		}
+		b = b[n:]
+		if err := fn(&f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeEnum(values []MessageType, v uint64) (MessageType, error) {
+	if v == 0 || v >= uint64(len(values)) {
+		return "", errors.ErrDecodeFailed.GenWithStack("unknown enum value %d", v)
+	}
+	return values[v], nil
+}
+
+func decodeProtobufMessage(data []byte, m *message) error {
+	return rangeFields(data, func(f *protobufField) error {
+		switch f.num {
+		case 2:
+			m.Type = MessageTypeWatermark
+			return rangeFields(f.bytes, func(f *protobufField) error {
+				switch f.num {
+				case 1:
+					m.Version = int(int32(f.number))
+				case 2:
+					m.CommitTs = f.number
+				case 3:
+					m.BuildTs = int64(f.number)
+				}
+				return nil
+			})
+		case 3:
+			m.Type = MessageTypeBootstrap
+			return rangeFields(f.bytes, func(f *protobufField) error {
+				var err error
+				switch f.num {
+				case 1:
+					m.Version = int(int32(f.number))
+				case 2:
+					m.BuildTs = int64(f.number)
+				case 3:
+					m.TableSchema, err = decodeTableSchema(f.bytes)
+				}
+				return err
+			})
+		case 4:
+			return decodeDDLMessage(f.bytes, m)
+		case 5:
+			return decodeDMLMessage(f.bytes, m)
+		}
+		return nil
+	})
+}
+
+func decodeDDLMessage(data []byte, m *message) error {
+	// the type is required, QUERY is the fallback of the unknown DDLs.
+	m.Type = DDLTypeQuery
+	return rangeFields(data, func(f *protobufField) error {
+		var err error
+		switch f.num {
+		case 1:
+			m.Version = int(int32(f.number))
+		case 2:
+			m.Type, err = decodeEnum(protobufDDLTypes, f.number)
+		case 3:
+			m.SQL = string(f.bytes)
+		case 4:
+			m.CommitTs = f.number
+		case 5:
+			m.BuildTs = int64(f.number)
+		case 6:
+			m.TableSchema, err = decodeTableSchema(f.bytes)
+		case 7:
+			m.PreTableSchema, err = decodeTableSchema(f.bytes)
+		}
+		return err
+	})
+}
+
+func decodeDMLMessage(b []byte, m *message) error {
+	var (
+		data = make(map[string]interface{})
+		old  = make(map[string]interface{})
+	)
+	err := rangeFields(b, func(f *protobufField) error {
+		var err error
+		switch f.num {
+		case 1:
+			m.Version = int(int32(f.number))
+		case 2:
+			m.Type, err = decodeEnum(protobufDMLTypes, f.number)
+		case 3:
+			m.Schema = string(f.bytes)
+		case 4:
+			m.Table = string(f.bytes)
+		case 5:
+			m.TableID = int64(f.number)
+		case 6:
+			m.CommitTs = f.number
+		case 7:
+			m.BuildTs = int64(f.number)
+		case 8:
+			m.SchemaVersion = f.number
+		case 9:
+			m.ClaimCheckLocation = string(f.bytes)
+		case 10:
+			m.HandleKeyOnly = protowire.DecodeBool(f.number)
+		case 11:
+			m.Checksum = &checksum{}
+			err = rangeFields(f.bytes, func(f *protobufField) error {
+				switch f.num {
+				case 1:
+					m.Checksum.Version = int(int32(f.number))
+				case 2:
+					m.Checksum.Corrupted = protowire.DecodeBool(f.number)
+				case 3:
+					m.Checksum.Current = uint32(f.number)
+				case 4:
+					m.Checksum.Previous = uint32(f.number)
+				}
+				return nil
+			})
+		case 12:
+			err = decodeValueMapEntry(f.bytes, data)
+		case 13:
+			err = decodeValueMapEntry(f.bytes, old)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	// the empty maps are not written, so they are set according to the type,
+	// which is the same as the other formats.
+	switch m.Type {
+	case DMLTypeInsert:
+		m.Data = data
+	case DMLTypeDelete:
+		m.Old = old
+	case DMLTypeUpdate:
+		m.Data, m.Old = data, old
+	default:
+		return errors.ErrDecodeFailed.GenWithStack("the type of the dml message is missing")
+	}
+	return nil
+}
+
+func decodeValueMapEntry(data []byte, values map[string]interface{}) error {
+	var (
+		name  string
+		value interface{}
+	)
+	err := rangeFields(data, func(f *protobufField) error {
+		var err error
+		switch f.num {
+		case 1:
+			name = string(f.bytes)
+		case 2:
+			value, err = decodeValue(f.bytes)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	values[name] = value
+	return nil
+}
+
+// decodeValue decodes the value into the same types as the avro format.
+func decodeValue(data []byte) (interface{}, error) {
+	var value interface{}
+	err := rangeFields(data, func(f *protobufField) error {
+		switch f.num {
+		case 1:
+			value = int64(f.number)
+		case 2:
+			value = math.Float32frombits(uint32(f.number))
+		case 3:
+			value = math.Float64frombits(f.number)
+		case 4:
+			value = string(f.bytes)
+		case 5:
+			value = bytes.Clone(f.bytes)
+		case 6:
+			timestamp := make(map[string]interface{}, 2)
+			timestamp["location"], timestamp["value"] = "", ""
+			value = timestamp
+			return rangeFields(f.bytes, func(f *protobufField) error {
+				switch f.num {
+				case 1:
+					timestamp["location"] = string(f.bytes)
+				case 2:
+					timestamp["value"] = string(f.bytes)
+				}
+				return nil
+			})
+		case 7:
+			value = map[string]interface{}{
+				"value": int64(f.number),
+			}
+		}
+		return nil
+	})
+	return value, err
+}
+
+func decodeTableSchema(data []byte) (*TableSchema, error) {
+	schema := &TableSchema{
+		Columns: make([]*columnSchema, 0),
+		Indexes: make([]*IndexSchema, 0),
+	}
+	err := rangeFields(data, func(f *protobufField) error {
+		switch f.num {
+		case 1:
+			schema.Schema = string(f.bytes)
+		case 2:
+			schema.Table = string(f.bytes)
+		case 3:
+			schema.TableID = int64(f.number)
+		case 4:
+			schema.Version = f.number
+		case 5:
+			col, err := decodeColumnSchema(f.bytes)
+			if err != nil {
+				return err
+			}
+			schema.Columns = append(schema.Columns, col)
+		case 6:
+			index := &IndexSchema{}
+			err := rangeFields(f.bytes, func(f *protobufField) error {
+				switch f.num {
+				case 1:
+					index.Name = string(f.bytes)
+				case 2:
+					index.Unique = protowire.DecodeBool(f.number)
+				case 3:
+					index.Primary = protowire.DecodeBool(f.number)
+				case 4:
+					index.Nullable = protowire.DecodeBool(f.number)
+				case 5:
+					index.Columns = append(index.Columns, string(f.bytes))
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			schema.Indexes = append(schema.Indexes, index)
+		}
+		return nil
+	})
+	return schema, err
+}
+
+func decodeColumnSchema(data []byte) (*columnSchema, error) {
+	col := &columnSchema{}
+	err := rangeFields(data, func(f *protobufField) error {
+		switch f.num {
+		case 1:
+			col.Name = string(f.bytes)
+		case 2:
+			return rangeFields(f.bytes, func(f *protobufField) error {
+				switch f.num {
+				case 1:
+					col.DataType.MySQLType = string(f.bytes)
+				case 2:
+					col.DataType.Charset = string(f.bytes)
+				case 3:
+					col.DataType.Collate = string(f.bytes)
+				case 4:
+					col.DataType.Length = int(int64(f.number))
+				case 5:
+					col.DataType.Decimal = int(int32(f.number))
+				case 6:
+					col.DataType.Elements = append(col.DataType.Elements, string(f.bytes))
+				case 7:
+					col.DataType.Unsigned = protowire.DecodeBool(f.number)
+				case 8:
+					col.DataType.Zerofill = protowire.DecodeBool(f.number)
+				}
+				return nil
+			})
+		case 3:
+			col.Nullable = protowire.DecodeBool(f.number)
+		case 4:
+			col.Default = string(f.bytes)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the default value of the bit column is the integer, decode it to the
+	// float64 as the json format does, see newTiColumnInfo.
+	if col.Default != nil && ptypes.StrToType(col.DataType.MySQLType) == mysql.TypeBit {
+		v, err := strconv.ParseUint(col.Default.(string), 10, 64)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrDecodeFailed, err)
+		}
+		col.Default = float64(v)
+	}
+	return col, nil
+}
@@ -16,6 +16,7 @@ package kafka
 import (
 	"context"
 
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
 	"github.com/pingcap/ticdc/pkg/sink/codec/common"
 )
 
@@ -67,4 +68,13 @@ type AsyncProducer interface {
 	// and run tha attached callback. the caller should call this
 	// method in a background goroutine
 	AsyncRunCallback(ctx context.Context) error
+
+	// SetRejectedMessageHandler sets the handler of the messages rejected by
+	// the brokers, it should be called before AsyncRunCallback. A rejected
+	// message fails the producer if the handler is not set.
+	SetRejectedMessageHandler(handler RejectedMessageHandler)
 }
+
+// RejectedMessageHandler handles the row events of a message rejected by the
+// brokers, the message is acknowledged if it returns nil.
+type RejectedMessageHandler func(events []*commonEvent.RowEvent, err error) error
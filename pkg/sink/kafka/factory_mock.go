@@ -219,3 +219,15 @@ func (mr *MockAsyncProducerMockRecorder) Close() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAsyncProducer)(nil).Close))
 }
+
+// SetRejectedMessageHandler mocks base method.
+func (m *MockAsyncProducer) SetRejectedMessageHandler(handler RejectedMessageHandler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRejectedMessageHandler", handler)
+}
+
+// SetRejectedMessageHandler indicates an expected call of SetRejectedMessageHandler.
+func (mr *MockAsyncProducerMockRecorder) SetRejectedMessageHandler(handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRejectedMessageHandler", reflect.TypeOf((*MockAsyncProducer)(nil).SetRejectedMessageHandler), handler)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/pingcap/log"
 	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
 	"github.com/pingcap/ticdc/pkg/errors"
 	codecCommon "github.com/pingcap/ticdc/pkg/sink/codec/common"
 	"go.uber.org/atomic"
@@ -31,12 +32,16 @@ type saramaAsyncProducer struct {
 	producer     sarama.AsyncProducer
 	changefeedID common.ChangeFeedID
 
+	// rejectedHandler is nil if the rejected messages fail the producer.
+	rejectedHandler RejectedMessageHandler
+
 	closed *atomic.Bool
 }
 
 type messageMetadata struct {
-	callback func()
-	logInfo  *codecCommon.MessageLogInfo
+	callback  func()
+	logInfo   *codecCommon.MessageLogInfo
+	rowEvents []*commonEvent.RowEvent
 }
 
 func (p *saramaAsyncProducer) Close() {
@@ -119,11 +124,59 @@ func (p *saramaAsyncProducer) AsyncRunCallback(
 			if err == nil {
 				return nil
 			}
+			handled, handleErr := p.handleRejectedMessage(err)
+			if handleErr != nil {
+				return handleErr
+			}
+			if handled {
+				continue
+			}
 			return p.handleProducerError(err)
 		}
 	}
 }
 
+// SetRejectedMessageHandler implements the AsyncProducer interface.
+func (p *saramaAsyncProducer) SetRejectedMessageHandler(handler RejectedMessageHandler) {
+	p.rejectedHandler = handler
+}
+
+// handleRejectedMessage passes the row events of the message rejected by the
+// brokers to the rejected message handler, and acknowledges the message once
+// they are handled. It returns false if the message is not handled.
+func (p *saramaAsyncProducer) handleRejectedMessage(err *sarama.ProducerError) (bool, error) {
+	if p.rejectedHandler == nil || !IsMessageRejected(err.Err) || err.Msg == nil {
+		return false, nil
+	}
+	meta, ok := err.Msg.Metadata.(*messageMetadata)
+	if !ok || meta == nil || len(meta.rowEvents) == 0 {
+		return false, nil
+	}
+	log.Warn("kafka message rejected by the brokers",
+		zap.String("keyspace", p.changefeedID.Keyspace()),
+		zap.String("changefeed", p.changefeedID.Name()),
+		zap.String("eventContext", BuildEventLogContext(
+			p.changefeedID.Keyspace(), p.changefeedID.Name(), meta.logInfo)),
+		zap.Error(err.Err))
+	if handleErr := p.rejectedHandler(meta.rowEvents, err.Err); handleErr != nil {
+		return false, errors.Trace(handleErr)
+	}
+	if meta.callback != nil {
+		meta.callback()
+	}
+	return true, nil
+}
+
+// IsMessageRejected returns whether the error means the message is rejected
+// by the brokers, such a message fails again however many times it's sent.
+func IsMessageRejected(err error) bool {
+	switch errors.Cause(err) {
+	case sarama.ErrMessageSizeTooLarge, sarama.ErrInvalidMessage:
+		return true
+	}
+	return false
+}
+
 func (p *saramaAsyncProducer) handleProducerError(err *sarama.ProducerError) error {
 	log.Error("kafka message send failed",
 		zap.String("keyspace", p.changefeedID.Keyspace()),
@@ -143,8 +196,9 @@ func (p *saramaAsyncProducer) AsyncSend(
 		return errors.ErrKafkaSinkClosed.GenWithStackByArgs()
 	}
 	meta := &messageMetadata{
-		callback: message.Callback,
-		logInfo:  message.LogInfo,
+		callback:  message.Callback,
+		logInfo:   message.LogInfo,
+		rowEvents: message.RowEvents,
 	}
 	msg := &sarama.ProducerMessage{
 		Topic:     topic,
@@ -22,6 +22,7 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/golang/mock/gomock"
 	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
 	"github.com/pingcap/ticdc/pkg/errors"
 	codecCommon "github.com/pingcap/ticdc/pkg/sink/codec/common"
 	"github.com/stretchr/testify/require"
@@ -144,3 +145,43 @@ func requireKafkaSendError(t *testing.T, err, cause error) {
 	require.Equal(t, 1, strings.Count(err.Error(), string(errors.ErrKafkaSendMessage.RFCCode())))
 	require.NotContains(t, err.Error(), "keyspace=test")
 }
+
+func TestAsyncProducerHandleRejectedMessage(t *testing.T) {
+	t.Parallel()
+
+	events := []*commonEvent.RowEvent{{CommitTs: 1}, {CommitTs: 2}}
+	acked := 0
+	newProducerError := func(err error) *sarama.ProducerError {
+		return &sarama.ProducerError{
+			Msg: &sarama.ProducerMessage{Metadata: &messageMetadata{
+				callback:  func() { acked++ },
+				rowEvents: events,
+			}},
+			Err: err,
+		}
+	}
+
+	// the rejected message fails the producer without the handler
+	producer := &saramaAsyncProducer{changefeedID: common.NewChangefeedID4Test("default", "test")}
+	handled, err := producer.handleRejectedMessage(newProducerError(sarama.ErrMessageSizeTooLarge))
+	require.NoError(t, err)
+	require.False(t, handled)
+
+	var rejected []*commonEvent.RowEvent
+	producer.SetRejectedMessageHandler(func(events []*commonEvent.RowEvent, err error) error {
+		require.ErrorIs(t, err, sarama.ErrMessageSizeTooLarge)
+		rejected = append(rejected, events...)
+		return nil
+	})
+	handled, err = producer.handleRejectedMessage(newProducerError(sarama.ErrMessageSizeTooLarge))
+	require.NoError(t, err)
+	require.True(t, handled)
+	require.Equal(t, events, rejected)
+	require.Equal(t, 1, acked)
+
+	// the other errors are not handled
+	handled, err = producer.handleRejectedMessage(newProducerError(sarama.ErrNotLeaderForPartition))
+	require.NoError(t, err)
+	require.False(t, handled)
+	require.Equal(t, 1, acked)
+}
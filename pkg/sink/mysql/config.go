@@ -113,11 +113,13 @@ type Config struct {
 	WriteTimeout         string
 	DialTimeout          string
 	SafeMode             bool
-	Timezone             string
-	TLS                  string
-	SSLCa                string
-	SSLCert              string
-	SSLKey               string
+	// safeModeSpecified indicates whether SafeMode is explicitly set by user via sink URI or changefeed config.
+	safeModeSpecified bool
+	Timezone          string
+	TLS               string
+	SSLCa             string
+	SSLCert           string
+	SSLKey            string
 
 	// retry number for dml
 	DMLMaxRetry uint64
@@ -166,6 +168,20 @@ type Config struct {
 	// It is configured via the sink URI query param `where-clause` and passed to
 	// sqlmodel.Gen{Delete,Update}SQL. See pkg/sink/sqlmodel for details.
 	whereClause string
+
+	// ExtraColumns are the metadata columns written with every row.
+	ExtraColumns []*config.ExtraColumn
+	// SoftDeleteColumn is the name of the deleted flag column, an empty string
+	// means the deleted rows are deleted from the downstream.
+	SoftDeleteColumn string
+	// ConflictPolicies are the rules resolving the rows conflicting with the
+	// existing rows of the matched tables.
+	ConflictPolicies []*config.ConflictPolicyRule
+	conflictPolicies []*conflictPolicy
+}
+
+func (c *Config) hasExtraColumns() bool {
+	return len(c.ExtraColumns) > 0 || c.SoftDeleteColumn != ""
 }
 
 // New returns the default mysql backend config.
@@ -196,6 +212,9 @@ func New() *Config {
 
 func (c *Config) mergeConfig(cfg *config.ChangefeedConfig) {
 	if cfg.SinkConfig != nil {
+		if cfg.SinkConfig.SafeMode != nil {
+			c.safeModeSpecified = true
+		}
 		merge(&c.SafeMode, cfg.SinkConfig.SafeMode)
 		if cfg.SinkConfig.MySQLConfig != nil {
 			mConfig := cfg.SinkConfig.MySQLConfig
@@ -220,6 +239,9 @@ func (c *Config) mergeConfig(cfg *config.ChangefeedConfig) {
 			merge(&c.BatchDMLEnable, mConfig.EnableBatchDML)
 			merge(&c.MultiStmtEnable, mConfig.EnableMultiStatement)
 			merge(&c.CachePrepStmts, mConfig.EnableCachePreparedStatement)
+			c.ExtraColumns = mConfig.GetExtraColumns()
+			c.SoftDeleteColumn = mConfig.GetSoftDeleteColumn()
+			c.ConflictPolicies = mConfig.ConflictPolicies
 		}
 	}
 }
@@ -265,6 +287,9 @@ func (c *Config) Apply(
 	if err = getSafeMode(query, &c.SafeMode); err != nil {
 		return err
 	}
+	if len(query.Get("safe-mode")) > 0 {
+		c.safeModeSpecified = true
+	}
 	if err = getTimezone(cfg.TimeZone, query, &c.Timezone); err != nil {
 		return err
 	}
@@ -295,6 +320,23 @@ func (c *Config) Apply(
 	if err = getWhereClause(query, &c.whereClause); err != nil {
 		return err
 	}
+	if c.conflictPolicies, err = newConflictPolicies(c.ConflictPolicies, cfg.CaseSensitive); err != nil {
+		return err
+	}
+	// The soft deleted rows are kept in the downstream, so the rows inserted
+	// again must be written by REPLACE to overwrite them.
+	if c.SoftDeleteColumn != "" && !c.SafeMode {
+		if c.safeModeSpecified {
+			return errors.ErrMySQLInvalidConfig.GenWithStack(
+				"safe mode can not be disabled when the soft delete column %s is set, "+
+					"the soft deleted rows are overwritten by the rows inserted again in safe mode",
+				c.SoftDeleteColumn)
+		}
+		log.Warn("soft delete is enabled, enable safe mode for mysql sink",
+			zap.String("changefeed", changefeedID.String()),
+			zap.String("softDeleteColumn", c.SoftDeleteColumn))
+		c.SafeMode = true
+	}
 
 	// c.EnableOldValue = config.EnableOldValue
 	// Note: The TiDBSourceID should never be 0 here, but we have found that
@@ -357,6 +399,15 @@ func newMysqlConfigAndDB(
 	}
 	cfg.EnableActiveActive = config.EnableActiveActive
 	cfg.ActiveActiveSyncStatsInterval = config.ActiveActiveSyncStatsInterval
+	if cfg.EnableActiveActive && cfg.hasExtraColumns() {
+		return nil, nil, "", errors.ErrMySQLInvalidConfig.GenWithStack(
+			"extra columns and soft delete column are not supported in active-active mode")
+	}
+	if cfg.EnableActiveActive && len(cfg.ConflictPolicies) > 0 {
+		return nil, nil, "", errors.ErrMySQLInvalidConfig.GenWithStack(
+			"conflict policies are not supported in active-active mode, " +
+				"the conflicts are resolved by the origin ts")
+	}
 
 	dsnStr, err = GenerateDSN(ctx, cfg)
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/ticdc/pkg/common"
 	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
 	"github.com/pingcap/ticdc/pkg/util"
 	"github.com/stretchr/testify/require"
 )
@@ -291,6 +292,7 @@ func TestApplySinkURIParamsToConfig(t *testing.T) {
 	// expected.BatchReplaceEnabled = true
 	// expected.BatchReplaceSize = 50
 	expected.SafeMode = false
+	expected.safeModeSpecified = true
 	expected.Timezone = `"UTC"`
 	expected.TidbTxnMode = "pessimistic"
 	expected.tidbTxnModeSpecified = true
@@ -315,6 +317,70 @@ func TestApplySinkURIParamsToConfig(t *testing.T) {
 	require.Equal(t, expected, cfg)
 }
 
+func TestApplyExtraColumns(t *testing.T) {
+	t.Parallel()
+
+	uri, err := url.Parse("mysql://127.0.0.1:3306/")
+	require.NoError(t, err)
+	extraColumns := []*config.ExtraColumn{
+		{Name: "_commit_ts", Type: config.ExtraColumnTypeCommitTs},
+	}
+	cfg := New()
+	changefeedConfig := &config.ChangefeedConfig{
+		TimeZone: "UTC",
+		SinkConfig: &config.SinkConfig{
+			TiDBSourceID: 1,
+			MySQLConfig: &config.MySQLConfig{
+				ExtraColumns:     extraColumns,
+				SoftDeleteColumn: util.AddressOf("_is_deleted"),
+			},
+		},
+	}
+	err = cfg.Apply(uri, common.NewChangefeedID4Test("default", "changefeed-01"), changefeedConfig)
+	require.NoError(t, err)
+	require.Equal(t, extraColumns, cfg.ExtraColumns)
+	require.Equal(t, "_is_deleted", cfg.SoftDeleteColumn)
+	// soft delete enables safe mode to overwrite the soft deleted rows
+	require.True(t, cfg.SafeMode)
+
+	// safe mode can not be disabled explicitly with soft delete
+	uri, err = url.Parse("mysql://127.0.0.1:3306/?safe-mode=false")
+	require.NoError(t, err)
+	err = New().Apply(uri, common.NewChangefeedID4Test("default", "changefeed-01"), changefeedConfig)
+	require.True(t, errors.ErrMySQLInvalidConfig.Equal(err))
+	require.ErrorContains(t, err, "safe mode can not be disabled")
+}
+
+func TestApplyConflictPolicies(t *testing.T) {
+	t.Parallel()
+
+	uri, err := url.Parse("mysql://127.0.0.1:3306/")
+	require.NoError(t, err)
+	policies := []*config.ConflictPolicyRule{
+		{Matcher: []string{"test.*"}, Policy: config.ConflictPolicyFirstWriterWins},
+	}
+	changefeedConfig := &config.ChangefeedConfig{
+		TimeZone: "UTC",
+		SinkConfig: &config.SinkConfig{
+			TiDBSourceID: 1,
+			MySQLConfig:  &config.MySQLConfig{ConflictPolicies: policies},
+		},
+	}
+	cfg := New()
+	err = cfg.Apply(uri, common.NewChangefeedID4Test("default", "changefeed-01"), changefeedConfig)
+	require.NoError(t, err)
+	require.Equal(t, policies, cfg.ConflictPolicies)
+	require.Len(t, cfg.conflictPolicies, 1)
+
+	// the invalid matcher is rejected
+	changefeedConfig.SinkConfig.MySQLConfig.ConflictPolicies = []*config.ConflictPolicyRule{
+		{Matcher: []string{"test.t["}, Policy: config.ConflictPolicyIgnore},
+	}
+	cfg = New()
+	err = cfg.Apply(uri, common.NewChangefeedID4Test("default", "changefeed-01"), changefeedConfig)
+	require.Error(t, err)
+}
+
 func TestDefaultWorkerCountByDownstream(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,457 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	tfilter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"go.uber.org/zap"
+)
+
+// conflictDuplicateKeyError is the error written to the dead letter queue or
+// the dead letter table with the inserted rows conflicting with the existing rows.
+const conflictDuplicateKeyError = "duplicate entry for the primary key or not null unique key"
+
+// conflictPolicy resolves the rows conflicting with the existing rows of the
+// tables matched by the rule.
+type conflictPolicy struct {
+	tableF tfilter.Filter
+	rule   *config.ConflictPolicyRule
+}
+
+func newConflictPolicies(rules []*config.ConflictPolicyRule, caseSensitive bool) ([]*conflictPolicy, error) {
+	var policies []*conflictPolicy
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+		tableF, err := tfilter.Parse(rule.Matcher)
+		if err != nil {
+			return nil, errors.WrapError(errors.ErrFilterRuleInvalid, err, rule.Matcher)
+		}
+		if !caseSensitive {
+			tableF = tfilter.CaseInsensitive(tableF)
+		}
+		policies = append(policies, &conflictPolicy{tableF: tableF, rule: rule})
+	}
+	return policies, nil
+}
+
+// matchConflictPolicy returns the conflict policy of the table, nil means the
+// rows are written as usual. The tables without primary key or not null unique
+// key are not matched, because their rows never conflict.
+func (c *Config) matchConflictPolicy(tableInfo *common.TableInfo) *conflictPolicy {
+	if !tableInfo.HasPKOrNotNullUK {
+		return nil
+	}
+	for _, policy := range c.conflictPolicies {
+		if policy.tableF.MatchTable(tableInfo.TableName.Schema, tableInfo.TableName.Table) {
+			return policy
+		}
+	}
+	return nil
+}
+
+// generateConflictPolicySQLs generates the statements of the events by the
+// conflict policy, each row is written by its own statements. Safe mode is
+// not used, since the statements generated by the policies are idempotent.
+// The checks of the statements whose rows are written to the dead letter if
+// they conflict with the existing rows are returned for the dead-letter policy.
+func (w *Writer) generateConflictPolicySQLs(
+	policy *conflictPolicy, events []*commonEvent.DMLEvent,
+) ([]string, [][]interface{}, []common.RowType, []*conflictCheck, error) {
+	var (
+		queries  []string
+		argsList [][]interface{}
+		rowTypes []common.RowType
+		checks   []*conflictCheck
+	)
+	appendCheck := func(event *commonEvent.DMLEvent, row chunk.Row) {
+		if policy.rule.Policy != config.ConflictPolicyDeadLetter {
+			return
+		}
+		checks = append(checks, &conflictCheck{
+			index:           len(queries) - 1,
+			event:           event,
+			row:             row,
+			deadLetterTable: policy.rule.DeadLetterTable,
+		})
+	}
+	appendSQL := func(query string, args []interface{}, rowType common.RowType) {
+		if query == "" {
+			return
+		}
+		queries = append(queries, query)
+		argsList = append(argsList, args)
+		rowTypes = append(rowTypes, rowType)
+	}
+	for _, event := range events {
+		if event.Len() == 0 {
+			continue
+		}
+		tableInfo := event.TableInfo
+		timestampOffset := -1
+		if policy.rule.Policy == config.ConflictPolicyLastWriterWins {
+			offset, ok := tableInfo.GetColumnOffsetByName(policy.rule.TimestampColumn)
+			if !ok {
+				event.Rewind()
+				return nil, nil, nil, nil, errors.ErrConflictPolicyFailed.GenWithStackByArgs(
+					fmt.Sprintf("timestamp column %s not found in table %s",
+						policy.rule.TimestampColumn, tableInfo.TableName.String()))
+			}
+			timestampOffset = offset
+		}
+		for {
+			row, ok := event.GetNextRow()
+			if !ok {
+				event.Rewind()
+				break
+			}
+			switch policy.rule.Policy {
+			case config.ConflictPolicyLastWriterWins:
+				if row.RowType == common.RowTypeUpdate && handleKeyChanged(tableInfo, &row) {
+					query, args := buildLastWriterWinsDelete(tableInfo, &row.PreRow, timestampOffset)
+					appendSQL(query, args, common.RowTypeDelete)
+					query, args = buildLastWriterWinsUpsert(tableInfo, &row.Row, timestampOffset)
+					appendSQL(query, args, common.RowTypeInsert)
+					continue
+				}
+				switch row.RowType {
+				case common.RowTypeInsert, common.RowTypeUpdate:
+					query, args := buildLastWriterWinsUpsert(tableInfo, &row.Row, timestampOffset)
+					appendSQL(query, args, row.RowType)
+				case common.RowTypeDelete:
+					query, args := buildLastWriterWinsDelete(tableInfo, &row.PreRow, timestampOffset)
+					appendSQL(query, args, common.RowTypeDelete)
+				}
+			case config.ConflictPolicyIgnore:
+				switch row.RowType {
+				case common.RowTypeInsert:
+					args := getArgs(&row.Row, tableInfo)
+					appendSQL(strings.Replace(tableInfo.GetPreInsertSQL(), "INSERT INTO", "INSERT IGNORE INTO", 1), args, row.RowType)
+				case common.RowTypeUpdate:
+					query, args := buildUpdate(tableInfo, row)
+					appendSQL(strings.Replace(query, "UPDATE", "UPDATE IGNORE", 1), args, row.RowType)
+				case common.RowTypeDelete:
+					query, args := buildDelete(tableInfo, row)
+					appendSQL(query, args, row.RowType)
+				}
+			default:
+				switch row.RowType {
+				case common.RowTypeInsert:
+					query, args := buildFirstWriterWinsInsert(tableInfo, &row.Row)
+					if query != "" {
+						appendSQL(query, args, row.RowType)
+						appendCheck(event, row.Row)
+					}
+				case common.RowTypeUpdate:
+					query, args := buildUpdate(tableInfo, row)
+					// The update moving the row to another key conflicts with the
+					// existing row of the key, which is kept like an inserted row.
+					if query != "" && handleKeyChanged(tableInfo, &row) {
+						appendSQL(strings.Replace(query, "UPDATE", "UPDATE IGNORE", 1), args, row.RowType)
+						appendCheck(event, row.Row)
+						continue
+					}
+					appendSQL(query, args, row.RowType)
+				case common.RowTypeDelete:
+					query, args := buildDelete(tableInfo, row)
+					appendSQL(query, args, row.RowType)
+				}
+			}
+		}
+	}
+	return queries, argsList, rowTypes, checks, nil
+}
+
+// handleKeyChanged returns whether the update changes the handle key of the row.
+func handleKeyChanged(tableInfo *common.TableInfo, row *commonEvent.RowChange) bool {
+	_, preArgs := whereSlice(&row.PreRow, tableInfo)
+	_, args := whereSlice(&row.Row, tableInfo)
+	return !reflect.DeepEqual(preArgs, args)
+}
+
+// buildLastWriterWinsUpsert builds the UPSERT used by the last-writer-wins policy:
+//
+//	INSERT INTO `test`.`t` (`id`,`name`,`ts`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE
+//	`id` = IF(`ts` IS NULL OR `ts` <= VALUES(`ts`), VALUES(`id`), `id`), ...,
+//	`ts` = IF(`ts` IS NULL OR `ts` <= VALUES(`ts`), VALUES(`ts`), `ts`)
+//
+// The assignments are evaluated from left to right, so the timestamp column is
+// assigned last to make all the conditions see the timestamp of the existing row.
+func buildLastWriterWinsUpsert(tableInfo *common.TableInfo, row *chunk.Row, timestampOffset int) (string, []interface{}) {
+	args := getArgs(row, tableInfo)
+	if len(args) == 0 {
+		return "", nil
+	}
+	columns := tableInfo.GetColumns()
+	timestampQuoted := common.QuoteName(columns[timestampOffset].Name.O)
+	cond := fmt.Sprintf("%s IS NULL OR %s <= VALUES(%s)", timestampQuoted, timestampQuoted, timestampQuoted)
+
+	var builder strings.Builder
+	builder.WriteString(tableInfo.GetPreInsertSQL())
+	builder.WriteString(" ON DUPLICATE KEY UPDATE ")
+	first := true
+	assign := func(quoted string) {
+		if !first {
+			builder.WriteString(",")
+		}
+		first = false
+		fmt.Fprintf(&builder, "%s = IF(%s, VALUES(%s), %s)", quoted, cond, quoted, quoted)
+	}
+	for i, col := range columns {
+		if col == nil || col.IsGenerated() || i == timestampOffset {
+			continue
+		}
+		assign(common.QuoteName(col.Name.O))
+	}
+	assign(timestampQuoted)
+	return builder.String(), args
+}
+
+// buildLastWriterWinsDelete builds the DELETE used by the last-writer-wins policy,
+// the existing row is kept if it is newer than the deleted row:
+//
+//	DELETE FROM `test`.`t` WHERE `id` = ? AND (`ts` IS NULL OR `ts` <= ?) LIMIT 1
+func buildLastWriterWinsDelete(tableInfo *common.TableInfo, preRow *chunk.Row, timestampOffset int) (string, []interface{}) {
+	query, args := buildDelete(tableInfo, commonEvent.RowChange{PreRow: *preRow})
+	if query == "" {
+		return "", nil
+	}
+	col := tableInfo.GetColumns()[timestampOffset]
+	timestampQuoted := common.QuoteName(col.Name.O)
+	var cond string
+	if ts := common.ExtractColVal(preRow, col, timestampOffset); ts == nil {
+		cond = fmt.Sprintf(" AND %s IS NULL", timestampQuoted)
+	} else {
+		cond = fmt.Sprintf(" AND (%s IS NULL OR %s <= ?)", timestampQuoted, timestampQuoted)
+		args = append(args, ts)
+	}
+	return strings.TrimSuffix(query, " LIMIT 1") + cond + " LIMIT 1", args
+}
+
+// buildFirstWriterWinsInsert builds the INSERT which keeps the existing row if
+// the row conflicts with it:
+//
+//	INSERT INTO `test`.`t` (`id`,`name`) VALUES (?,?) ON DUPLICATE KEY UPDATE `id` = `id`
+func buildFirstWriterWinsInsert(tableInfo *common.TableInfo, row *chunk.Row) (string, []interface{}) {
+	args := getArgs(row, tableInfo)
+	if len(args) == 0 {
+		return "", nil
+	}
+	colNames, _ := whereSlice(row, tableInfo)
+	quoted := common.QuoteName(colNames[0])
+	return fmt.Sprintf("%s ON DUPLICATE KEY UPDATE %s = %s", tableInfo.GetPreInsertSQL(), quoted, quoted), args
+}
+
+// conflictCheck is the statement of an inserted row, or an updated row moved
+// to another key, written by the dead-letter policy. The statement keeps the
+// existing row of the key, so the row conflicts with an existing row only if
+// the statement affects no row.
+type conflictCheck struct {
+	// index is the index of the statement in the prepared statements.
+	index           int
+	event           *commonEvent.DMLEvent
+	row             chunk.Row
+	deadLetterTable string
+}
+
+// conflictingRow is a row conflicting with an existing row, it is written to
+// the dead letter queue after the transaction of its event is committed.
+type conflictingRow struct {
+	event *commonEvent.DMLEvent
+	query string
+	args  []interface{}
+}
+
+// checkConflict is called in the transaction after the statement of the check
+// is executed, the row is written to the dead letter table in the same
+// transaction, or recorded to be written to the dead letter queue after the
+// transaction is committed, if it conflicts with a different existing row.
+// The existing row of the key is locked by the statement, so it can not be
+// changed by the other writers before the transaction is committed. The
+// existing row equal to the row is not a conflict, it is the row itself
+// written before the changefeed restarts.
+func (w *Writer) checkConflict(
+	ctx context.Context, tx *sql.Tx, check *conflictCheck, dmls *preparedDMLs,
+) error {
+	tableInfo := check.event.TableInfo
+	query, args := buildConflictCheckQuery(tableInfo, &check.row)
+	if query == "" {
+		return nil
+	}
+	var exists int
+	err := tx.QueryRowContext(ctx, query, args...).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	log.Info("the row conflicts with the existing row, write it to the dead letter",
+		zap.String("changefeed", w.ChangefeedID.String()),
+		zap.Stringer("table", tableInfo.TableName),
+		zap.Uint64("commitTs", check.event.CommitTs))
+	if w.deadLetterQueue == nil {
+		query, args = w.buildConflictDeadLetterInsert(check)
+		if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+			return errors.WrapError(errors.ErrMySQLTxnError, err)
+		}
+		return nil
+	}
+	query, args = buildInsert(tableInfo, commonEvent.RowChange{Row: check.row, RowType: common.RowTypeInsert}, true)
+	dmls.conflicts = append(dmls.conflicts, &conflictingRow{event: check.event, query: query, args: args})
+	return nil
+}
+
+// writeConflictingRows writes the conflicting rows to the dead letter queue.
+func (w *Writer) writeConflictingRows(conflicts []*conflictingRow) error {
+	for _, conflict := range conflicts {
+		err := w.deadLetterQueue.WriteDMLEvent(conflict.event,
+			[]string{conflict.query}, [][]interface{}{conflict.args},
+			errors.ErrConflictPolicyFailed.GenWithStackByArgs(conflictDuplicateKeyError))
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// buildConflictDeadLetterInsert builds the statement writing the conflicting
+// row to the dead letter table of the policy:
+//
+//	INSERT INTO `tidb_cdc`.`conflict_rows` (...) VALUES (?,?,?,?,?,?,?,?)
+func (w *Writer) buildConflictDeadLetterInsert(check *conflictCheck) (string, []interface{}) {
+	tableInfo := check.event.TableInfo
+	rowData := make(map[string]interface{}, len(tableInfo.GetColumns()))
+	for i, col := range tableInfo.GetColumns() {
+		if col == nil || col.IsGenerated() {
+			continue
+		}
+		rowData[col.Name.O] = common.ExtractColVal(&check.row, col, i)
+	}
+	data, err := json.Marshal(rowData)
+	if err != nil {
+		log.Warn("failed to marshal the conflicting row",
+			zap.String("changefeed", w.ChangefeedID.String()),
+			zap.Stringer("table", tableInfo.TableName), zap.Error(err))
+	}
+	query := "INSERT INTO " + common.QuoteSchema(filter.TiCDCSystemSchema, check.deadLetterTable) +
+		" (changefeed, source_schema, source_table, target_schema, target_table, commit_ts, row_data, error_message)" +
+		" VALUES (?,?,?,?,?,?,?,?)"
+	return query, []interface{}{
+		w.ChangefeedID.String(),
+		tableInfo.TableName.Schema,
+		tableInfo.TableName.Table,
+		tableInfo.TableName.GetTargetSchema(),
+		tableInfo.TableName.GetTargetTable(),
+		check.event.CommitTs,
+		string(data),
+		conflictDuplicateKeyError,
+	}
+}
+
+// buildConflictDeadLetterTableQuery builds the statement creating the dead
+// letter table of the conflicting rows.
+func buildConflictDeadLetterTableQuery(tableName string) string {
+	query := `CREATE TABLE IF NOT EXISTS %s
+	(
+		id bigint NOT NULL AUTO_INCREMENT,
+		changefeed varchar(255) NOT NULL,
+		source_schema varchar(255) NOT NULL,
+		source_table varchar(255) NOT NULL,
+		target_schema varchar(255) NOT NULL,
+		target_table varchar(255) NOT NULL,
+		commit_ts bigint unsigned NOT NULL,
+		row_data longtext,
+		error_message text,
+		created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		INDEX (changefeed, created_at),
+		PRIMARY KEY (id)
+	);`
+	return fmt.Sprintf(query, common.QuoteName(tableName))
+}
+
+// createConflictDeadLetterTable creates the dead letter table of the conflicting
+// rows if it is not created by the writer.
+func (w *Writer) createConflictDeadLetterTable(tableName string) error {
+	if w.conflictDeadLetterTableInit[tableName] {
+		return nil
+	}
+	err := w.createTable(filter.TiCDCSystemSchema, tableName, buildConflictDeadLetterTableQuery(tableName))
+	if err != nil {
+		return err
+	}
+	w.conflictDeadLetterTableInit[tableName] = true
+	return nil
+}
+
+// buildConflictCheckQuery builds the query checking whether the row conflicts
+// with a different existing row:
+//
+//	SELECT 1 FROM `test`.`t` WHERE `id` = ? AND NOT (`id` <=> ? AND `name` <=> ?) LIMIT 1 FOR UPDATE
+func buildConflictCheckQuery(tableInfo *common.TableInfo, row *chunk.Row) (string, []interface{}) {
+	colNames, whereArgs := whereSlice(row, tableInfo)
+	if len(whereArgs) == 0 {
+		return "", nil
+	}
+	var (
+		builder strings.Builder
+		args    []interface{}
+	)
+	builder.WriteString("SELECT 1 FROM ")
+	builder.WriteString(tableInfo.TableName.QuoteTargetString())
+	builder.WriteString(" WHERE ")
+	for i := range colNames {
+		if i > 0 {
+			builder.WriteString(" AND ")
+		}
+		builder.WriteString(common.QuoteName(colNames[i]))
+		if whereArgs[i] == nil {
+			builder.WriteString(" IS NULL")
+		} else {
+			builder.WriteString(" = ?")
+			args = append(args, whereArgs[i])
+		}
+	}
+	builder.WriteString(" AND NOT (")
+	first := true
+	for i, col := range tableInfo.GetColumns() {
+		if col == nil || col.IsGenerated() {
+			continue
+		}
+		if !first {
+			builder.WriteString(" AND ")
+		}
+		first = false
+		builder.WriteString(common.QuoteName(col.Name.O))
+		builder.WriteString(" <=> ?")
+		args = append(args, common.ExtractColVal(row, col, i))
+	}
+	builder.WriteString(") LIMIT 1 FOR UPDATE")
+	return builder.String(), args
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func setTestConflictPolicy(t *testing.T, writer *Writer, rule *config.ConflictPolicyRule) *conflictPolicy {
+	writer.cfg.ConflictPolicies = []*config.ConflictPolicyRule{rule}
+	policies, err := newConflictPolicies(writer.cfg.ConflictPolicies, false)
+	require.NoError(t, err)
+	writer.cfg.conflictPolicies = policies
+	return policies[0]
+}
+
+func TestMatchConflictPolicy(t *testing.T) {
+	writer, _, _ := newTestMysqlWriter(t)
+	defer writer.db.Close()
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	require.NotNil(t, helper.DDL2Job("create table t (id int primary key, name varchar(32));"))
+	require.NotNil(t, helper.DDL2Job("create table t1 (id int, name varchar(32));"))
+	require.NotNil(t, helper.DDL2Job("create table t2 (id int primary key, name varchar(32));"))
+
+	policy := setTestConflictPolicy(t, writer, &config.ConflictPolicyRule{
+		Matcher: []string{"TEST.t", "test.t1"},
+		Policy:  config.ConflictPolicyIgnore,
+	})
+	event := helper.DML2Event("test", "t", "insert into t values (1, 'a')")
+	require.Equal(t, policy, writer.cfg.matchConflictPolicy(event.TableInfo))
+	// the table without primary key or not null unique key is not matched
+	event = helper.DML2Event("test", "t1", "insert into t1 values (1, 'a')")
+	require.Nil(t, writer.cfg.matchConflictPolicy(event.TableInfo))
+	event = helper.DML2Event("test", "t2", "insert into t2 values (1, 'a')")
+	require.Nil(t, writer.cfg.matchConflictPolicy(event.TableInfo))
+
+	_, err := newConflictPolicies([]*config.ConflictPolicyRule{
+		{Matcher: []string{"test.t["}, Policy: config.ConflictPolicyIgnore},
+	}, false)
+	require.Error(t, err)
+}
+
+func TestGenerateConflictPolicySQLs(t *testing.T) {
+	writer, _, _ := newTestMysqlWriter(t)
+	defer writer.db.Close()
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	require.NotNil(t, helper.DDL2Job("create table t (id int primary key, name varchar(32), updated_at bigint);"))
+
+	lwwCond := "`updated_at` IS NULL OR `updated_at` <= VALUES(`updated_at`)"
+	lwwUpsert := "INSERT INTO `test`.`t` (`id`,`name`,`updated_at`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE " +
+		"`id` = IF(" + lwwCond + ", VALUES(`id`), `id`)," +
+		"`name` = IF(" + lwwCond + ", VALUES(`name`), `name`)," +
+		"`updated_at` = IF(" + lwwCond + ", VALUES(`updated_at`), `updated_at`)"
+
+	// last writer wins
+	policy := setTestConflictPolicy(t, writer, &config.ConflictPolicyRule{
+		Matcher:         []string{"test.*"},
+		Policy:          config.ConflictPolicyLastWriterWins,
+		TimestampColumn: "updated_at",
+	})
+	insertEvent := helper.DML2Event("test", "t", "insert into t values (1, 'a', 10)")
+	updateEvent, _ := helper.DML2UpdateEvent("test", "t",
+		"insert into t values (2, 'b', 10)", "update t set name = 'c', updated_at = 20 where id = 2")
+	deleteEvent := helper.DML2DeleteEvent("test", "t",
+		"insert into t values (3, 'd', 10)", "delete from t where id = 3")
+	sqls, args, rowTypes, _, err := writer.generateConflictPolicySQLs(policy,
+		[]*commonEvent.DMLEvent{insertEvent, updateEvent, deleteEvent})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		lwwUpsert,
+		lwwUpsert,
+		"DELETE FROM `test`.`t` WHERE `id` = ? AND (`updated_at` IS NULL OR `updated_at` <= ?) LIMIT 1",
+	}, sqls)
+	require.Equal(t, [][]interface{}{
+		{int64(1), "a", int64(10)},
+		{int64(2), "c", int64(20)},
+		{int64(3), int64(10)},
+	}, args)
+	require.Equal(t, []common.RowType{common.RowTypeInsert, common.RowTypeUpdate, common.RowTypeDelete}, rowTypes)
+
+	// the update changing the primary key is split into a delete and an insert
+	updateEvent, _ = helper.DML2UpdateEvent("test", "t",
+		"insert into t values (4, 'e', NULL)", "update t set id = 5, updated_at = 20 where id = 4")
+	sqls, args, _, _, err = writer.generateConflictPolicySQLs(policy, []*commonEvent.DMLEvent{updateEvent})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"DELETE FROM `test`.`t` WHERE `id` = ? AND `updated_at` IS NULL LIMIT 1",
+		lwwUpsert,
+	}, sqls)
+	require.Equal(t, [][]interface{}{{int64(4)}, {int64(5), "e", int64(20)}}, args)
+
+	// the timestamp column must exist
+	policy = setTestConflictPolicy(t, writer, &config.ConflictPolicyRule{
+		Matcher:         []string{"test.*"},
+		Policy:          config.ConflictPolicyLastWriterWins,
+		TimestampColumn: "modified_at",
+	})
+	_, _, _, _, err = writer.generateConflictPolicySQLs(policy, []*commonEvent.DMLEvent{insertEvent})
+	require.ErrorContains(t, err, "timestamp column modified_at not found")
+
+	// first writer wins
+	policy = setTestConflictPolicy(t, writer, &config.ConflictPolicyRule{
+		Matcher: []string{"test.*"},
+		Policy:  config.ConflictPolicyFirstWriterWins,
+	})
+	sqls, args, _, _, err = writer.generateConflictPolicySQLs(policy,
+		[]*commonEvent.DMLEvent{insertEvent, updateEvent, deleteEvent})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"INSERT INTO `test`.`t` (`id`,`name`,`updated_at`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `id` = `id`",
+		// the existing row of the new primary key is kept
+		"UPDATE IGNORE `test`.`t` SET `id` = ?, `name` = ?, `updated_at` = ? WHERE `id` = ? LIMIT 1",
+		"DELETE FROM `test`.`t` WHERE `id` = ? LIMIT 1",
+	}, sqls)
+	require.Equal(t, [][]interface{}{
+		{int64(1), "a", int64(10)},
+		{int64(5), "e", int64(20), int64(4)},
+		{int64(3)},
+	}, args)
+
+	// ignore
+	policy = setTestConflictPolicy(t, writer, &config.ConflictPolicyRule{
+		Matcher: []string{"test.*"},
+		Policy:  config.ConflictPolicyIgnore,
+	})
+	updateEvent, _ = helper.DML2UpdateEvent("test", "t",
+		"insert into t values (6, 'f', 10)", "update t set name = 'g' where id = 6")
+	sqls, args, _, _, err = writer.generateConflictPolicySQLs(policy,
+		[]*commonEvent.DMLEvent{insertEvent, updateEvent})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"INSERT IGNORE INTO `test`.`t` (`id`,`name`,`updated_at`) VALUES (?,?,?)",
+		"UPDATE IGNORE `test`.`t` SET `id` = ?, `name` = ?, `updated_at` = ? WHERE `id` = ? LIMIT 1",
+	}, sqls)
+	require.Equal(t, [][]interface{}{{int64(1), "a", int64(10)}, {int64(6), "g", int64(10), int64(6)}}, args)
+}
+
+func TestFlushConflictDeadLetter(t *testing.T) {
+	writer, db, mock := newTestMysqlWriter(t)
+	defer db.Close()
+	writer.cfg.CachePrepStmts = false
+	queue := &mockDeadLetterQueue{}
+	writer.SetDeadLetterQueue(queue)
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	require.NotNil(t, helper.DDL2Job("create table t (id int primary key, name varchar(32));"))
+	setTestConflictPolicy(t, writer, &config.ConflictPolicyRule{
+		Matcher:         []string{"test.*"},
+		Policy:          config.ConflictPolicyDeadLetter,
+		DeadLetterTable: config.DefaultConflictDeadLetterTable,
+	})
+
+	event1 := helper.DML2Event("test", "t", "insert into t values (1, 'a')")
+	event1.CommitTs = 2
+	event1.DispatcherID = common.NewDispatcherID()
+	event2 := helper.DML2Event("test", "t",
+		"insert into t values (2, 'b')", "delete from t where id = 2", "insert into t values (2, 'c')")
+	event2.CommitTs = 3
+	event2.DispatcherID = event1.DispatcherID
+
+	// the rows are checked only if their statements affect no row, the row 1
+	// conflicts with a different existing row, while the existing row 2 is
+	// equal to the row written before.
+	checkSQL := "SELECT 1 FROM `test`.`t` WHERE `id` = ? AND NOT (`id` <=> ? AND `name` <=> ?) LIMIT 1 FOR UPDATE"
+	insertSQL := "INSERT INTO `test`.`t` (`id`,`name`) VALUES (?,?) ON DUPLICATE KEY UPDATE `id` = `id`"
+	mock.ExpectBegin()
+	mock.ExpectExec(insertSQL).WithArgs(1, "a").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(checkSQL).WithArgs(1, 1, "a").
+		WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	mock.ExpectExec(insertSQL).WithArgs(2, "b").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(checkSQL).WithArgs(2, 2, "b").
+		WillReturnRows(sqlmock.NewRows([]string{"1"}))
+	mock.ExpectExec("DELETE FROM `test`.`t` WHERE `id` = ? LIMIT 1").WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insertSQL).WithArgs(2, "c").WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, writer.Flush([]*commonEvent.DMLEvent{event1, event2}))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the conflicting row is written to the dead letter queue after it's flushed
+	require.Equal(t, []*commonEvent.DMLEvent{event1}, queue.events)
+	require.Equal(t, [][]string{{"REPLACE INTO `test`.`t` (`id`,`name`) VALUES (?,?)"}}, queue.sqls)
+
+	// the conflicting row is written to the dead letter table in the same
+	// transaction without the dead letter queue
+	writer.SetDeadLetterQueue(nil)
+	event1.Rewind()
+	mock.ExpectBegin()
+	mock.ExpectExec("CREATE DATABASE IF NOT EXISTS tidb_cdc").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("USE tidb_cdc").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(buildConflictDeadLetterTableQuery(config.DefaultConflictDeadLetterTable)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(insertSQL).WithArgs(1, "a").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(checkSQL).WithArgs(1, 1, "a").
+		WillReturnRows(sqlmock.NewRows([]string{"1"}).AddRow(1))
+	mock.ExpectExec("INSERT INTO `tidb_cdc`.`conflict_rows` "+
+		"(changefeed, source_schema, source_table, target_schema, target_table, commit_ts, row_data, error_message) "+
+		"VALUES (?,?,?,?,?,?,?,?)").
+		WithArgs(writer.ChangefeedID.String(), "test", "t", "test", "t", uint64(2),
+			`{"id":1,"name":"a"}`, conflictDuplicateKeyError).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, writer.Flush([]*commonEvent.DMLEvent{event1}))
+	require.NoError(t, mock.ExpectationsWereMet())
+	// the dead letter table is created only once by the writer
+	require.True(t, writer.conflictDeadLetterTableInit[config.DefaultConflictDeadLetterTable])
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"github.com/pingcap/log"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// DeadLetterQueue is the queue which the transactions rejected by the
+// downstream are written to, so a single bad row does not fail the changefeed.
+type DeadLetterQueue interface {
+	// MaxRetries returns the number of times a rejected transaction is retried
+	// before it is written to the queue.
+	MaxRetries() int
+	// IsRejectedError returns whether the error means the transaction is
+	// rejected by the downstream, such as the data too long error.
+	IsRejectedError(err error) bool
+	// WriteDMLEvent writes the rejected transaction with its statements.
+	WriteDMLEvent(event *commonEvent.DMLEvent, sqls []string, args [][]interface{}, cause error) error
+}
+
+// SetDeadLetterQueue sets the dead letter queue of the writer.
+func (w *Writer) SetDeadLetterQueue(queue DeadLetterQueue) {
+	w.deadLetterQueue = queue
+}
+
+// flushWithDeadLetterQueue flushes the events one by one after the batch is
+// rejected by the downstream. The events still rejected after the retries are
+// written to the dead letter queue, so the other events in the batch are not
+// blocked by them.
+func (w *Writer) flushWithDeadLetterQueue(events []*commonEvent.DMLEvent, cause error) error {
+	log.Warn("dml events are rejected by the downstream, flush them one by one",
+		zap.String("changefeed", w.ChangefeedID.String()),
+		zap.Int("writerID", w.id),
+		zap.Int("eventCount", len(events)),
+		zap.Error(cause))
+	for _, event := range events {
+		if err := w.flushOneOrDeadLetter(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *Writer) flushOneOrDeadLetter(event *commonEvent.DMLEvent) error {
+	for attempt := 0; ; attempt++ {
+		dmls, err := w.flushOne(event)
+		if err == nil || !w.deadLetterQueue.IsRejectedError(err) {
+			dmlsPool.Put(dmls)
+			return errors.Trace(err)
+		}
+		if attempt >= w.deadLetterQueue.MaxRetries() {
+			err = w.deadLetterQueue.WriteDMLEvent(event, dmls.sqls, dmls.values, err)
+			dmlsPool.Put(dmls)
+			return errors.Trace(err)
+		}
+		dmlsPool.Put(dmls)
+		log.Info("dml event is rejected by the downstream, retry it",
+			zap.String("changefeed", w.ChangefeedID.String()),
+			zap.Int("writerID", w.id),
+			zap.Uint64("commitTs", event.CommitTs),
+			zap.Int("retry", attempt+1),
+			zap.Error(err))
+	}
+}
+
+// flushOne executes the statements of a single event, the caller should put
+// the returned dmls back to the pool.
+func (w *Writer) flushOne(event *commonEvent.DMLEvent) (*preparedDMLs, error) {
+	event.Rewind()
+	dmls, err := w.prepareDMLs([]*commonEvent.DMLEvent{event})
+	if err != nil {
+		// The preparing error is not rejected by the downstream, it's returned directly.
+		return dmls, err
+	}
+	if len(dmls.sqls) == 0 {
+		return dmls, nil
+	}
+	if err = w.execDMLWithMaxRetries(dmls); err != nil {
+		return dmls, err
+	}
+	return dmls, w.writeConflictingRows(dmls.conflicts)
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dmysql "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/errors"
+	tmysql "github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDeadLetterQueue struct {
+	maxRetries int
+	events     []*commonEvent.DMLEvent
+	sqls       [][]string
+}
+
+func (q *mockDeadLetterQueue) MaxRetries() int {
+	return q.maxRetries
+}
+
+func (q *mockDeadLetterQueue) IsRejectedError(err error) bool {
+	var mysqlErr *dmysql.MySQLError
+	return errors.As(errors.Cause(err), &mysqlErr) && mysqlErr.Number == tmysql.ErrDataTooLong
+}
+
+func (q *mockDeadLetterQueue) WriteDMLEvent(
+	event *commonEvent.DMLEvent, sqls []string, _ [][]interface{}, _ error,
+) error {
+	q.events = append(q.events, event)
+	q.sqls = append(q.sqls, append([]string(nil), sqls...))
+	return nil
+}
+
+func TestFlushWithDeadLetterQueue(t *testing.T) {
+	writer, db, mock := newTestMysqlWriter(t)
+	defer db.Close()
+	writer.cfg.DMLMaxRetry = 1
+	queue := &mockDeadLetterQueue{maxRetries: 1}
+	writer.SetDeadLetterQueue(queue)
+
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	require.NotNil(t, helper.DDL2Job("create table t (id int primary key, name varchar(32));"))
+
+	event1 := helper.DML2Event("test", "t", "insert into t values (1, 'a')")
+	event1.CommitTs = 2
+	event1.DispatcherID = common.NewDispatcherID()
+	event2 := helper.DML2Event("test", "t", "insert into t values (2, 'b')")
+	event2.CommitTs = 3
+	event2.DispatcherID = event1.DispatcherID
+	flushed := 0
+	event1.AddPostFlushFunc(func() { flushed++ })
+	event2.AddPostFlushFunc(func() { flushed++ })
+
+	dataTooLong := &dmysql.MySQLError{Number: tmysql.ErrDataTooLong, Message: "Data too long"}
+	// the batch is rejected, so the events are flushed one by one
+	mock.ExpectExec("BEGIN;INSERT INTO `test`.`t` (`id`,`name`) VALUES (?,?),(?,?);COMMIT;").
+		WithArgs(1, "a", 2, "b").
+		WillReturnError(dataTooLong)
+	mock.ExpectExec("BEGIN;INSERT INTO `test`.`t` (`id`,`name`) VALUES (?,?);COMMIT;").
+		WithArgs(1, "a").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	// the second event is retried once before it's written to the dead letter queue
+	for range 2 {
+		mock.ExpectExec("BEGIN;INSERT INTO `test`.`t` (`id`,`name`) VALUES (?,?);COMMIT;").
+			WithArgs(2, "b").
+			WillReturnError(dataTooLong)
+	}
+
+	require.NoError(t, writer.Flush([]*commonEvent.DMLEvent{event1, event2}))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, 2, flushed)
+	require.Equal(t, []*commonEvent.DMLEvent{event2}, queue.events)
+	require.Equal(t, [][]string{{"INSERT INTO `test`.`t` (`id`,`name`) VALUES (?,?)"}}, queue.sqls)
+
+	// the errors not rejected by the downstream are returned
+	mock.ExpectExec("BEGIN;INSERT INTO `test`.`t` (`id`,`name`) VALUES (?,?);COMMIT;").
+		WithArgs(1, "a").
+		WillReturnError(&dmysql.MySQLError{Number: tmysql.ErrNoSuchTable, Message: "Table doesn't exist"})
+	event1.Rewind()
+	require.Error(t, writer.Flush([]*commonEvent.DMLEvent{event1}))
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Len(t, queue.events, 1)
+}
@@ -0,0 +1,389 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"go.uber.org/zap"
+)
+
+const (
+	// extraColumnTypeSoftDelete is the type of the soft delete column, it is
+	// configured separately from the other extra columns.
+	extraColumnTypeSoftDelete = "soft-delete"
+
+	// extraColumnComment is the comment of the extra columns added by the sink,
+	// it marks the columns which are not a part of the upstream rows.
+	extraColumnComment = "written by TiCDC"
+
+	extraColumnOpInsert = "INSERT"
+	extraColumnOpUpdate = "UPDATE"
+	extraColumnOpDelete = "DELETE"
+
+	extraColumnsQuery = "SELECT COLUMN_NAME FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?"
+)
+
+type extraColumn struct {
+	name  string
+	tp    string
+	value string
+}
+
+// definition returns the column definition used to add the column to the
+// downstream tables.
+func (c *extraColumn) definition() string {
+	var definition string
+	switch c.tp {
+	case config.ExtraColumnTypeCommitTs:
+		definition = "BIGINT UNSIGNED NULL"
+	case config.ExtraColumnTypeOpType:
+		definition = "VARCHAR(16) NULL"
+	case extraColumnTypeSoftDelete:
+		definition = "TINYINT(1) NOT NULL DEFAULT 0"
+	default:
+		definition = "VARCHAR(255) NULL"
+	}
+	return definition + " COMMENT '" + extraColumnComment + "'"
+}
+
+func (c *extraColumn) fieldType() *types.FieldType {
+	var ft *types.FieldType
+	switch c.tp {
+	case config.ExtraColumnTypeCommitTs:
+		ft = types.NewFieldType(mysql.TypeLonglong)
+		ft.AddFlag(mysql.UnsignedFlag)
+	case extraColumnTypeSoftDelete:
+		ft = types.NewFieldType(mysql.TypeTiny)
+		ft.AddFlag(mysql.NotNullFlag)
+		ft.SetFlen(1)
+	default:
+		ft = types.NewFieldType(mysql.TypeVarchar)
+		ft.SetFlen(255)
+		if c.tp == config.ExtraColumnTypeOpType {
+			ft.SetFlen(16)
+		}
+		ft.SetCharset(mysql.DefaultCharset)
+		ft.SetCollate(mysql.DefaultCollationName)
+	}
+	return ft
+}
+
+func (c *extraColumn) datum(tableInfo *common.TableInfo, commitTs uint64, op string) types.Datum {
+	switch c.tp {
+	case config.ExtraColumnTypeSourceCluster:
+		return types.NewStringDatum(c.value)
+	case config.ExtraColumnTypeSourceSchema:
+		return types.NewStringDatum(tableInfo.TableName.Schema)
+	case config.ExtraColumnTypeSourceTable:
+		return types.NewStringDatum(tableInfo.TableName.Table)
+	case config.ExtraColumnTypeCommitTs:
+		return types.NewUintDatum(commitTs)
+	case config.ExtraColumnTypeOpType:
+		return types.NewStringDatum(op)
+	default:
+		if op == extraColumnOpDelete {
+			return types.NewIntDatum(1)
+		}
+		return types.NewIntDatum(0)
+	}
+}
+
+// extraTable is the table info with the extra columns of a table.
+type extraTable struct {
+	updateTs   uint64
+	tableInfo  *common.TableInfo
+	fieldTypes []*types.FieldType
+	// checked is true if the extra columns are checked to exist in the
+	// downstream table of this version of the table info.
+	checked bool
+}
+
+// extraColumnInjector adds the extra metadata columns to the rows written by
+// the sink. The rows are rewritten with a table info which contains the extra
+// columns after the upstream columns, so all the SQL builders write them as
+// normal columns. If soft delete is enabled, the deleted rows are turned into
+// updates setting the soft delete column to 1.
+type extraColumnInjector struct {
+	columns    []*extraColumn
+	softDelete bool
+	// tables caches the table info with the extra columns by table id,
+	// the writer using the injector is not shared, so no lock is needed.
+	tables map[int64]*extraTable
+}
+
+func newExtraColumnInjector(cfg *Config) *extraColumnInjector {
+	if !cfg.hasExtraColumns() {
+		return nil
+	}
+	columns := make([]*extraColumn, 0, len(cfg.ExtraColumns)+1)
+	for _, column := range cfg.ExtraColumns {
+		if column == nil {
+			continue
+		}
+		columns = append(columns, &extraColumn{name: column.Name, tp: column.Type, value: column.Value})
+	}
+	if cfg.SoftDeleteColumn != "" {
+		columns = append(columns, &extraColumn{name: cfg.SoftDeleteColumn, tp: extraColumnTypeSoftDelete})
+	}
+	return &extraColumnInjector{
+		columns:    columns,
+		softDelete: cfg.SoftDeleteColumn != "",
+		tables:     make(map[int64]*extraTable),
+	}
+}
+
+func (i *extraColumnInjector) getTable(tableInfo *common.TableInfo) (*extraTable, error) {
+	tableID := tableInfo.TableName.TableID
+	if table, ok := i.tables[tableID]; ok && table.updateTs == tableInfo.GetUpdateTS() {
+		return table, nil
+	}
+	// The soft deleted rows are kept and inserted again by REPLACE, which
+	// requires a key to find the existing row.
+	if i.softDelete && !tableInfo.HasPKOrNotNullUK {
+		return nil, errors.ErrExtraColumnFailed.GenWithStackByArgs(
+			fmt.Sprintf("table %s has no primary key or not null unique key", tableInfo.TableName.String()))
+	}
+
+	origin := tableInfo.ToTiDBTableInfo()
+	info := origin.Clone()
+	info.Columns = make([]*model.ColumnInfo, 0, len(origin.Columns)+len(i.columns))
+	info.Columns = append(info.Columns, origin.Columns...)
+	var maxColumnID int64
+	for _, col := range origin.Columns {
+		if col.ID > maxColumnID {
+			maxColumnID = col.ID
+		}
+	}
+	for _, column := range i.columns {
+		if info.FindPublicColumnByName(strings.ToLower(column.name)) != nil {
+			return nil, errors.ErrExtraColumnFailed.GenWithStackByArgs(
+				fmt.Sprintf("column %s already exists in table %s", column.name, tableInfo.TableName.String()))
+		}
+		maxColumnID++
+		info.Columns = append(info.Columns, &model.ColumnInfo{
+			ID:        maxColumnID,
+			Name:      ast.NewCIStr(column.name),
+			Offset:    len(info.Columns),
+			State:     model.StatePublic,
+			FieldType: *column.fieldType(),
+			Comment:   extraColumnComment,
+		})
+	}
+	info.MaxColumnID = maxColumnID
+
+	extraTableInfo := common.WrapTableInfo(tableInfo.TableName.Schema, info)
+	extraTableInfo.TableName = tableInfo.TableName
+	extraTableInfo.UpdateTS = tableInfo.GetUpdateTS()
+	table := &extraTable{
+		updateTs:   tableInfo.GetUpdateTS(),
+		tableInfo:  extraTableInfo,
+		fieldTypes: extraTableInfo.GetFieldSlice(),
+	}
+	i.tables[tableID] = table
+	return table, nil
+}
+
+// inject returns the events with the extra columns, the original events are
+// not changed, so their callbacks are still called by the caller.
+func (i *extraColumnInjector) inject(events []*commonEvent.DMLEvent) ([]*commonEvent.DMLEvent, error) {
+	result := make([]*commonEvent.DMLEvent, 0, len(events))
+	for _, event := range events {
+		table, err := i.getTable(event.TableInfo)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, i.injectEvent(event, table))
+	}
+	return result, nil
+}
+
+func (i *extraColumnInjector) injectEvent(event *commonEvent.DMLEvent, table *extraTable) *commonEvent.DMLEvent {
+	originFieldTypes := event.TableInfo.GetFieldSlice()
+	rows := chunk.NewChunkWithCapacity(table.fieldTypes, len(event.RowTypes))
+	rowTypes := make([]common.RowType, 0, len(event.RowTypes))
+	appendRow := func(row chunk.Row, op string) {
+		datums := row.GetDatumRow(originFieldTypes)
+		for _, column := range i.columns {
+			datums = append(datums, column.datum(event.TableInfo, event.CommitTs, op))
+		}
+		for j := range datums {
+			rows.AppendDatum(j, &datums[j])
+		}
+	}
+	for {
+		row, ok := event.GetNextRow()
+		if !ok {
+			event.Rewind()
+			break
+		}
+		switch row.RowType {
+		case common.RowTypeInsert:
+			appendRow(row.Row, extraColumnOpInsert)
+			rowTypes = append(rowTypes, common.RowTypeInsert)
+		case common.RowTypeUpdate:
+			appendRow(row.PreRow, extraColumnOpUpdate)
+			appendRow(row.Row, extraColumnOpUpdate)
+			rowTypes = append(rowTypes, common.RowTypeUpdate, common.RowTypeUpdate)
+		case common.RowTypeDelete:
+			if i.softDelete {
+				// The pre row only provides the handle key values to the where
+				// clause, the row sets the soft delete column to 1.
+				appendRow(row.PreRow, extraColumnOpUpdate)
+				appendRow(row.PreRow, extraColumnOpDelete)
+				rowTypes = append(rowTypes, common.RowTypeUpdate, common.RowTypeUpdate)
+			} else {
+				appendRow(row.PreRow, extraColumnOpDelete)
+				rowTypes = append(rowTypes, common.RowTypeDelete)
+			}
+		}
+	}
+
+	newEvent := commonEvent.NewDMLEvent(event.DispatcherID, event.PhysicalTableID, event.StartTs, event.CommitTs, table.tableInfo)
+	newEvent.ReplicatingTs = event.ReplicatingTs
+	newEvent.ApproximateSize = event.ApproximateSize
+	newEvent.SetRows(rows)
+	newEvent.RowTypes = rowTypes
+	newEvent.Length = event.Len()
+	return newEvent
+}
+
+// columnDefs returns the definitions of the extra columns.
+func (i *extraColumnInjector) columnDefs() ([]*ast.ColumnDef, error) {
+	definitions := make([]string, 0, len(i.columns))
+	for _, column := range i.columns {
+		definitions = append(definitions, common.QuoteName(column.name)+" "+column.definition())
+	}
+	stmt, err := parser.New().ParseOneStmt(
+		"CREATE TABLE t ("+strings.Join(definitions, ", ")+")", "", "")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return stmt.(*ast.CreateTableStmt).Cols, nil
+}
+
+// addColumnsToCreateTable appends the extra columns to the CREATE TABLE
+// statements in the query, so the tables created by the sink contain them.
+// The columns already defined by a statement are not added again.
+func (i *extraColumnInjector) addColumnsToCreateTable(query string) (string, bool, error) {
+	stmts, _, err := parser.New().Parse(query, "", "")
+	if err != nil {
+		return query, false, errors.Trace(err)
+	}
+	columnDefs, err := i.columnDefs()
+	if err != nil {
+		return query, false, err
+	}
+
+	changed := false
+	for _, stmt := range stmts {
+		createStmt, ok := stmt.(*ast.CreateTableStmt)
+		// The table created by CREATE TABLE LIKE copies the columns of a
+		// downstream table, the missing columns are added at the first write.
+		if !ok || createStmt.ReferTable != nil {
+			continue
+		}
+		defined := make(map[string]struct{}, len(createStmt.Cols))
+		for _, col := range createStmt.Cols {
+			defined[col.Name.Name.L] = struct{}{}
+		}
+		for _, columnDef := range columnDefs {
+			if _, ok := defined[columnDef.Name.Name.L]; ok {
+				continue
+			}
+			createStmt.Cols = append(createStmt.Cols, columnDef)
+			changed = true
+		}
+	}
+	if !changed {
+		return query, false, nil
+	}
+
+	restored := make([]string, 0, len(stmts))
+	for _, stmt := range stmts {
+		restoredQuery, err := commonEvent.Restore(stmt)
+		if err != nil {
+			return query, false, err
+		}
+		restored = append(restored, restoredQuery)
+	}
+	return strings.Join(restored, ";"), true, nil
+}
+
+// ensureExtraColumns adds the extra columns missing in the downstream table.
+// The tables created by the sink contain the extra columns, but the tables
+// existing before the changefeed, or recreated by TRUNCATE TABLE, RECOVER TABLE,
+// CREATE TABLE LIKE and EXCHANGE PARTITION may miss them. All these DDLs change
+// the table info, so the table is checked once for each version of it.
+func (w *Writer) ensureExtraColumns(tableInfo *common.TableInfo) error {
+	table, err := w.extraColumns.getTable(tableInfo)
+	if err != nil {
+		return err
+	}
+	if table.checked || w.cfg.DryRun {
+		return nil
+	}
+
+	schemaName, tableName := tableInfo.GetTargetSchemaName(), tableInfo.GetTargetTableName()
+	rows, err := w.db.QueryContext(w.ctx, extraColumnsQuery, schemaName, tableName)
+	if err != nil {
+		return errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	defer rows.Close()
+	existing := make(map[string]struct{})
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return errors.WrapError(errors.ErrMySQLQueryError, err)
+		}
+		existing[strings.ToLower(name)] = struct{}{}
+	}
+	if err = rows.Err(); err != nil {
+		return errors.WrapError(errors.ErrMySQLQueryError, err)
+	}
+	// The table does not exist in the downstream, the write reports the error.
+	if len(existing) == 0 {
+		return nil
+	}
+
+	quoteTable := common.QuoteSchema(schemaName, tableName)
+	for _, column := range w.extraColumns.columns {
+		if _, ok := existing[strings.ToLower(column.name)]; ok {
+			continue
+		}
+		query := "ALTER TABLE " + quoteTable + " ADD COLUMN " + common.QuoteName(column.name) + " " + column.definition()
+		if _, err = w.db.ExecContext(w.ctx, query); err != nil {
+			return errors.ErrExtraColumnFailed.GenWithStackByArgs(
+				fmt.Sprintf("add column %s to table %s: %s", column.name, quoteTable, err.Error()))
+		}
+		log.Info("add the missing extra column to the downstream table",
+			zap.String("changefeed", w.ChangefeedID.String()),
+			zap.String("table", quoteTable),
+			zap.String("column", column.name))
+	}
+	table.checked = true
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestExtraColumnConfig() *Config {
+	cfg := New()
+	cfg.ExtraColumns = []*config.ExtraColumn{
+		{Name: "_source_cluster", Type: config.ExtraColumnTypeSourceCluster, Value: "cluster-a"},
+		{Name: "_source_table", Type: config.ExtraColumnTypeSourceTable},
+		{Name: "_commit_ts", Type: config.ExtraColumnTypeCommitTs},
+		{Name: "_op_type", Type: config.ExtraColumnTypeOpType},
+	}
+	cfg.SoftDeleteColumn = "_is_deleted"
+	return cfg
+}
+
+func TestExtraColumnInjector(t *testing.T) {
+	writer, _, _ := newTestMysqlWriter(t)
+	defer writer.db.Close()
+	writer.cfg.SafeMode = false
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	job := helper.DDL2Job("create table t (id int primary key, name varchar(32));")
+	require.NotNil(t, job)
+
+	injector := newExtraColumnInjector(newTestExtraColumnConfig())
+	insertEvent := helper.DML2Event("test", "t", "insert into t values (1, 'test')")
+	deleteEvent := helper.DML2DeleteEvent("test", "t", "insert into t values (2, 'test')", "delete from t where id = 2")
+	events, err := injector.inject([]*commonEvent.DMLEvent{insertEvent, deleteEvent})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	// the original events are not changed
+	require.Len(t, insertEvent.TableInfo.GetColumns(), 2)
+
+	sqls, args, _ := writer.generateNormalSQL(events[0])
+	require.Equal(t, []string{
+		"INSERT INTO `test`.`t` (`id`,`name`,`_source_cluster`,`_source_table`,`_commit_ts`,`_op_type`,`_is_deleted`) VALUES (?,?,?,?,?,?,?)",
+	}, sqls)
+	require.Equal(t, []interface{}{
+		int64(1), "test", "cluster-a", "t", insertEvent.CommitTs, extraColumnOpInsert, int64(0),
+	}, args[0])
+
+	// the deleted row is turned into an update setting the soft delete column
+	sqls, args, _ = writer.generateNormalSQL(events[1])
+	require.Equal(t, []string{
+		"UPDATE `test`.`t` SET `id` = ?, `name` = ?, `_source_cluster` = ?, `_source_table` = ?, `_commit_ts` = ?, `_op_type` = ?, `_is_deleted` = ? WHERE `id` = ? LIMIT 1",
+	}, sqls)
+	require.Equal(t, []interface{}{
+		int64(2), "test", "cluster-a", "t", deleteEvent.CommitTs, extraColumnOpDelete, int64(1), int64(2),
+	}, args[0])
+
+	// the table without primary key or not null unique key is not supported
+	job = helper.DDL2Job("create table t1 (id int, name varchar(32));")
+	require.NotNil(t, job)
+	event := helper.DML2Event("test", "t1", "insert into t1 values (1, 'test')")
+	_, err = injector.inject([]*commonEvent.DMLEvent{event})
+	require.ErrorContains(t, err, "no primary key")
+
+	// the table without primary key is supported without soft delete, and the
+	// extra columns are not used to find the deleted row
+	noSoftDeleteConfig := newTestExtraColumnConfig()
+	noSoftDeleteConfig.SoftDeleteColumn = ""
+	deleteEvent = helper.DML2DeleteEvent("test", "t1", "insert into t1 values (2, 'test')", "delete from t1 where id = 2")
+	events, err = newExtraColumnInjector(noSoftDeleteConfig).inject([]*commonEvent.DMLEvent{deleteEvent})
+	require.NoError(t, err)
+	sqls, args, _ = writer.generateNormalSQL(events[0])
+	require.Equal(t, []string{"DELETE FROM `test`.`t1` WHERE `id` = ? AND `name` = ? LIMIT 1"}, sqls)
+	require.Equal(t, []interface{}{int64(2), "test"}, args[0])
+
+	// the extra column can not conflict with the upstream columns
+	job = helper.DDL2Job("create table t2 (id int primary key, _commit_ts bigint);")
+	require.NotNil(t, job)
+	event = helper.DML2Event("test", "t2", "insert into t2 values (1, 1)")
+	_, err = injector.inject([]*commonEvent.DMLEvent{event})
+	require.ErrorContains(t, err, "already exists")
+}
+
+func TestEnsureExtraColumns(t *testing.T) {
+	writer, db, mock := newTestMysqlWriter(t)
+	defer db.Close()
+	helper := commonEvent.NewEventTestHelper(t)
+	defer helper.Close()
+
+	helper.Tk().MustExec("use test")
+	require.NotNil(t, helper.DDL2Job("create table t (id int primary key, name varchar(32));"))
+	writer.extraColumns = newExtraColumnInjector(newTestExtraColumnConfig())
+
+	// the table existing before the changefeed misses some extra columns
+	mock.ExpectQuery(extraColumnsQuery).WithArgs("test", "t").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).
+			AddRow("id").AddRow("name").AddRow("_SOURCE_CLUSTER").AddRow("_commit_ts"))
+	mock.ExpectExec("ALTER TABLE `test`.`t` ADD COLUMN `_source_table` VARCHAR(255) NULL COMMENT 'written by TiCDC'").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ALTER TABLE `test`.`t` ADD COLUMN `_op_type` VARCHAR(16) NULL COMMENT 'written by TiCDC'").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ALTER TABLE `test`.`t` ADD COLUMN `_is_deleted` TINYINT(1) NOT NULL DEFAULT 0 COMMENT 'written by TiCDC'").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	event := helper.DML2Event("test", "t", "insert into t values (1, 'test')")
+	require.NoError(t, writer.ensureExtraColumns(event.TableInfo))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the table is checked only once for each version of the table info
+	require.NoError(t, writer.ensureExtraColumns(event.TableInfo))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// the table recreated by truncate table is checked again
+	require.NotNil(t, helper.DDL2Job("truncate table t"))
+	mock.ExpectQuery(extraColumnsQuery).WithArgs("test", "t").
+		WillReturnRows(sqlmock.NewRows([]string{"COLUMN_NAME"}).AddRow("id").AddRow("name").
+			AddRow("_source_cluster").AddRow("_source_table").AddRow("_commit_ts").AddRow("_op_type").AddRow("_is_deleted"))
+	event = helper.DML2Event("test", "t", "insert into t values (2, 'test')")
+	require.NoError(t, writer.ensureExtraColumns(event.TableInfo))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddExtraColumnsToCreateTable(t *testing.T) {
+	t.Parallel()
+
+	injector := newExtraColumnInjector(newTestExtraColumnConfig())
+
+	query, changed, err := injector.addColumnsToCreateTable(
+		"CREATE TABLE `test`.`t` (`id` INT PRIMARY KEY,`_op_type` VARCHAR(8))")
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Contains(t, query, "`_source_cluster` VARCHAR(255) NULL")
+	require.Contains(t, query, "`_source_table` VARCHAR(255) NULL")
+	require.Contains(t, query, "`_commit_ts` BIGINT UNSIGNED NULL")
+	require.Contains(t, query, "`_is_deleted` TINYINT(1) NOT NULL DEFAULT 0 COMMENT 'written by TiCDC'")
+	// the column defined by the statement is not added again
+	require.Contains(t, query, "`_op_type` VARCHAR(8)")
+	require.NotContains(t, query, "`_op_type` VARCHAR(16)")
+
+	// the rewritten query is not changed again when the ddl is retried
+	newQuery, changed, err := injector.addColumnsToCreateTable(query)
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Equal(t, query, newQuery)
+
+	query = "CREATE TABLE `test`.`t1` LIKE `test`.`t`"
+	newQuery, changed, err = injector.addColumnsToCreateTable(query)
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Equal(t, query, newQuery)
+}
@@ -85,6 +85,16 @@ type Writer struct {
 
 	// for dry-run mode
 	blockerTicker *time.Ticker
+
+	// extraColumns adds the extra metadata columns to the written rows and
+	// the created tables, it is nil if no extra column is configured.
+	extraColumns *extraColumnInjector
+	// conflictDeadLetterTableInit records the dead letter tables of the
+	// conflicting rows created by the writer.
+	conflictDeadLetterTableInit map[string]bool
+	// deadLetterQueue is the queue of the transactions rejected by the
+	// downstream, it is nil if the dead letter queue is not configured.
+	deadLetterQueue DeadLetterQueue
 }
 
 func NewWriter(
@@ -115,6 +125,8 @@ func NewWriter(
 		errorCausedSafeModeDuration:    defaultErrorCausedSafeModeDuration,
 		activeActiveSyncStatsCollector: activeActiveSyncStatsCollector,
 		activeActiveSyncStatsInterval:  cfg.ActiveActiveSyncStatsInterval,
+		extraColumns:                   newExtraColumnInjector(cfg),
+		conflictDeadLetterTableInit:    make(map[string]bool),
 	}
 
 	if cfg.DryRun && cfg.DryRunBlockInterval > 0 {
@@ -245,6 +257,13 @@ func (w *Writer) Flush(events []*commonEvent.DMLEvent) error {
 	}
 
 	if err != nil {
+		if w.deadLetterQueue == nil || !w.deadLetterQueue.IsRejectedError(err) {
+			return errors.Trace(err)
+		}
+		if err = w.flushWithDeadLetterQueue(events, err); err != nil {
+			return errors.Trace(err)
+		}
+	} else if err = w.writeConflictingRows(dmls.conflicts); err != nil {
 		return errors.Trace(err)
 	}
 
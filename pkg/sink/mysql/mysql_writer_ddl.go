@@ -77,6 +77,21 @@ func (w *Writer) execDDL(event *commonEvent.DDLEvent) error {
 				zap.String("newQuery", newQuery))
 			event.Query = newQuery
 		}
+	case timodel.ActionCreateTable, timodel.ActionCreateTables:
+		// The routed tables need the extra columns written by the DMLs.
+		if w.extraColumns != nil {
+			newQuery, changed, err := w.extraColumns.addColumnsToCreateTable(event.Query)
+			if err != nil {
+				return err
+			}
+			if changed {
+				log.Info("add extra columns to create table ddl",
+					zap.String("changefeed", w.ChangefeedID.String()),
+					zap.String("query", event.Query),
+					zap.String("newQuery", newQuery))
+				event.Query = newQuery
+			}
+		}
 	}
 	// Convert vector type to string type for unsupport database
 	if w.cfg.HasVectorType {
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pingcap/ticdc/pkg/common"
 	commonEvent "github.com/pingcap/ticdc/pkg/common/event"
+	"github.com/pingcap/ticdc/pkg/config"
 )
 
 func groupEventsByTable(events []*commonEvent.DMLEvent) map[int64][][]*commonEvent.DMLEvent {
@@ -91,8 +92,37 @@ func (w *Writer) prepareDMLs(events []*commonEvent.DMLEvent) (*preparedDMLs, err
 	)
 	for _, sortedEventGroups := range eventsGroupSortedByUpdateTs {
 		for _, eventsInGroup := range sortedEventGroups {
+			if w.extraColumns != nil {
+				if err := w.ensureExtraColumns(eventsInGroup[0].TableInfo); err != nil {
+					return dmls, err
+				}
+				var err error
+				eventsInGroup, err = w.extraColumns.inject(eventsInGroup)
+				if err != nil {
+					return dmls, err
+				}
+			}
 			tableInfo := eventsInGroup[0].TableInfo
-			if w.cfg.EnableActiveActive {
+			if policy := w.cfg.matchConflictPolicy(tableInfo); policy != nil {
+				if policy.rule.Policy == config.ConflictPolicyDeadLetter &&
+					w.deadLetterQueue == nil && !w.cfg.DryRun {
+					if err := w.createConflictDeadLetterTable(policy.rule.DeadLetterTable); err != nil {
+						return dmls, err
+					}
+				}
+				var (
+					checks []*conflictCheck
+					err    error
+				)
+				queryList, argsList, rowTypesList, checks, err = w.generateConflictPolicySQLs(policy, eventsInGroup)
+				if err != nil {
+					return dmls, err
+				}
+				for _, check := range checks {
+					check.index += len(dmls.sqls)
+					dmls.conflictChecks = append(dmls.conflictChecks, check)
+				}
+			} else if w.cfg.EnableActiveActive {
 				queryList, argsList, rowTypesList = w.genActiveActiveSQL(tableInfo, eventsInGroup)
 			} else {
 				if !w.shouldGenBatchSQL(tableInfo, eventsInGroup) {
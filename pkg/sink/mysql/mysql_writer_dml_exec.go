@@ -55,8 +55,11 @@ func (w *Writer) execDMLWithMaxRetries(dmls *preparedDMLs) error {
 			}
 		}()
 		err := w.dmlSession.withConn(w, writeTimeout, func(conn *sql.Conn) error {
-			if fallbackToSeqWay || !w.cfg.MultiStmtEnable {
+			// the conflicts are detected by the affected rows of each statement,
+			// so the dmls with the conflict checks are executed in sequence way.
+			if fallbackToSeqWay || !w.cfg.MultiStmtEnable || len(dmls.conflictChecks) > 0 {
 				// use sequence way to execute the dmls
+				dmls.conflicts = nil
 				tx, err := conn.BeginTx(w.ctx, nil)
 				if err != nil {
 					return errors.Trace(err)
@@ -126,9 +129,12 @@ func (w *Writer) execDMLWithMaxRetries(dmls *preparedDMLs) error {
 }
 
 // sequenceExecute runs each SQL sequentially inside a transaction.
+// The row of the statement with a conflict check is checked in the transaction
+// if the statement affects no row.
 func (w *Writer) sequenceExecute(
 	dmls *preparedDMLs, tx *sql.Tx, writeTimeout time.Duration,
 ) error {
+	checks := dmls.conflictChecks
 	for i, query := range dmls.sqls {
 		args := dmls.values[i]
 		log.Debug("exec row", zap.String("sql", query), zap.String("args", util.RedactArgs(args)), zap.Int("writerID", w.id))
@@ -169,11 +175,27 @@ func (w *Writer) sequenceExecute(
 			cancelFunc()
 			return errors.WrapError(errors.ErrMySQLTxnError, errors.WithMessage(execError, fmt.Sprintf("Failed to execute DMLs, query info:%s, args:%v; ", query, util.RedactArgs(args))))
 		}
-		if rowsAffected, err := res.RowsAffected(); err != nil {
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
 			log.Warn("get rows affected rows failed", zap.Error(err))
 		} else {
 			w.statistics.RecordRowsAffected(rowsAffected, dmls.rowTypes[i])
 		}
+		if len(checks) > 0 && checks[0].index == i {
+			if err != nil || rowsAffected == 0 {
+				err = w.checkConflict(ctx, tx, checks[0], dmls)
+				if err != nil {
+					if rbErr := tx.Rollback(); rbErr != nil {
+						if !errors.Is(errors.Cause(rbErr), context.Canceled) {
+							log.Warn("failed to rollback txn", zap.Error(rbErr), zap.Int("writerID", w.id))
+						}
+					}
+					cancelFunc()
+					return err
+				}
+			}
+			checks = checks[1:]
+		}
 		cancelFunc()
 	}
 	return nil
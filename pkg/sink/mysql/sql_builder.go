@@ -39,6 +39,12 @@ type preparedDMLs struct {
 	rowCount        int
 	approximateSize int64
 	tsPairs         []tsPair
+	// conflictChecks are the statements whose rows are written to the dead
+	// letter by the conflict policy if they conflict with the existing rows.
+	conflictChecks []*conflictCheck
+	// conflicts are the rows written to the dead letter queue by the
+	// conflict policy after the transaction is committed.
+	conflicts []*conflictingRow
 }
 
 func (d *preparedDMLs) LogWithoutValues() string {
@@ -146,6 +152,8 @@ func (d *preparedDMLs) reset() {
 	d.values = d.values[:0]
 	d.rowTypes = d.rowTypes[:0]
 	d.tsPairs = d.tsPairs[:0]
+	d.conflictChecks = nil
+	d.conflicts = nil
 	d.rowCount = 0
 	d.approximateSize = 0
 }
@@ -399,10 +407,11 @@ func whereSlice(row *chunk.Row, tableInfo *common.TableInfo) ([]string, []interf
 		args = append(args, v)
 	}
 
-	// if no explicit row id, use all key-values in where condition
+	// if no explicit row id, use all key-values in where condition,
+	// the extra columns written by the sink are not a part of the row.
 	if len(colNames) == 0 {
 		for i, col := range tableInfo.GetColumns() {
-			if col != nil && !col.IsVirtualGenerated() {
+			if col != nil && !col.IsVirtualGenerated() && col.Comment != extraColumnComment {
 				colNames = append(colNames, col.Name.O)
 				v := common.ExtractColVal(row, col, i)
 				args = append(args, v)
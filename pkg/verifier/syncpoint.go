@@ -0,0 +1,71 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/pingcap/errors"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+)
+
+// Syncpoint is a pair of upstream and downstream tso recorded by the
+// syncpoint of a changefeed, the upstream snapshot at PrimaryTs is
+// consistent with the downstream snapshot at SecondaryTs.
+type Syncpoint struct {
+	PrimaryTs   uint64 `json:"primary_ts"`
+	SecondaryTs uint64 `json:"secondary_ts"`
+}
+
+// QuerySyncpoint queries the syncpoint of the changefeed from the syncpoint
+// table in the downstream. If primaryTs is 0, the latest syncpoint is returned.
+func QuerySyncpoint(
+	ctx context.Context, db *sql.DB, clusterID, changefeed string, primaryTs uint64,
+) (*Syncpoint, error) {
+	query := fmt.Sprintf("SELECT primary_ts, secondary_ts FROM `%s`.`%s` WHERE ticdc_cluster_id = ? AND changefeed = ?",
+		filter.TiCDCSystemSchema, filter.SyncPointTable)
+	args := []any{clusterID, changefeed}
+	if primaryTs != 0 {
+		query += " AND primary_ts = ?"
+		args = append(args, strconv.FormatUint(primaryTs, 10))
+	}
+	query += " ORDER BY CAST(primary_ts AS UNSIGNED) DESC LIMIT 1"
+
+	var primary, secondary string
+	err := db.QueryRowContext(ctx, query, args...).Scan(&primary, &secondary)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if primaryTs != 0 {
+				return nil, cerror.ErrSyncPointNotFound.GenWithStackByArgs(
+					fmt.Sprintf("changefeed %s, primary ts %d", changefeed, primaryTs))
+			}
+			return nil, cerror.ErrSyncPointNotFound.GenWithStackByArgs(
+				fmt.Sprintf("changefeed %s", changefeed))
+		}
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+
+	sp := &Syncpoint{}
+	if sp.PrimaryTs, err = strconv.ParseUint(primary, 10, 64); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if sp.SecondaryTs, err = strconv.ParseUint(secondary, 10, 64); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sp, nil
+}
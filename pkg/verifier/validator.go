@@ -0,0 +1,370 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+	"github.com/pingcap/ticdc/pkg/integrity"
+	"github.com/pingcap/ticdc/pkg/metrics"
+	"github.com/pingcap/ticdc/pkg/routing"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tikv/client-go/v2/oracle"
+	"go.uber.org/zap"
+)
+
+// ValidationResultTable is the table in the downstream to record the results
+// of the periodic validation.
+const ValidationResultTable = "validation_result"
+
+const (
+	validationResultConsistent   = "consistent"
+	validationResultInconsistent = "inconsistent"
+	validationResultError        = "error"
+)
+
+// Validator validates the data of a changefeed at the syncpoints. After a
+// syncpoint is written, it samples some tables at the latest syncpoint,
+// compares the checksums of the upstream snapshot at the primary ts and the
+// downstream snapshot at the secondary ts, and records the results in the
+// downstream. The tables are sampled in turn, so all the tables are validated
+// after enough syncpoints.
+type Validator struct {
+	changefeedID common.ChangeFeedID
+	clusterID    string
+	cfg          *config.ValidationConfig
+	cfConfig     *config.ChangefeedConfig
+	filter       filter.Filter
+	router       routing.Router
+
+	// lastPrimaryTs is the primary ts of the last validated syncpoint.
+	lastPrimaryTs uint64
+	// nextTable is the index of the first table to sample in the next round.
+	nextTable    int
+	tableCreated bool
+	// notifyCh is notified after a syncpoint is written.
+	notifyCh chan struct{}
+
+	consistentCounter   prometheus.Counter
+	inconsistentCounter prometheus.Counter
+	errorCounter        prometheus.Counter
+	primaryTsGauge      prometheus.Gauge
+	duration            prometheus.Observer
+}
+
+// NewValidator creates a validator for the changefeed.
+func NewValidator(info *config.ChangeFeedInfo) (*Validator, error) {
+	cfConfig := info.ToChangefeedConfig()
+	f, err := filter.NewFilter(cfConfig.Filter, "", cfConfig.CaseSensitive, cfConfig.ForceReplicate)
+	if err != nil {
+		return nil, err
+	}
+	router, err := routing.NewRouter(info.ChangefeedID,
+		util.GetOrZero(cfConfig.SinkConfig.CaseSensitive), cfConfig.SinkConfig.DispatchRules)
+	if err != nil {
+		return nil, err
+	}
+	keyspace, name := info.ChangefeedID.Keyspace(), info.ChangefeedID.Name()
+	return &Validator{
+		changefeedID: info.ChangefeedID,
+		clusterID:    config.GetGlobalServerConfig().ClusterID,
+		cfg:          info.Config.Validation,
+		cfConfig:     cfConfig,
+		filter:       f,
+		router:       router,
+		notifyCh:     make(chan struct{}, 1),
+
+		consistentCounter:   metrics.ValidationTableCounter.WithLabelValues(keyspace, name, validationResultConsistent),
+		inconsistentCounter: metrics.ValidationTableCounter.WithLabelValues(keyspace, name, validationResultInconsistent),
+		errorCounter:        metrics.ValidationTableCounter.WithLabelValues(keyspace, name, validationResultError),
+		primaryTsGauge:      metrics.ValidationPrimaryTsGauge.WithLabelValues(keyspace, name),
+		duration:            metrics.ValidationDuration.WithLabelValues(keyspace, name),
+	}, nil
+}
+
+// NotifySyncPoint triggers a validation of the latest syncpoint, it is called
+// after a syncpoint is written and never blocks.
+func (v *Validator) NotifySyncPoint() {
+	select {
+	case v.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// Run validates the changefeed at each new syncpoint until the context is
+// canceled. A failed round is logged and retried at the next syncpoint, it
+// never affects the replication.
+func (v *Validator) Run(ctx context.Context) {
+	defer v.cleanupMetrics()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-v.notifyCh:
+		}
+		if err := v.validate(ctx); err != nil {
+			log.Warn("validate changefeed failed",
+				zap.String("keyspace", v.changefeedID.Keyspace()),
+				zap.String("changefeed", v.changefeedID.Name()),
+				zap.Error(err))
+		}
+	}
+}
+
+func (v *Validator) validate(ctx context.Context) error {
+	start := time.Now()
+	db, err := openDB(v.cfConfig.SinkURI, v.changefeedID, v.cfConfig, 0)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	sp, err := QuerySyncpoint(ctx, db, v.clusterID, v.changefeedID.String(), 0)
+	if err != nil {
+		if cerror.ErrSyncPointNotFound.Equal(err) {
+			return nil
+		}
+		return err
+	}
+	if !v.shouldValidate(sp.PrimaryTs) {
+		return nil
+	}
+
+	upstream, err := openDB(util.GetOrZero(v.cfg.UpstreamURI), v.changefeedID, v.cfConfig, sp.PrimaryTs)
+	if err != nil {
+		return err
+	}
+	defer upstream.Close()
+	downstream, err := openDB(v.cfConfig.SinkURI, v.changefeedID, v.cfConfig, sp.SecondaryTs)
+	if err != nil {
+		return err
+	}
+	defer downstream.Close()
+
+	tables, err := listTables(ctx, upstream, v.filter, nil)
+	if err != nil {
+		return err
+	}
+	tables = v.sampleTables(tables)
+	results := make([]*validationResult, 0, len(tables))
+	for _, table := range tables {
+		target := v.router.RouteTable(table.Schema, table.Table).Target
+		results = append(results, validateTable(ctx, table, target, upstream, downstream))
+	}
+	if err = v.saveResults(ctx, db, sp, results); err != nil {
+		return err
+	}
+
+	v.lastPrimaryTs = sp.PrimaryTs
+	v.primaryTsGauge.Set(float64(sp.PrimaryTs))
+	v.duration.Observe(time.Since(start).Seconds())
+	inconsistent := 0
+	for _, result := range results {
+		switch {
+		case result.err != nil:
+			v.errorCounter.Inc()
+			inconsistent++
+		case !result.consistent():
+			v.inconsistentCounter.Inc()
+			inconsistent++
+		default:
+			v.consistentCounter.Inc()
+		}
+	}
+	log.Info("validate changefeed at syncpoint finished",
+		zap.String("keyspace", v.changefeedID.Keyspace()),
+		zap.String("changefeed", v.changefeedID.Name()),
+		zap.Uint64("primaryTs", sp.PrimaryTs),
+		zap.Uint64("secondaryTs", sp.SecondaryTs),
+		zap.Int("tables", len(results)),
+		zap.Int("inconsistentTables", inconsistent),
+		zap.Duration("duration", time.Since(start)))
+	return nil
+}
+
+// shouldValidate returns whether the syncpoint of the primary ts is validated,
+// the syncpoints within the interval after the last validated one are skipped.
+// The interval is measured by the primary ts, so every syncpoint is validated
+// if the interval is the same as the sync point interval.
+func (v *Validator) shouldValidate(primaryTs uint64) bool {
+	if primaryTs <= v.lastPrimaryTs {
+		return false
+	}
+	if v.lastPrimaryTs == 0 {
+		return true
+	}
+	elapsed := oracle.GetTimeFromTS(primaryTs).Sub(oracle.GetTimeFromTS(v.lastPrimaryTs))
+	return elapsed >= util.GetOrZero(v.cfg.Interval)
+}
+
+// sampleTables returns at most SampleTableCount tables, starting from the
+// table next to the last sampled one.
+func (v *Validator) sampleTables(tables []routing.TableKey) []routing.TableKey {
+	count := util.GetOrZero(v.cfg.SampleTableCount)
+	if len(tables) <= count {
+		return tables
+	}
+	if v.nextTable >= len(tables) {
+		v.nextTable = 0
+	}
+	sampled := make([]routing.TableKey, 0, count)
+	for i := 0; i < count; i++ {
+		sampled = append(sampled, tables[(v.nextTable+i)%len(tables)])
+	}
+	v.nextTable = (v.nextTable + count) % len(tables)
+	return sampled
+}
+
+func (v *Validator) saveResults(
+	ctx context.Context, db *sql.DB, sp *Syncpoint, results []*validationResult,
+) error {
+	if !v.tableCreated {
+		if err := createValidationResultTable(ctx, db); err != nil {
+			return err
+		}
+		v.tableCreated = true
+	}
+	if len(results) != 0 {
+		query, args := buildSaveResultsQuery(v.clusterID, v.changefeedID.String(), sp, results)
+		if _, err := db.ExecContext(ctx, query, args...); err != nil {
+			return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+		}
+	}
+
+	// the results are kept as long as the syncpoints
+	query := fmt.Sprintf("DELETE FROM `%s`.`%s` WHERE ticdc_cluster_id = ? AND changefeed = ? AND created_at < (NOW() - INTERVAL ? SECOND)",
+		filter.TiCDCSystemSchema, ValidationResultTable)
+	_, err := db.ExecContext(ctx, query, v.clusterID, v.changefeedID.String(),
+		int64(v.cfConfig.SyncPointRetention.Seconds()))
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+	}
+	return nil
+}
+
+func (v *Validator) cleanupMetrics() {
+	keyspace, name := v.changefeedID.Keyspace(), v.changefeedID.Name()
+	metrics.ValidationTableCounter.DeleteLabelValues(keyspace, name, validationResultConsistent)
+	metrics.ValidationTableCounter.DeleteLabelValues(keyspace, name, validationResultInconsistent)
+	metrics.ValidationTableCounter.DeleteLabelValues(keyspace, name, validationResultError)
+	metrics.ValidationPrimaryTsGauge.DeleteLabelValues(keyspace, name)
+	metrics.ValidationDuration.DeleteLabelValues(keyspace, name)
+}
+
+// validationResult is the validation result of a table.
+type validationResult struct {
+	source     routing.TableKey
+	target     routing.TableKey
+	upstream   integrity.TableChecksum
+	downstream integrity.TableChecksum
+	err        error
+}
+
+func (r *validationResult) consistent() bool {
+	return r.err == nil && r.upstream.Equal(r.downstream)
+}
+
+// validateTable calculates the checksums of a table in the upstream and the
+// downstream, the columns of the upstream table are used for both of them.
+func validateTable(
+	ctx context.Context, source, target routing.TableKey, upstream, downstream *sql.DB,
+) *validationResult {
+	result := &validationResult{source: source, target: target}
+	columns, err := integrity.QueryTableColumns(ctx, upstream, source.Schema, source.Table)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	result.upstream, err = integrity.CalculateTableChecksum(ctx, upstream, source.Schema, source.Table, columns)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	result.downstream, err = integrity.CalculateTableChecksum(ctx, downstream, target.Schema, target.Table, columns)
+	if err != nil {
+		result.err = err
+	}
+	return result
+}
+
+func createValidationResultTable(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, "CREATE DATABASE IF NOT EXISTS "+filter.TiCDCSystemSchema)
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+	}
+	query := `CREATE TABLE IF NOT EXISTS %s.%s
+	(
+		ticdc_cluster_id varchar (255) NOT NULL,
+		changefeed varchar(255) NOT NULL,
+		primary_ts varchar(18),
+		secondary_ts varchar(18),
+		table_schema varchar(255),
+		table_name varchar(255),
+		target_schema varchar(255),
+		target_table varchar(255),
+		upstream_row_count bigint,
+		upstream_checksum bigint unsigned,
+		downstream_row_count bigint,
+		downstream_checksum bigint unsigned,
+		consistent tinyint(1),
+		error text,
+		created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		INDEX (created_at),
+		PRIMARY KEY (ticdc_cluster_id, changefeed, primary_ts, table_schema, table_name)
+	);`
+	_, err = db.ExecContext(ctx, fmt.Sprintf(query, filter.TiCDCSystemSchema, ValidationResultTable))
+	if err != nil {
+		return cerror.WrapError(cerror.ErrMySQLTxnError, err)
+	}
+	return nil
+}
+
+func buildSaveResultsQuery(
+	clusterID, changefeed string, sp *Syncpoint, results []*validationResult,
+) (string, []any) {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "REPLACE INTO `%s`.`%s` (ticdc_cluster_id, changefeed, primary_ts, secondary_ts, "+
+		"table_schema, table_name, target_schema, target_table, upstream_row_count, upstream_checksum, "+
+		"downstream_row_count, downstream_checksum, consistent, error) VALUES ",
+		filter.TiCDCSystemSchema, ValidationResultTable)
+	args := make([]any, 0, len(results)*14)
+	for i, result := range results {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString("(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+		var errMsg any
+		if result.err != nil {
+			errMsg = result.err.Error()
+		}
+		args = append(args, clusterID, changefeed,
+			strconv.FormatUint(sp.PrimaryTs, 10), strconv.FormatUint(sp.SecondaryTs, 10),
+			result.source.Schema, result.source.Table, result.target.Schema, result.target.Table,
+			result.upstream.RowCount, result.upstream.Checksum,
+			result.downstream.RowCount, result.downstream.Checksum,
+			result.consistent(), errMsg)
+	}
+	return builder.String(), args
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/integrity"
+	"github.com/pingcap/ticdc/pkg/routing"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/oracle"
+)
+
+func TestValidatorSampleTables(t *testing.T) {
+	v := &Validator{cfg: &config.ValidationConfig{SampleTableCount: util.AddressOf(2)}}
+	tables := []routing.TableKey{
+		{Schema: "test", Table: "t1"},
+		{Schema: "test", Table: "t2"},
+		{Schema: "test", Table: "t3"},
+	}
+	require.Equal(t, tables[:2], v.sampleTables(tables))
+	require.Equal(t, []routing.TableKey{tables[2], tables[0]}, v.sampleTables(tables))
+	require.Equal(t, tables[1:], v.sampleTables(tables))
+
+	// a table is dropped, the sampling restarts from the first table
+	v.nextTable = 2
+	require.Equal(t, tables[:2], v.sampleTables(tables[:2]))
+	require.Equal(t, tables[:1], v.sampleTables(tables[:1]))
+}
+
+func TestValidatorShouldValidate(t *testing.T) {
+	v := &Validator{cfg: &config.ValidationConfig{Interval: util.AddressOf(time.Minute)}}
+	start := time.Now()
+	primaryTs := oracle.GoTimeToTS(start)
+	require.True(t, v.shouldValidate(primaryTs))
+
+	v.lastPrimaryTs = primaryTs
+	// the syncpoint is validated already
+	require.False(t, v.shouldValidate(primaryTs))
+	// the syncpoint is within the interval after the last validated one
+	require.False(t, v.shouldValidate(oracle.GoTimeToTS(start.Add(30*time.Second))))
+	require.True(t, v.shouldValidate(oracle.GoTimeToTS(start.Add(time.Minute))))
+
+	// the notifications are merged, so the notifier never blocks
+	v.notifyCh = make(chan struct{}, 1)
+	v.NotifySyncPoint()
+	v.NotifySyncPoint()
+	require.Len(t, v.notifyCh, 1)
+}
+
+func TestValidatorSaveResults(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	v := &Validator{
+		changefeedID: common.NewChangeFeedIDWithName("test", common.DefaultKeyspaceName),
+		clusterID:    "default",
+		cfConfig:     &config.ChangefeedConfig{SyncPointRetention: time.Hour},
+	}
+	sp := &Syncpoint{PrimaryTs: 200, SecondaryTs: 210}
+	results := []*validationResult{
+		{
+			source:     routing.TableKey{Schema: "test", Table: "t1"},
+			target:     routing.TableKey{Schema: "test", Table: "t1"},
+			upstream:   integrity.TableChecksum{RowCount: 1, Checksum: 100},
+			downstream: integrity.TableChecksum{RowCount: 1, Checksum: 100},
+		},
+		{
+			source: routing.TableKey{Schema: "test", Table: "t2"},
+			target: routing.TableKey{Schema: "test", Table: "t2"},
+			err:    errors.New("table test.t2 does not exist"),
+		},
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE DATABASE IF NOT EXISTS tidb_cdc")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	// the results of the changefeeds with the same name in different clusters
+	// are kept separately.
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS tidb_cdc.validation_result") + ".*" +
+		regexp.QuoteMeta("PRIMARY KEY (ticdc_cluster_id, changefeed, primary_ts, table_schema, table_name)")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("REPLACE INTO `tidb_cdc`.`validation_result`")).
+		WithArgs(
+			"default", "default/test", "200", "210", "test", "t1", "test", "t1",
+			int64(1), uint64(100), int64(1), uint64(100), true, nil,
+			"default", "default/test", "200", "210", "test", "t2", "test", "t2",
+			int64(0), uint64(0), int64(0), uint64(0), false, "table test.t2 does not exist").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `tidb_cdc`.`validation_result`")).
+		WithArgs("default", "default/test", int64(3600)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, v.saveResults(context.Background(), db, sp, results))
+	require.True(t, v.tableCreated)
+
+	// the table is only created once
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `tidb_cdc`.`validation_result`")).
+		WithArgs("default", "default/test", int64(3600)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, v.saveResults(context.Background(), db, sp, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
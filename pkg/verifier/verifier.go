@@ -0,0 +1,308 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/pkg/common"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/diff"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+	"github.com/pingcap/ticdc/pkg/routing"
+	"github.com/pingcap/ticdc/pkg/sink/mysql"
+	"github.com/pingcap/ticdc/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/dbutil"
+	tfilter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"go.uber.org/zap"
+)
+
+// Config is the config of a verification job.
+type Config struct {
+	ChangefeedID common.ChangeFeedID
+	// ClusterID is the id of the TiCDC cluster, it is used to find the
+	// syncpoints of the changefeed.
+	ClusterID string
+	// ChangefeedConfig is the config of the changefeed to verify, its sink
+	// uri must be a MySQL compatible one.
+	ChangefeedConfig *config.ChangefeedConfig
+	// UpstreamURI is a MySQL compatible uri of the upstream TiDB.
+	UpstreamURI string
+	// SyncpointTs is the primary ts of the syncpoint to verify at,
+	// 0 means the latest syncpoint.
+	SyncpointTs uint64
+	// Tables are the table filter rules to limit the tables to verify,
+	// empty means all the tables replicated by the changefeed.
+	Tables []string
+	// ChunkSize is the number of rows of a chunk.
+	ChunkSize int
+	// CheckThreadCount is the number of goroutines to check chunks of a table.
+	CheckThreadCount int
+	// Resume continues the last verification from its checkpoint. The
+	// checkpoint is only used if the last verification is done at the same
+	// syncpoint with the same config.
+	Resume bool
+	// FixSQL generates the SQL to fix the downstream data.
+	FixSQL bool
+	// CheckpointURI is a MySQL compatible uri of the database saving the
+	// checkpoint of the verification, empty means the downstream.
+	CheckpointURI string
+}
+
+// Result is the result of a verification job.
+type Result struct {
+	Syncpoint
+	Consistent bool           `json:"consistent"`
+	Tables     []*TableResult `json:"tables"`
+}
+
+// TableResult is the verification result of a table.
+type TableResult struct {
+	Schema           string         `json:"schema"`
+	Table            string         `json:"table"`
+	TargetSchema     string         `json:"target_schema"`
+	TargetTable      string         `json:"target_table"`
+	StructEqual      bool           `json:"struct_equal"`
+	DataEqual        bool           `json:"data_equal"`
+	MismatchedChunks []*ChunkResult `json:"mismatched_chunks,omitempty"`
+	FixSQL           []string       `json:"fix_sql,omitempty"`
+	Error            string         `json:"error,omitempty"`
+}
+
+// Consistent returns true if the table is consistent between the upstream
+// and the downstream.
+func (r *TableResult) Consistent() bool {
+	return r.Error == "" && r.StructEqual && r.DataEqual
+}
+
+// ChunkResult is a chunk whose data is not equal or failed to be checked.
+type ChunkResult struct {
+	ID    int    `json:"id"`
+	Range string `json:"range"`
+	State string `json:"state"`
+}
+
+// Verify compares the upstream snapshot at the primary ts of a syncpoint with
+// the downstream snapshot at the secondary ts of the same syncpoint, and
+// reports the tables and chunks that are not consistent. An error of a table
+// is recorded in its result instead of stopping the whole verification.
+func Verify(ctx context.Context, cfg *Config) (*Result, error) {
+	cfConfig := cfg.ChangefeedConfig
+	rules, err := newTableRules(cfg.Tables, cfConfig.CaseSensitive)
+	if err != nil {
+		return nil, err
+	}
+	f, err := filter.NewFilter(cfConfig.Filter, "", cfConfig.CaseSensitive, cfConfig.ForceReplicate)
+	if err != nil {
+		return nil, err
+	}
+	router, err := routing.NewRouter(cfg.ChangefeedID,
+		util.GetOrZero(cfConfig.SinkConfig.CaseSensitive), cfConfig.SinkConfig.DispatchRules)
+	if err != nil {
+		return nil, err
+	}
+
+	// the syncpoints are queried from the downstream without snapshot.
+	syncpointDB, err := openDB(cfConfig.SinkURI, cfg.ChangefeedID, cfConfig, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer syncpointDB.Close()
+	// the checkpoint of the verification is saved in the downstream, unless
+	// another database is configured.
+	cpDB := syncpointDB
+	if cfg.CheckpointURI != "" {
+		cpDB, err = openDB(cfg.CheckpointURI, cfg.ChangefeedID, cfConfig, 0)
+		if err != nil {
+			return nil, err
+		}
+		defer cpDB.Close()
+	}
+
+	sp, err := QuerySyncpoint(ctx, syncpointDB, cfg.ClusterID, cfg.ChangefeedID.String(), cfg.SyncpointTs)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("verify changefeed at syncpoint",
+		zap.String("keyspace", cfg.ChangefeedID.Keyspace()),
+		zap.String("changefeed", cfg.ChangefeedID.Name()),
+		zap.Uint64("primaryTs", sp.PrimaryTs),
+		zap.Uint64("secondaryTs", sp.SecondaryTs),
+		zap.Bool("resume", cfg.Resume))
+
+	upstream, err := openDB(cfg.UpstreamURI, cfg.ChangefeedID, cfConfig, sp.PrimaryTs)
+	if err != nil {
+		return nil, err
+	}
+	defer upstream.Close()
+	downstream, err := openDB(cfConfig.SinkURI, cfg.ChangefeedID, cfConfig, sp.SecondaryTs)
+	if err != nil {
+		return nil, err
+	}
+	defer downstream.Close()
+
+	tables, err := listTables(ctx, upstream, f, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Syncpoint: *sp, Consistent: true}
+	for _, table := range tables {
+		if err = ctx.Err(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		target := router.RouteTable(table.Schema, table.Table).Target
+		tableResult := verifyTable(ctx, cfg, sp, table, target, upstream, downstream, cpDB)
+		if !tableResult.Consistent() {
+			result.Consistent = false
+		}
+		result.Tables = append(result.Tables, tableResult)
+	}
+	return result, nil
+}
+
+func verifyTable(
+	ctx context.Context, cfg *Config, sp *Syncpoint,
+	source, target routing.TableKey,
+	upstream, downstream, cpDB *sql.DB,
+) *TableResult {
+	result := &TableResult{
+		Schema:       source.Schema,
+		Table:        source.Table,
+		TargetSchema: target.Schema,
+		TargetTable:  target.Table,
+	}
+	// The instance ids are a part of the checkpoint config hash, so the
+	// checkpoint of a verification can only be resumed at the same syncpoint.
+	td := &diff.TableDiff{
+		SourceTables: []*diff.TableInstance{{
+			Conn:       upstream,
+			Schema:     source.Schema,
+			Table:      source.Table,
+			InstanceID: fmt.Sprintf("upstream-%d", sp.PrimaryTs),
+		}},
+		TargetTable: &diff.TableInstance{
+			Conn:       downstream,
+			Schema:     target.Schema,
+			Table:      target.Table,
+			InstanceID: fmt.Sprintf("downstream-%d", sp.SecondaryTs),
+		},
+		ChunkSize:        cfg.ChunkSize,
+		CheckThreadCount: cfg.CheckThreadCount,
+		UseChecksum:      true,
+		UseCheckpoint:    cfg.Resume,
+		CpDB:             cpDB,
+	}
+	structEqual, dataEqual, err := td.Equal(ctx, func(fixSQL string) error {
+		if cfg.FixSQL {
+			result.FixSQL = append(result.FixSQL, strings.TrimSpace(fixSQL))
+		}
+		return nil
+	})
+	result.StructEqual = structEqual
+	result.DataEqual = dataEqual
+	if err != nil {
+		log.Warn("verify table failed",
+			zap.String("keyspace", cfg.ChangefeedID.Keyspace()),
+			zap.String("changefeed", cfg.ChangefeedID.Name()),
+			zap.String("table", dbutil.TableName(source.Schema, source.Table)),
+			zap.Error(err))
+		result.Error = err.Error()
+	}
+	for _, chunk := range td.MismatchedChunks() {
+		result.MismatchedChunks = append(result.MismatchedChunks, &ChunkResult{
+			ID:    chunk.ID,
+			Range: dbutil.ReplacePlaceholder(chunk.Where, chunk.Args),
+			State: chunk.State,
+		})
+	}
+	return result
+}
+
+// openDB opens a connection pool to a MySQL compatible database, all the
+// connections read the snapshot at snapshotTs if it is not 0.
+func openDB(
+	uri string, changefeedID common.ChangeFeedID, cfConfig *config.ChangefeedConfig, snapshotTs uint64,
+) (*sql.DB, error) {
+	dbURI, err := url.Parse(uri)
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrSinkURIInvalid, err, uri)
+	}
+	mysqlCfg := mysql.New()
+	if err = mysqlCfg.Apply(dbURI, changefeedID, cfConfig); err != nil {
+		return nil, err
+	}
+	dsn, err := mysql.GenBasicDSN(mysqlCfg)
+	if err != nil {
+		return nil, err
+	}
+	if snapshotTs != 0 {
+		dsn.Params["tidb_snapshot"] = strconv.FormatUint(snapshotTs, 10)
+	}
+	return mysql.CreateMysqlDBConn(dsn.FormatDSN())
+}
+
+// listTables lists the tables in the upstream which are replicated by the
+// changefeed and matched by the rules.
+func listTables(
+	ctx context.Context, db *sql.DB, f filter.Filter, rules tfilter.Filter,
+) ([]routing.TableKey, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT TABLE_SCHEMA, TABLE_NAME FROM information_schema.TABLES WHERE TABLE_TYPE = 'BASE TABLE' ORDER BY TABLE_SCHEMA, TABLE_NAME")
+	if err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	defer rows.Close()
+
+	var tables []routing.TableKey
+	for rows.Next() {
+		var table routing.TableKey
+		if err = rows.Scan(&table.Schema, &table.Table); err != nil {
+			return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+		}
+		if f.ShouldIgnoreTable(table.Schema, table.Table) {
+			continue
+		}
+		if rules != nil && !rules.MatchTable(table.Schema, table.Table) {
+			continue
+		}
+		tables = append(tables, table)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, cerror.WrapError(cerror.ErrMySQLQueryError, err)
+	}
+	return tables, nil
+}
+
+func newTableRules(rules []string, caseSensitive bool) (tfilter.Filter, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	f, err := filter.VerifyTableRules(&config.FilterConfig{Rules: rules})
+	if err != nil {
+		return nil, err
+	}
+	if !caseSensitive {
+		f = tfilter.CaseInsensitive(f)
+	}
+	return f, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pingcap/ticdc/pkg/config"
+	cerror "github.com/pingcap/ticdc/pkg/errors"
+	"github.com/pingcap/ticdc/pkg/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerySyncpoint(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+
+	// the latest syncpoint
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT primary_ts, secondary_ts FROM `tidb_cdc`.`syncpoint_v1` WHERE ticdc_cluster_id = ? AND changefeed = ? ORDER BY")).
+		WithArgs("default", "default/test").
+		WillReturnRows(sqlmock.NewRows([]string{"primary_ts", "secondary_ts"}).AddRow("200", "210"))
+	sp, err := QuerySyncpoint(ctx, db, "default", "default/test", 0)
+	require.NoError(t, err)
+	require.Equal(t, &Syncpoint{PrimaryTs: 200, SecondaryTs: 210}, sp)
+
+	// the syncpoint at the given primary ts
+	mock.ExpectQuery(regexp.QuoteMeta("AND primary_ts = ?")).
+		WithArgs("default", "default/test", "100").
+		WillReturnRows(sqlmock.NewRows([]string{"primary_ts", "secondary_ts"}).AddRow("100", "105"))
+	sp, err = QuerySyncpoint(ctx, db, "default", "default/test", 100)
+	require.NoError(t, err)
+	require.Equal(t, &Syncpoint{PrimaryTs: 100, SecondaryTs: 105}, sp)
+
+	// the syncpoint does not exist
+	mock.ExpectQuery(regexp.QuoteMeta("AND primary_ts = ?")).
+		WithArgs("default", "default/test", "150").
+		WillReturnRows(sqlmock.NewRows([]string{"primary_ts", "secondary_ts"}))
+	_, err = QuerySyncpoint(ctx, db, "default", "default/test", 150)
+	require.True(t, cerror.ErrSyncPointNotFound.Equal(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTables(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	f, err := filter.NewFilter(&config.FilterConfig{Rules: []string{"test.*", "other.*"}}, "", false, false)
+	require.NoError(t, err)
+	rules, err := newTableRules([]string{"TEST.*"}, false)
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT TABLE_SCHEMA, TABLE_NAME FROM information_schema.TABLES")).
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_SCHEMA", "TABLE_NAME"}).
+			AddRow("mysql", "user").
+			AddRow("other", "t1").
+			AddRow("test", "t1").
+			AddRow("test", "t2").
+			AddRow("unmatched", "t1"))
+	tables, err := listTables(context.Background(), db, f, rules)
+	require.NoError(t, err)
+	require.Len(t, tables, 2)
+	require.Equal(t, "test", tables[0].Schema)
+	require.Equal(t, "t1", tables[0].Table)
+	require.Equal(t, "t2", tables[1].Table)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -34,11 +34,31 @@ import (
 	"golang.org/x/time/rate"
 )
 
+// campaigner campaigns the leader of an election, it's implemented by
+// the etcd election and the election in the meta database.
+type campaigner interface {
+	// Campaign blocks until it's elected as the leader with the value.
+	Campaign(ctx context.Context, value string) error
+	// Resign lets the leader start a new election.
+	Resign(ctx context.Context) error
+}
+
+// leaseHolder is implemented by the elections whose leadership can be lost
+// without the etcd session, the leader must step down when Done is closed.
+type leaseHolder interface {
+	Done() <-chan struct{}
+}
+
 type elector struct {
 	// election used for coordinator
-	election *concurrency.Election
+	election campaigner
 	// election used for log coordinator
-	logElection                     *concurrency.Election
+	logElection campaigner
+	// backend is the changefeed metadata store used by the coordinator
+	backend changefeed.Backend
+	// getCoordinatorVersion and getLogCoordinatorVersion return the version of the elected leaders
+	getCoordinatorVersion           func(ctx context.Context, nodeID string) (int64, error)
+	getLogCoordinatorVersion        func(ctx context.Context, nodeID string) (int64, error)
 	coordinatorMaxTaskConcurrency   int
 	coordinatorCheckBalanceInterval time.Duration
 	svr                             *server
@@ -46,18 +66,57 @@ type elector struct {
 
 // NewElector creates the coordinator elector with scheduler settings captured from server startup config.
 func NewElector(server *server, schedulerCfg *config.SchedulerConfig) common.SubModule {
-	election := concurrency.NewElection(server.session,
-		etcd.CaptureOwnerKey(server.EtcdClient.GetClusterID()))
-	logElection := concurrency.NewElection(server.session,
-		etcd.LogCoordinatorKey(server.EtcdClient.GetClusterID()))
 	maxTaskConcurrency, checkBalanceInterval := coordinatorSchedulerSettings(schedulerCfg)
-	return &elector{
-		election:                        election,
-		logElection:                     logElection,
+	e := &elector{
 		coordinatorMaxTaskConcurrency:   maxTaskConcurrency,
 		coordinatorCheckBalanceInterval: checkBalanceInterval,
 		svr:                             server,
 	}
+	if metaStore := server.sqlMetaStore; metaStore != nil {
+		e.election = metaStore.coordinatorElection
+		e.logElection = metaStore.logCoordinatorElection
+		e.backend = metaStore.backend
+		e.getCoordinatorVersion = func(context.Context, string) (int64, error) {
+			return metaStore.coordinatorElection.Revision(), nil
+		}
+		e.getLogCoordinatorVersion = func(context.Context, string) (int64, error) {
+			return metaStore.logCoordinatorElection.Revision(), nil
+		}
+		return e
+	}
+
+	e.election = concurrency.NewElection(server.session,
+		etcd.CaptureOwnerKey(server.EtcdClient.GetClusterID()))
+	e.logElection = concurrency.NewElection(server.session,
+		etcd.LogCoordinatorKey(server.EtcdClient.GetClusterID()))
+	e.backend = changefeed.NewEtcdBackend(server.EtcdClient)
+	e.getCoordinatorVersion = func(ctx context.Context, nodeID string) (int64, error) {
+		return server.EtcdClient.GetOwnerRevision(ctx, config.CaptureID(nodeID))
+	}
+	e.getLogCoordinatorVersion = func(ctx context.Context, nodeID string) (int64, error) {
+		return server.EtcdClient.GetLogCoordinatorRevision(ctx, config.CaptureID(nodeID))
+	}
+	return e
+}
+
+// withLease returns a context which is canceled when the leadership of the election
+// is lost, the leadership of the etcd election is bound to the session of the server,
+// so the context is only canceled by the parent context.
+func withLease(ctx context.Context, election campaigner, nodeID, role string) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	lease, ok := election.(leaseHolder)
+	if !ok {
+		return ctx, cancel
+	}
+	go func() {
+		select {
+		case <-lease.Done():
+			log.Warn("the leader lease is lost, stop the "+role, zap.String("nodeID", nodeID))
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
 }
 
 func (e *elector) Run(ctx context.Context) error {
@@ -132,7 +191,7 @@ func (e *elector) campaignCoordinator(ctx context.Context) error {
 			return nil
 		}
 
-		coordinatorVersion, err := e.svr.EtcdClient.GetOwnerRevision(ctx, config.CaptureID(nodeID))
+		coordinatorVersion, err := e.getCoordinatorVersion(ctx, nodeID)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -143,14 +202,16 @@ func (e *elector) campaignCoordinator(ctx context.Context) error {
 		co := coordinator.New(
 			e.svr.info,
 			e.svr.pdClient,
-			changefeed.NewEtcdBackend(e.svr.EtcdClient),
+			e.backend,
 			e.svr.EtcdClient.GetGCServiceID(),
 			coordinatorVersion,
 			e.coordinatorMaxTaskConcurrency,
 			e.coordinatorCheckBalanceInterval,
 		)
 		e.svr.setCoordinator(co)
-		err = co.Run(ctx)
+		coCtx, cancel := withLease(ctx, e.election, nodeID, "coordinator")
+		err = co.Run(coCtx)
+		cancel()
 		// When coordinator exits, we need to stop it.
 		e.svr.coordinator.Stop()
 		e.svr.setCoordinator(nil)
@@ -257,7 +318,7 @@ func (e *elector) campaignLogCoordinator(ctx context.Context) error {
 			return nil
 		}
 
-		logCoordinatorVersion, err := e.svr.EtcdClient.GetLogCoordinatorRevision(ctx, config.CaptureID(nodeID))
+		logCoordinatorVersion, err := e.getLogCoordinatorVersion(ctx, nodeID)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -267,7 +328,9 @@ func (e *elector) campaignLogCoordinator(ctx context.Context) error {
 			zap.Int64("logCoordinatorVersion", logCoordinatorVersion))
 
 		co := logcoordinator.New()
-		err = co.Run(ctx)
+		coCtx, cancel := withLease(ctx, e.logElection, nodeID, "log coordinator")
+		err = co.Run(coCtx)
+		cancel()
 
 		if err != nil && !errors.Is(err, context.Canceled) {
 			if !errors.ErrNotOwner.Equal(err) {
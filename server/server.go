@@ -111,6 +111,11 @@ type server struct {
 
 	EtcdClient etcd.CDCEtcdClient
 
+	// sqlMetaStore is not nil if the changefeed metadata and the coordinator
+	// election are kept in a database, see config.MetaStoreConfig. The etcd
+	// client is required even so, for the captures and the GC service id.
+	sqlMetaStore *sqlMetaStore
+
 	PDClock pdutil.Clock
 
 	tcpServer tcpserver.TCPServer
@@ -191,6 +196,9 @@ func (c *server) initialize(ctx context.Context) error {
 	}
 
 	nodeManager := watcher.NewNodeManager(c.session, c.EtcdClient)
+	if c.sqlMetaStore != nil {
+		nodeManager.SetCoordinatorIDGetter(c.getCoordinatorID)
+	}
 	nodeManager.RegisterNodeChangeHandler(
 		appctx.MessageCenter,
 		appctx.GetService[messaging.MessageCenter](appctx.MessageCenter).OnNodeChanges)
@@ -590,6 +598,9 @@ func (c *server) Close() {
 	} else {
 		log.Info("server info deleted from etcd", zap.String("captureID", string(c.info.ID)))
 	}
+	if c.sqlMetaStore != nil {
+		c.sqlMetaStore.close()
+	}
 
 	closeGroup.Wait()
 	log.Info("server closed", zap.Any("ServerInfo", c.info))
@@ -636,7 +647,7 @@ func (c *server) GetCoordinatorInfo(ctx context.Context) (*node.Info, error) {
 		return nil, err
 	}
 
-	coordinatorID, err := c.EtcdClient.GetOwnerID(ctx)
+	coordinatorID, err := c.getCoordinatorID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -660,6 +671,15 @@ func (c *server) GetCoordinatorInfo(ctx context.Context) (*node.Info, error) {
 	return nil, errors.ErrOwnerNotFound.FastGenByArgs()
 }
 
+// getCoordinatorID returns the node id of the coordinator, it's elected in the
+// meta database if the mysql meta store is used.
+func (c *server) getCoordinatorID(ctx context.Context) (string, error) {
+	if c.sqlMetaStore != nil {
+		return c.sqlMetaStore.coordinatorElection.Leader(ctx)
+	}
+	return c.EtcdClient.GetOwnerID(ctx)
+}
+
 func isErrCompacted(err error) bool {
 	return strings.Contains(err.Error(), "required revision has been compacted")
 }
@@ -0,0 +1,80 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql" // mysql driver of the meta database
+	"github.com/pingcap/log"
+	"github.com/pingcap/ticdc/coordinator/changefeed"
+	"github.com/pingcap/ticdc/pkg/config"
+	"github.com/pingcap/ticdc/pkg/election"
+	"github.com/pingcap/ticdc/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// sqlMetaStore keeps the changefeed metadata and campaigns the coordinators
+// in a MySQL compatible database instead of the PD etcd. The captures are still
+// registered, kept alive and discovered through the PD etcd.
+type sqlMetaStore struct {
+	db                     *sql.DB
+	backend                *changefeed.SQLBackend
+	coordinatorElection    *election.SQLElection
+	logCoordinatorElection *election.SQLElection
+}
+
+func newSQLMetaStore(ctx context.Context, cfg *config.MetaStoreConfig, clusterID string) (_ *sqlMetaStore, err error) {
+	db, err := sql.Open("mysql", cfg.DSN)
+	if err != nil {
+		return nil, errors.ErrMySQLConnectionError.Wrap(err).GenWithStack("fail to open the meta database")
+	}
+	defer func() {
+		if err != nil {
+			_ = db.Close()
+		}
+	}()
+	if err = db.PingContext(ctx); err != nil {
+		return nil, errors.ErrMySQLConnectionError.Wrap(err).GenWithStack("fail to open the meta database")
+	}
+
+	backend, err := changefeed.NewSQLBackend(ctx, db, clusterID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	leaseTTL := time.Duration(cfg.ElectionLeaseTTL)
+	coordinatorElection, err := election.NewSQLElection(ctx, db, clusterID, election.CoordinatorElection, leaseTTL)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	logCoordinatorElection, err := election.NewSQLElection(ctx, db, clusterID, election.LogCoordinatorElection, leaseTTL)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	log.Info("use the mysql meta store", zap.String("clusterID", clusterID), zap.Duration("electionLeaseTTL", leaseTTL))
+	return &sqlMetaStore{
+		db:                     db,
+		backend:                backend,
+		coordinatorElection:    coordinatorElection,
+		logCoordinatorElection: logCoordinatorElection,
+	}, nil
+}
+
+func (s *sqlMetaStore) close() {
+	if err := s.db.Close(); err != nil {
+		log.Warn("close the meta database failed", zap.Error(err))
+	}
+}
@@ -107,6 +107,19 @@ func (c *server) prepare(ctx context.Context) (err error) {
 	}
 	c.EtcdClient = cdcEtcdClient
 
+	if conf.MetaStore.IsSQL() {
+		c.sqlMetaStore, err = newSQLMetaStore(ctx, conf.MetaStore, conf.ClusterID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer func() {
+			if err != nil {
+				c.sqlMetaStore.close()
+				c.sqlMetaStore = nil
+			}
+		}()
+	}
+
 	// Collect all endpoints from pd here to make the server more robust.
 	// Because in some scenarios, the deployer may only provide one pd endpoint,
 	// this will cause the TiCDC server to fail to restart when some pd watcher is down.
@@ -42,6 +42,9 @@ type NodeManager struct {
 	etcdClient    etcd.CDCEtcdClient
 	coordinatorID atomic.Value
 	nodes         atomic.Pointer[map[node.ID]*node.Info]
+	// getCoordinatorID returns the node id of the elected coordinator,
+	// nil means the coordinator is elected by etcd.
+	getCoordinatorID func(ctx context.Context) (string, error)
 
 	nodeChangeHandlers struct {
 		sync.RWMutex
@@ -75,6 +78,12 @@ func NewNodeManager(
 	return m
 }
 
+// SetCoordinatorIDGetter replaces the way to get the coordinator id, it's used
+// when the coordinator is not elected by etcd. It must be called before the manager runs.
+func (c *NodeManager) SetCoordinatorIDGetter(getter func(ctx context.Context) (string, error)) {
+	c.getCoordinatorID = getter
+}
+
 func (c *NodeManager) Name() string {
 	return NodeManagerName
 }
@@ -92,7 +101,11 @@ func (c *NodeManager) Tick(
 
 	ownerChanged := false
 	oldCoordinatorID := c.coordinatorID.Load().(string)
-	newCoordinatorID, err := c.etcdClient.GetOwnerID(context.Background())
+	getCoordinatorID := c.getCoordinatorID
+	if getCoordinatorID == nil {
+		getCoordinatorID = c.etcdClient.GetOwnerID
+	}
+	newCoordinatorID, err := getCoordinatorID(context.Background())
 	if err != nil {
 		log.Warn("get coordinator id failed, will retry in next tick", zap.Error(err))
 		return state, nil